@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/events"
+)
+
+// TestAdminConfigMarksEnvAndMasksSecrets verifies GET /admin/config shows
+// the config hash and env overrides, and never the secrets themselves.
+func TestAdminConfigMarksEnvAndMasksSecrets(t *testing.T) {
+	t.Setenv("QL_SHADOW_PROVIDER_KIND", "azure_openai")
+	t.Setenv("AZURE_OPENAI_API_KEY", "sk-do-not-print")
+	t.Setenv("AZURE_OPENAI_ENDPOINT", "https://env-resource.example.com")
+
+	s, emitter := newTestServer(t, true)
+	cfg := s.currentConfig().circles
+
+	rec := httptest.NewRecorder()
+	s.handleAdminConfig(rec, httptest.NewRequest(http.MethodGet, "/admin/config", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.String()
+	for _, want := range []string{cfg.Hash(), "QL_SHADOW_PROVIDER_KIND", "AZURE_OPENAI_API_KEY", `class="env"`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected page to contain %q", want)
+		}
+	}
+	for _, secret := range []string{"sk-do-not-print", "env-resource"} {
+		if strings.Contains(body, secret) {
+			t.Errorf("page leaks %q", secret)
+		}
+	}
+
+	viewed := emitter.Query(events.Filter{TypePrefix: string(events.ConfigViewed)})
+	if len(viewed) != 1 || viewed[0].Metadata["config_hash"] != cfg.Hash() {
+		t.Errorf("expected one viewed event with the config hash, got %v", viewed)
+	}
+
+	rec = httptest.NewRecorder()
+	s.handleAdminConfig(rec, httptest.NewRequest(http.MethodPost, "/admin/config", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405 for POST, got %d", rec.Code)
+	}
+}
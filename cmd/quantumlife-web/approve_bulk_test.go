@@ -0,0 +1,60 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/persist"
+	"quantumlife/pkg/domain/approvalflow"
+	"quantumlife/pkg/domain/approvaltoken"
+	"quantumlife/pkg/domain/intersection"
+	"quantumlife/pkg/domain/storelog"
+	"quantumlife/pkg/events"
+)
+
+// TestApproveBulkRejectsForgedTokens verifies POST /approve/bulk checks
+// every token and reports forged ones per item without failing the rest.
+func TestApproveBulkRejectsForgedTokens(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	ledger, err := persist.NewApprovalLedger(storelog.NewInMemoryLog())
+	if err != nil {
+		t.Fatalf("approval ledger: %v", err)
+	}
+	ledger.WithTokenSigner(approvaltoken.NewKeySigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))))
+	s.approvalLedger = ledger
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-a"}, {PersonID: "person-b"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 2, 60, testSeed)
+	if err := ledger.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+	valid, err := ledger.IssueToken(state.StateID, "person-a", approvaltoken.ActionTypeApprove, testSeed)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	forged := approvaltoken.NewToken(state.StateID, "person-b", approvaltoken.ActionTypeApprove, testSeed, state.ExpiresAt)
+
+	form := url.Values{"state_id": {state.StateID, state.StateID}, "t": {valid.Encode(), forged.Encode()}}
+	req := httptest.NewRequest(http.MethodPost, "/approve/bulk", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	s.handleApproveBulk(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if body := rec.Body.String(); !strings.Contains(body, "1 applied, 0 skipped, 1 rejected.") {
+		t.Error("expected the forged token to be reported as rejected")
+	}
+	if ledger.GetApprovalState(state.StateID).GetApproval("person-b") != nil {
+		t.Error("forged token must not record a decision")
+	}
+	if got := emitter.Query(events.Filter{TypePrefix: string(events.Phase18ApprovalBulkApplied)}); len(got) != 1 {
+		t.Errorf("expected one aggregate event, got %d", len(got))
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/persist"
+	"quantumlife/pkg/domain/approvalflow"
+	"quantumlife/pkg/domain/approvaltoken"
+	"quantumlife/pkg/domain/intersection"
+	"quantumlife/pkg/domain/storelog"
+)
+
+// failingLog is an in-memory log whose appends fail once fail is set.
+type failingLog struct {
+	storelog.AppendOnlyLog
+	fail bool
+}
+
+func (l *failingLog) Append(record *storelog.LogRecord) error {
+	if l.fail {
+		return errors.New("disk full")
+	}
+	return l.AppendOnlyLog.Append(record)
+}
+
+// TestApproveReportsLedgerFailure verifies /approve does not call a token
+// valid when its decision could not be recorded.
+func TestApproveReportsLedgerFailure(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	backing := &failingLog{AppendOnlyLog: storelog.NewInMemoryLog()}
+	ledger, err := persist.NewApprovalLedger(backing)
+	if err != nil {
+		t.Fatalf("approval ledger: %v", err)
+	}
+	ledger.WithTokenSigner(approvaltoken.NewKeySigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))))
+	s.approvalLedger = ledger
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-a"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 1, 60, testSeed)
+	if err := ledger.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+	token, err := ledger.IssueToken(state.StateID, "person-a", approvaltoken.ActionTypeApprove, testSeed)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+
+	backing.fail = true
+	rec := httptest.NewRecorder()
+	s.handleApprove(rec, httptest.NewRequest(http.MethodGet, "/approve?t="+token.Encode(), nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+	if strings.Contains(rec.Body.String(), "This is a valid approval token.") {
+		t.Error("an unrecorded decision must not be reported as valid")
+	}
+	if ledger.GetApprovalState(state.StateID).GetApproval("person-a") != nil {
+		t.Error("expected no decision recorded")
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/internal/shadowllm/providers/azureopenai"
+	domainshadow "quantumlife/pkg/domain/shadowllm"
+)
+
+// TestAzureWrapperObserveProducesSignals verifies the Azure wrapper converts
+// a fixed ShadowContext into provider input and the validated output back
+// into bucketed signals.
+func TestAzureWrapperObserveProducesSignals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/openai/deployments/test-deployment/chat/completions") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var req azureopenai.ChatRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if len(req.Messages) != 2 || !strings.Contains(req.Messages[1].Content, "a_few") {
+			t.Errorf("expected bucketed prompt, got %+v", req.Messages)
+		}
+
+		json.NewEncoder(w).Encode(azureopenai.ChatResponse{
+			Choices: []azureopenai.ChatChoice{{
+				Message: azureopenai.ChatMessage{
+					Content: `{"confidence_bucket":"high","horizon_bucket":"soon","magnitude_bucket":"a_few",` +
+						`"category":"money","why_generic":"A few items may need attention.",` +
+						`"suggested_action_class":"surface"}`,
+				},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	provider, err := azureopenai.NewProvider(azureopenai.Config{
+		Endpoint:   server.URL,
+		Deployment: "test-deployment",
+		APIKey:     "test-key",
+	})
+	if err != nil {
+		t.Fatalf("NewProvider failed: %v", err)
+	}
+
+	now := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	ctx := domainshadow.ShadowContext{
+		CircleID:   "circle-personal",
+		InputsHash: "abcdefabcdefabcdefabcdef",
+		Seed:       42,
+		Clock:      func() time.Time { return now },
+		AbstractInputs: domainshadow.AbstractInputs{
+			ObligationCountByCategory: map[domainshadow.AbstractCategory]int{
+				domainshadow.CategoryMoney: 2,
+			},
+			HeldCountByCategory: map[domainshadow.AbstractCategory]int{
+				domainshadow.CategoryTime: 7,
+			},
+		},
+	}
+
+	run, err := wrapAzureProvider(provider, domainshadow.DefaultBucketScale()).Observe(ctx)
+	if err != nil {
+		t.Fatalf("Observe failed: %v", err)
+	}
+	if run.InputsHash != ctx.InputsHash || !run.CreatedAt.Equal(now) {
+		t.Errorf("unexpected run provenance: %+v", run)
+	}
+	if len(run.Signals) != 1 {
+		t.Fatalf("expected 1 signal, got %d", len(run.Signals))
+	}
+
+	sig := run.Signals[0]
+	if err := sig.Validate(); err != nil {
+		t.Errorf("signal should validate: %v", err)
+	}
+	if sig.Category != domainshadow.CategoryMoney {
+		t.Errorf("expected money category, got %s", sig.Category)
+	}
+	if sig.Kind != domainshadow.SignalKindCategoryPressure {
+		t.Errorf("expected category_pressure for surface, got %s", sig.Kind)
+	}
+	if domainshadow.ConfidenceFromFloat(sig.ConfidenceFloat) != domainshadow.ConfidenceHigh {
+		t.Errorf("expected high confidence bucket, got %v", sig.ConfidenceFloat)
+	}
+	if sig.ValueFloat < 0 || sig.ValueFloat >= 0.5 {
+		t.Errorf("expected value in the soon range, got %v", sig.ValueFloat)
+	}
+	if sig.NotesHash == "" || strings.Contains(sig.NotesHash, "attention") {
+		t.Error("notes must be hashed, never carried")
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/csrf"
+)
+
+// TestCSRFProtectedPaths verifies every state-changing request needs a
+// token except on the exempt OAuth callbacks.
+func TestCSRFProtectedPaths(t *testing.T) {
+	for _, tc := range []struct {
+		method, path string
+		want         bool
+	}{
+		{http.MethodPost, "/app/draft/d1/edit", true},
+		{http.MethodPost, "/admin/reload-config", true},
+		{http.MethodPost, "/disconnect/all", true},
+		{http.MethodPost, "/demo/reset", true},
+		{http.MethodPost, "/approve/bulk", true},
+		{http.MethodPost, "/approve/revoke", true},
+		{http.MethodPost, "/suppressions/import", true},
+		{http.MethodPost, "/run/shadow-all", true},
+		{http.MethodPost, "/connect/caldav/callback", true},
+		{http.MethodPost, "/not/a/route", true},
+		{http.MethodPut, "/interest", true},
+		{http.MethodDelete, "/trusted", true},
+		{http.MethodGet, "/approve/revoke", false},
+		{http.MethodHead, "/app", false},
+		{http.MethodPost, "/connect/gmail/callback", false},
+		{http.MethodPost, "/connect/plaid/callback", false},
+	} {
+		if got := csrfProtected(tc.method, tc.path); got != tc.want {
+			t.Errorf("csrfProtected(%s %q) = %v, want %v", tc.method, tc.path, got, tc.want)
+		}
+	}
+	for path := range csrfExemptPaths {
+		if !strings.HasSuffix(path, "/callback") {
+			t.Errorf("csrf exemption %q is not an OAuth callback", path)
+		}
+	}
+}
+
+// TestCSRFProtect verifies pages carry the session token in POST forms and
+// protected POSTs are rejected without it.
+func TestCSRFProtect(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	posted := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("/shadow/candidates", s.handleShadowCandidates)
+	mux.HandleFunc("/shadow/candidates/refresh", func(w http.ResponseWriter, r *http.Request) { posted = true })
+	mux.HandleFunc("/approve/revoke", func(w http.ResponseWriter, r *http.Request) { posted = true })
+	mux.HandleFunc("/connect/gmail/callback", func(w http.ResponseWriter, r *http.Request) { posted = true })
+	h := s.csrfProtect(mux)
+
+	// GET issues a session cookie and embeds its token in POST forms
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/shadow/candidates", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET: expected 200, got %d", rec.Code)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != csrf.CookieName || !cookies[0].HttpOnly {
+		t.Fatalf("expected one HttpOnly session cookie, got %v", cookies)
+	}
+	session := cookies[0]
+	m := regexp.MustCompile(`name="csrf_token" value="([0-9a-f]+)"`).FindStringSubmatch(rec.Body.String())
+	if m == nil {
+		t.Fatal("expected token field in POST form")
+	}
+	token := m[1]
+
+	post := func(path, token string, withCookie bool) int {
+		posted = false
+		form := url.Values{}
+		if token != "" {
+			form.Set(csrf.FieldName, token)
+		}
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if withCookie {
+			req.AddCookie(session)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := post("/shadow/candidates/refresh", "", true); code != http.StatusForbidden || posted {
+		t.Errorf("missing token: expected 403, got %d (handler ran: %v)", code, posted)
+	}
+	if code := post("/shadow/candidates/refresh", token, false); code != http.StatusForbidden || posted {
+		t.Errorf("token without session: expected 403, got %d (handler ran: %v)", code, posted)
+	}
+	if code := post("/shadow/candidates/refresh", strings.Repeat("0", len(token)), true); code != http.StatusForbidden || posted {
+		t.Errorf("forged token: expected 403, got %d (handler ran: %v)", code, posted)
+	}
+	if post("/shadow/candidates/refresh", token, true); !posted {
+		t.Error("valid token: expected handler to run")
+	}
+	if code := post("/approve/revoke", "", true); code != http.StatusForbidden || posted {
+		t.Errorf("unlisted route: expected 403 without a token, got %d (handler ran: %v)", code, posted)
+	}
+	if post("/connect/gmail/callback", "", false); !posted {
+		t.Error("exempt callback: expected handler to run without a token")
+	}
+}
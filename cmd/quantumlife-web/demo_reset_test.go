@@ -0,0 +1,162 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	domainevents "quantumlife/pkg/domain/events"
+	"quantumlife/pkg/domain/policy"
+	"quantumlife/pkg/events"
+)
+
+// TestDemoResetRestoresSeededFixtures verifies POST /demo/reset clears
+// in-memory state and restores the seeded mock fixtures.
+func TestDemoResetRestoresSeededFixtures(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	seededEvents := s.engine.EventStore.Count()
+	seededSummaries := s.trustStore.GetSummaryCount()
+	seededPreference := s.preferenceStore.LatestPreference()
+	if seededEvents == 0 || seededSummaries == 0 {
+		t.Fatalf("expected seeded fixtures, got %d events, %d summaries", seededEvents, seededSummaries)
+	}
+
+	// Drift away from the seeded state
+	extra := domainevents.NewEmailMessageEvent("gmail", "msg-extra", "self@work.com", testSeed, testSeed)
+	if err := s.engine.EventStore.Store(extra); err != nil {
+		t.Fatalf("store extra event: %v", err)
+	}
+	if _, err := s.preferenceStore.Record("show_all", "web"); err != nil {
+		t.Fatalf("record preference: %v", err)
+	}
+	if s.engine.EventStore.Count() == seededEvents {
+		t.Fatal("expected state to drift before reset")
+	}
+	seededPolicies := s.policyStore.Get()
+	if err := s.policyStore.UpdateCircle("work", func(cp policy.CirclePolicy) policy.CirclePolicy {
+		cp.NotifyThreshold++
+		return cp
+	}, testSeed); err != nil {
+		t.Fatalf("edit policy: %v", err)
+	}
+	s.handleFirstMinutesDismiss(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/first-minutes/dismiss", nil))
+	eventStore, routes := s.engine.EventStore, s.routes
+
+	rec := httptest.NewRecorder()
+	s.handleDemoReset(rec, httptest.NewRequest(http.MethodPost, "/demo/reset", nil))
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected redirect, got %d", rec.Code)
+	}
+
+	// Stores are cleared in place, not replaced
+	if s.engine.EventStore != eventStore || s.routes != routes {
+		t.Error("expected reset to keep the server's stores and routes")
+	}
+	if got := s.policyStore.Get(); got.Circles["work"].NotifyThreshold != seededPolicies.Circles["work"].NotifyThreshold {
+		t.Errorf("expected the default work policy after reset, got %+v", got.Circles["work"])
+	}
+	page := httptest.NewRecorder()
+	s.handleToday(page, httptest.NewRequest(http.MethodGet, "/today", nil))
+	if !strings.Contains(page.Body.String(), "first-minutes-cue") {
+		t.Error("expected the first-minutes dismissal to be cleared")
+	}
+
+	if got := s.engine.EventStore.Count(); got != seededEvents {
+		t.Errorf("expected %d events after reset, got %d", seededEvents, got)
+	}
+	if got := s.trustStore.GetSummaryCount(); got != seededSummaries {
+		t.Errorf("expected %d trust summaries after reset, got %d", seededSummaries, got)
+	}
+	if got := s.preferenceStore.LatestPreference(); got != seededPreference {
+		t.Errorf("expected preference %q after reset, got %q", seededPreference, got)
+	}
+	if emitter.Count(events.DemoReset) != 1 {
+		t.Error("expected one demo reset event")
+	}
+}
+
+// TestDemoResetDuringRequests verifies a reset waits for requests in
+// flight and never races them. Run with -race.
+func TestDemoResetDuringRequests(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+	handler := s.demoGuard(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/demo/reset":
+			s.handleDemoReset(w, r)
+		default:
+			s.handleToday(w, r)
+		}
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/today", nil))
+		}()
+		go func() {
+			defer wg.Done()
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/demo/reset", nil))
+		}()
+	}
+	wg.Wait()
+
+	if got := emitter.Count(events.DemoReset); got != 4 {
+		t.Errorf("expected 4 demo reset events, got %d", got)
+	}
+	if s.trustStore.GetSummaryCount() != 3 {
+		t.Errorf("expected the seeded trust summaries once, got %d", s.trustStore.GetSummaryCount())
+	}
+}
+
+// TestDemoResetRefusedWithoutMock verifies reset is refused in non-mock mode.
+func TestDemoResetRefusedWithoutMock(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+	s.opts.mockData = false
+
+	rec := httptest.NewRecorder()
+	s.handleDemoReset(rec, httptest.NewRequest(http.MethodPost, "/demo/reset", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 in non-mock mode, got %d", rec.Code)
+	}
+	if emitter.Count(events.DemoReset) != 0 {
+		t.Error("refused reset must not emit a reset event")
+	}
+}
+
+// TestDemoResetRegistersEveryStore verifies every store the server or its
+// engine holds with a Clear method is registered with the demo reset, so
+// a new store cannot survive a reset by being forgotten.
+func TestDemoResetRegistersEveryStore(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	registered := make(map[uintptr]bool)
+	for _, store := range s.demo.stores {
+		if v := reflect.ValueOf(store); v.Kind() == reflect.Pointer {
+			registered[v.Pointer()] = true
+		}
+	}
+
+	storeType := reflect.TypeOf((*demoStore)(nil)).Elem()
+	check := func(owner reflect.Value, prefix string) {
+		for i := 0; i < owner.NumField(); i++ {
+			field, name := owner.Field(i), prefix+owner.Type().Field(i).Name
+			if field.Kind() == reflect.Interface {
+				field = field.Elem()
+			}
+			if field.Kind() != reflect.Pointer || field.IsNil() || !field.Type().Implements(storeType) {
+				continue
+			}
+			if !registered[field.Pointer()] {
+				t.Errorf("%s is not registered with the demo reset", name)
+			}
+		}
+	}
+	check(reflect.ValueOf(s).Elem(), "")
+	check(reflect.ValueOf(s.engine).Elem(), "engine.")
+}
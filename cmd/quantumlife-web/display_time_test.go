@@ -0,0 +1,32 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+)
+
+// TestDisplayTimezoneFlowsIntoCurrentTime verifies the configured display
+// zone is applied to the rendered CurrentTime, with UTC as the default.
+func TestDisplayTimezoneFlowsIntoCurrentTime(t *testing.T) {
+	render := func(zone string) string {
+		cfg := config.DefaultConfig(testSeed)
+		cfg.DisplayTimezone = zone
+		s, _ := newTestServerWith(t, clock.NewFixed(testSeed), cfg, true)
+
+		rec := httptest.NewRecorder()
+		s.handleHistory(rec, httptest.NewRequest(http.MethodGet, "/history", nil))
+		return rec.Body.String()
+	}
+
+	if body := render(""); !strings.Contains(body, "2025-01-15 09:00:00") {
+		t.Error("expected default display zone to be UTC")
+	}
+	if body := render("Asia/Tokyo"); !strings.Contains(body, "2025-01-15 18:00:00") {
+		t.Error("expected CurrentTime to be formatted in the configured zone")
+	}
+}
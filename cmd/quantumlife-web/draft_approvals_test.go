@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/approvalflow"
+	"quantumlife/pkg/domain/approvaltoken"
+	"quantumlife/pkg/domain/draft"
+	domainshadow "quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/events"
+)
+
+// TestExecuteChecksApprovalsByActionClass verifies POST /execute/:id runs
+// drafts below their action class threshold on confirm and sends the rest
+// through the approval-token flow, on the default server config.
+func TestExecuteChecksApprovalsByActionClass(t *testing.T) {
+	s, emitter := newTestServer(t, false)
+
+	putApprovedDraft(t, s, "draft-cal", draft.DraftTypeCalendarResponse, mockCalendarAccept)
+	putApprovedDraft(t, s, "draft-email", draft.DraftTypeEmailReply, draft.EmailDraftContent{To: "alice@example.com", Subject: "Re: Plans", Body: "Sounds good.", ThreadID: "thread-1", InReplyToMessageID: "msg-1", ProviderHint: "mock"})
+
+	execute := func(id string) string {
+		rec := httptest.NewRecorder()
+		s.handleExecute(rec, httptest.NewRequest(http.MethodPost, "/execute/"+id, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("execute %s: status %d", id, rec.Code)
+		}
+		return rec.Body.String()
+	}
+
+	if page := execute("draft-cal"); strings.Contains(page, "approvals") {
+		t.Error("calendar responses need no approvals and must not be gated")
+	}
+	if page := execute("draft-email"); !strings.Contains(page, "Approval requested") || !strings.Contains(page, "0 recorded so far") {
+		t.Error("expected an approval request for the email draft")
+	}
+
+	states := s.approvalLedger.ListApprovalStates()
+	if len(states) != 1 || states[0].Threshold != 1 || states[0].ActionHash == "" {
+		t.Fatalf("expected one action-bound approval state with threshold 1, got %+v", states)
+	}
+	state := states[0]
+	for _, e := range emitter.Query(events.Filter{TypePrefix: string(events.Phase10ExecutionBlocked)}) {
+		if err := events.ValidateMetadataPrivacy(e.Metadata); err != nil {
+			t.Errorf("blocked event metadata: %v", err)
+		}
+	}
+	created := emitter.Query(events.Filter{TypePrefix: string(events.Phase15ApprovalStateCreated)})
+	if len(created) != 1 {
+		t.Fatalf("expected 1 approval state created event, got %d", len(created))
+	}
+	if err := events.ValidateMetadataPrivacy(created[0].Metadata); err != nil {
+		t.Errorf("approval state created metadata: %v", err)
+	}
+	if got := created[0].Metadata["threshold"]; got != string(domainshadow.MagnitudeFromCount(1)) {
+		t.Errorf("expected a bucketed threshold, got %q", got)
+	}
+
+	// Executing again while the request is pending reuses the same state.
+	execute("draft-email")
+	if got := len(s.approvalLedger.ListApprovalStates()); got != 1 {
+		t.Errorf("expected the pending approval state to be reused, got %d states", got)
+	}
+
+	var approve *approvaltoken.Token
+	for _, tok := range s.approvalLedger.GetTokensForStateAndPerson(state.StateID, ownerID) {
+		if tok.ActionType == approvaltoken.ActionTypeApprove {
+			approve = tok
+		}
+	}
+	if approve == nil {
+		t.Fatal("expected an approve token issued to the owner")
+	}
+	rec := httptest.NewRecorder()
+	s.handleApprove(rec, httptest.NewRequest(http.MethodGet, "/approve?t="+approve.Encode(), nil))
+	if got := s.approvalLedger.GetApprovalState(state.StateID); got == nil || got.ComputeStatus(testSeed) != approvalflow.StatusApproved {
+		t.Fatalf("expected the owner's token to approve the state")
+	}
+
+	if page := execute("draft-email"); strings.Contains(page, "approvals;") {
+		t.Error("expected the approved email draft to pass the approval gate")
+	}
+}
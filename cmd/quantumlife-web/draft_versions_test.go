@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/events"
+)
+
+// TestDraftVersionsEditRegenerateRevert verifies POST /app/draft/:id/*
+// record versions, keep the draft's content on the selected version and
+// emit version events without draft text.
+func TestDraftVersionsEditRegenerateRevert(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	circleID := identity.NewGenerator().CircleFromName("owner-1", "Personal", testSeed).ID()
+	original := "Thank you for your email regarding \"Plans\".\n\nI will check and reply tomorrow."
+	_ = s.engine.DraftStore.Put(draft.Draft{
+		DraftID:   "draft-versions-1",
+		DraftType: draft.DraftTypeEmailReply,
+		CircleID:  circleID,
+		Status:    draft.StatusProposed,
+		CreatedAt: testSeed,
+		ExpiresAt: testSeed.Add(48 * time.Hour),
+		Content:   draft.EmailDraftContent{To: "alice@example.com", Subject: "Re: Plans", Body: original, ThreadID: "thread-versions-1"},
+	})
+
+	post := func(action string, form url.Values) int {
+		req := httptest.NewRequest(http.MethodPost, "/app/draft/draft-versions-1/"+action, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		s.handleAppDraft(rec, req)
+		return rec.Code
+	}
+	body := func() string {
+		d, _ := s.engine.DraftStore.Get("draft-versions-1")
+		b, _ := draft.ContentBody(d.Content)
+		return b
+	}
+
+	if code := post("edit", url.Values{"body": {"Sounds good, see you then."}}); code != http.StatusFound {
+		t.Fatalf("edit: expected redirect, got %d", code)
+	}
+	if code := post("regenerate", url.Values{"hint": {"warmer"}}); code != http.StatusFound {
+		t.Fatalf("regenerate: expected redirect, got %d", code)
+	}
+	if got := body(); got != "Hi,\n\nSounds good, see you then.\n\nThanks so much!" {
+		t.Errorf("unexpected regenerated body %q", got)
+	}
+	if code := post("regenerate", url.Values{"hint": {"louder"}}); code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown hint, got %d", code)
+	}
+
+	rec := httptest.NewRecorder()
+	s.handleAppDraft(rec, httptest.NewRequest(http.MethodGet, "/app/draft/draft-versions-1?compare=1", nil))
+	page := rec.Body.String()
+	if rec.Code != http.StatusOK || !strings.Contains(page, "Changes from version 1") || !strings.Contains(page, "+ Thanks so much!") {
+		t.Errorf("expected a diff against version 1, got %d", rec.Code)
+	}
+
+	if code := post("select", url.Values{"version": {"1"}}); code != http.StatusFound {
+		t.Fatalf("select: expected redirect, got %d", code)
+	}
+	if got := body(); got != original {
+		t.Errorf("expected the original body after revert, got %q", got)
+	}
+
+	created := emitter.Query(events.Filter{TypePrefix: string(events.EventDraftVersionCreated)})
+	selected := emitter.Query(events.Filter{TypePrefix: string(events.EventDraftVersionSelected)})
+	if len(created) != 2 || len(selected) != 1 {
+		t.Fatalf("expected 2 created and 1 selected events, got %d and %d", len(created), len(selected))
+	}
+	if meta := created[1].Metadata; meta["version"] != "3" || meta["hints"] != "warmer" || meta["version_count"] != "3" {
+		t.Errorf("unexpected created metadata: %v", meta)
+	}
+	if meta := selected[0].Metadata; meta["version"] != "1" || meta["previous_version"] != "3" {
+		t.Errorf("unexpected selected metadata: %v", meta)
+	}
+
+	// Approved drafts are locked to their selected version.
+	_ = s.engine.DraftStore.UpdateStatus("draft-versions-1", draft.StatusApproved, "ok", "user", testSeed)
+	if code := post("edit", url.Values{"body": {"Too late"}}); code != http.StatusConflict {
+		t.Errorf("expected 409 for an approved draft, got %d", code)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/events"
+)
+
+// TestEventsQueryFiltersAndHidesUnsafeMetadata verifies GET /events filters
+// the stream and never renders metadata that fails the privacy lint.
+func TestEventsQueryFiltersAndHidesUnsafeMetadata(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	// Bypass the lint to simulate an unsafe value already in the buffer
+	emitter.Buffer.Emit(events.Event{Type: "phase99.test.viewed", Timestamp: testSeed, CircleID: "personal",
+		Metadata: map[string]string{"hash": "abc123", "from": "someone@example.com"}})
+	emitter.Buffer.Emit(events.Event{Type: "phase98.other", Timestamp: testSeed})
+
+	s.opts.debug = false
+	rec := httptest.NewRecorder()
+	s.handleEvents(rec, httptest.NewRequest(http.MethodGet, "/events", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 without -debug, got %d", rec.Code)
+	}
+
+	s.opts.debug = true
+	rec = httptest.NewRecorder()
+	s.handleEvents(rec, httptest.NewRequest(http.MethodGet, "/events?type=phase99.&circle_id=personal", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "phase99.test.viewed") || !strings.Contains(body, "hash=abc123") {
+		t.Error("expected matching event with safe metadata")
+	}
+	if strings.Contains(body, "someone") || strings.Contains(body, "phase98.other") {
+		t.Error("expected unsafe metadata and non-matching events to be hidden")
+	}
+
+	rec = httptest.NewRecorder()
+	s.handleEvents(rec, httptest.NewRequest(http.MethodGet, "/events?since=yesterday", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for bad since, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/events"
+)
+
+// TestExecuteDryRun verifies that in dry-run mode POST /execute/:id routes
+// the draft and reports a simulated outcome without calling a writer.
+func TestExecuteDryRun(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+	s.execExecutor.SetDryRun(true)
+
+	putApprovedDraft(t, s, "draft-cal", draft.DraftTypeCalendarResponse, mockCalendarAccept)
+
+	rec := httptest.NewRecorder()
+	s.handleExecute(rec, httptest.NewRequest(http.MethodPost, "/execute/draft-cal", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d", rec.Code)
+	}
+	page := rec.Body.String()
+	if !strings.Contains(page, "Dry run complete.") || !strings.Contains(page, "nothing was sent") {
+		t.Error("expected the page to report a dry run")
+	}
+
+	if dryRuns := emitter.Query(events.Filter{TypePrefix: string(events.Phase10ExecutionDryRun)}); len(dryRuns) != 1 {
+		t.Errorf("expected 1 dry-run event, got %d", len(dryRuns))
+	}
+	for _, prefix := range []events.EventType{events.Phase10ExecutionSucceeded, events.Phase10ExecutionFailed} {
+		if got := emitter.Query(events.Filter{TypePrefix: string(prefix)}); len(got) != 0 {
+			t.Errorf("expected no %s events in dry-run mode, got %d", prefix, len(got))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/events"
+)
+
+// TestExecuteDoublePostExecutesOnce verifies a resubmitted execute form
+// reaches the boundary executor once and shows the prior outcome.
+func TestExecuteDoublePostExecutesOnce(t *testing.T) {
+	s, emitter := newTestServer(t, false)
+
+	putApprovedDraft(t, s, "draft-cal", draft.DraftTypeCalendarResponse, mockCalendarAccept)
+
+	var pages []string
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		s.handleExecute(rec, httptest.NewRequest(http.MethodPost, "/execute/draft-cal", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("execute %d: status %d", i+1, rec.Code)
+		}
+		pages = append(pages, rec.Body.String())
+	}
+
+	if routed := emitter.Query(events.Filter{TypePrefix: string(events.Phase10ExecutionRouted)}); len(routed) != 1 {
+		t.Errorf("expected 1 routed execution, got %d", len(routed))
+	}
+	if strings.Contains(pages[0], "Already executed") {
+		t.Error("the first request must execute")
+	}
+	if !strings.Contains(pages[1], "Already executed") {
+		t.Error("expected the repeat request to show the prior outcome")
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/events"
+)
+
+// testSeed is the fixed time test servers are seeded at.
+var testSeed = time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+
+// newTestServer builds a server with the default config on a clock fixed
+// at testSeed. Tests whose mock writes fail pass strict=false: execution
+// failure events carry free-text error detail, which strict event privacy
+// rejects.
+func newTestServer(t *testing.T, strict bool) (*Server, *eventLogger) {
+	t.Helper()
+	return newTestServerWith(t, clock.NewFixed(testSeed), config.DefaultConfig(testSeed), strict)
+}
+
+// newTestServerWith is newTestServer with its own clock and config. The
+// server gets the default command-line options.
+func newTestServerWith(t *testing.T, clk clock.Clock, cfg *config.MultiCircleConfig, strict bool) (*Server, *eventLogger) {
+	t.Helper()
+	emitter := &eventLogger{Buffer: events.NewBuffer(0), strictPrivacy: strict}
+	s, _ := newServer(clk, cfg, emitter, testSeed, optionsFromFlags())
+	return s, emitter
+}
+
+// putApprovedDraft stores an approved draft that is ready to execute.
+func putApprovedDraft(t *testing.T, s *Server, id draft.DraftID, draftType draft.DraftType, content draft.DraftContent) {
+	t.Helper()
+	err := s.engine.DraftStore.Put(draft.Draft{
+		DraftID:            id,
+		DraftType:          draftType,
+		CircleID:           "circle-1",
+		Status:             draft.StatusApproved,
+		CreatedAt:          testSeed,
+		ExpiresAt:          testSeed.Add(48 * time.Hour),
+		Content:            content,
+		PolicySnapshotHash: "policy-hash",
+		ViewSnapshotHash:   "view-hash",
+	})
+	if err != nil {
+		t.Fatalf("put draft %s: %v", id, err)
+	}
+}
+
+// mockCalendarAccept is the content of a calendar draft routed to the mock
+// writer.
+var mockCalendarAccept = draft.CalendarDraftContent{EventID: "ev-1", Response: draft.CalendarResponseAccept, ProviderHint: "mock"}
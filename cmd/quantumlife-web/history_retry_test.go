@@ -0,0 +1,62 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/execexecutor"
+	"quantumlife/pkg/domain/draft"
+)
+
+// TestHistoryRetry verifies a failed execution can be retried from
+// /history as a new envelope, and that retries stop at the cap.
+func TestHistoryRetry(t *testing.T) {
+	s, _ := newTestServer(t, false)
+
+	putApprovedDraft(t, s, "draft-cal", draft.DraftTypeCalendarResponse, mockCalendarAccept)
+	s.handleExecute(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/execute/draft-cal", nil))
+
+	failed := s.execExecutor.History(execexecutor.HistoryFilter{Status: execexecutor.HistoryFailed})
+	if len(failed) != 1 {
+		t.Fatalf("expected one failed envelope, got %d", len(failed))
+	}
+	rec := httptest.NewRecorder()
+	s.handleHistory(rec, httptest.NewRequest(http.MethodGet, "/history", nil))
+	if !strings.Contains(rec.Body.String(), `action="/history/retry/`+failed[0].EnvelopeID+`"`) {
+		t.Fatal("expected a retry button on the failed envelope")
+	}
+
+	retry := func(method, envelopeID string) (int, string) {
+		rec := httptest.NewRecorder()
+		s.handleHistoryRetry(rec, httptest.NewRequest(method, "/history/retry/"+envelopeID, nil))
+		return rec.Code, rec.Body.String()
+	}
+	if code, _ := retry(http.MethodGet, failed[0].EnvelopeID); code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: status %d, want 405", code)
+	}
+	if code, _ := retry(http.MethodPost, "env-missing"); code != http.StatusNotFound {
+		t.Errorf("unknown envelope: status %d, want 404", code)
+	}
+
+	// The mock writer keeps failing, so each retry adds a failed envelope
+	// for the next retry. The cap itself is covered in execexecutor.
+	envelopeID := failed[0].EnvelopeID
+	seen := map[string]bool{envelopeID: true}
+	for attempt := 1; attempt <= execexecutor.MaxRetriesPerEnvelope; attempt++ {
+		code, page := retry(http.MethodPost, envelopeID)
+		if code != http.StatusOK || !strings.Contains(page, "Retry attempt") || !strings.Contains(page, envelopeID) {
+			t.Fatalf("attempt %d: status %d, expected the retry to link envelope %s", attempt, code, envelopeID)
+		}
+		for _, entry := range s.execExecutor.History(execexecutor.HistoryFilter{Status: execexecutor.HistoryFailed}) {
+			if !seen[entry.EnvelopeID] {
+				envelopeID = entry.EnvelopeID
+				seen[envelopeID] = true
+			}
+		}
+	}
+	if _, page := retry(http.MethodPost, envelopeID); !strings.Contains(page, "retry limit") {
+		t.Error("expected the page to report the retry limit")
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
+)
+
+// TestHistoryFilters verifies /history lists executed envelopes, applies
+// the kind and status filters and never shows draft content.
+func TestHistoryFilters(t *testing.T) {
+	s, _ := newTestServer(t, false)
+	s.engine.DraftEngine.SetApprovalThresholds(execintent.DefaultApprovalThresholds().WithOverrides(map[execintent.ActionClass]int{execintent.ActionEmailSend: 0}))
+
+	putApprovedDraft(t, s, "draft-cal", draft.DraftTypeCalendarResponse, mockCalendarAccept)
+	putApprovedDraft(t, s, "draft-email", draft.DraftTypeEmailReply, draft.EmailDraftContent{To: "alice@example.com", Subject: "Re: Secret plans", Body: "Sounds good.", ThreadID: "thread-1", InReplyToMessageID: "msg-1", ProviderHint: "mock"})
+	for _, id := range []string{"draft-cal", "draft-email"} {
+		s.handleExecute(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/execute/"+id, nil))
+	}
+
+	get := func(query string) (int, string) {
+		rec := httptest.NewRecorder()
+		s.handleHistory(rec, httptest.NewRequest(http.MethodGet, "/history"+query, nil))
+		return rec.Code, rec.Body.String()
+	}
+
+	code, page := get("")
+	if code != http.StatusOK {
+		t.Fatalf("status %d", code)
+	}
+	if !strings.Contains(page, "/draft/draft-cal") || !strings.Contains(page, "/draft/draft-email") {
+		t.Error("expected both executions in the unfiltered history")
+	}
+	for _, private := range []string{"alice@example.com", "Secret plans", "Sounds good."} {
+		if strings.Contains(page, private) {
+			t.Errorf("history must stay abstract, found %q", private)
+		}
+	}
+
+	if _, page := get("?kind=calendar"); !strings.Contains(page, "/draft/draft-cal") || strings.Contains(page, "/draft/draft-email") {
+		t.Error("expected kind=calendar to list only the calendar execution")
+	}
+	if _, page := get("?kind=email&status=cancelled"); strings.Contains(page, "/draft/draft-email") || !strings.Contains(page, "No executions match.") {
+		t.Error("expected status=cancelled to exclude the email execution")
+	}
+	if _, page := get("?until=2025-01-14"); strings.Contains(page, "/draft/draft-") {
+		t.Error("expected until to exclude later executions")
+	}
+	if _, page := get("?from=2025-01-15&until=2025-01-15"); !strings.Contains(page, "/draft/draft-email") {
+		t.Error("expected from and until to include the whole day")
+	}
+
+	for _, query := range []string{"?kind=sms", "?status=done", "?from=15-01-2025"} {
+		if code, _ := get(query); code != http.StatusBadRequest {
+			t.Errorf("%s: status %d, want 400", query, code)
+		}
+	}
+}
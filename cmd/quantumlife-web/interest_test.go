@@ -0,0 +1,69 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/internal/interest"
+	"quantumlife/pkg/events"
+)
+
+// TestInterestThrottleLooksLikeSuccess verifies an over-limit submission
+// gets the same response as a registration but registers nothing.
+func TestInterestThrottleLooksLikeSuccess(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+	s.interestLimiter = interest.NewLimiter(1, time.Minute, s.clk.Now)
+
+	first := postInterest(t, s, "first@example.com")
+	second := postInterest(t, s, "second@example.com")
+	if first != second {
+		t.Error("throttled response should match the registration response")
+	}
+	if !strings.Contains(second, "Noted. We&#39;ll be in touch when this is real.") {
+		t.Error("expected the calm noted message")
+	}
+	if got := s.interestStore.Count(); got != 1 {
+		t.Errorf("expected 1 registration, got %d", got)
+	}
+	if got := len(emitter.Query(events.Filter{TypePrefix: string(events.Phase18_1InterestThrottled)})); got != 1 {
+		t.Errorf("expected 1 throttled event, got %d", got)
+	}
+}
+
+// TestInterestThrottleKeepsValidationErrors verifies an invalid address
+// gets the same validation error over the limit as under it.
+func TestInterestThrottleKeepsValidationErrors(t *testing.T) {
+	s, _ := newTestServer(t, true)
+	s.interestLimiter = interest.NewLimiter(1, time.Minute, s.clk.Now)
+
+	underLimit := postInterest(t, s, "not-an-email")
+	postInterest(t, s, "first@example.com") // Spends the only token
+	overLimit := postInterest(t, s, "not-an-email")
+
+	if underLimit != overLimit {
+		t.Error("an invalid address should get the same response over the limit")
+	}
+	if !strings.Contains(overLimit, "That doesn&#39;t look like an email address.") {
+		t.Error("expected the validation error over the limit")
+	}
+}
+
+// postInterest submits email to /interest from a fixed client address and
+// returns the page.
+func postInterest(t *testing.T, s *Server, email string) string {
+	t.Helper()
+	form := url.Values{"email": {email}}
+	req := httptest.NewRequest(http.MethodPost, "/interest", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = "203.0.113.7:51000"
+	rec := httptest.NewRecorder()
+	s.handleInterest(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	return rec.Body.String()
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/interruptions"
+	"quantumlife/pkg/events"
+)
+
+// TestPolicyEditUpdatesCircle verifies POST /policies/:id validates the
+// form, applies it only against the hash it was loaded from, and records
+// bucketed before/after values.
+func TestPolicyEditUpdatesCircle(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	post := func(form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/policies/work", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		s.handlePolicyDetail(rec, req)
+		return rec
+	}
+
+	loaded := s.policyStore.Hash()
+	rec := httptest.NewRecorder()
+	s.handlePolicyDetail(rec, httptest.NewRequest(http.MethodGet, "/policies/work", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `name="policy_hash" value="`+loaded+`"`) {
+		t.Fatalf("expected the form to carry the policy hash, got %d", rec.Code)
+	}
+
+	if rec := post(url.Values{"policy_hash": {loaded}, "urgent_threshold": {"50"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for non-monotonic thresholds, got %d", rec.Code)
+	}
+	if rec := post(url.Values{"policy_hash": {loaded}, "daily_notify_quota": {"101"}}); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an out-of-range quota, got %d", rec.Code)
+	}
+	if s.policyStore.Hash() != loaded {
+		t.Fatal("invalid edits must not change the policy")
+	}
+
+	rec = post(url.Values{"policy_hash": {loaded}, "daily_notify_quota": {"25"}})
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected redirect, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if q := s.policyStore.Get().Circles["work"].DailyNotifyQuota; q != 25 {
+		t.Errorf("expected quota 25, got %d", q)
+	}
+	updated := emitter.Query(events.Filter{TypePrefix: string(events.Phase14PolicyUpdated)})
+	if len(updated) != 1 {
+		t.Fatalf("expected 1 policy updated event, got %d", len(updated))
+	}
+	meta := updated[0].Metadata
+	if meta["before_daily_notify"] != "few" || meta["after_daily_notify"] != "many" || meta["new_hash"] != s.policyStore.Hash() {
+		t.Errorf("unexpected event metadata: %v", meta)
+	}
+
+	// A second edit from the same (now stale) page is refused.
+	if rec := post(url.Values{"policy_hash": {loaded}, "daily_notify_quota": {"1"}}); rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for a stale hash, got %d", rec.Code)
+	}
+	if q := s.policyStore.Get().Circles["work"].DailyNotifyQuota; q != 25 {
+		t.Errorf("stale edit must not apply, got quota %d", q)
+	}
+
+	// The regret threshold and queued quota are editable too.
+	rec = post(url.Values{"policy_hash": {s.policyStore.Hash()}, "regret_threshold": {"40"}, "daily_queued_quota": {"5"}})
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected redirect, got %d: %s", rec.Code, rec.Body.String())
+	}
+	after := s.policyStore.Get().Circles["work"]
+	if after.RegretThreshold != 40 || after.DailyQueuedQuota != 5 {
+		t.Errorf("expected regret threshold 40 and queued quota 5, got %d and %d", after.RegretThreshold, after.DailyQueuedQuota)
+	}
+}
+
+// TestDefaultPoliciesKeepEngineDefaults verifies the policies shown before
+// any edit are the interruption engine's defaults, so installing them
+// changes no thresholds or quotas.
+func TestDefaultPoliciesKeepEngineDefaults(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	ps := s.policyStore.Get()
+	if len(ps.Circles) == 0 {
+		t.Fatal("expected default circle policies")
+	}
+	for id, cp := range ps.Circles {
+		if want := s.engine.InterruptionEngine.DefaultCirclePolicy(id); cp.CanonicalString() != want.CanonicalString() {
+			t.Errorf("%s: expected engine defaults %+v, got %+v", id, want, cp)
+		}
+	}
+
+	work := ps.Circles["work"]
+	if want := interruptions.DefaultQuotaConfig().MaxNotifyUrgentPerDay["work"]; work.DailyNotifyQuota != want {
+		t.Errorf("expected work notify quota %d, got %d", want, work.DailyNotifyQuota)
+	}
+	if cfg := interruptions.DefaultConfig(); work.NotifyThreshold != cfg.NotifyThreshold || work.UrgentThreshold != cfg.UrgentThreshold {
+		t.Errorf("expected work thresholds %d/%d, got %d/%d",
+			cfg.NotifyThreshold, cfg.UrgentThreshold, work.NotifyThreshold, work.UrgentThreshold)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/feedback"
+	"quantumlife/pkg/domain/interrupt"
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/view"
+)
+
+// TestPolicySuggestions verifies /policies suggests a higher notify
+// threshold after repeated unnecessary interruptions, changes nothing by
+// itself, and that accepting it goes through the policy edit form and
+// changes what the interruption engine notifies.
+func TestPolicySuggestions(t *testing.T) {
+	now := testSeed
+	s, _ := newTestServerWith(t, clock.NewFunc(func() time.Time { return now }), config.DefaultConfig(testSeed), true)
+
+	policies := func() string {
+		rec := httptest.NewRecorder()
+		s.handlePolicies(rec, httptest.NewRequest(http.MethodGet, "/policies", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status %d", rec.Code)
+		}
+		return rec.Body.String()
+	}
+	if strings.Contains(policies(), `name="notify_threshold"`) {
+		t.Fatal("expected no suggestion without feedback")
+	}
+
+	now = testSeed.Add(time.Hour)
+	for i := 0; i < feedback.MinUnwantedForSuggestion; i++ {
+		if _, err := s.engine.RecordFeedback(feedback.TargetInterruption, fmt.Sprintf("int-%d", i), "work", feedback.SignalUnnecessary, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	before := s.policyStore.Get()
+	work := before.Circles["work"]
+	proposed := work.NotifyThreshold + feedback.NotifyThresholdStep
+	if proposed > work.UrgentThreshold {
+		proposed = work.UrgentThreshold
+	}
+	if !strings.Contains(policies(), `name="notify_threshold" value="`+strconv.Itoa(proposed)+`"`) {
+		t.Fatalf("expected a suggestion to raise the work notify threshold to %d", proposed)
+	}
+	if s.policyStore.Hash() != before.Hash {
+		t.Fatal("a suggestion must not change the policy")
+	}
+
+	// Regret 75 for work: base 15, due within 24 hours 30, obligation regret 30.
+	dueSoon := obligation.NewObligation("work", "evt-suggest", "email", obligation.ObligationReview, now).
+		WithDueBy(now.Add(20*time.Hour), now).WithScoring(1, 0.9)
+	level := func() interrupt.Level {
+		engine := s.engine.InterruptionEngine.Fresh(clock.NewFixed(now))
+		daily := view.NewDailyViewBuilder(now, view.DefaultNeedsYouConfig()).Build()
+		result := engine.Process(daily, []*obligation.Obligation{dueSoon})
+		if len(result.Interruptions) != 1 {
+			t.Fatalf("expected 1 interruption, got %d", len(result.Interruptions))
+		}
+		return result.Interruptions[0].Level
+	}
+	if got := level(); got != interrupt.LevelNotify {
+		t.Fatalf("expected notify at the current threshold, got %s", got)
+	}
+
+	// Accepting posts the same form the policy edit page uses.
+	form := url.Values{"policy_hash": {before.Hash}, "notify_threshold": {strconv.Itoa(proposed)}}
+	req := httptest.NewRequest(http.MethodPost, "/policies/work", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	s.handlePolicyDetail(rec, req)
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected redirect, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if got := s.policyStore.Get().Circles["work"].NotifyThreshold; got != proposed {
+		t.Errorf("expected notify threshold %d, got %d", proposed, got)
+	}
+	if got := level(); got != interrupt.LevelQueued {
+		t.Errorf("expected the accepted threshold to queue instead of notify, got %s", got)
+	}
+
+	// The feedback behind the accepted suggestion is not counted again.
+	if strings.Contains(policies(), `name="notify_threshold"`) {
+		t.Error("expected no suggestion after accepting it")
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/events"
+)
+
+// TestPreferenceHistoryRevert verifies the history page lists past choices
+// by day and that reverting restores an earlier preference.
+func TestPreferenceHistoryRevert(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	if _, err := s.preferenceStore.Record("show_all", "web"); err != nil {
+		t.Fatalf("record preference: %v", err)
+	}
+	if _, err := s.preferenceStore.Record("quiet", "web"); err != nil {
+		t.Fatalf("record preference: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.handlePreferenceHistory(rec, httptest.NewRequest(http.MethodGet, "/today/preference/history", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "2025-01-15") || strings.Contains(body, "09:00:00") {
+		t.Error("expected day buckets and no exact timestamps")
+	}
+
+	old := s.preferenceStore.History()[1]
+	form := url.Values{"record_hash": {old.Hash}}
+	req := httptest.NewRequest(http.MethodPost, "/today/preference/revert", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	s.handlePreferenceRevert(rec, req)
+
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected redirect, got %d", rec.Code)
+	}
+	if got := s.preferenceStore.LatestPreference(); got != "show_all" {
+		t.Errorf("expected show_all after revert, got %s", got)
+	}
+	if got := len(emitter.Query(events.Filter{TypePrefix: string(events.Phase18_2PreferenceReverted)})); got != 1 {
+		t.Errorf("expected 1 reverted event, got %d", got)
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/policy"
+)
+
+// TestProofRefusalsFromConfig verifies /proof/refusals lists the refusals
+// the config declares, and only those.
+func TestProofRefusalsFromConfig(t *testing.T) {
+	cfg, err := config.LoadFromString("[circle:work]\nname = Work\n\n[policy]\nrefusals = never_pay\n", testSeed)
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	s, _ := newTestServerWith(t, clock.NewFixed(testSeed), cfg, true)
+
+	rec := httptest.NewRecorder()
+	s.handleProofRefusals(rec, httptest.NewRequest(http.MethodGet, "/proof/refusals", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	body := rec.Body.String()
+	if !strings.Contains(body, html.EscapeString(policy.RefusePay.Statement())) {
+		t.Errorf("expected the configured refusal %q on the page", policy.RefusePay.Statement())
+	}
+	if strings.Contains(body, html.EscapeString(policy.RefuseSend.Statement())) {
+		t.Errorf("expected the unconfigured refusal %q to be absent", policy.RefuseSend.Statement())
+	}
+}
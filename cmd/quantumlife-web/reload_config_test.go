@@ -0,0 +1,106 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/events"
+)
+
+// TestReloadConfigSwapsValidConfig verifies POST /admin/reload-config swaps
+// in a valid config and keeps the old one when the file is invalid.
+func TestReloadConfigSwapsValidConfig(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	path := filepath.Join(t.TempDir(), "circles.qlconf")
+	s.opts.configPath = path
+
+	reload := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		s.handleReloadConfig(rec, httptest.NewRequest(http.MethodPost, "/admin/reload-config", nil))
+		return rec
+	}
+
+	valid := "[circle:home]\nname = Home\n"
+	if err := os.WriteFile(path, []byte(valid), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	oldHash := s.currentConfig().circles.Hash()
+	rec := reload()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if s.currentConfig().circles.GetCircle("home") == nil {
+		t.Fatal("expected the reloaded circle")
+	}
+	reloaded := emitter.Query(events.Filter{TypePrefix: string(events.ConfigReloaded)})
+	if len(reloaded) != 1 {
+		t.Fatalf("expected 1 reloaded event, got %d", len(reloaded))
+	}
+	if reloaded[0].Metadata["old_hash"] != oldHash || reloaded[0].Metadata["new_hash"] != s.currentConfig().circles.Hash() {
+		t.Errorf("unexpected reload hashes: %v", reloaded[0].Metadata)
+	}
+
+	kept := s.currentConfig().circles
+	if err := os.WriteFile(path, []byte("[circle:home]\nunknown_key = x\n"), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	rec = reload()
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "Config not reloaded") {
+		t.Fatalf("expected the error to be reported, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if s.currentConfig().circles != kept {
+		t.Error("expected the old config to stay after a failed reload")
+	}
+	if emitter.Count(events.ConfigReloadRejected) != 1 {
+		t.Error("expected one rejected event")
+	}
+}
+
+// TestReloadConfigDuringRequests verifies a reload swaps the config while
+// requests read it, and the approval thresholds follow the snapshot.
+// Run with -race.
+func TestReloadConfigDuringRequests(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	path := filepath.Join(t.TempDir(), "circles.qlconf")
+	s.opts.configPath = path
+	if err := os.WriteFile(path, []byte("[circle:home]\nname = Home\n\n[approvals]\nemail_send = 2\n"), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	reply := draft.Draft{DraftType: draft.DraftTypeEmailReply}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			s.handleReloadConfig(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/admin/reload-config", nil))
+		}()
+		go func() {
+			defer wg.Done()
+			s.handleToday(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/today?circle_id=home", nil))
+		}()
+		go func() {
+			defer wg.Done()
+			s.engine.DraftEngine.RequiredApprovals(reply)
+		}()
+	}
+	wg.Wait()
+
+	if got := s.defaultCircle(); got != "home" {
+		t.Errorf("expected the reloaded default circle, got %q", got)
+	}
+	if got := s.engine.DraftEngine.RequiredApprovals(reply); got != 2 {
+		t.Errorf("expected the reloaded email approval threshold, got %d", got)
+	}
+	if got := emitter.Count(events.ConfigReloaded); got != 4 {
+		t.Errorf("expected 4 reloaded events, got %d", got)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/runlog"
+)
+
+// TestRunsRenderShortRunIDs verifies run pages render run IDs shorter than
+// the loop's 16 hex characters instead of failing on a fixed slice.
+func TestRunsRenderShortRunIDs(t *testing.T) {
+	s, _ := newTestServer(t, true)
+	snapshot := runlog.NewRunSnapshot("run-1", testSeed, testSeed, "personal", "cfg")
+	snapshot.FinalizeSnapshot()
+	if err := s.runStore.Store(snapshot); err != nil {
+		t.Fatalf("store snapshot: %v", err)
+	}
+
+	for _, path := range []string{"/runs", "/runs/run-1"} {
+		rec := httptest.NewRecorder()
+		if path == "/runs" {
+			s.handleRuns(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		} else {
+			s.handleRunDetail(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		}
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: expected 200, got %d", path, rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), "run-1") {
+			t.Errorf("%s: expected the full short run ID", path)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestSecurityHeaders verifies every response carries the hardening
+// headers and the -csp policy, and an empty policy sends no CSP.
+func TestSecurityHeaders(t *testing.T) {
+	old := *cspPolicy
+	defer func() { *cspPolicy = old }()
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	*cspPolicy = defaultContentSecurityPolicy
+	rec := httptest.NewRecorder()
+	securityHeaders(ok).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/today", nil))
+	for header, want := range map[string]string{
+		"Content-Security-Policy": defaultContentSecurityPolicy,
+		"X-Content-Type-Options":  "nosniff",
+		"Referrer-Policy":         "no-referrer",
+		"X-Frame-Options":         "DENY",
+	} {
+		if got := rec.Header().Get(header); got != want {
+			t.Errorf("%s = %q, want %q", header, got, want)
+		}
+	}
+
+	*cspPolicy = "default-src 'self' https://cdn.example.com"
+	rec = httptest.NewRecorder()
+	securityHeaders(ok).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/today", nil))
+	if got := rec.Header().Get("Content-Security-Policy"); got != *cspPolicy {
+		t.Errorf("custom policy = %q, want %q", got, *cspPolicy)
+	}
+
+	*cspPolicy = ""
+	rec = httptest.NewRecorder()
+	securityHeaders(ok).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/today", nil))
+	if _, set := rec.Header()["Content-Security-Policy"]; set {
+		t.Error("expected no CSP header for an empty policy")
+	}
+	if rec.Header().Get("X-Frame-Options") != "DENY" {
+		t.Error("expected fixed headers without a policy")
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStaticFilesFallsBackToEmbedded verifies /static/ is served from the
+// embedded copy when the on-disk directory is missing.
+func TestStaticFilesFallsBackToEmbedded(t *testing.T) {
+	fsys, source := staticFiles(filepath.Join(t.TempDir(), "missing"))
+	if source != "embedded" {
+		t.Fatalf("source = %q, want embedded", source)
+	}
+
+	srv := httptest.NewServer(http.StripPrefix("/static/", http.FileServer(fsys)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/static/app.css")
+	if err != nil {
+		t.Fatalf("GET app.css: %v", err)
+	}
+	defer resp.Body.Close()
+	got, _ := io.ReadAll(resp.Body)
+	want, err := os.ReadFile("static/app.css")
+	if err != nil {
+		t.Fatalf("read app.css: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || string(got) != string(want) {
+		t.Errorf("embedded app.css: status %d, %d bytes, want 200 and %d bytes", resp.StatusCode, len(got), len(want))
+	}
+}
+
+// TestStaticFilesPrefersDisk verifies an existing directory wins.
+func TestStaticFilesPrefersDisk(t *testing.T) {
+	dir := t.TempDir()
+	if _, source := staticFiles(dir); source != dir {
+		t.Errorf("source = %q, want %q", source, dir)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/events"
+)
+
+// TestSurfaceAllRequiresShowAll verifies /surface/all only renders once
+// the user has chosen show_all.
+func TestSurfaceAllRequiresShowAll(t *testing.T) {
+	s, emitter := newTestServer(t, true)
+
+	get := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		s.handleSurfaceAll(rec, httptest.NewRequest(http.MethodGet, "/surface/all", nil))
+		return rec
+	}
+
+	rec := get()
+	if rec.Code != http.StatusFound || rec.Header().Get("Location") != "/surface" {
+		t.Fatalf("expected redirect to /surface for quiet, got %d %q", rec.Code, rec.Header().Get("Location"))
+	}
+
+	if _, err := s.preferenceStore.Record("show_all", "surface"); err != nil {
+		t.Fatalf("record preference: %v", err)
+	}
+	rec = get()
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for show_all, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), "surface-footer") {
+		t.Error("expected the surface list page")
+	}
+	if got := len(emitter.Query(events.Filter{TypePrefix: string(events.Phase18_4SurfaceListRendered)})); got != 1 {
+		t.Errorf("expected 1 list rendered event, got %d", got)
+	}
+}
+
+// TestProofShowsSurfaceStats verifies the proof page carries the bucketed
+// surface line only once items were surfaced.
+func TestProofShowsSurfaceStats(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	proof := func() string {
+		rec := httptest.NewRecorder()
+		s.handleProof(rec, httptest.NewRequest(http.MethodGet, "/proof", nil))
+		return rec.Body.String()
+	}
+
+	if strings.Contains(proof(), "proof-surface-text") {
+		t.Fatal("expected no surface stats before anything was surfaced")
+	}
+
+	_ = s.surfaceStore.RecordViewed("", "item-a")
+	_ = s.surfaceStore.RecordHeld("", "item-a")
+
+	body := proof()
+	if !strings.Contains(body, "Everything we offered, you held for later.") {
+		t.Error("expected the held line on the proof page")
+	}
+}
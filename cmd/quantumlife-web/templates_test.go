@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRenderPageTemplatesAreDefined verifies each template name passed to
+// renderPage is defined in the embedded template files.
+func TestRenderPageTemplatesAreDefined(t *testing.T) {
+	tmpl, err := parseTemplates(time.UTC)
+	if err != nil {
+		t.Fatalf("parse templates: %v", err)
+	}
+
+	src, err := os.ReadFile("main.go")
+	if err != nil {
+		t.Fatalf("read main.go: %v", err)
+	}
+	calls := regexp.MustCompile(`s\.renderPage\(w, "([^"]+)"`).FindAllSubmatch(src, -1)
+	if len(calls) == 0 {
+		t.Fatal("expected renderPage calls in main.go")
+	}
+	for _, call := range calls {
+		if tmpl.Lookup(string(call[1])) == nil {
+			t.Errorf("template %q is rendered but not defined", call[1])
+		}
+	}
+}
+
+// TestTemplatePagesRender verifies pages that used to be written inline
+// render through their named templates.
+func TestTemplatePagesRender(t *testing.T) {
+	s, _ := newTestServer(t, true)
+
+	for _, tc := range []struct {
+		path    string
+		handler http.HandlerFunc
+		want    string
+	}{
+		{"/shadow/report", s.handleShadowReport, "<title>Shadow Report</title>"},
+		{"/shadow/candidates", s.handleShadowCandidates, "<!DOCTYPE html>"},
+		{"/shadow/packs", s.handleRulePackList, "<!DOCTYPE html>"},
+		{"/shadow/health", s.handleShadowHealth, "<!DOCTYPE html>"},
+		{"/proof/refusals", s.handleProofRefusals, "<!DOCTYPE html>"},
+		{"/trust", s.handleTrust, "<!DOCTYPE html>"},
+		{"/replay/diff", s.handleReplayDiff, `<form action="/replay/diff"`},
+	} {
+		rec := httptest.NewRecorder()
+		tc.handler(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", tc.path, rec.Code)
+			continue
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+			t.Errorf("%s: expected HTML content type, got %q", tc.path, ct)
+		}
+		if !strings.Contains(rec.Body.String(), tc.want) {
+			t.Errorf("%s: expected %q in body", tc.path, tc.want)
+		}
+	}
+}
+
+// TestReplayDiffTemplateEscapesRecords verifies record fields are escaped
+// and the empty diff renders its own message.
+func TestReplayDiffTemplateEscapesRecords(t *testing.T) {
+	tmpl, err := parseTemplates(time.UTC)
+	if err != nil {
+		t.Fatalf("parse templates: %v", err)
+	}
+
+	type line struct{ Mark, Type, Period, Hash string }
+	var buf bytes.Buffer
+	err = tmpl.ExecuteTemplate(&buf, "replay-diff", map[string]any{
+		"From": "aaa", "To": "bbb", "Added": 1,
+		"Lines": []line{{"+", "<script>", "2025-01-15", "abc123"}},
+	})
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if strings.Contains(buf.String(), "<script>") {
+		t.Error("expected record type to be escaped")
+	}
+	if !strings.Contains(buf.String(), "&lt;script&gt; 2025-01-15 abc123") {
+		t.Error("expected escaped record line")
+	}
+
+	buf.Reset()
+	if err := tmpl.ExecuteTemplate(&buf, "replay-diff", map[string]any{"From": "aaa", "To": "aaa"}); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Both bundles hold the same records.") {
+		t.Error("expected empty diff message")
+	}
+}
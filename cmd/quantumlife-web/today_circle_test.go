@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+)
+
+// TestTodayCircleSelection verifies ?circle_id= selects a configured circle
+// and anything else falls back to the default circle.
+func TestTodayCircleSelection(t *testing.T) {
+	cfg := config.DefaultConfig(testSeed)
+	cfg.Circles["work"] = &config.CircleConfig{ID: "work", Name: "Work"}
+	s, _ := newTestServerWith(t, clock.NewFixed(testSeed), cfg, true)
+
+	for _, tc := range []struct {
+		query string
+		want  string
+	}{
+		{"?circle_id=work", "work"},
+		{"?circle_id=personal", "personal"},
+		{"?circle_id=unknown", "personal"},
+		{"", "personal"},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/today"+tc.query, nil)
+		if got := s.todayCircle(r); string(got) != tc.want {
+			t.Errorf("%q: expected circle %s, got %s", tc.query, tc.want, got)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	s.handleToday(rec, httptest.NewRequest(http.MethodGet, "/today?circle_id=work", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, `<p class="today-circle">Work</p>`) {
+		t.Error("expected active circle name in header")
+	}
+	if !strings.Contains(body, `name="circle_id" value="work"`) {
+		t.Error("expected preference form to keep the circle")
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/pkg/domain/connection"
+)
+
+// TestTodayCueReason verifies the debug cue reason names the cue /today
+// shows, with the inputs the single whisper selection decided on.
+func TestTodayCueReason(t *testing.T) {
+	s, _ := newTestServer(t, true)
+	s.opts.debug = true
+	_ = s.connectionStore.AppendIntent(connection.NewConnectIntent(connection.KindEmail, connection.ModeMock, testSeed, connection.NoteUserInitiated))
+
+	today := func() string {
+		rec := httptest.NewRecorder()
+		s.handleToday(rec, httptest.NewRequest(http.MethodGet, "/today", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+		return rec.Body.String()
+	}
+
+	page := today()
+	if !strings.Contains(page, `class="whisper-cue first-minutes-cue"`) {
+		t.Fatal("expected the first-minutes cue on a fresh server")
+	}
+	if !strings.Contains(page, `data-kind="first_minutes" data-priority-rank="3" data-inputs="other_cue_active=false"`) {
+		t.Error("expected the cue reason to name the first-minutes cue")
+	}
+
+	// Once first minutes is dismissed, selection falls through every
+	// lower cue to the unviewed reminder.
+	s.handleFirstMinutesDismiss(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/first-minutes/dismiss", nil))
+	page = today()
+	if !strings.Contains(page, `class="whisper-cue unviewed-reminder"`) {
+		t.Fatal("expected the unviewed reminder once first minutes is dismissed")
+	}
+	if !strings.Contains(page, `data-kind="unviewed_connection" data-priority-rank="8" data-inputs="dismissed=false,mirror_viewed=false"`) {
+		t.Error("expected the cue reason to carry the reminder's inputs")
+	}
+
+	s.handleUnviewedReminderDismiss(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/mirror/reminder/dismiss", nil))
+	if page = today(); strings.Contains(page, "debug-cue-reason") {
+		t.Error("expected no cue reason once every cue is dismissed")
+	}
+}
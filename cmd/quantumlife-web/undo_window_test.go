@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"quantumlife/internal/config"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
+)
+
+// TestUndoWindowFromConfig checks that [undo] window_minutes reaches the
+// undoable execution engine.
+func TestUndoWindowFromConfig(t *testing.T) {
+	cfg := config.DefaultConfig(testSeed)
+	cfg.UndoWindowMinutes = 60
+	s, _ := newTestServerWith(t, clock.NewFixed(testSeed), cfg, true)
+
+	if s.undoableExecEngine == nil || s.trustActionEngine == nil {
+		t.Fatal("Expected undoable execution and trust action engines")
+	}
+
+	putApprovedDraft(t, s, "draft-email-1", draft.DraftTypeEmailReply, draft.EmailDraftContent{
+		Subject:            "Re: plans",
+		Body:               "Sounds good.",
+		ThreadID:           "thread-1",
+		ProviderHint:       "mock",
+		InReplyToMessageID: "msg-1",
+	})
+
+	ctx := context.Background()
+	eligibility := s.undoableExecEngine.EligibleAction(ctx, "circle-1")
+	if !eligibility.Eligible {
+		t.Fatalf("Expected eligible, got %s", eligibility.Reason)
+	}
+	result := s.undoableExecEngine.RunOnce(ctx, "circle-1", eligibility.DraftID)
+	if !result.Success {
+		t.Fatalf("RunOnce failed: %s", result.Error)
+	}
+
+	bucket := result.UndoRecord.UndoAvailableUntilBucket
+	if bucket.BucketDurationMinutes != 60 {
+		t.Errorf("Expected a 60 minute undo window, got %d", bucket.BucketDurationMinutes)
+	}
+	// The window opens one 15 minute bucket after execution
+	if want := testSeed.Add(75 * time.Minute); !bucket.Deadline().Equal(want) {
+		t.Errorf("Expected undo deadline %v, got %v", want, bucket.Deadline())
+	}
+}
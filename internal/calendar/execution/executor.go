@@ -382,6 +382,11 @@ func (e *Executor) GetEnvelope(id string) (Envelope, bool) {
 	return e.envelopeStore.Get(id)
 }
 
+// ListEnvelopes returns all envelopes matching the filter.
+func (e *Executor) ListEnvelopes(filter ListFilter) []Envelope {
+	return e.envelopeStore.List(filter)
+}
+
 // GetEnvelopeByDraft retrieves an envelope by draft ID.
 func (e *Executor) GetEnvelopeByDraft(draftID draft.DraftID) (Envelope, bool) {
 	return e.envelopeStore.GetByDraftID(draftID)
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"sort"
 	"sync"
 
 	"quantumlife/pkg/domain/draft"
@@ -49,6 +50,13 @@ func NewMemoryStore() *MemoryStore {
 	}
 }
 
+// Clear removes all envelopes.
+func (s *MemoryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.envelopes = make(map[string]Envelope)
+}
+
 // Put stores an envelope.
 func (s *MemoryStore) Put(env Envelope) error {
 	s.mu.Lock()
@@ -111,6 +119,7 @@ func (s *MemoryStore) Update(env Envelope) error {
 }
 
 // List lists envelopes matching a filter.
+// Results are sorted deterministically by EnvelopeID.
 func (s *MemoryStore) List(filter ListFilter) []Envelope {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -125,6 +134,10 @@ func (s *MemoryStore) List(filter ListFilter) []Envelope {
 			result = append(result, env)
 		}
 	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].EnvelopeID < result[j].EnvelopeID
+	})
 	return result
 }
 
@@ -91,6 +91,11 @@ func (e *Engine) BuildObservations(in CommerceIngestInput) CommerceIngestResult
 		}
 	}
 
+	source := in.Source
+	if source == "" {
+		source = commerceobserver.SourceGmailReceipt
+	}
+
 	// Select top categories (up to MaxCategories)
 	selectedCategories := selectTopCategories(commerceCounts, MaxCategories)
 
@@ -121,7 +126,7 @@ func (e *Engine) BuildObservations(in CommerceIngestInput) CommerceIngestResult
 		evidenceHash := commerceobserver.ComputeEvidenceHash(evidenceTokens)
 
 		obs := commerceobserver.CommerceObservation{
-			Source:       commerceobserver.SourceGmailReceipt,
+			Source:       source,
 			Category:     cat,
 			Frequency:    frequency,
 			Stability:    stability,
@@ -186,11 +191,24 @@ func selectTopCategories(counts map[commerceobserver.CategoryBucket]int, n int)
 }
 
 // BuildFromGmailMessages converts Gmail message metadata into observations.
-// This is a convenience function that combines receipt scanning and ingestion.
+// Thin wrapper over BuildFromMessages with the gmail_receipt source.
+func (e *Engine) BuildFromGmailMessages(
+	circleID string,
+	period string,
+	syncReceiptHash string,
+	messageData []MessageData,
+) CommerceIngestResult {
+	return e.BuildFromMessages(commerceobserver.SourceGmailReceipt, circleID, period, syncReceiptHash, messageData)
+}
+
+// BuildFromMessages converts message metadata from any receipt source into
+// observations tagged with that source. This is a convenience function that
+// combines receipt scanning and ingestion.
 //
 // CRITICAL: messageData is used for classification only and is NEVER stored.
 // After this function returns, all raw data is discarded.
-func (e *Engine) BuildFromGmailMessages(
+func (e *Engine) BuildFromMessages(
+	source commerceobserver.SourceKind,
 	circleID string,
 	period string,
 	syncReceiptHash string,
@@ -226,6 +244,7 @@ func (e *Engine) BuildFromGmailMessages(
 		CircleID:        circleID,
 		Period:          period,
 		SyncReceiptHash: syncReceiptHash,
+		Source:          source,
 		ScanResults:     scanResults,
 	})
 }
@@ -233,7 +252,7 @@ func (e *Engine) BuildFromGmailMessages(
 // MessageData contains the message metadata needed for receipt classification.
 // CRITICAL: This data is used for classification only and is NEVER stored.
 type MessageData struct {
-	// MessageID is the Gmail message ID (will be hashed, never stored raw).
+	// MessageID is the provider message ID (will be hashed, never stored raw).
 	MessageID string
 
 	// SenderDomain is the domain part of the sender email.
@@ -249,7 +268,7 @@ type MessageData struct {
 	Snippet string
 }
 
-// ExtractMessageData extracts MessageData from raw Gmail API responses.
+// ExtractMessageData extracts MessageData from raw provider API responses.
 // This is the boundary where raw data enters and abstract signals exit.
 func ExtractMessageData(messageID, senderDomain, subject, snippet string) MessageData {
 	return MessageData{
@@ -85,9 +85,12 @@ type CommerceIngestInput struct {
 	// Period is the observation period (e.g., "2025-W03").
 	Period string
 
-	// SyncReceiptHash is the hash of the Gmail sync receipt (optional).
+	// SyncReceiptHash is the hash of the source sync receipt (optional).
 	SyncReceiptHash string
 
+	// Source tags the observations. Empty means gmail_receipt.
+	Source commerceobserver.SourceKind
+
 	// ScanResults contains the receipt scan results.
 	ScanResults []receiptscan.ReceiptScanResult
 }
@@ -100,6 +103,11 @@ func (i *CommerceIngestInput) Validate() error {
 	if i.Period == "" {
 		return fmt.Errorf("missing period")
 	}
+	if i.Source != "" {
+		if err := i.Source.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -11,11 +11,21 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
+	"quantumlife/internal/proof"
+	"quantumlife/internal/surface"
+	trustengine "quantumlife/internal/trust"
 	pkgconfig "quantumlife/pkg/domain/config"
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/policy"
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/domain/suppress"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // Type aliases for convenience (re-export from pkg/domain/config)
@@ -40,6 +50,7 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 	config := &MultiCircleConfig{
 		Circles:    make(map[identity.EntityID]*CircleConfig),
 		Shadow:     pkgconfig.DefaultShadowConfig(), // CRITICAL: OFF by default
+		Refusals:   policy.DefaultRefusals(),
 		SourcePath: path,
 		LoadedAt:   loadedAt,
 	}
@@ -50,6 +61,11 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 	var currentSection string
 	var currentCircleID identity.EntityID
 
+	// Source lines for validation errors, and problems the config map
+	// cannot represent (a circle defined twice merges into one entry)
+	pos := make(positions)
+	var duplicates []ValidationError
+
 	for scanner.Scan() {
 		lineNum++
 		line := strings.TrimSpace(scanner.Text())
@@ -70,6 +86,13 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 					config.Circles[currentCircleID] = &CircleConfig{
 						ID: currentCircleID,
 					}
+					pos[header] = lineNum
+				} else {
+					duplicates = append(duplicates, ValidationError{
+						Line:    lineNum,
+						Field:   header,
+						Message: fmt.Sprintf("duplicate circle ID (first defined at line %d)", pos[header]),
+					})
 				}
 			} else if header == "routing" {
 				currentSection = "routing"
@@ -77,6 +100,36 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 			} else if header == "shadow" {
 				currentSection = "shadow"
 				currentCircleID = ""
+			} else if header == "policy" {
+				currentSection = "policy"
+				currentCircleID = ""
+			} else if header == "sync" {
+				currentSection = "sync"
+				currentCircleID = ""
+			} else if header == "approvals" {
+				currentSection = "approvals"
+				currentCircleID = ""
+			} else if header == "defaults" {
+				currentSection = "defaults"
+				currentCircleID = ""
+			} else if header == "proof" {
+				currentSection = "proof"
+				currentCircleID = ""
+			} else if header == "surface" {
+				currentSection = "surface"
+				currentCircleID = ""
+			} else if header == "display" {
+				currentSection = "display"
+				currentCircleID = ""
+			} else if header == "magnitude" {
+				currentSection = "magnitude"
+				currentCircleID = ""
+			} else if header == "trust" {
+				currentSection = "trust"
+				currentCircleID = ""
+			} else if header == "undo" {
+				currentSection = "undo"
+				currentCircleID = ""
 			} else {
 				return nil, &ParseError{Line: lineNum, Message: "unknown section: " + header}
 			}
@@ -91,6 +144,11 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 
 		key := strings.TrimSpace(parts[0])
 		value := strings.TrimSpace(parts[1])
+		if currentSection == "circle" {
+			pos["circle:"+string(currentCircleID)+"."+key] = lineNum
+		} else {
+			pos[currentSection+"."+key] = lineNum
+		}
 
 		switch currentSection {
 		case "circle":
@@ -108,6 +166,7 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 				if err != nil {
 					return nil, &ParseError{Line: lineNum, Message: err.Error()}
 				}
+				pos[fmt.Sprintf("circle:%s.email[%d]", currentCircleID, len(circle.EmailIntegrations))] = lineNum
 				circle.EmailIntegrations = append(circle.EmailIntegrations, integration)
 
 			case "calendar":
@@ -115,6 +174,7 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 				if err != nil {
 					return nil, &ParseError{Line: lineNum, Message: err.Error()}
 				}
+				pos[fmt.Sprintf("circle:%s.calendar[%d]", currentCircleID, len(circle.CalendarIntegrations))] = lineNum
 				circle.CalendarIntegrations = append(circle.CalendarIntegrations, integration)
 
 			case "finance":
@@ -122,8 +182,42 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 				if err != nil {
 					return nil, &ParseError{Line: lineNum, Message: err.Error()}
 				}
+				pos[fmt.Sprintf("circle:%s.finance[%d]", currentCircleID, len(circle.FinanceIntegrations))] = lineNum
 				circle.FinanceIntegrations = append(circle.FinanceIntegrations, integration)
 
+			case "email_sync_max_messages":
+				n := parsePositiveInt(value)
+				if n <= 0 {
+					return nil, &ParseError{Line: lineNum, Message: "invalid email sync max messages: " + value}
+				}
+				circle.EmailSyncMaxMessages = n
+
+			case "email_sync_window_days":
+				n := parsePositiveInt(value)
+				if n <= 0 {
+					return nil, &ParseError{Line: lineNum, Message: "invalid email sync window days: " + value}
+				}
+				circle.EmailSyncWindowDays = n
+
+			case "email_sync_labels":
+				labels, err := parseLabelFilter(value)
+				if err != nil {
+					return nil, &ParseError{Line: lineNum, Message: err.Error()}
+				}
+				circle.EmailSyncLabels = labels
+
+			case "quiet_hours":
+				if _, err := suppress.ParseTimeWindow(string(currentCircleID), value, ""); err != nil {
+					return nil, &ParseError{Line: lineNum, Message: "invalid quiet hours: " + value}
+				}
+				circle.QuietHours = value
+
+			case "quiet_hours_timezone":
+				if _, err := time.LoadLocation(value); err != nil || value == "" {
+					return nil, &ParseError{Line: lineNum, Message: "invalid quiet hours timezone: " + value}
+				}
+				circle.QuietHoursTimezone = value
+
 			default:
 				return nil, &ParseError{Line: lineNum, Message: "unknown circle key: " + key}
 			}
@@ -158,7 +252,7 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 				config.Shadow.ModelName = value
 			case "provider_kind":
 				// Phase 19.3: provider kind
-				if value != "none" && value != "stub" && value != "azure_openai" && value != "local_slm" {
+				if value != "none" && value != "stub" && value != "azure_openai" && value != "anthropic" && value != "local_slm" {
 					return nil, &ParseError{Line: lineNum, Message: "invalid shadow provider_kind: " + value}
 				}
 				config.Shadow.ProviderKind = value
@@ -168,9 +262,10 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 			case "max_suggestions":
 				// Phase 19.3c: max suggestions per run
 				n := parsePositiveInt(value)
-				if n > 0 {
-					config.Shadow.MaxSuggestions = n
+				if n <= 0 {
+					return nil, &ParseError{Line: lineNum, Message: "invalid shadow max_suggestions: " + value}
 				}
+				config.Shadow.MaxSuggestions = n
 			case "azure_endpoint":
 				// Phase 19.3: Azure OpenAI endpoint (optional - env var preferred)
 				config.Shadow.AzureOpenAI.Endpoint = value
@@ -189,10 +284,150 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 			case "azure_key_env_name":
 				// Phase 19.3b: Environment variable name for API key
 				config.Shadow.AzureOpenAI.APIKeyEnvName = value
+			case "azure_endpoint_allowlist":
+				// Approved Azure endpoint hosts (optional)
+				config.Shadow.AzureOpenAI.EndpointAllowlist = parseCSV(value)
 			default:
 				return nil, &ParseError{Line: lineNum, Message: "unknown shadow key: " + key}
 			}
 
+		case "policy":
+			switch key {
+			case "refusals":
+				refusals, err := policy.ParseRefusals(parseCSV(value))
+				if err != nil {
+					return nil, &ParseError{Line: lineNum, Message: err.Error()}
+				}
+				config.Refusals = refusals
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown policy key: " + key}
+			}
+
+		case "sync":
+			// <kind>_lookback_days = N (clamped per kind at read time)
+			kind := connection.ConnectionKind(strings.TrimSuffix(key, "_lookback_days"))
+			if !strings.HasSuffix(key, "_lookback_days") || !kind.Valid() {
+				return nil, &ParseError{Line: lineNum, Message: "unknown sync key: " + key}
+			}
+			days := parsePositiveInt(value)
+			if days <= 0 {
+				return nil, &ParseError{Line: lineNum, Message: "invalid lookback days: " + value}
+			}
+			if config.Sync.LookbackDays == nil {
+				config.Sync.LookbackDays = make(map[connection.ConnectionKind]int)
+			}
+			config.Sync.LookbackDays[kind] = days
+
+		case "approvals":
+			// approvers = person IDs issued approval tokens for drafts
+			if key == "approvers" {
+				for _, id := range parseCSV(value) {
+					config.Approvals.Approvers = append(config.Approvals.Approvers, identity.EntityID(id))
+				}
+				break
+			}
+			// <action_class> = N approvals before a draft executes (0 = on confirm)
+			action := execintent.ActionClass(key)
+			if !action.Valid() {
+				return nil, &ParseError{Line: lineNum, Message: "unknown approvals key: " + key}
+			}
+			n := parsePositiveInt(value)
+			if (n == 0 && value != "0") || n > execintent.MaxRequiredApprovals {
+				return nil, &ParseError{Line: lineNum, Message: "invalid required approvals: " + value}
+			}
+			if config.Approvals.Required == nil {
+				config.Approvals.Required = make(map[execintent.ActionClass]int)
+			}
+			config.Approvals.Required[action] = n
+
+		case "defaults":
+			switch key {
+			case "circle":
+				config.DefaultCircleID = identity.EntityID(value)
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown defaults key: " + key}
+			}
+
+		case "proof":
+			switch key {
+			case "categories":
+				cats, err := proof.ParseCategories(parseCSV(value))
+				if err != nil {
+					return nil, &ParseError{Line: lineNum, Message: err.Error()}
+				}
+				config.ProofCategories = make([]string, len(cats))
+				for i, c := range cats {
+					config.ProofCategories[i] = string(c)
+				}
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown proof key: " + key}
+			}
+
+		case "surface":
+			switch key {
+			case "promotion_threshold":
+				threshold := parsePositiveInt(value)
+				if threshold <= 0 || threshold > surface.MaxPromotionThreshold {
+					return nil, &ParseError{Line: lineNum, Message: "invalid promotion threshold: " + value}
+				}
+				config.SurfacePromotionThreshold = threshold
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown surface key: " + key}
+			}
+
+		case "display":
+			switch key {
+			case "timezone":
+				if _, err := time.LoadLocation(value); err != nil || value == "" {
+					return nil, &ParseError{Line: lineNum, Message: "invalid display timezone: " + value}
+				}
+				config.DisplayTimezone = value
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown display key: " + key}
+			}
+
+		case "magnitude":
+			switch key {
+			case "a_few_max":
+				n := parsePositiveInt(value)
+				if n <= 0 || n > shadowllm.MaxAFewMax {
+					return nil, &ParseError{Line: lineNum, Message: "invalid a_few_max: " + value}
+				}
+				config.MagnitudeAFewMax = n
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown magnitude key: " + key}
+			}
+
+		case "trust":
+			switch key {
+			case "meaningful_min":
+				bucket := shadowllm.MagnitudeBucket(value)
+				if bucket != shadowllm.MagnitudeAFew && bucket != shadowllm.MagnitudeSeveral {
+					return nil, &ParseError{Line: lineNum, Message: "invalid meaningful_min: " + value}
+				}
+				config.TrustMeaningfulMin = value
+			case "max_shown":
+				n := parsePositiveInt(value)
+				if n <= 0 || n > trustengine.MaxShownLimit {
+					return nil, &ParseError{Line: lineNum, Message: "invalid max_shown: " + value}
+				}
+				config.TrustMaxShown = n
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown trust key: " + key}
+			}
+
+		case "undo":
+			switch key {
+			case "window_minutes":
+				n := parsePositiveInt(value)
+				if n <= 0 || time.Duration(n)*time.Minute > undowindow.Max {
+					return nil, &ParseError{Line: lineNum, Message: "invalid undo window_minutes: " + value}
+				}
+				config.UndoWindowMinutes = n
+			default:
+				return nil, &ParseError{Line: lineNum, Message: "unknown undo key: " + key}
+			}
+
 		default:
 			return nil, &ParseError{Line: lineNum, Message: "key outside of section"}
 		}
@@ -202,9 +437,10 @@ func LoadFromFile(path string, loadedAt time.Time) (*MultiCircleConfig, error) {
 		return nil, fmt.Errorf("error reading config file: %w", err)
 	}
 
-	// Validate the config
-	if err := config.Validate(); err != nil {
-		return nil, err
+	// Validate the config, reporting every problem at once
+	if errs := append(duplicates, validate(config, pos)...); len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool { return errs[i].Line < errs[j].Line })
+		return nil, ValidationErrors(errs)
 	}
 
 	return config, nil
@@ -277,6 +513,25 @@ func parseFinanceIntegration(value string) (FinanceIntegration, error) {
 }
 
 // parseCSV parses a comma-separated list of values.
+// parseLabelFilter parses a Gmail label filter list.
+// "all" means no filter; otherwise each entry is a label ID, "-" to exclude.
+func parseLabelFilter(value string) ([]string, error) {
+	if value == "all" {
+		return []string{}, nil
+	}
+	labels := parseCSV(value)
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("invalid email sync labels: %s", value)
+	}
+	for _, l := range labels {
+		id := strings.TrimPrefix(l, "-")
+		if id == "" || strings.Trim(id, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-/") != "" {
+			return nil, fmt.Errorf("invalid email sync label: %s", l)
+		}
+	}
+	return labels, nil
+}
+
 func parseCSV(value string) []string {
 	if value == "" {
 		return nil
@@ -348,7 +603,8 @@ func DefaultConfig(loadedAt time.Time) *MultiCircleConfig {
 			},
 		},
 		Shadow:     pkgconfig.DefaultShadowConfig(), // CRITICAL: OFF by default
+		Refusals:   policy.DefaultRefusals(),
 		LoadedAt:   loadedAt,
-		SourcePath: "(default)",
+		SourcePath: pkgconfig.DefaultSourcePath,
 	}
 }
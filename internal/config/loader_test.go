@@ -1,11 +1,17 @@
 package config
 
 import (
+	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	pkgconfig "quantumlife/pkg/domain/config"
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/policy"
 )
 
 func TestLoadFromString_BasicConfig(t *testing.T) {
@@ -348,6 +354,43 @@ func TestDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestLoadFromString_Refusals(t *testing.T) {
+	content := `
+[circle:work]
+name = Work
+
+[policy]
+refusals = never_pay
+`
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	config, err := LoadFromString(content, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	if !config.Refusals.Contains(policy.RefusePay) {
+		t.Error("expected configured refusal never_pay to be declared")
+	}
+	if config.Refusals.Contains(policy.RefuseSend) {
+		t.Error("expected unconfigured refusal never_send to be absent")
+	}
+
+	statements := config.Refusals.Statements()
+	if len(statements) != 1 || statements[0] != policy.RefusePay.Statement() {
+		t.Errorf("expected configured refusal statement to appear, got %v", statements)
+	}
+
+	// Default config declares every refusal.
+	if len(DefaultConfig(now).Refusals.Statements()) != len(policy.AllRefusalClasses()) {
+		t.Error("expected default config to declare all refusals")
+	}
+
+	// Unknown refusal classes are rejected.
+	if _, err := LoadFromString("[circle:work]\nname = Work\n[policy]\nrefusals = never_sleep", now); err == nil {
+		t.Error("expected error for unknown refusal class")
+	}
+}
+
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsSubstring(s, substr))
 }
@@ -360,3 +403,599 @@ func containsSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+func TestLoadFromString_DefaultCircle(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	// Configured default circle is honored.
+	config, err := LoadFromString(`
+[circle:work]
+name = Work
+
+[circle:personal]
+name = Personal
+
+[defaults]
+circle = personal
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if got := config.DefaultCircle(); got != "personal" {
+		t.Errorf("expected configured default circle personal, got %q", got)
+	}
+
+	// Without a configured default, the first sorted circle is used.
+	config, err = LoadFromString(`
+[circle:work]
+name = Work
+
+[circle:family]
+name = Family
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	got := config.DefaultCircle()
+	if got != "family" {
+		t.Errorf("expected first sorted circle family, got %q", got)
+	}
+	if config.GetCircle(got) == nil {
+		t.Errorf("default circle %q does not resolve to a configured circle", got)
+	}
+
+	// A default that names an unconfigured circle is rejected.
+	_, err = LoadFromString(`
+[circle:work]
+name = Work
+
+[defaults]
+circle = demo-circle
+`, now)
+	if err == nil {
+		t.Error("expected error for unconfigured default circle")
+	}
+
+	// The shipped default config resolves to a real circle.
+	if def := DefaultConfig(now); def.GetCircle(def.DefaultCircle()) == nil {
+		t.Error("expected default config to resolve to a configured circle")
+	}
+}
+
+func TestLoadFromString_ProofCategories(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[proof]
+categories = work, money
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if got := strings.Join(config.ProofCategories, ","); got != "money,work" {
+		t.Errorf("expected canonical categories money,work, got %q", got)
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[proof]
+categories = gossip
+`, now)
+	if err == nil {
+		t.Error("expected unknown proof category to be rejected")
+	}
+}
+
+func TestLoadFromString_AzureEndpointAllowlist(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[shadow]
+azure_endpoint_allowlist = a.openai.azure.com, b.openai.azure.com
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if got := strings.Join(config.Shadow.AzureOpenAI.EndpointAllowlist, ","); got != "a.openai.azure.com,b.openai.azure.com" {
+		t.Errorf("expected two allowed hosts, got %q", got)
+	}
+}
+
+func TestLoadFromString_SurfacePromotionThreshold(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[surface]
+promotion_threshold = 3
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if config.SurfacePromotionThreshold != 3 {
+		t.Errorf("expected promotion threshold 3, got %d", config.SurfacePromotionThreshold)
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[surface]
+promotion_threshold = 9
+`, now)
+	if err == nil {
+		t.Error("expected out-of-range promotion threshold to be rejected")
+	}
+}
+
+func TestLoadFromString_MagnitudeAFewMax(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[magnitude]
+a_few_max = 5
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if config.MagnitudeAFewMax != 5 {
+		t.Errorf("expected a_few_max 5, got %d", config.MagnitudeAFewMax)
+	}
+	if !strings.Contains(config.CanonicalString(), "magnitude|a_few_max:5") {
+		t.Error("expected magnitude threshold in canonical string")
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[magnitude]
+a_few_max = 50
+`, now)
+	if err == nil {
+		t.Error("expected out-of-range a_few_max to be rejected")
+	}
+}
+
+func TestLoadFromString_DisplayTimezone(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if config.DisplayLocation() != time.UTC {
+		t.Errorf("expected UTC by default, got %s", config.DisplayLocation())
+	}
+
+	config, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[display]
+timezone = Europe/London
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if got := config.DisplayLocation().String(); got != "Europe/London" {
+		t.Errorf("expected Europe/London, got %s", got)
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[display]
+timezone = Mars/Olympus
+`, now)
+	if err == nil {
+		t.Error("expected unknown display timezone to be rejected")
+	}
+}
+
+func TestLoadFromString_EmailSyncPolicy(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+email_sync_max_messages = 50
+email_sync_window_days = 14
+email_sync_labels = -CATEGORY_PROMOTIONS, IMPORTANT
+
+[circle:work]
+name = Work
+email_sync_labels = all
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	personal := config.Circles["personal"]
+	if personal.EmailSyncMaxMessages != 50 || personal.EmailSyncWindowDays != 14 {
+		t.Errorf("expected 50/14, got %d/%d", personal.EmailSyncMaxMessages, personal.EmailSyncWindowDays)
+	}
+	work := config.Circles["work"]
+	if work.EmailSyncMaxMessages != 0 || work.EmailSyncWindowDays != 0 {
+		t.Errorf("expected unset policy for work, got %d/%d", work.EmailSyncMaxMessages, work.EmailSyncWindowDays)
+	}
+	if !strings.Contains(config.CanonicalString(), "|email_sync:50,14") {
+		t.Error("expected email sync policy in canonical string")
+	}
+	if len(personal.EmailSyncLabels) != 2 || personal.EmailSyncLabels[1] != "IMPORTANT" {
+		t.Errorf("expected two label filters, got %v", personal.EmailSyncLabels)
+	}
+	if work.EmailSyncLabels == nil || len(work.EmailSyncLabels) != 0 {
+		t.Errorf("expected empty (no filter) labels for work, got %v", work.EmailSyncLabels)
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+email_sync_labels = -in:spam OR
+`, now)
+	if err == nil {
+		t.Error("expected search operators in labels to be rejected")
+	}
+
+	_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+email_sync_max_messages = lots
+`, now)
+	if err == nil {
+		t.Error("expected non-numeric max messages to be rejected")
+	}
+}
+
+func TestLoadFromString_SyncLookback(t *testing.T) {
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:work]
+name = Work
+
+[sync]
+email_lookback_days = 365
+finance_lookback_days = 30
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if days := config.Sync.EffectiveLookbackDays(connection.KindEmail); days != 30 {
+		t.Errorf("expected email lookback clamped to 30, got %d", days)
+	}
+	if days := config.Sync.EffectiveLookbackDays(connection.KindFinance); days != 30 {
+		t.Errorf("expected finance lookback 30, got %d", days)
+	}
+	if days := config.Sync.EffectiveLookbackDays(connection.KindCalendar); days != 7 {
+		t.Errorf("expected unset calendar lookback to default to 7, got %d", days)
+	}
+
+	buckets := map[int]string{1: "a_few", 7: "a_few", 8: "several", 14: "several", 15: "many", 90: "many"}
+	for days, want := range buckets {
+		if got := pkgconfig.LookbackBucket(days); got != want {
+			t.Errorf("LookbackBucket(%d) = %q, want %q", days, got, want)
+		}
+	}
+}
+
+func TestLoadFromString_QuietHours(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+quiet_hours = 22:00-07:00
+quiet_hours_timezone = Europe/London
+
+[circle:work]
+name = Work
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	personal := config.Circles["personal"]
+	if personal.QuietHours != "22:00-07:00" || personal.QuietHoursTimezone != "Europe/London" {
+		t.Errorf("expected quiet hours in Europe/London, got %q %q", personal.QuietHours, personal.QuietHoursTimezone)
+	}
+	if config.Circles["work"].QuietHours != "" {
+		t.Error("expected no quiet hours for work")
+	}
+	if !strings.Contains(config.CanonicalString(), "|quiet_hours:22:00-07:00,Europe/London") {
+		t.Error("expected quiet hours in canonical string")
+	}
+
+	for _, bad := range []string{"quiet_hours = 22:00", "quiet_hours = 07:00-07:00", "quiet_hours_timezone = Mars/Olympus"} {
+		_, err := LoadFromString("[circle:personal]\nname = Personal\n"+bad+"\n", now)
+		if err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestLoadFromString_TrustThresholds(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[trust]
+meaningful_min = several
+max_shown = 1
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if config.TrustMeaningfulMin != "several" || config.TrustMaxShown != 1 {
+		t.Errorf("expected several/1, got %q/%d", config.TrustMeaningfulMin, config.TrustMaxShown)
+	}
+	if !strings.Contains(config.CanonicalString(), "trust|meaningful_min:several|max_shown:1") {
+		t.Error("expected trust thresholds in canonical string")
+	}
+
+	for _, bad := range []string{"meaningful_min = nothing", "max_shown = 0", "max_shown = 11"} {
+		_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[trust]
+`+bad+`
+`, now)
+		if err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestLoadFromString_UndoWindow(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[undo]
+window_minutes = 60
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if config.UndoWindowMinutes != 60 {
+		t.Errorf("expected undo window 60, got %d", config.UndoWindowMinutes)
+	}
+	if !strings.Contains(config.CanonicalString(), "undo|window_minutes:60") {
+		t.Error("expected undo window in canonical string")
+	}
+
+	for _, bad := range []string{"window_minutes = 0", "window_minutes = 241", "window = 5"} {
+		_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[undo]
+`+bad+`
+`, now)
+		if err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestLoadFromString_Approvals(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	config, err := LoadFromString(`
+[circle:personal]
+name = Personal
+
+[approvals]
+email_send = 0
+finance_payment = 3
+approvers = person-a, person-b
+`, now)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	thresholds := config.Approvals.Thresholds()
+	for action, want := range map[execintent.ActionClass]int{
+		execintent.ActionEmailSend:       0,
+		execintent.ActionCalendarRespond: 0,
+		execintent.ActionFinancePayment:  3,
+	} {
+		if got := thresholds.Required(action); got != want {
+			t.Errorf("%s: expected %d approvals, got %d", action, want, got)
+		}
+	}
+	if !strings.Contains(config.CanonicalString(), "approvals|email_send:0|calendar_respond:0|finance_payment:3|approvers:person-a,person-b") {
+		t.Error("expected approvals in canonical string")
+	}
+	if got := config.Approvals.Approvers; len(got) != 2 || got[0] != "person-a" || got[1] != "person-b" {
+		t.Errorf("expected approvers person-a and person-b, got %v", got)
+	}
+
+	for _, bad := range []string{"finance_move = 2", "email_send = -1", "email_send = 11", "email_send = one"} {
+		_, err = LoadFromString(`
+[circle:personal]
+name = Personal
+
+[approvals]
+`+bad+`
+`, now)
+		if err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestLoadFromString_ValidationErrors(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	content := `[circle:work]
+name = Work
+email = yahoo:me@work.com
+calendar = google:primary
+
+[circle:home]
+finance = plaid:checking
+
+[circle:work]
+name = Work again
+
+[defaults]
+circle = family
+`
+	_, err := LoadFromString(content, now)
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T: %v", err, err)
+	}
+
+	want := []ValidationError{
+		{Line: 3, Field: "circle:work.email[0].provider"},
+		{Line: 6, Field: "circle:home.name"},
+		{Line: 9, Field: "circle:work"},
+		{Line: 13, Field: "defaults.circle"},
+	}
+	if len(verrs) != len(want) {
+		t.Fatalf("expected %d problems, got %d: %v", len(want), len(verrs), verrs)
+	}
+	for i, w := range want {
+		if verrs[i].Line != w.Line || verrs[i].Field != w.Field {
+			t.Errorf("problem %d = line %d %s, want line %d %s", i, verrs[i].Line, verrs[i].Field, w.Line, w.Field)
+		}
+	}
+	if !strings.Contains(verrs[0].Message, `unknown provider "yahoo"`) {
+		t.Errorf("expected an actionable provider message, got %q", verrs[0].Message)
+	}
+	if !strings.Contains(verrs[2].Message, "first defined at line 1") {
+		t.Errorf("expected duplicate to point at the first definition, got %q", verrs[2].Message)
+	}
+}
+
+func TestLoadFromString_RejectsNonPositiveMaxSuggestions(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	_, err := LoadFromString("[circle:work]\nname = Work\n[shadow]\nmax_suggestions = -3\n", now)
+	if err == nil || !strings.Contains(err.Error(), "max_suggestions") {
+		t.Fatalf("expected max_suggestions to be rejected, got %v", err)
+	}
+}
+
+func TestValidate_ReportsEveryProblem(t *testing.T) {
+	cfg := &MultiCircleConfig{
+		Circles: map[identity.EntityID]*CircleConfig{
+			"work": {
+				ID:                  "work",
+				Name:                "Work",
+				EmailSyncWindowDays: -1,
+				FinanceIntegrations: []FinanceIntegration{{Provider: "", Identifier: ""}},
+			},
+		},
+		SurfacePromotionThreshold: 99,
+		TrustMaxShown:             -2,
+	}
+
+	errs := Validate(cfg)
+	fields := make(map[string]bool)
+	for _, e := range errs {
+		if e.Line != 0 {
+			t.Errorf("expected no line for a config built in code, got %d", e.Line)
+		}
+		fields[e.Field] = true
+	}
+	for _, f := range []string{
+		"circle:work.email_sync_window_days",
+		"circle:work.finance[0].provider",
+		"circle:work.finance[0].identifier",
+		"surface.promotion_threshold",
+		"trust.max_shown",
+	} {
+		if !fields[f] {
+			t.Errorf("expected a problem for %s, got %v", f, errs)
+		}
+	}
+
+	if errs := Validate(DefaultConfig(time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))); len(errs) != 0 {
+		t.Errorf("expected the default config to be valid, got %v", errs)
+	}
+}
+
+func TestEffectiveView_MarksSourcesAndMasksSecrets(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	cfg, err := LoadFromString("[circle:work]\nname = Work\n[surface]\npromotion_threshold = 2\n[shadow]\nazure_endpoint = https://secret-resource.example.com\n", now)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	env := map[string]string{
+		"QL_SHADOW_PROVIDER_KIND": "azure_openai",
+		"AZURE_OPENAI_API_KEY":    "sk-do-not-print",
+		"AZURE_OPENAI_ENDPOINT":   "https://env-resource.example.com",
+	}
+	view := cfg.EffectiveView(func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	})
+
+	settings := make(map[string]pkgconfig.EffectiveSetting)
+	for _, s := range view.Settings {
+		settings[s.Key] = s
+	}
+	want := map[string]pkgconfig.EffectiveSource{
+		"circle:work.name":            pkgconfig.SourceFile,
+		"surface.promotion_threshold": pkgconfig.SourceFile,
+		"display.timezone":            pkgconfig.SourceDefault,
+		"shadow.provider_kind":        pkgconfig.SourceEnv,
+		"shadow.azure_api_key":        pkgconfig.SourceEnv,
+		"shadow.azure_endpoint":       pkgconfig.SourceFile,
+	}
+	for key, source := range want {
+		if got := settings[key].Source; got != source {
+			t.Errorf("%s: expected source %q, got %q", key, source, got)
+		}
+	}
+	if s := settings["shadow.provider_kind"]; s.Value != "azure_openai" || s.EnvVar != "QL_SHADOW_PROVIDER_KIND" {
+		t.Errorf("expected provider kind from env, got %+v", s)
+	}
+	for _, key := range []string{"shadow.azure_api_key", "shadow.azure_endpoint"} {
+		if s := settings[key]; !s.Secret || s.Value != pkgconfig.MaskedSet {
+			t.Errorf("%s: expected a masked secret, got %+v", key, s)
+		}
+	}
+
+	canonical := view.CanonicalString()
+	for _, secret := range []string{"sk-do-not-print", "secret-resource", "env-resource"} {
+		if strings.Contains(canonical, secret) {
+			t.Errorf("canonical view leaks %q", secret)
+		}
+	}
+	if view.ConfigHash != cfg.Hash() {
+		t.Errorf("expected the config hash, got %q", view.ConfigHash)
+	}
+	again := cfg.EffectiveView(func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	})
+	if view.Hash() != again.Hash() {
+		t.Error("expected a deterministic view hash")
+	}
+	noEnv := cfg.EffectiveView(nil)
+	if got := len(noEnv.FromEnv()); got != 0 {
+		t.Errorf("expected no env settings without env, got %d", got)
+	}
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"quantumlife/internal/surface"
+	trustengine "quantumlife/internal/trust"
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/domain/undowindow"
+)
+
+// ValidationError is one problem in a config that parsed but is invalid.
+type ValidationError struct {
+	// Line is the 1-based line in the source file, or 0 when unknown.
+	Line int
+
+	// Field is the config path, e.g. "circle:work.email[0].provider".
+	Field string
+
+	// Message says what is wrong and what is expected.
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("line %d: %s: %s", e.Line, e.Field, e.Message)
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors is every problem found in a config.
+// LoadFromFile returns it when a config parses but is invalid.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Error()
+	}
+	return "invalid config: " + strings.Join(msgs, "; ")
+}
+
+// Known integration providers by kind.
+var (
+	emailProviders    = []string{"google", "microsoft", "mock"}
+	calendarProviders = []string{"caldav", "google", "microsoft", "mock"}
+	financeProviders  = []string{"mock", "plaid", "truelayer"}
+)
+
+// positions maps a config path to the source line that set it.
+// Circle paths fall back to the circle's section header.
+type positions map[string]int
+
+// line returns the line for field, falling back to fallback.
+func (p positions) line(field, fallback string) int {
+	if n, ok := p[field]; ok {
+		return n
+	}
+	return p[fallback]
+}
+
+// Validate reports every problem in cfg: missing required fields, unknown
+// provider kinds and out-of-range thresholds. It returns nil for a valid
+// config. Configs built in code carry no file positions, so Line is 0.
+func Validate(cfg *MultiCircleConfig) []ValidationError {
+	return validate(cfg, nil)
+}
+
+// validate checks cfg, attaching lines from pos where known.
+// Errors are ordered by line, then field.
+func validate(cfg *MultiCircleConfig, pos positions) []ValidationError {
+	var errs []ValidationError
+	add := func(field, fallback, format string, args ...interface{}) {
+		errs = append(errs, ValidationError{
+			Line:    pos.line(field, fallback),
+			Field:   field,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if len(cfg.Circles) == 0 {
+		add("circles", "", "at least one [circle:<id>] section is required")
+	}
+	if cfg.DefaultCircleID != "" && cfg.Circles[cfg.DefaultCircleID] == nil {
+		add("defaults.circle", "", "circle %q is not configured", cfg.DefaultCircleID)
+	}
+
+	ids := make([]identity.EntityID, 0, len(cfg.Circles))
+	for id := range cfg.Circles {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		circle := cfg.Circles[id]
+		section := "circle:" + string(id)
+		if id == "" {
+			add(section, "", "circle ID is required, e.g. [circle:work]")
+		}
+		if circle.Name == "" {
+			add(section+".name", section, "name is required")
+		}
+		if circle.EmailSyncMaxMessages < 0 {
+			add(section+".email_sync_max_messages", section, "must not be negative, got %d", circle.EmailSyncMaxMessages)
+		}
+		if circle.EmailSyncWindowDays < 0 {
+			add(section+".email_sync_window_days", section, "must not be negative, got %d", circle.EmailSyncWindowDays)
+		}
+		for i, e := range circle.EmailIntegrations {
+			field := fmt.Sprintf("%s.email[%d]", section, i)
+			checkIntegration(add, field, e.Provider, emailProviders, "identifier", e.Identifier)
+		}
+		for i, c := range circle.CalendarIntegrations {
+			field := fmt.Sprintf("%s.calendar[%d]", section, i)
+			checkIntegration(add, field, c.Provider, calendarProviders, "calendar_id", c.CalendarID)
+		}
+		for i, f := range circle.FinanceIntegrations {
+			field := fmt.Sprintf("%s.finance[%d]", section, i)
+			checkIntegration(add, field, f.Provider, financeProviders, "identifier", f.Identifier)
+		}
+	}
+
+	kinds := make([]string, 0, len(cfg.Sync.LookbackDays))
+	for kind := range cfg.Sync.LookbackDays {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		field := "sync." + kind + "_lookback_days"
+		if !connection.ConnectionKind(kind).Valid() {
+			add(field, "", "unknown connection kind %q", kind)
+		}
+		if days := cfg.Sync.LookbackDays[connection.ConnectionKind(kind)]; days < 0 {
+			add(field, "", "must not be negative, got %d", days)
+		}
+	}
+
+	actions := make([]string, 0, len(cfg.Approvals.Required))
+	for action := range cfg.Approvals.Required {
+		actions = append(actions, string(action))
+	}
+	sort.Strings(actions)
+	for _, action := range actions {
+		field := "approvals." + action
+		if !execintent.ActionClass(action).Valid() {
+			add(field, "", "unknown action class %q", action)
+		}
+		checkRange(add, field, cfg.Approvals.Required[execintent.ActionClass(action)], execintent.MaxRequiredApprovals)
+	}
+
+	checkRange(add, "shadow.max_suggestions", cfg.Shadow.MaxSuggestions, 0)
+	checkRange(add, "surface.promotion_threshold", cfg.SurfacePromotionThreshold, surface.MaxPromotionThreshold)
+	checkRange(add, "magnitude.a_few_max", cfg.MagnitudeAFewMax, shadowllm.MaxAFewMax)
+	checkRange(add, "trust.max_shown", cfg.TrustMaxShown, trustengine.MaxShownLimit)
+	checkRange(add, "undo.window_minutes", cfg.UndoWindowMinutes, int(undowindow.Max/time.Minute))
+
+	if m := shadowllm.MagnitudeBucket(cfg.TrustMeaningfulMin); m != "" && m != shadowllm.MagnitudeAFew && m != shadowllm.MagnitudeSeveral {
+		add("trust.meaningful_min", "", "unknown magnitude %q (want a_few or several)", cfg.TrustMeaningfulMin)
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		if errs[i].Line != errs[j].Line {
+			return errs[i].Line < errs[j].Line
+		}
+		return errs[i].Field < errs[j].Field
+	})
+	return errs
+}
+
+// checkIntegration reports a missing or unknown provider and a missing target.
+func checkIntegration(add func(field, fallback, format string, args ...interface{}), field, provider string, known []string, targetName, target string) {
+	switch {
+	case provider == "":
+		add(field+".provider", field, "provider is required (want %s)", strings.Join(known, ", "))
+	case !knownProvider(known, provider):
+		add(field+".provider", field, "unknown provider %q (want %s)", provider, strings.Join(known, ", "))
+	}
+	if target == "" {
+		add(field+"."+targetName, field, "%s is required", targetName)
+	}
+}
+
+// checkRange reports a negative value, or one above max when max > 0.
+// Zero means unset and is always allowed.
+func checkRange(add func(field, fallback, format string, args ...interface{}), field string, value, max int) {
+	switch {
+	case value < 0:
+		add(field, "", "must not be negative, got %d", value)
+	case max > 0 && value > max:
+		add(field, "", "must be at most %d, got %d", max, value)
+	}
+}
+
+// knownProvider reports whether list holds s.
+func knownProvider(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -172,6 +172,9 @@ func (c Config) IsProviderConfigured(provider ProviderID) bool {
 		return c.TrueLayer.IsConfigured()
 	case ProviderPlaid:
 		return c.Plaid.IsConfigured()
+	case ProviderCalDAV:
+		// No app registration - each circle supplies its own app password
+		return true
 	default:
 		return false
 	}
@@ -192,6 +195,7 @@ func (c Config) ConfiguredProviders() []ProviderID {
 	if c.Plaid.IsConfigured() {
 		providers = append(providers, ProviderPlaid)
 	}
+	providers = append(providers, ProviderCalDAV)
 	return providers
 }
 
@@ -10,6 +10,7 @@
 package impl_inmem
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -139,6 +140,9 @@ func (b *Broker) BeginOAuth(provider auth.ProviderID, redirectURI string, state
 	case auth.ProviderPlaid:
 		// Plaid uses Link token flow - return instructions
 		return b.buildPlaidLinkInstructions(redirectURI, state, providerScopes)
+	case auth.ProviderCalDAV:
+		// CalDAV uses app passwords submitted through a form - no OAuth
+		return "", fmt.Errorf("%w: caldav has no oauth flow", auth.ErrInvalidProvider)
 	default:
 		return "", auth.ErrInvalidProvider
 	}
@@ -257,6 +261,11 @@ func (b *Broker) ExchangeCode(ctx context.Context, provider auth.ProviderID, cod
 		return b.exchangePlaidPublicToken(ctx, "demo-circle", code)
 	}
 
+	// CalDAV stores the submitted secret as-is
+	if provider == auth.ProviderCalDAV {
+		return b.storeCalDAVSecret(ctx, "demo-circle", code)
+	}
+
 	// Build token request for OAuth providers
 	var tokenURL string
 	var clientID, clientSecret string
@@ -285,6 +294,9 @@ func (b *Broker) ExchangeCode(ctx context.Context, provider auth.ProviderID, cod
 	data.Set("client_secret", clientSecret)
 	data.Set("redirect_uri", redirectURI)
 	data.Set("grant_type", "authorization_code")
+	if verifier := auth.CodeVerifierFromContext(ctx); verifier != "" {
+		data.Set("code_verifier", verifier) // PKCE (RFC 7636)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
@@ -359,6 +371,11 @@ func (b *Broker) ExchangeCodeForCircle(ctx context.Context, circleID string, pro
 		return b.exchangePlaidPublicTokenForCircle(ctx, circleID, code)
 	}
 
+	// CalDAV stores the submitted secret as-is
+	if provider == auth.ProviderCalDAV {
+		return b.storeCalDAVSecret(ctx, circleID, code)
+	}
+
 	// Build token request for OAuth providers
 	var tokenURL string
 	var clientID, clientSecret string
@@ -387,6 +404,9 @@ func (b *Broker) ExchangeCodeForCircle(ctx context.Context, circleID string, pro
 	data.Set("client_secret", clientSecret)
 	data.Set("redirect_uri", redirectURI)
 	data.Set("grant_type", "authorization_code")
+	if verifier := auth.CodeVerifierFromContext(ctx); verifier != "" {
+		data.Set("code_verifier", verifier) // PKCE (RFC 7636)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
@@ -415,6 +435,9 @@ func (b *Broker) ExchangeCodeForCircle(ctx context.Context, circleID string, pro
 		RefreshToken string `json:"refresh_token"`
 		ExpiresIn    int    `json:"expires_in"`
 		Scope        string `json:"scope"`
+
+		// Google reports this for apps in testing mode (7-day refresh tokens)
+		RefreshTokenExpiresIn int `json:"refresh_token_expires_in"`
 	}
 	if err := json.Unmarshal(body, &tokenResp); err != nil {
 		return auth.TokenHandle{}, err
@@ -430,6 +453,9 @@ func (b *Broker) ExchangeCodeForCircle(ctx context.Context, circleID string, pro
 
 	// Store the refresh token (encrypted)
 	var expiresAt time.Time
+	if tokenResp.RefreshTokenExpiresIn > 0 {
+		expiresAt = b.clockFunc().Add(time.Duration(tokenResp.RefreshTokenExpiresIn) * time.Second)
+	}
 	var handle auth.TokenHandle
 
 	// Use persistent store if available, otherwise use regular store
@@ -532,6 +558,24 @@ func (b *Broker) exchangePlaidPublicTokenForCircle(ctx context.Context, circleID
 	return handle, nil
 }
 
+// storeCalDAVSecret stores an opaque CalDAV secret for a circle.
+// The broker never parses the secret; the caldav_read adapter does.
+// CRITICAL: Only calendar:read is stored. The secret is encrypted at rest.
+func (b *Broker) storeCalDAVSecret(ctx context.Context, circleID, secret string) (auth.TokenHandle, error) {
+	if secret == "" {
+		return auth.TokenHandle{}, fmt.Errorf("%w: empty caldav secret", auth.ErrInvalidCode)
+	}
+
+	qlScopes := []string{"calendar:read"}
+	var expiresAt time.Time
+
+	// Use persistent store if available
+	if b.persistentStore != nil {
+		return b.persistentStore.StoreWithPersist(circleID, auth.ProviderCalDAV, secret, qlScopes, expiresAt)
+	}
+	return b.store.Store(ctx, circleID, auth.ProviderCalDAV, secret, qlScopes, expiresAt)
+}
+
 // MintAccessToken mints an access token for an operation.
 func (b *Broker) MintAccessToken(ctx context.Context, envelope primitives.ExecutionEnvelope, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error) {
 	// Validate envelope
@@ -606,6 +650,11 @@ func (b *Broker) refreshAccessToken(ctx context.Context, provider auth.ProviderI
 		return refreshToken, 86400, nil
 	}
 
+	// CalDAV secrets are not OAuth tokens - return as-is with a short expiry
+	if provider == auth.ProviderCalDAV {
+		return refreshToken, 3600, nil
+	}
+
 	var tokenURL string
 	var clientID, clientSecret string
 
@@ -675,6 +724,23 @@ func (b *Broker) HasToken(ctx context.Context, circleID string, provider auth.Pr
 	return b.store.HasToken(ctx, circleID, provider), nil
 }
 
+// TimeUntilExpiry returns how long the stored token for a circle/provider
+// stays usable, measured against the broker clock.
+func (b *Broker) TimeUntilExpiry(ctx context.Context, circleID string, provider auth.ProviderID) (time.Duration, error) {
+	handle, ok := b.GetTokenHandle(circleID, provider)
+	if !ok {
+		return 0, auth.ErrNoToken
+	}
+	if handle.ExpiresAt.IsZero() {
+		return auth.NoExpiry, nil
+	}
+	remaining := handle.ExpiresAt.Sub(b.clockFunc())
+	if remaining <= 0 {
+		return 0, auth.ErrTokenExpired
+	}
+	return remaining, nil
+}
+
 // StoreTokenDirectly stores a token directly (for demo/testing).
 // This bypasses OAuth flow and should only be used in tests.
 func (b *Broker) StoreTokenDirectly(ctx context.Context, circleID string, provider auth.ProviderID, refreshToken string, scopes []string) (auth.TokenHandle, error) {
@@ -768,5 +834,27 @@ func (b *Broker) Sync() error {
 	return b.persistentStore.Sync()
 }
 
+// RotateEncryptionKey re-encrypts all stored tokens under newKey in one pass.
+// Both keys must be non-empty and differ. On error nothing changes.
+// CRITICAL: Neither key is ever logged or included in an error.
+func (b *Broker) RotateEncryptionKey(oldKey, newKey []byte) error {
+	if len(oldKey) == 0 || len(newKey) == 0 || bytes.Equal(oldKey, newKey) {
+		return auth.ErrInvalidEncryptionKey
+	}
+
+	var err error
+	if b.persistentStore != nil {
+		err = b.persistentStore.RotateKey(string(oldKey), string(newKey))
+	} else {
+		err = b.store.RotateKey(string(oldKey), string(newKey))
+	}
+	if err != nil {
+		return err
+	}
+
+	b.config.TokenEncryptionKey = string(newKey)
+	return nil
+}
+
 // Verify interface compliance at compile time.
 var _ auth.TokenBroker = (*Broker)(nil)
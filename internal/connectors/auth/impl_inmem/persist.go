@@ -330,3 +330,39 @@ func (ts *TokenStoreWithPersistence) Sync() error {
 
 	return ts.persist.Save(tokens, nextID)
 }
+
+// RotateKey re-encrypts every stored token from oldKey to newKey and rewrites
+// the persisted store under newKey.
+//
+// CRITICAL: The on-disk store must decrypt under oldKey, so a wrong old key
+// can never overwrite it. The new file is written atomically before memory
+// is switched; on any failure both stay on oldKey.
+func (ts *TokenStoreWithPersistence) RotateKey(oldKey, newKey string) error {
+	if ts.persist.IsEnabled() {
+		oldPM := NewPersistenceManager(oldKey, WithPersistPath(ts.persist.GetFilePath()))
+		if _, _, err := oldPM.Load(); err != nil {
+			return fmt.Errorf("persisted store does not open under old key: %w", err)
+		}
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	newEnc := NewTokenEncryptor(newKey)
+	tokens, err := reencryptTokens(ts.tokens, NewTokenEncryptor(oldKey), newEnc)
+	if err != nil {
+		return err
+	}
+
+	newPM := NewPersistenceManager(newKey, WithPersistPath(ts.persist.GetFilePath()))
+	if ts.persist.IsEnabled() {
+		if err := newPM.Save(tokens, ts.idCounter); err != nil {
+			return fmt.Errorf("failed to persist rotated store: %w", err)
+		}
+	}
+
+	ts.tokens = tokens
+	ts.encryptor = newEnc
+	ts.persist = newPM
+	return nil
+}
@@ -233,3 +233,113 @@ func TestOutputNeverContainsTokenMaterial(t *testing.T) {
 		}
 	}
 }
+
+func TestRotateKeyReencryptsPersistedStore(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "broker_store.json")
+	oldKey, newKey := "old-test-key", "new-test-key"
+	ctx := context.Background()
+
+	store, err := NewTokenStoreWithPersistence(oldKey, WithPersistPath(storePath))
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	if _, err := store.StoreWithPersist("circle-1", auth.ProviderGoogle, "refresh-token-1", []string{"email:read"}, time.Time{}); err != nil {
+		t.Fatalf("Failed to store: %v", err)
+	}
+
+	if err := store.RotateKey(oldKey, newKey); err != nil {
+		t.Fatalf("RotateKey failed: %v", err)
+	}
+
+	// The running store still decrypts its tokens
+	if refresh, _, err := store.Get(ctx, "circle-1", auth.ProviderGoogle); err != nil || refresh != "refresh-token-1" {
+		t.Errorf("Expected rotated token to decrypt, got %q (%v)", refresh, err)
+	}
+
+	// The file opens under the new key only
+	if _, _, err := NewPersistenceManager(oldKey, WithPersistPath(storePath)).Load(); err == nil {
+		t.Error("Expected persisted store to reject the old key after rotation")
+	}
+	reopened, err := NewTokenStoreWithPersistence(newKey, WithPersistPath(storePath))
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	if refresh, _, err := reopened.Get(ctx, "circle-1", auth.ProviderGoogle); err != nil || refresh != "refresh-token-1" {
+		t.Errorf("Expected reopened token to decrypt, got %q (%v)", refresh, err)
+	}
+}
+
+func TestRotateKeyWrongOldKeyChangesNothing(t *testing.T) {
+	storePath := filepath.Join(t.TempDir(), "broker_store.json")
+	oldKey := "old-test-key"
+	ctx := context.Background()
+
+	store, err := NewTokenStoreWithPersistence(oldKey, WithPersistPath(storePath))
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	if _, err := store.StoreWithPersist("circle-1", auth.ProviderGoogle, "refresh-token-1", []string{"email:read"}, time.Time{}); err != nil {
+		t.Fatalf("Failed to store: %v", err)
+	}
+	before, err := os.ReadFile(storePath)
+	if err != nil {
+		t.Fatalf("Failed to read store: %v", err)
+	}
+
+	err = store.RotateKey("wrong-old-key", "new-test-key")
+	if err == nil {
+		t.Fatal("Expected rotation with the wrong old key to fail")
+	}
+	for _, key := range []string{"wrong-old-key", "new-test-key", oldKey} {
+		if strings.Contains(err.Error(), key) {
+			t.Errorf("Error must not contain key material: %v", err)
+		}
+	}
+
+	after, _ := os.ReadFile(storePath)
+	if string(before) != string(after) {
+		t.Error("Persisted store must be untouched after a failed rotation")
+	}
+	if refresh, _, err := store.Get(ctx, "circle-1", auth.ProviderGoogle); err != nil || refresh != "refresh-token-1" {
+		t.Errorf("Expected token to stay on the old key, got %q (%v)", refresh, err)
+	}
+}
+
+func TestBrokerRotateEncryptionKeyRejectsEmptyOrSameKey(t *testing.T) {
+	broker := NewBroker(auth.Config{TokenEncryptionKey: "k"}, nil)
+	for _, keys := range [][2]string{{"", "new"}, {"k", ""}, {"k", "k"}} {
+		if err := broker.RotateEncryptionKey([]byte(keys[0]), []byte(keys[1])); err != auth.ErrInvalidEncryptionKey {
+			t.Errorf("RotateEncryptionKey(%q, %q) = %v, want ErrInvalidEncryptionKey", keys[0], keys[1], err)
+		}
+	}
+}
+
+func TestBrokerTimeUntilExpiry(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	broker := NewBroker(auth.Config{TokenEncryptionKey: "test-key"}, nil, WithClock(func() time.Time { return now }))
+	ctx := context.Background()
+
+	if _, err := broker.TimeUntilExpiry(ctx, "circle-1", auth.ProviderGoogle); err != auth.ErrNoToken {
+		t.Errorf("Expected ErrNoToken, got %v", err)
+	}
+
+	// Refresh tokens without a reported expiry never lapse
+	if _, err := broker.StoreTokenDirectly(ctx, "circle-1", auth.ProviderGoogle, "refresh-token", []string{"email:read"}); err != nil {
+		t.Fatalf("Failed to store: %v", err)
+	}
+	if d, err := broker.TimeUntilExpiry(ctx, "circle-1", auth.ProviderGoogle); err != nil || d != auth.NoExpiry {
+		t.Errorf("Expected NoExpiry, got %v (%v)", d, err)
+	}
+
+	if _, err := broker.GetStore().Store(ctx, "circle-1", auth.ProviderMicrosoft, "refresh-token", []string{"email:read"}, now.Add(36*time.Hour)); err != nil {
+		t.Fatalf("Failed to store: %v", err)
+	}
+	if d, err := broker.TimeUntilExpiry(ctx, "circle-1", auth.ProviderMicrosoft); err != nil || d != 36*time.Hour {
+		t.Errorf("Expected 36h remaining, got %v (%v)", d, err)
+	}
+
+	now = now.Add(48 * time.Hour)
+	if _, err := broker.TimeUntilExpiry(ctx, "circle-1", auth.ProviderMicrosoft); err != auth.ErrTokenExpired {
+		t.Errorf("Expected ErrTokenExpired, got %v", err)
+	}
+}
@@ -25,9 +25,18 @@ var googleScopeMap = map[string]string{
 }
 
 // QuantumLife to Microsoft scope mapping (v6: includes write scopes).
+// Added email:read for Microsoft Graph (Outlook) read-only mail access.
 var microsoftScopeMap = map[string]string{
 	"calendar:read":  "Calendars.Read",
 	"calendar:write": "Calendars.ReadWrite",
+	"email:read":     "Mail.Read",
+}
+
+// QuantumLife to CalDAV scope mapping (READ-ONLY).
+// CalDAV has no provider scopes; an app password grants what the account
+// grants, so read-only is enforced by the adapter issuing REPORT only.
+var caldavScopeMap = map[string]string{
+	"calendar:read": "calendar:read",
 }
 
 // QuantumLife to TrueLayer scope mapping (v8.2: READ-ONLY).
@@ -88,6 +97,8 @@ func (m *ScopeMapper) MapToProviderWithMode(provider auth.ProviderID, quantumlif
 		scopeMap = googleScopeMap
 	case auth.ProviderMicrosoft:
 		scopeMap = microsoftScopeMap
+	case auth.ProviderCalDAV:
+		scopeMap = caldavScopeMap
 	default:
 		return nil, auth.ErrInvalidProvider
 	}
@@ -197,6 +208,8 @@ func (m *ScopeMapper) MapFromProvider(provider auth.ProviderID, providerScopes [
 		reverseMap = reverseGoogleScopeMap
 	case auth.ProviderMicrosoft:
 		reverseMap = reverseMicrosoftScopeMap
+	case auth.ProviderCalDAV:
+		reverseMap = caldavScopeMap
 	default:
 		return nil
 	}
@@ -234,6 +247,10 @@ var reverseGoogleScopeMap = map[string]string{
 var reverseMicrosoftScopeMap = map[string]string{
 	"Calendars.Read":      "calendar:read",
 	"Calendars.ReadWrite": "calendar:write",
+	"Mail.Read":           "email:read",
+
+	// Graph may echo granted scopes in fully-qualified form.
+	"https://graph.microsoft.com/Mail.Read": "email:read",
 }
 
 // TrueLayer scopes that indicate finance:read access (v8.2).
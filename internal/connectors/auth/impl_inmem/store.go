@@ -202,3 +202,41 @@ func (s *TokenStore) UpdateToken(ctx context.Context, circleID string, provider
 
 	return nil
 }
+
+// RotateKey re-encrypts every stored token from oldKey to newKey.
+// The store is left unchanged if any token fails to decrypt or re-encrypt.
+func (s *TokenStore) RotateKey(oldKey, newKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	newEnc := NewTokenEncryptor(newKey)
+	tokens, err := reencryptTokens(s.tokens, NewTokenEncryptor(oldKey), newEnc)
+	if err != nil {
+		return err
+	}
+
+	s.tokens = tokens
+	s.encryptor = newEnc
+	return nil
+}
+
+// reencryptTokens returns copies of tokens with refresh tokens moved from
+// oldEnc to newEnc. The input map is not modified.
+func reencryptTokens(tokens map[string]*StoredToken, oldEnc, newEnc *TokenEncryptor) (map[string]*StoredToken, error) {
+	rotated := make(map[string]*StoredToken, len(tokens))
+	for key, token := range tokens {
+		refreshToken, err := oldEnc.DecryptString(token.EncryptedRefreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt token %s under old key: %w", key, err)
+		}
+		encrypted, err := newEnc.EncryptString(refreshToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to re-encrypt token %s: %w", key, err)
+		}
+
+		copied := *token
+		copied.EncryptedRefreshToken = encrypted
+		rotated[key] = &copied
+	}
+	return rotated, nil
+}
@@ -32,17 +32,22 @@ const (
 	// ProviderPlaid is Plaid financial data API (v8.3).
 	// CRITICAL: This provider is READ-ONLY. No payment products are allowed.
 	ProviderPlaid ProviderID = "plaid"
+
+	// ProviderCalDAV is a CalDAV server (Fastmail, Nextcloud) reached with an
+	// app password. The stored secret is opaque to the broker.
+	// CRITICAL: This provider is READ-ONLY. Only calendar:read is stored.
+	ProviderCalDAV ProviderID = "caldav"
 )
 
 // ValidProviders returns all valid provider IDs.
 func ValidProviders() []ProviderID {
-	return []ProviderID{ProviderGoogle, ProviderMicrosoft, ProviderTrueLayer, ProviderPlaid}
+	return []ProviderID{ProviderGoogle, ProviderMicrosoft, ProviderTrueLayer, ProviderPlaid, ProviderCalDAV}
 }
 
 // IsValidProvider checks if the provider ID is valid.
 func IsValidProvider(p ProviderID) bool {
 	switch p {
-	case ProviderGoogle, ProviderMicrosoft, ProviderTrueLayer, ProviderPlaid:
+	case ProviderGoogle, ProviderMicrosoft, ProviderTrueLayer, ProviderPlaid, ProviderCalDAV:
 		return true
 	default:
 		return false
@@ -121,8 +126,22 @@ type TokenBroker interface {
 
 	// HasToken checks if a circle has a stored token for a provider.
 	HasToken(ctx context.Context, circleID string, provider ProviderID) (bool, error)
+
+	// TimeUntilExpiry returns how long the stored token stays usable.
+	// Returns NoExpiry when the provider reported no expiry, ErrNoToken
+	// when nothing is stored and ErrTokenExpired once it has lapsed.
+	TimeUntilExpiry(ctx context.Context, circleID string, provider ProviderID) (time.Duration, error)
+
+	// RotateEncryptionKey re-encrypts every stored token under newKey.
+	//
+	// CRITICAL: Atomic. If any token fails to re-encrypt, nothing changes
+	// and all tokens stay on oldKey. Keys are never logged.
+	RotateEncryptionKey(oldKey, newKey []byte) error
 }
 
+// NoExpiry is returned by TimeUntilExpiry for tokens without a known expiry.
+const NoExpiry time.Duration = 1<<63 - 1
+
 // TokenHandle is an opaque identifier for a stored refresh token.
 // The actual token is stored encrypted; this handle references it.
 type TokenHandle struct {
@@ -198,6 +217,9 @@ var (
 	// ErrProviderNotConfigured is returned when provider credentials are not set.
 	ErrProviderNotConfigured = errors.New("provider credentials not configured")
 
+	// ErrInvalidEncryptionKey is returned when a rotation key is empty or unchanged.
+	ErrInvalidEncryptionKey = errors.New("encryption keys must be non-empty and differ")
+
 	// ErrInvalidCode is returned when OAuth code exchange fails.
 	ErrInvalidCode = errors.New("invalid or expired authorization code")
 )
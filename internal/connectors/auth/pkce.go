@@ -0,0 +1,21 @@
+package auth
+
+import "context"
+
+// codeVerifierKey is the context key for a PKCE code verifier.
+type codeVerifierKey struct{}
+
+// WithCodeVerifier returns a context carrying a PKCE (RFC 7636) code
+// verifier. Brokers send it as code_verifier on the code exchange.
+//
+// CRITICAL: The verifier is secret. Never log it or put it in events.
+func WithCodeVerifier(ctx context.Context, verifier string) context.Context {
+	return context.WithValue(ctx, codeVerifierKey{}, verifier)
+}
+
+// CodeVerifierFromContext returns the PKCE code verifier carried by ctx,
+// or "" if the flow did not use PKCE.
+func CodeVerifierFromContext(ctx context.Context) string {
+	verifier, _ := ctx.Value(codeVerifierKey{}).(string)
+	return verifier
+}
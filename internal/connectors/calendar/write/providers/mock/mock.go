@@ -22,6 +22,9 @@ type Writer struct {
 	// callCount tracks calls per EventID for testing.
 	callCount map[string]int
 
+	// skipped tracks idempotency keys of responses a dry run skipped.
+	skipped []string
+
 	// failNext causes the next call to fail.
 	failNext bool
 
@@ -140,12 +143,28 @@ func (w *Writer) GetCallCount(eventID string) int {
 	return w.callCount[eventID]
 }
 
+// RecordSkipped records a response skipped by a dry run.
+// The event is not changed and no call is counted.
+func (w *Writer) RecordSkipped(idempotencyKey string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.skipped = append(w.skipped, idempotencyKey)
+}
+
+// GetSkippedCount returns the number of responses dry runs skipped.
+func (w *Writer) GetSkippedCount() int {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return len(w.skipped)
+}
+
 // Reset clears all state.
 func (w *Writer) Reset() {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 	w.responses = make(map[string]write.RespondReceipt)
 	w.callCount = make(map[string]int)
+	w.skipped = nil
 	w.failNext = false
 	w.failError = ""
 }
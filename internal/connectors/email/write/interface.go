@@ -88,6 +88,26 @@ type Writer interface {
 	IsSandbox() bool
 }
 
+// ScheduledWriter is a Writer whose provider can hold a reply and
+// dispatch it later.
+//
+// Real email cannot be unsent, so a held reply is the only undoable send:
+// undo cancels it before dispatch. Writers that cannot delay a send must
+// not implement this interface.
+type ScheduledWriter interface {
+	Writer
+
+	// ScheduleReply queues a reply for dispatch at sendAt.
+	//
+	// CRITICAL: Nothing leaves the provider before sendAt.
+	// CRITICAL: Must be idempotent - same IdempotencyKey returns same result.
+	ScheduleReply(ctx context.Context, req SendReplyRequest, sendAt time.Time) (SendReplyReceipt, error)
+
+	// CancelScheduledReply cancels a held reply before dispatch.
+	// Returns ErrAlreadyDispatched once sendAt has passed.
+	CancelScheduledReply(ctx context.Context, messageID string) error
+}
+
 // ValidateSendReplyRequest validates the input for SendReply.
 func ValidateSendReplyRequest(req SendReplyRequest) error {
 	if req.Provider == "" {
@@ -116,6 +136,8 @@ var (
 	ErrMissingBody               = writeError("missing body: cannot send empty reply")
 	ErrMissingIdempotencyKey     = writeError("missing idempotency_key")
 	ErrDuplicateSend             = writeError("duplicate send detected via idempotency key")
+	ErrScheduledNotFound         = writeError("scheduled reply not found")
+	ErrAlreadyDispatched         = writeError("scheduled reply already dispatched")
 )
 
 type writeError string
@@ -24,6 +24,12 @@ type Writer struct {
 	// sentMessages tracks sent messages by idempotency key.
 	sentMessages map[string]write.SendReplyReceipt
 
+	// scheduled tracks held replies by idempotency key.
+	scheduled map[string]*scheduledReply
+
+	// skipped tracks idempotency keys of sends a dry run skipped.
+	skipped []string
+
 	// clock provides deterministic time.
 	clock func() time.Time
 
@@ -34,6 +40,13 @@ type Writer struct {
 	failNextError string
 }
 
+// scheduledReply is a reply held until sendAt.
+type scheduledReply struct {
+	receipt   write.SendReplyReceipt
+	sendAt    time.Time
+	cancelled bool
+}
+
 // Option configures the mock writer.
 type Option func(*Writer)
 
@@ -48,6 +61,7 @@ func WithClock(clock func() time.Time) Option {
 func NewWriter(opts ...Option) *Writer {
 	w := &Writer{
 		sentMessages: make(map[string]write.SendReplyReceipt),
+		scheduled:    make(map[string]*scheduledReply),
 		clock:        time.Now,
 	}
 	for _, opt := range opts {
@@ -107,6 +121,82 @@ func (w *Writer) SendReply(ctx context.Context, req write.SendReplyRequest) (wri
 	return receipt, nil
 }
 
+// ScheduleReply holds a mock email reply until sendAt.
+//
+// CRITICAL: Deterministic - same IdempotencyKey returns same receipt.
+func (w *Writer) ScheduleReply(ctx context.Context, req write.SendReplyRequest, sendAt time.Time) (write.SendReplyReceipt, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Validate request
+	if err := write.ValidateSendReplyRequest(req); err != nil {
+		return write.SendReplyReceipt{
+			Success:        false,
+			Error:          err.Error(),
+			IdempotencyKey: req.IdempotencyKey,
+		}, nil
+	}
+
+	// Check idempotency - return prior receipt if exists
+	if prior, exists := w.scheduled[req.IdempotencyKey]; exists {
+		return prior.receipt, nil
+	}
+
+	// Check if we should fail
+	if w.failNext {
+		w.failNext = false
+		return write.SendReplyReceipt{
+			Success:        false,
+			Error:          w.failNextError,
+			IdempotencyKey: req.IdempotencyKey,
+		}, nil
+	}
+
+	messageID := w.generateMessageID(req)
+
+	receipt := write.SendReplyReceipt{
+		Success:            true,
+		MessageID:          messageID,
+		ThreadID:           req.ThreadID,
+		SentAt:             sendAt,
+		ProviderResponseID: fmt.Sprintf("mock-scheduled-%s", messageID[:8]),
+		IdempotencyKey:     req.IdempotencyKey,
+	}
+
+	w.scheduled[req.IdempotencyKey] = &scheduledReply{receipt: receipt, sendAt: sendAt}
+
+	return receipt, nil
+}
+
+// CancelScheduledReply cancels a held mock reply before its send time.
+func (w *Writer) CancelScheduledReply(ctx context.Context, messageID string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	reply := w.findScheduled(messageID)
+	if reply == nil {
+		return write.ErrScheduledNotFound
+	}
+	if reply.cancelled {
+		return nil
+	}
+	if w.clock().After(reply.sendAt) {
+		return write.ErrAlreadyDispatched
+	}
+	reply.cancelled = true
+	return nil
+}
+
+// findScheduled returns the held reply with messageID, if any.
+func (w *Writer) findScheduled(messageID string) *scheduledReply {
+	for _, reply := range w.scheduled {
+		if reply.receipt.MessageID == messageID {
+			return reply
+		}
+	}
+	return nil
+}
+
 // generateMessageID creates a deterministic message ID.
 func (w *Writer) generateMessageID(req write.SendReplyRequest) string {
 	canonical := fmt.Sprintf("mock-email|%s|%s|%s|%s|%s",
@@ -157,13 +247,53 @@ func (w *Writer) GetSentMessages() []write.SendReplyReceipt {
 	return result
 }
 
-// Reset clears all sent messages.
+// RecordSkipped records a send skipped by a dry run.
+// Nothing is sent or stored as sent.
+func (w *Writer) RecordSkipped(idempotencyKey string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.skipped = append(w.skipped, idempotencyKey)
+}
+
+// GetSkippedCount returns the number of sends dry runs skipped.
+func (w *Writer) GetSkippedCount() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.skipped)
+}
+
+// GetScheduledCount returns the number of replies held and not cancelled.
+func (w *Writer) GetScheduledCount() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	count := 0
+	for _, reply := range w.scheduled {
+		if !reply.cancelled {
+			count++
+		}
+	}
+	return count
+}
+
+// IsCancelled reports whether the held reply with messageID was cancelled.
+func (w *Writer) IsCancelled(messageID string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	reply := w.findScheduled(messageID)
+	return reply != nil && reply.cancelled
+}
+
+// Reset clears all sent and scheduled messages.
 func (w *Writer) Reset() {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 	w.sentMessages = make(map[string]write.SendReplyReceipt)
+	w.scheduled = make(map[string]*scheduledReply)
+	w.skipped = nil
 	w.failNext = false
 }
 
-// Ensure Writer implements write.Writer.
-var _ write.Writer = (*Writer)(nil)
+// Ensure Writer implements write.ScheduledWriter.
+var _ write.ScheduledWriter = (*Writer)(nil)
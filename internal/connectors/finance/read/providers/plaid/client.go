@@ -108,10 +108,22 @@ func (c *Client) CreateLinkToken(ctx context.Context, req LinkTokenCreateRequest
 	if req.RedirectURI != "" {
 		body["redirect_uri"] = req.RedirectURI
 	}
+	if req.HostedLink != nil {
+		body["hosted_link"] = req.HostedLink
+	}
 
 	return doPost[LinkTokenCreateResponse](ctx, c, "/link/token/create", body)
 }
 
+// GetLinkToken fetches a link token and the results of its Link sessions.
+// Used by Hosted Link to collect the public token after completion.
+func (c *Client) GetLinkToken(ctx context.Context, linkToken string) (*LinkTokenGetResponse, error) {
+	body := map[string]interface{}{
+		"link_token": linkToken,
+	}
+	return doPost[LinkTokenGetResponse](ctx, c, "/link/token/get", body)
+}
+
 // ExchangePublicToken exchanges a public token for an access token.
 func (c *Client) ExchangePublicToken(ctx context.Context, publicToken string) (*ItemPublicTokenExchangeResponse, error) {
 	body := map[string]interface{}{
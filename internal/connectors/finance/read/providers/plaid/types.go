@@ -120,6 +120,16 @@ type LinkTokenCreateRequest struct {
 	User         LinkTokenUser `json:"user"`
 	Products     []string      `json:"products"`
 	RedirectURI  string        `json:"redirect_uri,omitempty"`
+
+	// HostedLink requests a Plaid-hosted Link page instead of the
+	// client-side widget. Optional.
+	HostedLink *LinkTokenHostedLink `json:"hosted_link,omitempty"`
+}
+
+// LinkTokenHostedLink configures Hosted Link.
+type LinkTokenHostedLink struct {
+	// CompletionRedirectURI is where Plaid sends the browser when Link finishes.
+	CompletionRedirectURI string `json:"completion_redirect_uri,omitempty"`
 }
 
 // LinkTokenUser identifies the user for Link.
@@ -129,9 +139,51 @@ type LinkTokenUser struct {
 
 // LinkTokenCreateResponse is the response from /link/token/create.
 type LinkTokenCreateResponse struct {
-	LinkToken  string    `json:"link_token"`
-	Expiration time.Time `json:"expiration"`
-	RequestID  string    `json:"request_id"`
+	LinkToken     string    `json:"link_token"`
+	Expiration    time.Time `json:"expiration"`
+	HostedLinkURL string    `json:"hosted_link_url,omitempty"`
+	RequestID     string    `json:"request_id"`
+}
+
+// LinkTokenGetResponse is the response from /link/token/get.
+type LinkTokenGetResponse struct {
+	LinkToken    string        `json:"link_token"`
+	LinkSessions []LinkSession `json:"link_sessions"`
+	RequestID    string        `json:"request_id"`
+}
+
+// LinkSession is one Link session started with a link token.
+type LinkSession struct {
+	LinkSessionID string              `json:"link_session_id"`
+	Results       *LinkSessionResults `json:"results,omitempty"`
+}
+
+// LinkSessionResults holds the outcome of a finished Link session.
+type LinkSessionResults struct {
+	ItemAddResults []LinkItemAddResult `json:"item_add_results"`
+}
+
+// LinkItemAddResult carries the public token for an Item added through Link.
+type LinkItemAddResult struct {
+	// PublicToken is exchanged for an access token.
+	// SENSITIVE: Never log this value.
+	PublicToken string `json:"public_token"`
+}
+
+// PublicToken returns the first public token produced by the link sessions,
+// or "" if Link has not completed.
+func (r *LinkTokenGetResponse) PublicToken() string {
+	for _, session := range r.LinkSessions {
+		if session.Results == nil {
+			continue
+		}
+		for _, item := range session.Results.ItemAddResults {
+			if item.PublicToken != "" {
+				return item.PublicToken
+			}
+		}
+	}
+	return ""
 }
 
 // ItemPublicTokenExchangeRequest is the request for /item/public_token/exchange.
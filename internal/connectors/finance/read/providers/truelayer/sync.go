@@ -7,7 +7,7 @@
 //   - NO goroutines
 //   - NO time.Now() - clock injection only
 //   - Deterministic output: same inputs + clock = same hashes/receipts
-//   - Bounded sync: max 25 accounts, max 25 transactions per account, 7-day window
+//   - Bounded sync: max 25 accounts, max 25 transactions per account, 7-day default window (90-day cap)
 //   - NO retries - single attempt, fail gracefully
 //   - NEVER log secrets (access token, refresh token)
 //   - Privacy: only classification fields extracted, no amounts/merchants/timestamps stored
@@ -32,9 +32,12 @@ const (
 	// MaxTransactionsPerAccount is the maximum transactions per account.
 	MaxTransactionsPerAccount = 25
 
-	// SyncWindowDays is the number of days of transaction history.
+	// SyncWindowDays is the default number of days of transaction history.
 	SyncWindowDays = 7
 
+	// MaxSyncWindowDays is the hard cap on a configured sync window.
+	MaxSyncWindowDays = 90
+
 	// ResponseSizeLimit is the maximum response size in bytes (1MB).
 	ResponseSizeLimit = 1024 * 1024
 )
@@ -71,6 +74,21 @@ type SyncInput struct {
 	// AccessToken is the OAuth access token.
 	// SENSITIVE: Never log this value.
 	AccessToken string
+
+	// WindowDays is the configured lookback in days.
+	// Zero means SyncWindowDays. Clamped to MaxSyncWindowDays.
+	WindowDays int
+}
+
+// EffectiveWindowDays returns the clamped lookback in days.
+func (i SyncInput) EffectiveWindowDays() int {
+	if i.WindowDays <= 0 {
+		return SyncWindowDays
+	}
+	if i.WindowDays > MaxSyncWindowDays {
+		return MaxSyncWindowDays
+	}
+	return i.WindowDays
 }
 
 // SyncOutput contains the result of a sync operation.
@@ -148,9 +166,9 @@ func (s *SyncService) Sync(ctx context.Context, input SyncInput) (*SyncOutput, e
 		accounts = accounts[:MaxAccounts]
 	}
 
-	// Calculate date range (7-day window)
+	// Calculate date range (bounded lookback window)
 	toDate := now
-	fromDate := now.AddDate(0, 0, -SyncWindowDays)
+	fromDate := now.AddDate(0, 0, -input.EffectiveWindowDays())
 
 	// Fetch transactions for each account (bounded)
 	var allTxData []TransactionClassification
@@ -40,6 +40,9 @@ type Connector struct {
 	// abortedEnvelopes tracks aborted envelopes.
 	abortedEnvelopes map[string]bool
 
+	// skipped tracks envelope IDs of payments a dry run skipped.
+	skipped []string
+
 	// eventEmitter emits audit events.
 	eventEmitter events.Emitter
 
@@ -459,10 +462,22 @@ func (c *Connector) GetExecutedPayments() map[string]*write.PaymentReceipt {
 	return result
 }
 
+// RecordSkipped records a payment skipped by a dry run.
+// CRITICAL: Nothing is executed, not even a simulated payment.
+func (c *Connector) RecordSkipped(envelopeID string) {
+	c.skipped = append(c.skipped, envelopeID)
+}
+
+// GetSkippedCount returns the number of payments dry runs skipped (for testing).
+func (c *Connector) GetSkippedCount() int {
+	return len(c.skipped)
+}
+
 // Reset clears all state (for testing).
 func (c *Connector) Reset() {
 	c.executedPayments = make(map[string]*write.PaymentReceipt)
 	c.abortedEnvelopes = make(map[string]bool)
+	c.skipped = nil
 }
 
 func (c *Connector) emit(event events.Event) {
@@ -10,13 +10,16 @@
 package demo_phase14_policy_learning
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"quantumlife/internal/persist"
 	"quantumlife/internal/preflearn"
 	"quantumlife/pkg/domain/feedback"
 	"quantumlife/pkg/domain/interrupt"
 	"quantumlife/pkg/domain/policy"
+	"quantumlife/pkg/domain/storelog"
 	"quantumlife/pkg/domain/suppress"
 )
 
@@ -635,6 +638,40 @@ func TestPolicyVersionIncrement(t *testing.T) {
 	t.Logf("Policy version: %d -> %d", originalVersion, result.NewPolicy.Version)
 }
 
+// TestPolicyStoreUpdateCircleIfHash demonstrates optimistic concurrency:
+// an edit based on a stale policy hash is refused and changes nothing.
+func TestPolicyStoreUpdateCircleIfHash(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	store, err := persist.NewPolicyStore(storelog.NewInMemoryLog())
+	if err != nil {
+		t.Fatalf("NewPolicyStore error: %v", err)
+	}
+	ps := policy.DefaultPolicySet(now)
+	if err := store.Put(&ps); err != nil {
+		t.Fatalf("Put error: %v", err)
+	}
+
+	loaded := store.Hash()
+	raise := func(cp policy.CirclePolicy) policy.CirclePolicy {
+		cp.DailyNotifyQuota++
+		return cp
+	}
+	if err := store.UpdateCircleIfHash("work", loaded, raise, now); err != nil {
+		t.Fatalf("first edit should apply: %v", err)
+	}
+	if err := store.UpdateCircleIfHash("work", loaded, raise, now); !errors.Is(err, persist.ErrPolicyHashMismatch) {
+		t.Fatalf("second edit with a stale hash should be refused, got %v", err)
+	}
+
+	got := store.Get()
+	if got.Version != ps.Version+1 {
+		t.Errorf("expected exactly one new version, got %d", got.Version)
+	}
+	if q := got.Circles["work"].DailyNotifyQuota; q != ps.Circles["work"].DailyNotifyQuota+1 {
+		t.Errorf("expected quota raised once, got %d", q)
+	}
+}
+
 func containsStr(s, substr string) bool {
 	for i := 0; i <= len(s)-len(substr); i++ {
 		if s[i:i+len(substr)] == substr {
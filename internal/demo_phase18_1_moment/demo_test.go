@@ -1,6 +1,7 @@
 package demo_phase18_1_moment
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -108,6 +109,133 @@ func TestInterestStoreNoSideEffects(t *testing.T) {
 	t.Log("PASS: No side effects from reading")
 }
 
+// TestParseEmail verifies which submissions count as an email address.
+func TestParseEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string // empty when the input must be rejected
+	}{
+		{"plain", "test@example.com", "test@example.com"},
+		{"quoted local part", `"x"@y.com`, "x@y.com"},
+		{"quoted local part with space", `"a b"@y.com`, `"a b"@y.com`},
+		{"display name", "Sam <sam@example.com>", "sam@example.com"},
+		{"unicode domain", "user@例え.jp", "user@例え.jp"},
+		{"unicode local part", "ü@example.com", "ü@example.com"},
+		{"single-letter labels", "a@b.c", "a@b.c"},
+		{"no tld", "a@b", ""},
+		{"trailing dot", "a@b.", ""},
+		{"empty label", "a@b..com", ""},
+		{"empty local part", "@example.com", ""},
+		{"empty quoted local part", `""@example.com`, ""},
+		{"empty domain", "a@", ""},
+		{"no at sign", "example.com", ""},
+		{"two at signs", "a@@example.com", ""},
+		{"domain literal", "a@[192.0.2.1]", ""},
+		{"space in local part", "a b@example.com", ""},
+		{"dot only", ".", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := interest.ParseEmail(tt.input)
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("ParseEmail(%q) = %q, want error", tt.input, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseEmail(%q) = %q, %v; want %q", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+// TestInterestLimiter verifies the per-client token bucket refills from
+// the injected clock.
+func TestInterestLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	limiter := interest.NewLimiter(5, time.Minute, func() time.Time { return now })
+
+	for i := 0; i < 5; i++ {
+		if !limiter.Allow("203.0.113.7") {
+			t.Fatalf("request %d should be allowed", i+1)
+		}
+	}
+	if limiter.Allow("203.0.113.7") {
+		t.Error("sixth request in the same minute should be throttled")
+	}
+	if !limiter.Allow("198.51.100.2") {
+		t.Error("another client should have its own bucket")
+	}
+
+	// One token refills every 12 seconds
+	now = now.Add(11 * time.Second)
+	if limiter.Allow("203.0.113.7") {
+		t.Error("no token should have refilled after 11s")
+	}
+	now = now.Add(time.Second)
+	if !limiter.Allow("203.0.113.7") {
+		t.Error("one token should have refilled after 12s")
+	}
+	if limiter.Allow("203.0.113.7") {
+		t.Error("only one token should have refilled")
+	}
+
+	// Idle clients refill to capacity, never beyond
+	now = now.Add(time.Hour)
+	for i := 0; i < 5; i++ {
+		if !limiter.Allow("203.0.113.7") {
+			t.Fatalf("request %d after refill should be allowed", i+1)
+		}
+	}
+	if limiter.Allow("203.0.113.7") {
+		t.Error("refill should stop at capacity")
+	}
+
+	t.Log("PASS: Interest limiter throttles per client")
+}
+
+// TestInterestLimiterBoundsClients verifies many distinct active clients
+// cannot grow the limiter past MaxLimiterKeys.
+func TestInterestLimiterBoundsClients(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	limiter := interest.NewLimiter(5, time.Hour, func() time.Time { return now })
+
+	// Every client spends a token, so no bucket refills in time to be forgotten
+	for i := 0; i < interest.MaxLimiterKeys+50; i++ {
+		limiter.Allow(fmt.Sprintf("client-%d", i))
+		now = now.Add(time.Millisecond)
+	}
+	if got := limiter.Len(); got != interest.MaxLimiterKeys {
+		t.Errorf("expected %d tracked clients, got %d", interest.MaxLimiterKeys, got)
+	}
+
+	// The most recent client keeps its spent bucket
+	last := fmt.Sprintf("client-%d", interest.MaxLimiterKeys+49)
+	for i := 0; i < 4; i++ {
+		if !limiter.Allow(last) {
+			t.Fatalf("request %d for %s should be allowed", i+2, last)
+		}
+	}
+	if limiter.Allow(last) {
+		t.Error("recent client should still be throttled after eviction")
+	}
+}
+
+// TestInterestLimiterDisabled verifies a zero capacity allows everything.
+func TestInterestLimiterDisabled(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	limiter := interest.NewLimiter(0, time.Minute, func() time.Time { return now })
+
+	for i := 0; i < 100; i++ {
+		if !limiter.Allow("203.0.113.7") {
+			t.Fatalf("request %d should be allowed with no limit", i+1)
+		}
+	}
+}
+
 // TestMomentPageCopy verifies the page copy matches specification.
 func TestMomentPageCopy(t *testing.T) {
 	// These are the exact phrases required by Phase 18.1 spec
@@ -1,10 +1,13 @@
 package demo_phase18_2_today_quietly
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"quantumlife/internal/loop"
 	"quantumlife/internal/todayquietly"
+	"quantumlife/pkg/domain/obligation"
 )
 
 // TestDeterministicPageGeneration verifies same inputs + same clock produce identical output.
@@ -340,3 +343,154 @@ func TestNoSideEffectsFromReading(t *testing.T) {
 
 	t.Log("PASS: No side effects from reading")
 }
+
+// TestQuietAffirmationOnlyWhenGenuinelyQuiet verifies the affirmation shows only
+// when no cue was selected, nothing surfaced, and nothing was dismissed.
+func TestQuietAffirmationOnlyWhenGenuinelyQuiet(t *testing.T) {
+	quiet := todayquietly.BuildQuietAffirmation(todayquietly.QuietAffirmationInput{Period: "2025-01-15"})
+	if quiet == nil {
+		t.Fatal("expected affirmation when genuinely quiet")
+	}
+	if quiet.Line != "Quiet, as intended." {
+		t.Errorf("unexpected line: %q", quiet.Line)
+	}
+	again := todayquietly.BuildQuietAffirmation(todayquietly.QuietAffirmationInput{Period: "2025-01-15"})
+	if again == nil || again.Hash != quiet.Hash {
+		t.Error("expected deterministic affirmation hash")
+	}
+
+	notQuiet := []todayquietly.QuietAffirmationInput{
+		{Period: "2025-01-15", CueShown: true},
+		{Period: "2025-01-15", ItemSurfaced: true},
+		{Period: "2025-01-15", CueDismissed: true},
+	}
+	for _, in := range notQuiet {
+		if todayquietly.BuildQuietAffirmation(in) != nil {
+			t.Errorf("expected no affirmation for %+v", in)
+		}
+	}
+}
+
+// TestInputFromLoopReflectsObligations verifies the projection follows loop results.
+func TestInputFromLoopReflectsObligations(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	reply := obligation.NewObligation("circle-work", "evt-1", "email", obligation.ObligationReply, fixedTime)
+	invite := obligation.NewObligation("circle-personal", "evt-2", "calendar", obligation.ObligationDecide, fixedTime)
+	invite.Horizon = obligation.Horizon7d
+	invite.Severity = obligation.SeverityHigh
+
+	result := &loop.RunResult{
+		Circles: []loop.CircleResult{
+			{CircleID: "circle-personal", CircleName: "Personal", Obligations: []*obligation.Obligation{invite}},
+			{CircleID: "circle-work", CircleName: "Work", Obligations: []*obligation.Obligation{reply}},
+			{CircleID: "circle-finance", CircleName: "Finance"},
+		},
+	}
+
+	input := todayquietly.InputFromLoop(result, "")
+	if !input.HasWorkObligations || !input.HasFamilyObligations || !input.HasCalendarCommitments ||
+		!input.HasOpenConversations || !input.HasImportantNotTimeSensitive {
+		t.Errorf("expected signals from loop obligations, got %+v", input)
+	}
+	if input.HasFinanceObligations {
+		t.Error("expected no finance signal without money obligations")
+	}
+	if input.CircleCount != 3 {
+		t.Errorf("expected 3 circles, got %d", input.CircleCount)
+	}
+
+	// Scoped to one circle
+	work := todayquietly.InputFromLoop(result, "circle-work")
+	if !work.HasWorkObligations || work.HasFamilyObligations || work.HasCalendarCommitments {
+		t.Errorf("expected only work signals, got %+v", work)
+	}
+
+	// Same run, same page
+	engine := todayquietly.NewEngine(func() time.Time { return fixedTime })
+	if engine.Generate(input).PageHash != engine.Generate(todayquietly.InputFromLoop(result, "")).PageHash {
+		t.Error("expected same loop result to produce the same page")
+	}
+
+	// An empty run projects no signals
+	if empty := todayquietly.InputFromLoop(&loop.RunResult{}, ""); empty != (todayquietly.ProjectionInput{}) {
+		t.Errorf("expected empty input, got %+v", empty)
+	}
+}
+
+// TestPreferenceHistoryBucketsAndOrder verifies history is newest first
+// and exposes only day buckets.
+func TestPreferenceHistoryBucketsAndOrder(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	store := todayquietly.NewPreferenceStore(
+		todayquietly.WithStoreClock(func() time.Time { return now }),
+	)
+
+	if len(store.History()) != 0 {
+		t.Fatal("expected empty history")
+	}
+
+	_, _ = store.Record("quiet", "web")
+	now = now.Add(26 * time.Hour)
+	_, _ = store.Record("show_all", "web")
+
+	history := store.History()
+	if len(history) != 2 {
+		t.Fatalf("expected 2 history entries, got %d", len(history))
+	}
+	if history[0].Mode != "show_all" || history[1].Mode != "quiet" {
+		t.Errorf("expected newest first, got %s then %s", history[0].Mode, history[1].Mode)
+	}
+	if history[0].TimeBucket != "2025-01-16" || history[1].TimeBucket != "2025-01-15" {
+		t.Errorf("unexpected time buckets: %s, %s", history[0].TimeBucket, history[1].TimeBucket)
+	}
+	for _, r := range history {
+		if !r.RecordedAt.IsZero() {
+			t.Error("history must not expose exact timestamps")
+		}
+	}
+
+	for i := 0; i < todayquietly.MaxPreferenceHistory+3; i++ {
+		now = now.Add(time.Minute)
+		_, _ = store.Record("quiet", "web")
+	}
+	if got := len(store.History()); got != todayquietly.MaxPreferenceHistory {
+		t.Errorf("expected history bounded to %d, got %d", todayquietly.MaxPreferenceHistory, got)
+	}
+}
+
+// TestPreferenceRevertAppends verifies revert records a new entry equal
+// to the old one and never rewrites history.
+func TestPreferenceRevertAppends(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := todayquietly.NewPreferenceStore(
+		todayquietly.WithStoreClock(func() time.Time { return now }),
+	)
+
+	_, _ = store.Record("show_all", "web")
+	now = now.Add(time.Hour)
+	_, _ = store.Record("quiet", "web")
+
+	old := store.History()[1]
+	now = now.Add(time.Hour)
+	mode, err := store.Revert(old.Hash)
+	if err != nil {
+		t.Fatalf("revert error: %v", err)
+	}
+	if mode != "show_all" || store.LatestPreference() != "show_all" {
+		t.Errorf("expected show_all after revert, got %s", store.LatestPreference())
+	}
+	if store.Count() != 3 {
+		t.Errorf("expected revert to append, got count=%d", store.Count())
+	}
+	if latest := store.History()[0]; latest.Source != todayquietly.SourceRevert {
+		t.Errorf("expected revert source, got %s", latest.Source)
+	}
+
+	if _, err := store.Revert("unknown"); !errors.Is(err, todayquietly.ErrPreferenceNotFound) {
+		t.Errorf("expected ErrPreferenceNotFound, got %v", err)
+	}
+	if store.Count() != 3 {
+		t.Error("failed revert must not record anything")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"quantumlife/internal/held"
+	"quantumlife/pkg/domain/obligation"
 )
 
 // TestDeterministicSummaryGeneration verifies same inputs + same clock produce identical output.
@@ -326,3 +327,126 @@ func TestCategoryDisplayNames(t *testing.T) {
 
 	t.Log("PASS: Category display names verified")
 }
+
+// TestCategoryMagnitudesConsistentWithAggregate verifies the per-category
+// magnitude map sums consistently with the aggregate magnitude.
+func TestCategoryMagnitudesConsistentWithAggregate(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := held.NewEngine(func() time.Time { return fixedTime })
+
+	rank := map[string]int{"nothing": 0, "a_few": 1, "several": 2}
+
+	tests := []struct {
+		name          string
+		input         held.HeldInput
+		wantAggregate string
+		want          map[held.Category]string
+	}{
+		{
+			name:          "no per-category counts",
+			input:         held.DefaultInput(), // 3 held, categories flagged only
+			wantAggregate: "a_few",
+			want:          map[held.Category]string{},
+		},
+		{
+			name: "explicit per-category counts",
+			input: held.HeldInput{
+				HeldByCategory: map[held.Category]int{
+					held.CategoryMoney: 2,
+					held.CategoryWork:  5,
+				},
+			},
+			wantAggregate: "several",
+			want: map[held.Category]string{
+				held.CategoryMoney: "a_few",
+				held.CategoryWork:  "several",
+			},
+		},
+		{
+			name:          "nothing held",
+			input:         held.EmptyInput(),
+			wantAggregate: "nothing",
+			want:          map[held.Category]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := engine.Generate(tt.input)
+
+			if summary.Magnitude != tt.wantAggregate {
+				t.Errorf("aggregate magnitude: got %s, want %s", summary.Magnitude, tt.wantAggregate)
+			}
+			if len(summary.CategoryMagnitudes) != len(tt.want) {
+				t.Fatalf("category count: got %d, want %d", len(summary.CategoryMagnitudes), len(tt.want))
+			}
+			for _, cm := range summary.CategoryMagnitudes {
+				if cm.Magnitude != tt.want[cm.Category] {
+					t.Errorf("%s: got %s, want %s", cm.Category, cm.Magnitude, tt.want[cm.Category])
+				}
+				// No single category can exceed the aggregate
+				if rank[cm.Magnitude] > rank[summary.Magnitude] {
+					t.Errorf("%s magnitude %s exceeds aggregate %s", cm.Category, cm.Magnitude, summary.Magnitude)
+				}
+			}
+		})
+	}
+}
+
+// TestCategoryMagnitudeLabel verifies chip labels are abstract.
+func TestCategoryMagnitudeLabel(t *testing.T) {
+	cm := held.CategoryMagnitude{Category: held.CategoryMoney, Magnitude: "a_few"}
+	if got := cm.Label(); got != "Money: a few" {
+		t.Errorf("got %q, want %q", got, "Money: a few")
+	}
+}
+
+// TestInputFromObligations verifies held obligations become abstract
+// category counts and a summary whose hash ignores obligation order.
+func TestInputFromObligations(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := held.NewEngine(func() time.Time { return fixedTime })
+
+	obls := []*obligation.Obligation{
+		obligation.NewObligation("circle-1", "evt-a", "email", obligation.ObligationReply, fixedTime),
+		obligation.NewObligation("circle-1", "evt-b", "email", obligation.ObligationReply, fixedTime),
+		obligation.NewObligation("circle-1", "evt-pay", "finance", obligation.ObligationPay, fixedTime),
+	}
+
+	input := held.InputFromObligations(obls)
+	if input.HeldByCategory[held.CategoryPeople] != 2 || input.HeldByCategory[held.CategoryMoney] != 1 {
+		t.Errorf("unexpected category counts: %v", input.HeldByCategory)
+	}
+	if !input.HasPeopleItems || !input.HasMoneyItems || input.HasTimeItems || input.HasWorkItems {
+		t.Error("presence flags should follow category counts")
+	}
+
+	summary := engine.Generate(input)
+	if summary.Magnitude != "a_few" {
+		t.Errorf("expected a_few, got %s", summary.Magnitude)
+	}
+	for _, id := range []string{"evt-a", "evt-pay", "circle-1", obls[0].ID} {
+		if strings.Contains(summary.Statement, id) || strings.Contains(input.Hash(), id) {
+			t.Errorf("summary leaks identifier %q", id)
+		}
+	}
+
+	reversed := []*obligation.Obligation{obls[2], obls[1], obls[0]}
+	if got := engine.Generate(held.InputFromObligations(reversed)).Hash; got != summary.Hash {
+		t.Error("summary hash should not depend on obligation order")
+	}
+	reversedInput := held.InputFromObligations(reversed)
+	if reversedInput.Hash() != input.Hash() {
+		t.Error("input hash should not depend on obligation order")
+	}
+
+	fewer := held.InputFromObligations(obls[:2])
+	if fewer.Hash() == input.Hash() {
+		t.Error("different obligation sets should produce different input hashes")
+	}
+
+	empty := engine.Generate(held.InputFromObligations(nil))
+	if empty.Magnitude != "nothing" || len(empty.Categories) != 0 {
+		t.Errorf("expected nothing held, got %s with %d categories", empty.Magnitude, len(empty.Categories))
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"quantumlife/internal/surface"
+	"quantumlife/pkg/domain/obligation"
 )
 
 // TestDeterministicCueGeneration verifies same inputs + same clock produce identical output.
@@ -489,3 +490,221 @@ func TestInputHashDeterminism(t *testing.T) {
 
 	t.Log("PASS: Input hash is deterministic")
 }
+
+// TestPromotionThresholdSuppressesBorderlineItem verifies a raised threshold
+// keeps a borderline held item from surfacing while stronger items still do.
+func TestPromotionThresholdSuppressesBorderlineItem(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return fixedTime }
+
+	borderline := surface.SurfaceInput{
+		HeldCategories: map[surface.Category]surface.MagnitudeBucket{
+			surface.CategoryHome: surface.MagnitudeAFew,
+		},
+		UserPreference: "quiet",
+		Now:            fixedTime,
+	}
+
+	// Default threshold preserves current behavior: a_few surfaces.
+	if cue := surface.NewEngine(clock).BuildCue(borderline); !cue.Available {
+		t.Fatal("expected borderline item to surface at default threshold")
+	}
+
+	raised := surface.NewEngine(clock, surface.WithPromotionThreshold(2))
+	if cue := raised.BuildCue(borderline); cue.Available {
+		t.Error("expected raised threshold to suppress borderline item")
+	}
+	if page := raised.BuildSurfacePage(borderline, false); page.Item.Category != "" {
+		t.Errorf("expected empty surface page, got category %s", page.Item.Category)
+	}
+
+	// The same item surfaces once it has lingered long enough.
+	borderline.HeldAging = map[surface.Category]surface.AgingBucket{
+		surface.CategoryHome: surface.AgingLingering,
+	}
+	if cue := raised.BuildCue(borderline); !cue.Available {
+		t.Error("expected lingering item to reach raised threshold")
+	}
+
+	t.Log("PASS: Promotion threshold suppresses borderline item")
+}
+
+// TestInputFromHeldBucketsCounts verifies held loop obligations become abstract magnitudes.
+func TestInputFromHeldBucketsCounts(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	var held []*obligation.Obligation
+	for i := 0; i < 4; i++ {
+		held = append(held, obligation.NewObligation("circle-1", "evt-"+string(rune('a'+i)), "email", obligation.ObligationReply, fixedTime))
+	}
+	held = append(held, obligation.NewObligation("circle-1", "evt-pay", "finance", obligation.ObligationPay, fixedTime))
+
+	input := surface.InputFromHeld(held, "quiet", fixedTime)
+	if input.HeldCategories[surface.CategoryPeople] != surface.MagnitudeSeveral {
+		t.Errorf("expected several people items, got %s", input.HeldCategories[surface.CategoryPeople])
+	}
+	if input.HeldCategories[surface.CategoryMoney] != surface.MagnitudeAFew {
+		t.Errorf("expected a few money items, got %s", input.HeldCategories[surface.CategoryMoney])
+	}
+	if !input.SuppressedFinance || input.SuppressedWork {
+		t.Errorf("unexpected suppression flags: finance=%v work=%v", input.SuppressedFinance, input.SuppressedWork)
+	}
+	if input.Hash() != surface.InputFromHeld(held, "quiet", fixedTime).Hash() {
+		t.Error("expected same held obligations to produce the same input")
+	}
+
+	engine := surface.NewEngine(func() time.Time { return fixedTime })
+	if engine.BuildCue(surface.InputFromHeld(nil, "quiet", fixedTime)).Available {
+		t.Error("cue should not be available when the loop held nothing")
+	}
+}
+
+// TestSurfaceListGatedByShowAll verifies the quiet default never lists items.
+func TestSurfaceListGatedByShowAll(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := surface.NewEngine(func() time.Time { return fixedTime })
+
+	input := surface.DefaultInput() // quiet
+	page := engine.BuildSurfaceList(input, surface.MaxSurfaceListItems)
+	if len(page.Items) != 0 {
+		t.Errorf("expected no items for quiet, got %d", len(page.Items))
+	}
+	if page.Title != "Nothing to show" {
+		t.Errorf("expected empty page title, got %q", page.Title)
+	}
+}
+
+// TestSurfaceListRankedAndBounded verifies show_all lists promotable
+// categories in priority order, up to max.
+func TestSurfaceListRankedAndBounded(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := surface.NewEngine(func() time.Time { return fixedTime })
+
+	input := surface.DefaultInput()
+	input.UserPreference = "show_all"
+	input.HeldCategories[surface.CategoryHome] = surface.MagnitudeAFew
+	input.HeldCategories[surface.CategoryPeople] = surface.MagnitudeNothing
+
+	page := engine.BuildSurfaceList(input, 0)
+	var got []surface.Category
+	for _, item := range page.Items {
+		got = append(got, item.Category)
+	}
+	want := []surface.Category{surface.CategoryMoney, surface.CategoryTime, surface.CategoryWork, surface.CategoryHome}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+
+	// The first item is the one the single-item page would show
+	single := engine.BuildSurfacePage(input, false)
+	if page.Items[0].ItemKeyHash != single.Item.ItemKeyHash {
+		t.Error("expected first list item to match the single surface item")
+	}
+
+	bounded := engine.BuildSurfaceList(input, 2)
+	if len(bounded.Items) != 2 || bounded.Items[1].Category != surface.CategoryTime {
+		t.Errorf("expected money and time only, got %d items", len(bounded.Items))
+	}
+
+	if surface.MaxSurfaceListItems != len(surface.CategoryPriority) {
+		t.Error("MaxSurfaceListItems should allow one item per category")
+	}
+}
+
+// TestSurfaceListStableAcrossRequests verifies item order does not change
+// between requests for the same held state.
+func TestSurfaceListStableAcrossRequests(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := surface.NewEngine(func() time.Time { return now })
+
+	order := func() string {
+		input := surface.DefaultInput()
+		input.UserPreference = "show_all"
+		input.Now = now
+		var cats []string
+		for _, item := range engine.BuildSurfaceList(input, 0).Items {
+			cats = append(cats, string(item.Category))
+		}
+		return strings.Join(cats, ",")
+	}
+
+	first := order()
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Minute)
+		if got := order(); got != first {
+			t.Fatalf("order changed between requests: %s vs %s", first, got)
+		}
+	}
+}
+
+// TestRatioFromCounts verifies ratios are bucketed, never exact.
+func TestRatioFromCounts(t *testing.T) {
+	tests := []struct {
+		part, whole int
+		want        surface.RatioBucket
+	}{
+		{0, 0, surface.RatioNone},
+		{3, 0, surface.RatioNone},
+		{0, 4, surface.RatioNone},
+		{1, 4, surface.RatioSome},
+		{2, 4, surface.RatioMost},
+		{3, 4, surface.RatioMost},
+		{4, 4, surface.RatioAll},
+		{5, 4, surface.RatioAll},
+	}
+	for _, tt := range tests {
+		if got := surface.RatioFromCounts(tt.part, tt.whole); got != tt.want {
+			t.Errorf("RatioFromCounts(%d, %d) = %s, want %s", tt.part, tt.whole, got, tt.want)
+		}
+	}
+}
+
+// TestActionStatsByPeriod verifies stats only cover the requested week
+// and ignore views of an empty surface page.
+func TestActionStatsByPeriod(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC) // 2025-W03
+	store := surface.NewActionStore(surface.WithStoreClock(func() time.Time { return now }))
+
+	_ = store.RecordViewed("", "item-a")
+	_ = store.RecordViewed("", "item-b")
+	_ = store.RecordViewed("", "") // empty page, not surfaced
+	_ = store.RecordHeld("", "item-a")
+
+	now = now.AddDate(0, 0, 7) // 2025-W04
+	_ = store.RecordViewed("", "item-c")
+	_ = store.RecordPreferShowAll("", "item-c")
+
+	week3 := store.Stats("2025-W03")
+	if week3.Surfaced != surface.MagnitudeAFew || week3.Held != surface.RatioMost || week3.PreferShowAll != surface.RatioNone {
+		t.Errorf("unexpected W03 stats: %+v", week3)
+	}
+	if week3.PreferLine() != "" {
+		t.Error("expected no show_all line without a show_all request")
+	}
+
+	week4 := store.Stats("2025-W04")
+	if week4.Held != surface.RatioNone || week4.PreferShowAll != surface.RatioAll {
+		t.Errorf("unexpected W04 stats: %+v", week4)
+	}
+
+	empty := store.Stats("2025-W05")
+	if empty.HasEvidence() || empty.HeldLine() != "" {
+		t.Error("expected no evidence for a week with no actions")
+	}
+
+	// Lines never carry numbers
+	for _, line := range []string{week3.HeldLine(), week4.HeldLine(), week4.PreferLine()} {
+		if regexp.MustCompile(`\d`).MatchString(line) {
+			t.Errorf("stats line contains a digit: %q", line)
+		}
+	}
+
+	if store.Stats("2025-W03").Hash != week3.Hash {
+		t.Error("expected deterministic stats hash")
+	}
+}
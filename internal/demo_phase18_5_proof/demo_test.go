@@ -10,6 +10,9 @@ import (
 	"time"
 
 	"quantumlife/internal/proof"
+	"quantumlife/pkg/domain/heldproof"
+	"quantumlife/pkg/domain/interrupt"
+	"quantumlife/pkg/domain/suppress"
 )
 
 // TestProofDeterminism verifies that the same inputs produce the same output.
@@ -472,3 +475,185 @@ func TestCueLinkTextUnchanged(t *testing.T) {
 		t.Errorf("Expected 'Proof, if you want it.', got %q", cue.LinkText)
 	}
 }
+
+// TestSuppressionHistoryChangesMagnitude verifies proof is derived from
+// stored suppressions, not constants.
+func TestSuppressionHistoryChangesMagnitude(t *testing.T) {
+	engine := proof.NewEngine()
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	build := func(src proof.SuppressionSources) proof.ProofSummary {
+		return engine.BuildProof(proof.ProofInput{
+			SuppressedByCategory: proof.SuppressedByCategory(src, nil),
+			PreferenceQuiet:      true,
+			Period:               "week",
+		})
+	}
+
+	var src proof.SuppressionSources
+	if got := build(src).Magnitude; got != proof.MagnitudeNothing {
+		t.Errorf("empty history: expected nothing, got %s", got)
+	}
+
+	src.Rules = append(src.Rules,
+		suppress.NewSuppressionRule("circle-1", suppress.ScopeTrigger, string(interrupt.TriggerFinanceLowBalance), now, nil, "quiet", suppress.SourceManual),
+		suppress.NewSuppressionRule("circle-1", suppress.ScopePerson, "person-hash", now, nil, "quiet", suppress.SourceManual),
+	)
+	few := build(src)
+	if few.Magnitude != proof.MagnitudeAFew {
+		t.Errorf("two suppressions: expected a_few, got %s", few.Magnitude)
+	}
+
+	for i := 0; i < 3; i++ {
+		src.HeldSignals = append(src.HeldSignals, heldproof.HeldProofSignal{CircleType: heldproof.CircleTypeInstitution})
+	}
+	several := build(src)
+	if several.Magnitude != proof.MagnitudeSeveral {
+		t.Errorf("five suppressions: expected several, got %s", several.Magnitude)
+	}
+	if several.Hash == few.Hash {
+		t.Error("proof hash should change with suppression history")
+	}
+}
+
+// TestConfiguredCategoriesFilterProof verifies only configured categories count.
+func TestConfiguredCategoriesFilterProof(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	src := proof.SuppressionSources{
+		Rules: []suppress.SuppressionRule{
+			suppress.NewSuppressionRule("circle-1", suppress.ScopeTrigger, string(interrupt.TriggerCalendarConflict), now, nil, "quiet", suppress.SourceManual),
+			suppress.NewSuppressionRule("circle-1", suppress.ScopeVendor, "vendor-hash", now, nil, "quiet", suppress.SourceManual),
+		},
+	}
+
+	cats, err := proof.ParseCategories([]string{"money", "money"})
+	if err != nil {
+		t.Fatalf("ParseCategories: %v", err)
+	}
+	counts := proof.SuppressedByCategory(src, cats)
+	if len(counts) != 1 || counts[proof.CategoryMoney] != 1 {
+		t.Errorf("expected only money counted, got %v", counts)
+	}
+
+	if _, err := proof.ParseCategories([]string{"vendors"}); err == nil {
+		t.Error("expected unknown category to be rejected")
+	}
+}
+
+// TestSuppressionsRollIntoPreventedBucket verifies suppressions recorded over a
+// period roll into the matching prevented magnitude, per period.
+func TestSuppressionsRollIntoPreventedBucket(t *testing.T) {
+	engine := proof.NewEngine()
+	ss := suppress.NewSuppressionSet()
+
+	week1 := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC) // Monday, 2025-W03
+	week1Later := week1.Add(72 * time.Hour)
+	week2 := week1.Add(7 * 24 * time.Hour)
+
+	build := func(at time.Time) proof.ProofSummary {
+		return engine.BuildProof(proof.ProofInput{
+			SuppressedByCategory: map[proof.Category]int{proof.CategoryWork: 1},
+			PreferenceQuiet:      true,
+			Period:               "week",
+			SuppressedInPeriod:   ss.SuppressedCount(at),
+		})
+	}
+
+	if got := build(week1).Prevented; got != proof.MagnitudeNothing {
+		t.Errorf("Expected nothing prevented before suppressions, got %s", got)
+	}
+
+	// Two distinct items; a repeat of the same item counts once
+	ss.RecordSuppressed(week1, "intr-1")
+	ss.RecordSuppressed(week1Later, "intr-2")
+	if ss.RecordSuppressed(week1Later, "intr-1") {
+		t.Error("Same item should be counted once per period")
+	}
+
+	summary := build(week1Later)
+	if summary.Prevented != proof.MagnitudeAFew {
+		t.Errorf("Expected a_few prevented, got %s", summary.Prevented)
+	}
+	if summary.PreventedLine != "A few interruptions prevented." {
+		t.Errorf("Unexpected prevented line: %q", summary.PreventedLine)
+	}
+
+	for _, key := range []string{"intr-3", "intr-4", "intr-5"} {
+		ss.RecordSuppressed(week1, key)
+	}
+	summary = build(week1)
+	if summary.Prevented != proof.MagnitudeSeveral {
+		t.Errorf("Expected several prevented, got %s", summary.Prevented)
+	}
+	if summary.PreventedLine != "Several interruptions prevented." {
+		t.Errorf("Unexpected prevented line: %q", summary.PreventedLine)
+	}
+
+	// A new period starts empty
+	if got := build(week2).Prevented; got != proof.MagnitudeNothing {
+		t.Errorf("Expected new period to start at nothing, got %s", got)
+	}
+
+	// Prevented magnitude is part of the proof hash
+	if build(week1).Hash == build(week2).Hash {
+		t.Error("Prevented magnitude should change the proof hash")
+	}
+}
+
+// TestExportLedgerCSVDeterministic verifies the ledger CSV has stable
+// columns and rows and contains buckets only.
+func TestExportLedgerCSVDeterministic(t *testing.T) {
+	engine := proof.NewEngine()
+
+	build := func(periodKey string, counts map[proof.Category]int) proof.ProofSummary {
+		return engine.BuildProof(proof.ProofInput{
+			SuppressedByCategory: counts,
+			PreferenceQuiet:      true,
+			Period:               "week",
+			PeriodKey:            periodKey,
+		})
+	}
+
+	w03 := build("2025-W03", map[proof.Category]int{proof.CategoryWork: 7, proof.CategoryMoney: 2})
+	w02 := build("2025-W02", map[proof.Category]int{proof.CategoryTime: 1})
+
+	first, err := engine.ExportLedgerCSV([]proof.ProofSummary{w03, w02})
+	if err != nil {
+		t.Fatalf("ExportLedgerCSV failed: %v", err)
+	}
+	second, err := engine.ExportLedgerCSV([]proof.ProofSummary{w02, w03})
+	if err != nil {
+		t.Fatalf("ExportLedgerCSV failed: %v", err)
+	}
+	if string(first) != string(second) {
+		t.Error("Ledger CSV should not depend on summary order")
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(first)), "\n")
+	want := []string{
+		"period,category,magnitude_bucket,proof_hash",
+		"2025-W02,time,a_few," + w02.Hash,
+		"2025-W03,money,a_few," + w03.Hash,
+		"2025-W03,work,several," + w03.Hash,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d:\n%s", len(want), len(lines), first)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("Line %d: expected %q, got %q", i, want[i], lines[i])
+		}
+	}
+
+	// No raw counts
+	for _, n := range []string{",7,", ",2,", ",1,"} {
+		if strings.Contains(string(first), n) {
+			t.Errorf("Ledger CSV contains a raw count %q", n)
+		}
+	}
+
+	// The period key does not change the proof hash
+	if build("2025-W04", map[proof.Category]int{proof.CategoryTime: 1}).Hash != w02.Hash {
+		t.Error("Period key should not be part of the proof hash")
+	}
+}
@@ -362,3 +362,73 @@ func TestIntentCountTracking(t *testing.T) {
 		t.Errorf("Expected 3 intents, got %d", store.IntentCount())
 	}
 }
+
+// TestConsentHistoryConnectThenRevoke verifies a connect followed by a
+// revoke shows both entries in the consent history.
+func TestConsentHistoryConnectThenRevoke(t *testing.T) {
+	store := persist.NewInMemoryConnectionStore()
+
+	connect := connection.NewConnectIntent(connection.KindEmail, connection.ModeReal, fixedTime, connection.NoteOAuthCallback)
+	revoke := connection.NewDisconnectIntent(connection.KindEmail, connection.ModeReal, fixedTime.Add(48*time.Hour), connection.NoteOAuthRevoke)
+	mock := connection.NewConnectIntent(connection.KindCalendar, connection.ModeMock, fixedTime, connection.NoteUserInitiated)
+	for _, intent := range []*connection.ConnectionIntent{revoke, connect, mock} {
+		if err := store.AppendIntent(intent); err != nil {
+			t.Fatalf("AppendIntent failed: %v", err)
+		}
+	}
+
+	history := connection.BuildConsentHistory(store.ListIntents())
+
+	if len(history.Entries) != 2 {
+		t.Fatalf("Expected 2 consent entries, got %d", len(history.Entries))
+	}
+	first, second := history.Entries[0], history.Entries[1]
+	if first.Action != connection.ActionConnect || first.ReceiptHash != connect.Hash() {
+		t.Errorf("First entry should be the connect, got %+v", first)
+	}
+	if second.Action != connection.ActionDisconnect || second.ReceiptHash != revoke.Hash() {
+		t.Errorf("Second entry should be the revoke, got %+v", second)
+	}
+	if first.PeriodBucket != "2025-01-15" || second.PeriodBucket != "2025-01-17" {
+		t.Errorf("Unexpected period buckets: %s, %s", first.PeriodBucket, second.PeriodBucket)
+	}
+	if first.Access != connection.AccessRead {
+		t.Errorf("Expected read access class, got %s", first.Access)
+	}
+
+	again := connection.BuildConsentHistory(store.ListIntents())
+	if again.HistoryHash != history.HistoryHash {
+		t.Error("Consent history hash should be deterministic")
+	}
+}
+
+// TestConnectionHealthDerivation maps recency, success and liveness to health.
+func TestConnectionHealthDerivation(t *testing.T) {
+	tests := []struct {
+		name     string
+		lastSync time.Time
+		success  bool
+		live     bool
+		want     connection.Health
+	}{
+		{"recent success", fixedTime.Add(-time.Hour), true, true, connection.HealthHealthy},
+		{"recent failure", fixedTime.Add(-time.Hour), false, true, connection.HealthDegraded},
+		{"old success", fixedTime.Add(-48 * time.Hour), true, true, connection.HealthStale},
+		{"old failure", fixedTime.Add(-48 * time.Hour), false, true, connection.HealthDegraded},
+		{"never synced", time.Time{}, false, true, connection.HealthStale},
+		{"not live", fixedTime.Add(-time.Hour), true, false, connection.HealthDegraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := connection.DeriveHealth(connection.HealthInput{
+				Recency:         connection.RecencyFor(tt.lastSync, fixedTime),
+				LastSyncSuccess: tt.success,
+				Live:            tt.live,
+			})
+			if got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
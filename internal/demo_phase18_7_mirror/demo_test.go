@@ -400,3 +400,44 @@ func min(a, b int) int {
 	}
 	return b
 }
+
+// TestUnviewedReminderClearsAfterViewing verifies a connected but unviewed
+// source shows the reminder, and viewing the mirror clears it.
+func TestUnviewedReminderClearsAfterViewing(t *testing.T) {
+	store := mirror.NewAckStore(10)
+	reminders := mirror.NewReminderStore(0)
+	period := fixedTime.Format("2006-01-02")
+
+	build := func() *mirror.UnviewedReminder {
+		return mirror.BuildUnviewedReminder(mirror.UnviewedReminderInput{
+			Period:         period,
+			ConnectedCount: 1,
+			MirrorViewed:   store.HasViewed(),
+			Dismissed:      reminders.IsDismissed(period),
+		})
+	}
+
+	reminder := build()
+	if reminder == nil {
+		t.Fatal("expected reminder for unviewed connection")
+	}
+	if reminder.Line != "You have a connection you haven't looked at." {
+		t.Errorf("unexpected reminder line: %q", reminder.Line)
+	}
+
+	if err := store.Record(domainmirror.AckViewed, "mirror-hash", fixedTime); err != nil {
+		t.Fatalf("record view: %v", err)
+	}
+	if build() != nil {
+		t.Error("expected viewing the mirror to clear the reminder")
+	}
+
+	// No connections means no reminder, and dismissal is per period.
+	if mirror.BuildUnviewedReminder(mirror.UnviewedReminderInput{Period: period}) != nil {
+		t.Error("expected no reminder without connections")
+	}
+	reminders.Dismiss(period)
+	if !reminders.IsDismissed(period) || reminders.IsDismissed("2025-01-16") {
+		t.Error("expected dismissal to apply to its period only")
+	}
+}
@@ -12,8 +12,13 @@ package demo_phase18_8_oauth_gmail
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -22,6 +27,7 @@ import (
 	"quantumlife/internal/connectors/auth/impl_inmem"
 	"quantumlife/internal/oauth"
 	"quantumlife/internal/persist"
+	"quantumlife/pkg/domain/connection"
 )
 
 // fixedClock provides a deterministic clock for testing.
@@ -435,3 +441,168 @@ func TestMockHTTPServer(t *testing.T) {
 	t.Log("Mock revocation succeeded!")
 	t.Log("\n=== OAuth HTTP Handling Demo Complete ===")
 }
+
+// googleStub answers Google token refresh and tokeninfo calls in-process.
+type googleStub struct {
+	refreshStatus   int
+	tokenInfoStatus int
+	paths           []string
+}
+
+func (g *googleStub) RoundTrip(r *http.Request) (*http.Response, error) {
+	g.paths = append(g.paths, r.URL.Path)
+	status, body := g.tokenInfoStatus, "{}"
+	if r.URL.String() == auth.GoogleTokenURL {
+		status, body = g.refreshStatus, `{"access_token":"at","expires_in":3600}`
+		if status != http.StatusOK {
+			body = `{"error":"invalid_grant"}`
+		}
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// TestGmailHasValidToken demonstrates the token health check.
+func TestGmailHasValidToken(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)}
+	stateManager := oauth.NewStateManager([]byte("test-secret-32-bytes-for-hmac!!"), clock.Now)
+	circleID := "circle-health-test"
+
+	tests := []struct {
+		name            string
+		storeToken      bool
+		refreshStatus   int
+		tokenInfoStatus int
+		want            connection.TokenStatus
+	}{
+		{"no token", false, http.StatusOK, http.StatusOK, connection.TokenRevoked},
+		{"valid", true, http.StatusOK, http.StatusOK, connection.TokenValid},
+		{"introspection rejected", true, http.StatusOK, http.StatusBadRequest, connection.TokenRevoked},
+		{"refresh rejected", true, http.StatusBadRequest, http.StatusOK, connection.TokenRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &googleStub{refreshStatus: tt.refreshStatus, tokenInfoStatus: tt.tokenInfoStatus}
+			client := &http.Client{Transport: stub}
+			broker := impl_inmem.NewBroker(auth.Config{TokenEncryptionKey: "test-encryption-key-32-bytes!!!"}, nil,
+				impl_inmem.WithHTTPClient(client), impl_inmem.WithClock(clock.Now))
+			if tt.storeToken {
+				if _, err := broker.StoreTokenDirectly(context.Background(), circleID, auth.ProviderGoogle, "refresh", oauth.GmailScopes); err != nil {
+					t.Fatalf("StoreTokenDirectly failed: %v", err)
+				}
+			}
+			handler := oauth.NewGmailHandler(stateManager, broker, client, "http://localhost:8080", clock.Now)
+
+			got, err := handler.HasValidToken(context.Background(), circleID)
+			if err != nil {
+				t.Fatalf("HasValidToken failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			for _, p := range stub.paths {
+				if strings.Contains(p, "/gmail/") {
+					t.Errorf("health check must not call the Gmail API, called %s", p)
+				}
+			}
+		})
+	}
+}
+
+// TestDisconnectAllReceiptHashIgnoresOrder demonstrates the summary receipt
+// hash does not depend on the order sources were revoked in.
+func TestDisconnectAllReceiptHashIgnoresOrder(t *testing.T) {
+	at := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	a := &oauth.DisconnectAllReceipt{
+		CircleID:        "circle-1",
+		At:              at,
+		Kinds:           []string{"email", "finance"},
+		LocalRevoked:    []string{"gmail", "truelayer"},
+		ProviderRevoked: []string{"gmail"},
+	}
+	b := &oauth.DisconnectAllReceipt{
+		CircleID:        "circle-1",
+		At:              at,
+		Kinds:           []string{"finance", "email"},
+		LocalRevoked:    []string{"truelayer", "gmail"},
+		ProviderRevoked: []string{"gmail"},
+	}
+	if a.Hash() != b.Hash() {
+		t.Error("hash should not depend on revoke order")
+	}
+	if a.LocalRevoked[0] != "gmail" {
+		t.Error("hashing must not reorder the receipt's own lists")
+	}
+
+	b.ProviderRevoked = nil
+	if a.Hash() == b.Hash() {
+		t.Error("provider revocations must change the hash")
+	}
+}
+
+// exchangeStub answers the Google token exchange and records the form sent.
+type exchangeStub struct {
+	form url.Values
+}
+
+func (e *exchangeStub) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(r.Body)
+	e.form, _ = url.ParseQuery(string(body))
+	resp := `{"access_token":"at","refresh_token":"rt","expires_in":3600,"scope":"https://www.googleapis.com/auth/gmail.readonly"}`
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(resp)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+// TestGmailOAuthPKCE demonstrates the PKCE challenge/verifier round trip.
+func TestGmailOAuthPKCE(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)}
+	stateManager := oauth.NewStateManager([]byte("test-secret-32-bytes-for-hmac!!"), clock.Now)
+	stub := &exchangeStub{}
+	broker := impl_inmem.NewBroker(auth.Config{
+		Google:             auth.GoogleConfig{ClientID: "test-client-id", ClientSecret: "test-client-secret"},
+		TokenEncryptionKey: "test-encryption-key-32-bytes!!!",
+	}, nil, impl_inmem.WithHTTPClient(&http.Client{Transport: stub}))
+	handler := oauth.NewGmailHandler(stateManager, broker, nil, "http://localhost:8080", clock.Now)
+
+	start, err := handler.Start("circle-pkce")
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	authURL, err := url.Parse(start.AuthURL)
+	if err != nil {
+		t.Fatalf("AuthURL does not parse: %v", err)
+	}
+	q := authURL.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") != start.State.CodeChallenge {
+		t.Fatalf("AuthURL missing PKCE challenge: %v", q)
+	}
+
+	result, err := handler.Callback(context.Background(), "auth-code", q.Get("state"))
+	if err != nil {
+		t.Fatalf("Callback failed: %v", err)
+	}
+	if !result.PKCE {
+		t.Error("Expected callback to report PKCE")
+	}
+
+	verifier := stub.form.Get("code_verifier")
+	sum := sha256.Sum256([]byte(verifier))
+	if verifier == "" || base64.RawURLEncoding.EncodeToString(sum[:]) != start.State.CodeChallenge {
+		t.Error("Token exchange must send the verifier matching the challenge")
+	}
+	if strings.Contains(result.Receipt.CanonicalString(), verifier) {
+		t.Error("Receipt must not contain the verifier")
+	}
+
+	// The verifier is single-use: replaying the state fails before exchange
+	if _, err := handler.Callback(context.Background(), "auth-code", q.Get("state")); !errors.Is(err, oauth.ErrMissingCodeVerifier) {
+		t.Errorf("Expected ErrMissingCodeVerifier on replay, got %v", err)
+	}
+}
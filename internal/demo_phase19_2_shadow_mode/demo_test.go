@@ -23,6 +23,7 @@ import (
 	"quantumlife/internal/shadowllm/stub"
 	"quantumlife/pkg/clock"
 	"quantumlife/pkg/domain/identity"
+	domainrulepack "quantumlife/pkg/domain/rulepack"
 	domainshadow "quantumlife/pkg/domain/shadowllm"
 )
 
@@ -449,3 +450,94 @@ func TestMissingCircleID(t *testing.T) {
 
 	t.Log("Missing circle ID correctly rejected")
 }
+
+// TestReceiptStorePruneKeepsLatestPerCircle verifies retention pruning drops
+// old receipts but never empties a circle.
+func TestReceiptStorePruneKeepsLatestPerCircle(t *testing.T) {
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	store := persist.NewShadowReceiptStore(func() time.Time { return base })
+
+	// Three receipts for one circle and one for another, a day apart
+	for i, circleID := range []string{"circle-a", "circle-a", "circle-a", "circle-b"} {
+		at := base.AddDate(0, 0, i)
+		engine := shadowllm.NewEngine(clock.NewFixed(at), stub.NewStubModel())
+		output, err := engine.Run(shadowllm.RunInput{
+			CircleID: identity.EntityID(circleID),
+			Digest:   createTestDigest(circleID, true),
+		})
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if err := store.Append(&output.Receipt); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	// Everything is older than the cutoff; only the latest per circle survives
+	removed := store.Prune(base.AddDate(0, 0, 10))
+	if removed != 2 {
+		t.Errorf("Expected 2 removed, got %d", removed)
+	}
+	if store.Count() != 2 {
+		t.Errorf("Expected 2 kept, got %d", store.Count())
+	}
+	latest, ok := store.GetLatestForCircle("circle-a")
+	if !ok || !latest.CreatedAt.Equal(base.AddDate(0, 0, 2)) {
+		t.Errorf("Expected latest circle-a receipt kept, got %v", latest)
+	}
+	if _, ok := store.GetLatestForCircle("circle-b"); !ok {
+		t.Error("Expected circle-b receipt kept")
+	}
+}
+
+func TestReceiptStoreExportImportRoundTrip(t *testing.T) {
+	base := time.Date(2024, 1, 1, 10, 0, 0, 500, time.UTC)
+	store := persist.NewShadowReceiptStore(func() time.Time { return base })
+
+	var receipts []domainshadow.ShadowReceipt
+	for i, circleID := range []string{"circle-a", "circle-a", "circle-b"} {
+		engine := shadowllm.NewEngine(clock.NewFixed(base.AddDate(0, 0, i)), stub.NewStubModel())
+		output, err := engine.Run(shadowllm.RunInput{
+			CircleID: identity.EntityID(circleID),
+			Digest:   createTestDigest(circleID, true),
+		})
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if err := store.Append(&output.Receipt); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		receipts = append(receipts, output.Receipt)
+	}
+
+	data, err := store.ExportJSONL("circle-a")
+	if err != nil {
+		t.Fatalf("ExportJSONL failed: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Fatalf("Expected 2 lines for circle-a, got %d", lines)
+	}
+	if err := domainrulepack.ValidateExportPrivacy(string(data)); err != nil {
+		t.Errorf("Export failed privacy validation: %v", err)
+	}
+
+	imported := persist.NewShadowReceiptStore(func() time.Time { return base })
+	count, err := imported.ImportJSONL(data)
+	if err != nil {
+		t.Fatalf("ImportJSONL failed: %v", err)
+	}
+	if count != 2 || imported.Count() != 2 {
+		t.Errorf("Expected 2 imported, got %d (count %d)", count, imported.Count())
+	}
+	for _, r := range receipts[:2] {
+		if !imported.VerifyHash(r.ReceiptID, r.Hash()) {
+			t.Errorf("Round-tripped receipt %s hash mismatch", r.ReceiptID)
+		}
+	}
+
+	// A tampered bucket no longer matches its recorded hash
+	tampered := strings.Replace(string(data), `"window_bucket":"`, `"window_bucket":"x`, 1)
+	if _, err := persist.NewShadowReceiptStore(nil).ImportJSONL([]byte(tampered)); err != persist.ErrReceiptHashMismatch {
+		t.Errorf("Expected hash mismatch, got %v", err)
+	}
+}
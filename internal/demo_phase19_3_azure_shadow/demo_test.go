@@ -364,6 +364,7 @@ func TestProviderKindValidation(t *testing.T) {
 		{domainshadow.ProviderKindNone, true, false},
 		{domainshadow.ProviderKindStub, true, false},
 		{domainshadow.ProviderKindAzureOpenAI, true, true},
+		{domainshadow.ProviderKindAnthropic, true, true},
 		{domainshadow.ProviderKindLocalSLM, true, true},
 		{"invalid_provider", false, false},
 	}
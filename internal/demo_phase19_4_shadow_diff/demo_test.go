@@ -6,6 +6,7 @@
 //   - Determinism: same canon + same shadow + same clock => same diff hash
 //   - Agreement rules: match, earlier, later, softer, conflict
 //   - Novelty detection: shadow-only, canon-only
+//   - Novelty scoring: similar unmatched signals downgrade to match
 //   - Vote persistence and replay
 //   - Stats aggregation stability
 //   - No influence: shadow does NOT affect any execution path
@@ -14,6 +15,8 @@
 package demo_phase19_4_shadow_diff
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -778,3 +781,127 @@ func TestDiffEngineNonEmptyWithStub(t *testing.T) {
 	t.Logf("Diff engine non-empty verified: %d diffs (canon_only=%d, shadow_only=%d, matches=%d)",
 		len(output.Results), output.Summary.CanonOnlyCount, output.Summary.ShadowOnlyCount, output.Summary.MatchCount)
 }
+
+// =============================================================================
+// Test 17: Embeddings Novelty Scoring
+// =============================================================================
+
+// categoryEmbedder embeds descriptors by their leading category token, so
+// signals in the same category are identical and others are orthogonal.
+type categoryEmbedder struct {
+	calls int
+	fail  bool
+}
+
+func (e *categoryEmbedder) Embed(descriptor string) ([]float64, error) {
+	e.calls++
+	if e.fail {
+		return nil, errors.New("embed unavailable")
+	}
+	if strings.HasPrefix(descriptor, string(shadowllm.CategoryWork)+" ") {
+		return []float64{1, 0}, nil
+	}
+	return []float64{0, 1}, nil
+}
+
+func noveltyInput(clk clock.Clock) shadowdiff.DiffInput {
+	return shadowdiff.DiffInput{
+		Canon: shadowdiff.CanonResult{
+			CircleID: identity.EntityID("novelty"),
+			Signals: []domaindiff.CanonSignal{
+				createCanonSignal("novelty", "item-canon", shadowllm.CategoryWork, shadowllm.HorizonNow, shadowllm.MagnitudeSeveral),
+			},
+			ComputedAt: clk.Now(),
+		},
+		Shadow: &shadowllm.ShadowReceipt{
+			ReceiptID: "novelty-receipt",
+			CircleID:  identity.EntityID("novelty"),
+			CreatedAt: clk.Now(),
+			Suggestions: []shadowllm.ShadowSuggestion{
+				{
+					Category:       shadowllm.CategoryWork,
+					Horizon:        shadowllm.HorizonNow,
+					Magnitude:      shadowllm.MagnitudeSeveral,
+					Confidence:     shadowllm.ConfidenceHigh,
+					SuggestionType: shadowllm.SuggestHold,
+					ItemKeyHash:    "item-shadow",
+				},
+				{
+					Category:       shadowllm.CategoryFamily,
+					Horizon:        shadowllm.HorizonLater,
+					Magnitude:      shadowllm.MagnitudeAFew,
+					Confidence:     shadowllm.ConfidenceMed,
+					SuggestionType: shadowllm.SuggestSurfaceCandidate,
+					ItemKeyHash:    "item-family",
+				},
+			},
+		},
+	}
+}
+
+func TestNoveltyScorerDowngradesSimilarNovelty(t *testing.T) {
+	clk := createTestClock()
+	embedder := &categoryEmbedder{}
+	engine := shadowdiff.NewEngine(clk).WithNoveltyScorer(shadowdiff.NewEmbeddingScorer(embedder))
+
+	output, err := engine.Compute(noveltyInput(clk))
+	if err != nil {
+		t.Fatalf("Compute failed: %v", err)
+	}
+
+	// item-canon and item-shadow pair into one match; item-family stays shadow-only
+	if len(output.Results) != 2 {
+		t.Fatalf("Expected 2 diffs, got: %d", len(output.Results))
+	}
+	if output.Summary.MatchCount != 1 || output.Summary.ShadowOnlyCount != 1 || output.Summary.CanonOnlyCount != 0 {
+		t.Errorf("Unexpected summary: %+v", output.Summary)
+	}
+
+	var paired *domaindiff.DiffResult
+	for i := range output.Results {
+		if output.Results[i].Key.ItemKeyHash == "item-canon" {
+			paired = &output.Results[i]
+		}
+	}
+	if paired == nil {
+		t.Fatal("Expected paired diff under the canon key")
+	}
+	if paired.Agreement != domaindiff.AgreementMatch || paired.Similarity != domaindiff.SimilarityHigh {
+		t.Errorf("Expected high-similarity match, got: %s / %s", paired.Agreement, paired.Similarity)
+	}
+	if err := paired.Validate(); err != nil {
+		t.Errorf("Paired diff should validate: %v", err)
+	}
+
+	// Once item-canon is paired, item-family has no canon candidate left
+	if embedder.calls != 2 {
+		t.Errorf("Expected 2 embed calls, got: %d", embedder.calls)
+	}
+}
+
+func TestNoveltyScorerFallsBackToExact(t *testing.T) {
+	clk := createTestClock()
+	input := noveltyInput(clk)
+
+	exact, err := shadowdiff.NewEngine(clk).Compute(input)
+	if err != nil {
+		t.Fatalf("Compute failed: %v", err)
+	}
+	failing := shadowdiff.NewEngine(clk).WithNoveltyScorer(shadowdiff.NewEmbeddingScorer(&categoryEmbedder{fail: true}))
+	scored, err := failing.Compute(input)
+	if err != nil {
+		t.Fatalf("Compute failed: %v", err)
+	}
+
+	if len(exact.Results) != 3 || len(scored.Results) != len(exact.Results) {
+		t.Fatalf("Expected 3 exact diffs, got: %d / %d", len(exact.Results), len(scored.Results))
+	}
+	for i := range exact.Results {
+		if exact.Results[i].Hash() != scored.Results[i].Hash() {
+			t.Errorf("Diff %d should match exact comparison", i)
+		}
+		if scored.Results[i].Similarity != "" {
+			t.Errorf("Diff %d should carry no similarity", i)
+		}
+	}
+}
@@ -11,6 +11,7 @@ package demo_phase19_real_keys_smoke
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -533,3 +534,55 @@ func TestEnvVarOverride_ProviderKind(t *testing.T) {
 		})
 	}
 }
+
+// TestNewProvider_EndpointAllowlist verifies that an endpoint outside the
+// configured allowlist is refused without leaking the endpoint.
+func TestNewProvider_EndpointAllowlist(t *testing.T) {
+	cfg := azureopenai.Config{
+		Endpoint:     "https://rogue.example.com",
+		Deployment:   "test-deployment",
+		APIKey:       "test-key",
+		AllowedHosts: []string{"approved.openai.azure.com"},
+	}
+
+	_, err := azureopenai.NewProvider(cfg)
+	if err == nil {
+		t.Fatal("Expected error for disallowed endpoint")
+	}
+	if !azureopenai.IsEndpointNotAllowed(err) {
+		t.Errorf("Expected endpoint_not_allowed, got %v", err)
+	}
+	if strings.Contains(err.Error(), "rogue.example.com") {
+		t.Error("Error must never contain the endpoint")
+	}
+
+	// Approved host is accepted (case-insensitive)
+	cfg.Endpoint = "https://Approved.OpenAI.Azure.com/"
+	if _, err := azureopenai.NewProvider(cfg); err != nil {
+		t.Errorf("Expected approved endpoint to be accepted, got %v", err)
+	}
+
+	// Non-https endpoints never match a non-empty allowlist
+	cfg.Endpoint = "http://approved.openai.azure.com"
+	if _, err := azureopenai.NewProvider(cfg); !azureopenai.IsEndpointNotAllowed(err) {
+		t.Errorf("Expected non-https endpoint to be refused, got %v", err)
+	}
+
+	// Empty allowlist allows any endpoint
+	cfg.Endpoint = "https://rogue.example.com"
+	cfg.AllowedHosts = nil
+	if _, err := azureopenai.NewProvider(cfg); err != nil {
+		t.Errorf("Expected empty allowlist to allow endpoint, got %v", err)
+	}
+
+	// Chat provider honors the same allowlist
+	_, err = azureopenai.NewChatProvider(azureopenai.ChatConfig{
+		Endpoint:     "https://rogue.example.com",
+		Deployment:   "test-deployment",
+		APIKey:       "test-key",
+		AllowedHosts: []string{"approved.openai.azure.com"},
+	})
+	if !azureopenai.IsEndpointNotAllowed(err) {
+		t.Errorf("Expected chat provider to refuse disallowed endpoint, got %v", err)
+	}
+}
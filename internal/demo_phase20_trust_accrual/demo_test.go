@@ -16,6 +16,8 @@
 package demo_phase20_trust_accrual
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -34,7 +36,7 @@ func TestTrustSummary_DeterministicHash(t *testing.T) {
 	// Fixed clock for determinism
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	// Create mock source with consistent data
 	source := trustengine.NewMockSource()
@@ -90,7 +92,7 @@ func TestTrustSummary_DeterministicHash(t *testing.T) {
 func TestTrustSummary_SilenceIsDefault(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	// Empty source - nothing happened
 	source := trustengine.NullSource{}
@@ -133,7 +135,7 @@ func TestTrustSummary_SilenceIsDefault(t *testing.T) {
 func TestTrustSummary_SuppressionsCreateSignal(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	// Source with suppressions
 	source := trustengine.NewMockSource()
@@ -176,7 +178,7 @@ func TestTrustSummary_SuppressionsCreateSignal(t *testing.T) {
 func TestTrustSummary_HeldCreatesSignal(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	// Source with held obligations
 	source := trustengine.NewMockSource()
@@ -215,7 +217,7 @@ func TestTrustSummary_HeldCreatesSignal(t *testing.T) {
 func TestTrustSummary_MagnitudeBucketsOnly(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	testCases := []struct {
 		name     string
@@ -636,7 +638,7 @@ func TestTrustStore_GetRecentMeaningfulSummary(t *testing.T) {
 func TestTrustEngine_SuppressionsPriority(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	clk := clock.NewFixed(fixedTime)
-	engine := trustengine.NewEngine(clk)
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig())
 
 	// Source with BOTH suppressions and held
 	source := trustengine.NewMockSource()
@@ -785,6 +787,178 @@ func TestTrustSummary_ValidationErrors(t *testing.T) {
 	t.Log("✓ Validation errors work correctly")
 }
 
+// =============================================================================
+// Test: Aggregate Across Circles
+// =============================================================================
+
+func TestTrustEngine_AggregateAcrossCircles(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	engine := trustengine.NewEngine(clock.NewFixed(fixedTime), trustengine.DefaultConfig())
+
+	summary := func(key string, kind trust.TrustSignalKind, mag shadowllm.MagnitudeBucket) trust.TrustSummary {
+		return trust.TrustSummary{
+			Period:          trust.PeriodWeek,
+			PeriodKey:       key,
+			SignalKind:      kind,
+			MagnitudeBucket: mag,
+			CreatedBucket:   trust.FiveMinuteBucket(fixedTime),
+		}
+	}
+
+	summaries := []trust.TrustSummary{
+		summary("2024-W02", trust.SignalQuietHeld, shadowllm.MagnitudeAFew),
+		summary("2024-W03", trust.SignalQuietHeld, shadowllm.MagnitudeAFew),
+		summary("2024-W02", trust.SignalInterruptionPrevented, shadowllm.MagnitudeAFew),
+		summary("2024-W02", trust.SignalQuietHeld, shadowllm.MagnitudeSeveral),
+		summary("2024-W04", trust.SignalNothingRequired, shadowllm.MagnitudeNothing),
+	}
+
+	agg := engine.Aggregate(summaries)
+	if len(agg.Statements) != 2 {
+		t.Fatalf("Expected one statement per meaningful period, got %d", len(agg.Statements))
+	}
+
+	latest, earlier := agg.Statements[0], agg.Statements[1]
+	if latest.PeriodKey != "2024-W03" || latest.MagnitudeBucket != shadowllm.MagnitudeAFew {
+		t.Errorf("Expected 2024-W03 a_few first, got %s %s", latest.PeriodKey, latest.MagnitudeBucket)
+	}
+	if earlier.MagnitudeBucket != shadowllm.MagnitudeSeveral {
+		t.Errorf("Expected buckets to saturate at several, got %s", earlier.MagnitudeBucket)
+	}
+	if earlier.SignalKind != trust.SignalInterruptionPrevented {
+		t.Errorf("Expected prevented interruptions to dominate, got %s", earlier.SignalKind)
+	}
+
+	// Reversed order must yield the same hash
+	reversed := make([]trust.TrustSummary, len(summaries))
+	for i := range summaries {
+		reversed[len(summaries)-1-i] = summaries[i]
+	}
+	if engine.Aggregate(reversed).AggregateHash != agg.AggregateHash {
+		t.Error("Aggregate hash depends on circle iteration order")
+	}
+
+	if trust.CombineMagnitudes(shadowllm.MagnitudeAFew, shadowllm.MagnitudeAFew) != shadowllm.MagnitudeSeveral {
+		t.Error("Expected a_few + a_few = several")
+	}
+	if trust.CombineMagnitudes(shadowllm.MagnitudeNothing, shadowllm.MagnitudeAFew) != shadowllm.MagnitudeAFew {
+		t.Error("Expected nothing to be the identity")
+	}
+
+	t.Log("✓ Aggregation is saturating and order-independent")
+}
+
+// =============================================================================
+// Test: Signed Statement Export
+// =============================================================================
+
+func TestTrustEngine_SignedStatementVerifies(t *testing.T) {
+	fixedTime := time.Date(2024, 2, 15, 10, 30, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+
+	store := persist.NewTrustStore(clk.Now)
+	summary := &trust.TrustSummary{
+		Period:          trust.PeriodMonth,
+		PeriodKey:       "2024-01",
+		SignalKind:      trust.SignalQuietHeld,
+		MagnitudeBucket: shadowllm.MagnitudeSeveral,
+		CreatedBucket:   trust.FiveMinuteBucket(fixedTime),
+		CreatedAt:       fixedTime,
+	}
+	summary.SummaryID = summary.ComputeID()
+	summary.SummaryHash = summary.ComputeHash()
+	if err := store.AppendSummary(summary); err != nil {
+		t.Fatalf("AppendSummary failed: %v", err)
+	}
+
+	keyStore := persist.NewDeviceKeyStore(filepath.Join(t.TempDir(), "device-key"))
+	if _, _, err := keyStore.EnsureKeypair(); err != nil {
+		t.Fatalf("EnsureKeypair failed: %v", err)
+	}
+
+	engine := trustengine.NewEngine(clk, trustengine.DefaultConfig()).WithStatementSigner(store, keyStore)
+	st, err := engine.BuildStatement("circle-1", trust.PeriodMonth)
+	if err != nil {
+		t.Fatalf("BuildStatement failed: %v", err)
+	}
+	if st.PeriodKey != "2024-01" || st.MagnitudeBucket != shadowllm.MagnitudeSeveral {
+		t.Errorf("Expected previous month several, got %s %s", st.PeriodKey, st.MagnitudeBucket)
+	}
+
+	parsed, err := trust.ParseSignedStatement(st.Text())
+	if err != nil {
+		t.Fatalf("ParseSignedStatement failed: %v", err)
+	}
+	if err := trust.VerifyStatement(parsed); err != nil {
+		t.Fatalf("Expected statement to verify, got %v", err)
+	}
+
+	// Tampering with the bucket must break verification
+	tampered := strings.Replace(st.Text(), "|several", "|a_few", 1)
+	parsed, err = trust.ParseSignedStatement(tampered)
+	if err != nil {
+		t.Fatalf("ParseSignedStatement failed: %v", err)
+	}
+	if err := trust.VerifyStatement(parsed); err == nil {
+		t.Error("Expected tampered statement to fail verification")
+	}
+
+	// Statement carries the period and buckets only
+	if containsWord(st.Text(), "circle-1") {
+		t.Error("Statement must not contain the raw circle ID")
+	}
+
+	t.Log("✓ Signed statements verify and reject tampering")
+}
+
+// =============================================================================
+// Test: Configurable Meaningful Threshold
+// =============================================================================
+
+func TestTrustEngine_MeaningfulThresholdBoundary(t *testing.T) {
+	clk := clock.NewFixed(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC))
+
+	aFew := trust.TrustSummary{PeriodKey: "2024-W03", MagnitudeBucket: shadowllm.MagnitudeAFew}
+	several := trust.TrustSummary{PeriodKey: "2024-W02", MagnitudeBucket: shadowllm.MagnitudeSeveral}
+	nothing := trust.TrustSummary{PeriodKey: "2024-W01", MagnitudeBucket: shadowllm.MagnitudeNothing}
+
+	// Default: a_few is the boundary where a summary becomes meaningful
+	def := trustengine.NewEngine(clk, trustengine.DefaultConfig())
+	if !def.IsMeaningful(&aFew) || def.IsMeaningful(&nothing) {
+		t.Error("Default threshold: expected a_few shown and nothing hidden")
+	}
+
+	// Quieter: a_few flips to hidden, several stays meaningful
+	quiet := trustengine.NewEngine(clk, trustengine.Config{MeaningfulMinMagnitude: shadowllm.MagnitudeSeveral})
+	if quiet.IsMeaningful(&aFew) {
+		t.Error("Quieter threshold: expected a_few hidden")
+	}
+	if !quiet.IsMeaningful(&several) {
+		t.Error("Quieter threshold: expected several shown")
+	}
+
+	// "nothing" is never accepted as a minimum
+	loose := trustengine.NewEngine(clk, trustengine.Config{MeaningfulMinMagnitude: shadowllm.MagnitudeNothing})
+	if loose.IsMeaningful(&nothing) {
+		t.Error("Expected nothing to stay hidden whatever the threshold")
+	}
+
+	// MaxShown caps visible summaries after filtering
+	all := []trust.TrustSummary{aFew, nothing, several}
+	if got := def.Visible(all); len(got) != 2 {
+		t.Errorf("Expected 2 visible with defaults, got %d", len(got))
+	}
+	capped := trustengine.NewEngine(clk, trustengine.Config{MaxShown: 1})
+	if got := capped.Visible(all); len(got) != 1 || got[0].PeriodKey != "2024-W03" {
+		t.Errorf("Expected only the most recent meaningful summary, got %v", got)
+	}
+	if got := quiet.Visible(all); len(got) != 1 || got[0].PeriodKey != "2024-W02" {
+		t.Errorf("Expected only several under quieter threshold, got %v", got)
+	}
+
+	t.Log("✓ Meaningful threshold and MaxShown are configurable")
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -368,3 +368,141 @@ func TestAckStore_BoundedSize(t *testing.T) {
 
 	t.Logf("Store size after overflow: %d (max: %d)", store.Len(), maxRecords)
 }
+
+// milestoneReceipt builds a receipt with one suggestion from the given provider.
+func milestoneReceipt(id string, kind shadowllm.ProviderKind, now time.Time) *shadowllm.ShadowReceipt {
+	return &shadowllm.ShadowReceipt{
+		ReceiptID:    id,
+		CircleID:     identity.EntityID("circle-1"),
+		WindowBucket: "2025-01-15",
+		CreatedAt:    now,
+		Suggestions: []shadowllm.ShadowSuggestion{
+			{
+				Category:   shadowllm.CategoryMoney,
+				Magnitude:  shadowllm.MagnitudeAFew,
+				Horizon:    shadowllm.HorizonSoon,
+				Confidence: shadowllm.ConfidenceMed,
+			},
+		},
+		Provenance: shadowllm.Provenance{
+			ProviderKind: kind,
+			Status:       shadowllm.ReceiptStatusSuccess,
+		},
+	}
+}
+
+// TestMilestone_OnlyFirstRealSuggestion verifies the milestone is recorded once.
+//
+// CRITICAL: Stub suggestions never trigger it. Later real suggestions never re-trigger it.
+func TestMilestone_OnlyFirstRealSuggestion(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := shadowview.NewMilestoneStore()
+
+	if _, ok := store.Observe(milestoneReceipt("stub-1", shadowllm.ProviderKindStub, now), "2025-01-15", now); ok {
+		t.Error("Stub suggestion should not record milestone")
+	}
+	if _, ok := store.Get(); ok {
+		t.Fatal("Milestone should not exist after stub suggestion")
+	}
+
+	first := milestoneReceipt("real-1", shadowllm.ProviderKindAzureOpenAI, now)
+	record, ok := store.Observe(first, "2025-01-15", now)
+	if !ok {
+		t.Fatal("First real suggestion should record milestone")
+	}
+	if record.ReceiptHash != first.Hash() {
+		t.Errorf("Milestone hash mismatch: %s != %s", record.ReceiptHash, first.Hash())
+	}
+
+	later := now.Add(24 * time.Hour)
+	if _, ok := store.Observe(milestoneReceipt("real-2", shadowllm.ProviderKindAzureOpenAI, later), "2025-01-16", later); ok {
+		t.Error("Subsequent real suggestion should not re-trigger milestone")
+	}
+
+	got, ok := store.Get()
+	if !ok || got.ReceiptHash != first.Hash() {
+		t.Error("Milestone should remain pinned to the first real suggestion")
+	}
+
+	engine := shadowview.NewEngine(fixedClock(now))
+	page := engine.BuildPage(shadowview.BuildPageInput{Receipt: first, Milestone: &got})
+	if !page.Milestone.HasMilestone || page.Milestone.Statement == "" {
+		t.Error("Receipt page should show the milestone acknowledgment")
+	}
+}
+
+// TestMilestone_IgnoresEmptyAndFailed verifies non-suggestions never record the milestone.
+func TestMilestone_IgnoresEmptyAndFailed(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := shadowview.NewMilestoneStore()
+
+	empty := milestoneReceipt("real-empty", shadowllm.ProviderKindAzureOpenAI, now)
+	empty.Suggestions = nil
+	failed := milestoneReceipt("real-failed", shadowllm.ProviderKindAzureOpenAI, now)
+	failed.Provenance.Status = shadowllm.ReceiptStatusFailed
+
+	for _, r := range []*shadowllm.ShadowReceipt{empty, failed} {
+		if _, ok := store.Observe(r, "2025-01-15", now); ok {
+			t.Errorf("Receipt %s should not record milestone", r.ReceiptID)
+		}
+	}
+}
+
+// TestModeChange_ExactlyOneAcknowledgment verifies a mode transition triggers
+// exactly one acknowledgment, dismissible per change.
+func TestModeChange_ExactlyOneAcknowledgment(t *testing.T) {
+	store := mode.NewAckStore()
+	circleID := identity.EntityID("circle-1")
+
+	// First observation is the baseline, not a change
+	if _, changed := store.Observe(circleID, mode.ModeDemo); changed {
+		t.Error("First observation should not be a change")
+	}
+	if store.Pending(circleID) != nil {
+		t.Error("Expected no pending acknowledgment at baseline")
+	}
+
+	// Transition Demo -> Connected, observed on repeated renders
+	acks := 0
+	for i := 0; i < 3; i++ {
+		if _, changed := store.Observe(circleID, mode.ModeConnected); changed {
+			acks++
+		}
+	}
+	if acks != 1 {
+		t.Fatalf("Expected exactly 1 acknowledgment, got %d", acks)
+	}
+
+	pending := store.Pending(circleID)
+	if pending == nil {
+		t.Fatal("Expected pending acknowledgment")
+	}
+	if pending.From != mode.ModeDemo || pending.To != mode.ModeConnected {
+		t.Errorf("Unexpected change: %s -> %s", pending.From, pending.To)
+	}
+	if pending.Line() != "You're now in Connected mode." {
+		t.Errorf("Unexpected line: %q", pending.Line())
+	}
+
+	// Dismissal with the wrong hash is ignored
+	if store.Dismiss(circleID, "wrong") {
+		t.Error("Dismiss with wrong hash should fail")
+	}
+	if !store.Dismiss(circleID, pending.ChangeHash) {
+		t.Error("Dismiss with matching hash should succeed")
+	}
+	if store.Pending(circleID) != nil {
+		t.Error("Acknowledgment should not reappear after dismissal")
+	}
+
+	// Next transition shows a new acknowledgment
+	change, changed := store.Observe(circleID, mode.ModeShadow)
+	if !changed || change.ChangeHash == pending.ChangeHash {
+		t.Error("Expected a new acknowledgment for the next transition")
+	}
+
+	// Other circles are unaffected
+	if store.Pending(identity.EntityID("circle-2")) != nil {
+		t.Error("Other circle should have no acknowledgment")
+	}
+}
@@ -19,13 +19,21 @@
 package demo_phase25_first_undoable_execution
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"quantumlife/internal/connectors/email/write"
+	mockemail "quantumlife/internal/connectors/email/write/providers/mock"
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/internal/execexecutor"
 	"quantumlife/internal/persist"
+	"quantumlife/internal/undoableexec"
+	pkgclock "quantumlife/pkg/clock"
 	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/identity"
 	domainundoableexec "quantumlife/pkg/domain/undoableexec"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // =============================================================================
@@ -198,6 +206,90 @@ func TestUndoRecord_IsUndoAvailable_WrongState(t *testing.T) {
 	}
 }
 
+func TestUndoRecord_ConfiguredUndoWindow_Boundary(t *testing.T) {
+	executedAt := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+
+	// Execution bucket closes at 10:15; undo stays open UndoWindow after that.
+	testCases := []struct {
+		name     string
+		window   time.Duration
+		lastOpen time.Time
+	}{
+		{"default", 0, time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{"one minute", time.Minute, time.Date(2025, 1, 15, 10, 16, 0, 0, time.UTC)},
+		{"fifteen minutes", 15 * time.Minute, time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)},
+		{"sixty minutes", 60 * time.Minute, time.Date(2025, 1, 15, 11, 15, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := undowindow.Config{Window: tc.window}
+			record := domainundoableexec.NewUndoRecordWithConfig(
+				cfg,
+				"2025-01-15",
+				"circle-1",
+				domainundoableexec.ActionKindCalendarRespond,
+				"draft-abc",
+				"env-123",
+				domainundoableexec.StatusNeedsAction,
+				domainundoableexec.StatusAccepted,
+				executedAt,
+			)
+
+			if !record.IsUndoAvailable(tc.lastOpen.Add(-time.Minute)) {
+				t.Error("Undo should be available the minute before the deadline")
+			}
+			if !record.IsUndoAvailable(tc.lastOpen) {
+				t.Error("Undo should be available at the deadline minute")
+			}
+			if record.IsUndoAvailable(tc.lastOpen.Add(time.Minute)) {
+				t.Error("Undo should not be available the minute after the deadline")
+			}
+		})
+	}
+}
+
+func TestUndoRecord_ConfiguredUndoWindow_KeepsHash(t *testing.T) {
+	executedAt := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+
+	short := domainundoableexec.NewUndoRecordWithConfig(
+		undowindow.Config{Window: time.Minute},
+		"2025-01-15", "circle-1", domainundoableexec.ActionKindCalendarRespond,
+		"draft-abc", "env-123",
+		domainundoableexec.StatusNeedsAction, domainundoableexec.StatusAccepted,
+		executedAt,
+	)
+	long := domainundoableexec.NewUndoRecordWithConfig(
+		undowindow.Config{Window: time.Hour},
+		"2025-01-15", "circle-1", domainundoableexec.ActionKindCalendarRespond,
+		"draft-abc", "env-123",
+		domainundoableexec.StatusNeedsAction, domainundoableexec.StatusAccepted,
+		executedAt,
+	)
+
+	if short.Hash() != long.Hash() {
+		t.Error("Undo window should not change the record hash")
+	}
+}
+
+func TestUndoRecord_ConfiguredUndoWindow_Clamped(t *testing.T) {
+	executedAt := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+
+	record := domainundoableexec.NewUndoRecordWithConfig(
+		undowindow.Config{Window: 48 * time.Hour},
+		"2025-01-15", "circle-1", domainundoableexec.ActionKindCalendarRespond,
+		"draft-abc", "env-123",
+		domainundoableexec.StatusNeedsAction, domainundoableexec.StatusAccepted,
+		executedAt,
+	)
+
+	want := int(undowindow.Max / time.Minute)
+	if record.UndoAvailableUntilBucket.BucketDurationMinutes != want {
+		t.Errorf("Expected window clamped to %d minutes, got %d",
+			want, record.UndoAvailableUntilBucket.BucketDurationMinutes)
+	}
+}
+
 func TestPeriodKey_DailyBucket(t *testing.T) {
 	// Test that period keys use daily buckets
 	t1 := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
@@ -562,6 +654,11 @@ func TestActionKind_OnlyCalendarRespond(t *testing.T) {
 		t.Error("calendar_respond should be supported")
 	}
 
+	// A held email send is undoable; a sent email is not
+	if !domainundoableexec.ActionKindEmailScheduledSend.IsSupported() {
+		t.Error("email_scheduled_send should be supported")
+	}
+
 	// Verify unsupported kinds
 	var unsupported domainundoableexec.UndoableActionKind = "email_send"
 	if unsupported.IsSupported() {
@@ -569,6 +666,172 @@ func TestActionKind_OnlyCalendarRespond(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Email Scheduled Send Tests
+// =============================================================================
+
+// unscheduledWriter hides the mock's scheduling, like a provider that
+// can only send immediately.
+type unscheduledWriter struct {
+	write.Writer
+}
+
+// newEmailUndoEngine builds an engine with one approved email reply draft.
+func newEmailUndoEngine(t *testing.T, clock func() time.Time, writer write.Writer) *undoableexec.Engine {
+	t.Helper()
+	return newEmailUndoEngineWithDryRun(t, clock, writer, nil)
+}
+
+// newEmailUndoEngineWithDryRun is newEmailUndoEngine with a dry-run switch.
+func newEmailUndoEngineWithDryRun(t *testing.T, clock func() time.Time, writer write.Writer, dryRun func() bool) *undoableexec.Engine {
+	t.Helper()
+
+	drafts := draft.NewInMemoryStore()
+	err := drafts.Put(draft.Draft{
+		DraftID:   "draft-email-1",
+		CircleID:  "circle-1",
+		DraftType: draft.DraftTypeEmailReply,
+		Status:    draft.StatusApproved,
+		Content: draft.EmailDraftContent{
+			Subject:            "Re: plans",
+			Body:               "Sounds good.",
+			ThreadID:           "thread-1",
+			ProviderHint:       "mock",
+			InReplyToMessageID: "msg-1",
+		},
+		CreatedAt: clock(),
+		ExpiresAt: clock().Add(24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Put draft failed: %v", err)
+	}
+
+	return undoableexec.NewEngine(undoableexec.EngineConfig{
+		Clock:      clock,
+		DraftStore: drafts,
+		UndoStore:  persist.NewUndoableExecStore(clock),
+		EmailExecutor: emailexec.NewExecutor(
+			emailexec.WithExecutorClock(clock),
+			emailexec.WithWriter("mock", writer),
+		),
+		DryRun: dryRun,
+	})
+}
+
+func TestEmailScheduledSend_RunAndUndoCancelsBeforeDispatch(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	writer := mockemail.NewWriter(mockemail.WithClock(clock))
+	engine := newEmailUndoEngine(t, clock, writer)
+	ctx := context.Background()
+
+	eligibility := engine.EligibleAction(ctx, "circle-1")
+	if !eligibility.Eligible {
+		t.Fatalf("Expected eligible, got %s", eligibility.Reason)
+	}
+	if eligibility.ActionKind != domainundoableexec.ActionKindEmailScheduledSend {
+		t.Errorf("Expected email_scheduled_send, got %s", eligibility.ActionKind)
+	}
+
+	result := engine.RunOnce(ctx, "circle-1", eligibility.DraftID)
+	if !result.Success {
+		t.Fatalf("RunOnce failed: %s", result.Error)
+	}
+	if writer.GetSentCount() != 0 || writer.GetScheduledCount() != 1 {
+		t.Fatalf("Expected reply held, not sent: sent=%d scheduled=%d",
+			writer.GetSentCount(), writer.GetScheduledCount())
+	}
+
+	// The hold lasts exactly as long as the undo window
+	sendAt := result.EmailEnvelope.SendAt
+	if sendAt == nil || !sendAt.Equal(result.UndoRecord.UndoAvailableUntilBucket.Deadline()) {
+		t.Errorf("Expected send held until the undo deadline, got %v", sendAt)
+	}
+
+	now = *sendAt
+	undo := engine.Undo(ctx, result.UndoRecord.ID)
+	if !undo.Success {
+		t.Fatalf("Undo failed: %s", undo.Error)
+	}
+	if !writer.IsCancelled(result.EmailEnvelope.ExecutionResult.MessageID) {
+		t.Error("Undo should cancel the held reply")
+	}
+	if undo.EmailEnvelope.Status != emailexec.EnvelopeStatusCancelled {
+		t.Errorf("Expected cancelled envelope, got %s", undo.EmailEnvelope.Status)
+	}
+}
+
+func TestEmailScheduledSend_UndoAfterWindowFails(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	writer := mockemail.NewWriter(mockemail.WithClock(clock))
+	engine := newEmailUndoEngine(t, clock, writer)
+	ctx := context.Background()
+
+	eligibility := engine.EligibleAction(ctx, "circle-1")
+	result := engine.RunOnce(ctx, "circle-1", eligibility.DraftID)
+	if !result.Success {
+		t.Fatalf("RunOnce failed: %s", result.Error)
+	}
+
+	now = result.EmailEnvelope.SendAt.Add(time.Minute)
+	undo := engine.Undo(ctx, result.UndoRecord.ID)
+	if undo.Success {
+		t.Error("Undo should fail once the reply has been dispatched")
+	}
+	if writer.IsCancelled(result.EmailEnvelope.ExecutionResult.MessageID) {
+		t.Error("A dispatched reply must not be marked cancelled")
+	}
+}
+
+func TestEmailScheduledSend_DryRunWritesNothing(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+	clock := mockClock(now)
+	writer := mockemail.NewWriter(mockemail.WithClock(clock))
+	executor := execexecutor.NewExecutor(pkgclock.NewFixed(now), nil)
+	executor.SetDryRun(true)
+	engine := newEmailUndoEngineWithDryRun(t, clock, writer, executor.DryRun)
+	ctx := context.Background()
+
+	eligibility := engine.EligibleAction(ctx, "circle-1")
+	result := engine.RunOnce(ctx, "circle-1", eligibility.DraftID)
+	if result.Success || result.Error != execexecutor.DryRunBlockedReason {
+		t.Errorf("Expected RunOnce refused in dry-run mode, got success=%t error=%q", result.Success, result.Error)
+	}
+	if writer.GetSentCount() != 0 || writer.GetScheduledCount() != 0 {
+		t.Errorf("Nothing may be written in dry-run mode: sent=%d scheduled=%d",
+			writer.GetSentCount(), writer.GetScheduledCount())
+	}
+	if engine.HasExecutedThisPeriod("circle-1") {
+		t.Error("A refused dry run must not use up the period's single execution")
+	}
+
+	executor.SetDryRun(false)
+	if result := engine.RunOnce(ctx, "circle-1", eligibility.DraftID); !result.Success {
+		t.Errorf("Expected RunOnce to run once dry-run mode is off, got %q", result.Error)
+	}
+}
+
+func TestEmailScheduledSend_NotOfferedWithoutScheduling(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+	clock := mockClock(now)
+	writer := mockemail.NewWriter(mockemail.WithClock(clock))
+	engine := newEmailUndoEngine(t, clock, unscheduledWriter{writer})
+
+	eligibility := engine.EligibleAction(context.Background(), "circle-1")
+	if eligibility.Eligible {
+		t.Error("Email must not be offered when the provider cannot delay a send")
+	}
+
+	result := engine.RunOnce(context.Background(), "circle-1", "draft-email-1")
+	if result.Success {
+		t.Error("RunOnce must not send when the provider cannot delay a send")
+	}
+	if writer.GetSentCount() != 0 {
+		t.Error("Nothing should have been sent")
+	}
+}
+
 // =============================================================================
 // Response Status Tests
 // =============================================================================
@@ -642,6 +905,36 @@ func TestStorelog_RecordRoundtrip(t *testing.T) {
 	}
 }
 
+func TestStorelog_RecordRoundtrip_KeepsUndoWindow(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+	store := persist.NewUndoableExecStore(mockClock(now))
+
+	record := domainundoableexec.NewUndoRecordWithConfig(
+		undowindow.Config{Window: time.Hour},
+		"2025-01-15", "circle-1", domainundoableexec.ActionKindCalendarRespond,
+		"draft-abc", "env-123",
+		domainundoableexec.StatusNeedsAction, domainundoableexec.StatusAccepted,
+		now,
+	)
+
+	store2 := persist.NewUndoableExecStore(mockClock(now))
+	if err := store2.ReplayRecordFromStorelog(store.RecordToStorelogRecord(record)); err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	replayed, found := store2.GetByID(record.ID)
+	if !found {
+		t.Fatal("Replayed record not found")
+	}
+
+	deadline := time.Date(2025, 1, 15, 11, 15, 0, 0, time.UTC)
+	if !replayed.IsUndoAvailable(deadline) {
+		t.Error("Replayed record should keep its undo window")
+	}
+	if replayed.IsUndoAvailable(deadline.Add(time.Minute)) {
+		t.Error("Replayed record should expire after its undo window")
+	}
+}
+
 func TestStorelog_AckRoundtrip(t *testing.T) {
 	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 	store := persist.NewUndoableExecStore(mockClock(now))
@@ -154,6 +154,41 @@ func TestDismissalSuppressesRerender(t *testing.T) {
 	}
 }
 
+// TestDismissalFromBeforeHashV1 verifies a dismissal persisted with the
+// pre-hashutil status hash still suppresses the same summary.
+func TestDismissalFromBeforeHashV1(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	engine := firstminutes.NewEngine(fixedClock(now))
+
+	inputs := &domainfirstminutes.FirstMinutesInputs{
+		CircleID:       "circle-123",
+		Period:         "2024-01-15",
+		HasConnection:  true,
+		ConnectionMode: "real",
+		HasSyncReceipt: true,
+		SyncMagnitude:  domainfirstminutes.MagnitudeAFew,
+	}
+
+	// Status hash of this summary as computed before hashutil v1.
+	const legacyHash = "682402d23e8db4f29f974652fc7128ed"
+
+	summary := engine.ComputeSummary(inputs)
+	if summary == nil {
+		t.Fatal("Expected non-nil summary")
+	}
+	if summary.StatusHash == legacyHash {
+		t.Fatal("Expected the v1 status hash to differ from the legacy one")
+	}
+	if !summary.MatchesStatusHash(legacyHash) || !summary.MatchesStatusHash(summary.StatusHash) {
+		t.Error("Expected both the legacy and the v1 status hash to match")
+	}
+
+	inputs.DismissedSummaryHash = legacyHash
+	if engine.ComputeSummary(inputs) != nil {
+		t.Error("Expected nil summary after a legacy dismissal with matching state")
+	}
+}
+
 // TestDismissalMaterialChange verifies material change after dismissal shows summary.
 func TestDismissalMaterialChange(t *testing.T) {
 	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
@@ -597,3 +632,55 @@ func TestStoreOperations(t *testing.T) {
 		t.Errorf("Dismissed hash should be hash123, got %s", dismissedHash)
 	}
 }
+
+// TestCardSVGDeterministic verifies identical summaries yield identical SVG bytes.
+func TestCardSVGDeterministic(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	engine := firstminutes.NewEngine(fixedClock(now))
+
+	inputs := &domainfirstminutes.FirstMinutesInputs{
+		CircleID:        "circle-secret-123",
+		Period:          "2024-01-15",
+		HasConnection:   true,
+		ConnectionMode:  "real",
+		HasSyncReceipt:  true,
+		SyncMagnitude:   domainfirstminutes.MagnitudeAFew,
+		HasHeldItems:    true,
+		HeldMagnitude:   domainfirstminutes.MagnitudeSeveral,
+		ActionPreviewed: true,
+	}
+
+	card1 := firstminutes.RenderCardSVG(engine.ComputeSummary(inputs))
+	card2 := firstminutes.RenderCardSVG(engine.ComputeSummary(inputs))
+
+	if len(card1) == 0 {
+		t.Fatal("Expected non-empty card")
+	}
+	if string(card1) != string(card2) {
+		t.Error("Identical summaries must yield identical SVG bytes")
+	}
+
+	svg := string(card1)
+	if !strings.HasPrefix(svg, "<svg") {
+		t.Error("Expected SVG document")
+	}
+	if strings.Contains(svg, "circle-secret-123") {
+		t.Error("Card must not contain identifiers")
+	}
+	for _, forbidden := range []string{"<script", "href=", "<image", "url("} {
+		if strings.Contains(svg, forbidden) {
+			t.Errorf("Card must not reference external assets: found %q", forbidden)
+		}
+	}
+
+	// Different summary produces a different card
+	inputs.ActionExecuted = true
+	card3 := firstminutes.RenderCardSVG(engine.ComputeSummary(inputs))
+	if string(card1) == string(card3) {
+		t.Error("Different summaries should yield different cards")
+	}
+
+	if firstminutes.RenderCardSVG(nil) != nil {
+		t.Error("Nil summary should render nothing")
+	}
+}
@@ -543,3 +543,44 @@ func TestCueNotAvailableWhenNeverSynced(t *testing.T) {
 		t.Error("Cue should not be available when never synced")
 	}
 }
+
+func TestCoverageLineMapsConnectedKinds(t *testing.T) {
+	tests := []struct {
+		name     string
+		kinds    []string
+		commerce bool
+		want     string
+	}{
+		{"nothing", nil, false, "No sources connected yet."},
+		{"email only", []string{"email"}, false, "email connected"},
+		{"email and finance", []string{"email", "finance"}, true, "email and finance connected; commerce observed"},
+		{"all kinds", []string{"email", "calendar", "finance"}, false, "email, calendar and finance connected"},
+		{"commerce only", nil, true, "commerce observed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reality.CoverageLine(tt.kinds, tt.commerce); got != tt.want {
+				t.Errorf("CoverageLine(%v, %v) = %q, want %q", tt.kinds, tt.commerce, got, tt.want)
+			}
+		})
+	}
+
+	// The coverage line is part of the page and its status hash.
+	engine := reality.NewEngine(&fixedClock{t: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)})
+	inputs := &domainreality.RealityInputs{
+		CircleID:       "test-circle",
+		NowBucket:      "2025-01-15",
+		GmailConnected: true,
+		ConnectedKinds: []string{"email"},
+		SyncBucket:     domainreality.SyncBucketNever,
+	}
+	before := engine.BuildPage(inputs)
+	if before.CoverageLine != "email connected" {
+		t.Errorf("unexpected coverage line: %q", before.CoverageLine)
+	}
+	inputs.CommerceObserved = true
+	if after := engine.BuildPage(inputs); after.StatusHash == before.StatusHash {
+		t.Error("expected coverage change to change the status hash")
+	}
+}
@@ -3,7 +3,7 @@
 // These tests verify the critical safety invariants:
 //   - Only calendar_respond action allowed
 //   - Single execution per period
-//   - 15-minute undo window
+//   - Bounded undo window (15 minutes by default)
 //   - Hash-only storage
 //   - Silence after completion
 //   - No re-invitation
@@ -13,11 +13,17 @@
 package demo_phase28_trust_kept
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"quantumlife/internal/execexecutor"
 	"quantumlife/internal/persist"
+	trustactionengine "quantumlife/internal/trustaction"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/trustaction"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // testClock returns a deterministic clock for testing.
@@ -124,6 +130,43 @@ func TestUndoBucketExpiry(t *testing.T) {
 	}
 }
 
+// TestUndoBucketConfiguredWindow verifies the configured undo window
+// flips expiry at exactly the boundary minute.
+func TestUndoBucketConfiguredWindow(t *testing.T) {
+	executedAt := time.Date(2025, 1, 15, 10, 7, 0, 0, time.UTC)
+
+	// The window runs from the 10:00 bucket start.
+	testCases := []struct {
+		name     string
+		window   time.Duration
+		lastOpen time.Time
+	}{
+		{"default", 0, time.Date(2025, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"ten minutes", 10 * time.Minute, time.Date(2025, 1, 15, 10, 10, 0, 0, time.UTC)},
+		{"fifteen minutes", 15 * time.Minute, time.Date(2025, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"sixty minutes", 60 * time.Minute, time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bucket := trustaction.NewUndoBucketWithConfig(undowindow.Config{Window: tc.window}, executedAt)
+
+			if bucket.IsExpired(tc.lastOpen.Add(-time.Minute)) {
+				t.Error("expected open the minute before the deadline")
+			}
+			if bucket.IsExpired(tc.lastOpen) {
+				t.Error("expected open at the deadline minute")
+			}
+			if !bucket.IsExpired(tc.lastOpen.Add(time.Minute)) {
+				t.Error("expected expired the minute after the deadline")
+			}
+			if !bucket.Deadline().Equal(tc.lastOpen) {
+				t.Errorf("Deadline() = %v, want %v", bucket.Deadline(), tc.lastOpen)
+			}
+		})
+	}
+}
+
 // TestReceiptHashDeterminism verifies hash computation is deterministic.
 func TestReceiptHashDeterminism(t *testing.T) {
 	receipt := &trustaction.TrustActionReceipt{
@@ -429,3 +472,88 @@ func TestGetLatestForCircle(t *testing.T) {
 		t.Errorf("ReceiptID mismatch: got %s, want %s", latest.ReceiptID, receipt.ReceiptID)
 	}
 }
+
+// TestUndoRemainingBuckets verifies the remaining undo window maps to
+// abstract buckets only, with no countdown.
+func TestUndoRemainingBuckets(t *testing.T) {
+	executed := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	bucket := trustaction.NewUndoBucket(executed) // closes at 10:15
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want trustaction.UndoRemainingBucket
+		line string
+	}{
+		{"just executed", executed, trustaction.UndoRemainingLonger, "Undo available for a little longer."},
+		{"mid window", executed.Add(9 * time.Minute), trustaction.UndoRemainingLonger, "Undo available for a little longer."},
+		{"closing", executed.Add(11 * time.Minute), trustaction.UndoRemainingClosing, "Undo window closing soon."},
+		{"at deadline", executed.Add(15 * time.Minute), trustaction.UndoRemainingClosing, "Undo window closing soon."},
+		{"expired", executed.Add(16 * time.Minute), trustaction.UndoRemainingClosed, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bucket.Remaining(tt.now)
+			if got != tt.want {
+				t.Errorf("Remaining(%s) = %s, want %s", tt.now.Format("15:04"), got, tt.want)
+			}
+			if got.DisplayText() != tt.line {
+				t.Errorf("DisplayText() = %q, want %q", got.DisplayText(), tt.line)
+			}
+		})
+	}
+
+	if got := (&trustaction.UndoBucket{BucketStartRFC3339: "invalid"}).Remaining(executed); got != trustaction.UndoRemainingClosed {
+		t.Errorf("expected invalid bucket to be closed, got %s", got)
+	}
+}
+
+// TestUndoRefusedInDryRun verifies the engine writes nothing, undos
+// included, while the executor's dry-run switch is on.
+func TestUndoRefusedInDryRun(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := persist.NewTrustActionStore(testClock(now))
+	drafts := draft.NewInMemoryStore()
+	if err := drafts.Put(draft.Draft{
+		DraftID:   "draft-cal-1",
+		CircleID:  "circle_123",
+		DraftType: draft.DraftTypeCalendarResponse,
+		Status:    draft.StatusApproved,
+		Content:   draft.CalendarDraftContent{EventID: "evt-1", Response: draft.CalendarResponseAccept, ProviderHint: "mock"},
+		CreatedAt: now,
+		ExpiresAt: now.Add(24 * time.Hour),
+	}); err != nil {
+		t.Fatalf("Put draft failed: %v", err)
+	}
+
+	receipt := &trustaction.TrustActionReceipt{
+		ActionKind:   trustaction.ActionKindCalendarRespond,
+		State:        trustaction.StateExecuted,
+		UndoBucket:   trustaction.NewUndoBucket(now),
+		Period:       "2025-01-15",
+		CircleID:     "circle_123",
+		DraftIDHash:  trustaction.HashString("draft-cal-1"),
+		EnvelopeHash: "def456",
+	}
+	if err := store.AppendReceiptWithDraftID(receipt, "draft-cal-1"); err != nil {
+		t.Fatalf("AppendReceiptWithDraftID failed: %v", err)
+	}
+
+	executor := execexecutor.NewExecutor(clock.NewFixed(now), nil)
+	executor.SetDryRun(true)
+	engine := trustactionengine.NewEngine(trustactionengine.EngineConfig{
+		Clock:            testClock(now),
+		DraftStore:       drafts,
+		TrustActionStore: store,
+		DryRun:           executor.DryRun,
+	})
+
+	result := engine.Undo(context.Background(), receipt.ReceiptID)
+	if result.Success || result.Error != execexecutor.DryRunBlockedReason {
+		t.Errorf("Expected undo refused in dry-run mode, got success=%t error=%q", result.Success, result.Error)
+	}
+	if got := store.GetByID(receipt.ReceiptID); got.State != trustaction.StateExecuted {
+		t.Errorf("A refused undo must leave the receipt executed, got %s", got.State)
+	}
+}
@@ -12,9 +12,18 @@
 package demo_phase29_truelayer_finance_mirror
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
+	"quantumlife/internal/connectors/auth"
+	"quantumlife/internal/connectors/auth/impl_inmem"
+	plaid "quantumlife/internal/connectors/finance/read/providers/plaid"
+	"quantumlife/internal/oauth"
 	"quantumlife/internal/persist"
 	"quantumlife/pkg/domain/financemirror"
 )
@@ -466,3 +475,88 @@ func containsString(s, substr string) bool {
 	}
 	return false
 }
+
+// plaidStub serves the Plaid endpoints used by Hosted Link and records
+// the products requested.
+type plaidStub struct {
+	products []string
+}
+
+func (p *plaidStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body map[string]interface{}
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	var resp string
+	switch req.URL.Path {
+	case "/link/token/create":
+		for _, v := range body["products"].([]interface{}) {
+			p.products = append(p.products, v.(string))
+		}
+		resp = `{"link_token":"link-sandbox-1","hosted_link_url":"https://hosted.plaid.com/link/1"}`
+	case "/link/token/get":
+		resp = `{"link_token":"link-sandbox-1","link_sessions":[{"results":{"item_add_results":[{"public_token":"public-sandbox-1"}]}}]}`
+	case "/item/public_token/exchange":
+		resp = `{"access_token":"access-sandbox-1","item_id":"item-1"}`
+	default:
+		return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("{}")), Header: make(http.Header)}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(resp)), Header: make(http.Header)}, nil
+}
+
+// TestPlaidLinkFlowReadOnly verifies Plaid Link requests read-only products
+// and stores a finance:read token through the broker.
+func TestPlaidLinkFlowReadOnly(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	clk := func() time.Time { return now }
+	stub := &plaidStub{}
+	httpClient := &http.Client{Transport: stub}
+
+	client, err := plaid.NewClient(plaid.ClientConfig{ClientID: "client", Secret: "secret", HTTPClient: httpClient})
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	broker := impl_inmem.NewBroker(auth.Config{
+		Plaid:              auth.PlaidConfig{ClientID: "client", Secret: "secret"},
+		TokenEncryptionKey: "test-encryption-key-32-bytes!!!",
+	}, nil, impl_inmem.WithHTTPClient(httpClient), impl_inmem.WithClock(clk))
+	stateManager := oauth.NewStateManager([]byte("test-secret-32-bytes-for-hmac!!"), clk)
+	handler := oauth.NewPlaidHandler(stateManager, broker, client, "http://localhost:8080", clk)
+
+	start, err := handler.Start(context.Background(), "circle-plaid")
+	if err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if start.AuthURL != "https://hosted.plaid.com/link/1" {
+		t.Errorf("expected hosted link url, got %s", start.AuthURL)
+	}
+	if len(stub.products) != 1 || stub.products[0] != plaid.ProductTransactions {
+		t.Errorf("expected only the transactions product, got %v", stub.products)
+	}
+
+	state := start.State.Encode()
+	result, err := handler.Callback(context.Background(), state)
+	if err != nil {
+		t.Fatalf("Callback failed: %v", err)
+	}
+	if !result.Receipt.Success || result.Receipt.Provider != oauth.ProviderPlaid {
+		t.Errorf("unexpected receipt: %+v", result.Receipt)
+	}
+	if strings.Contains(result.Receipt.CanonicalString(), "sandbox") {
+		t.Error("receipt must not contain tokens")
+	}
+
+	token, err := broker.MintReadOnlyAccessToken(context.Background(), "circle-plaid", auth.ProviderPlaid, oauth.PlaidScopes)
+	if err != nil || token.Token != "access-sandbox-1" {
+		t.Fatalf("expected stored read-only token, got %q, %v", token.Token, err)
+	}
+
+	// The link token is single-use: replaying the state fails
+	if _, err := handler.Callback(context.Background(), state); err == nil {
+		t.Error("expected replayed callback to fail")
+	}
+
+	revoke, err := handler.Revoke(context.Background(), "circle-plaid")
+	if err != nil || !revoke.Receipt.LocalRemoved || revoke.Receipt.ProviderRevoked {
+		t.Errorf("expected local-only revoke, got %+v, %v", revoke.Receipt, err)
+	}
+}
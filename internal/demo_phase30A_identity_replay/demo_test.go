@@ -13,6 +13,9 @@
 package demo_phase30A_identity_replay
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -242,6 +245,57 @@ func TestDeviceKeyStoreCreation(t *testing.T) {
 	}
 }
 
+// TestDeviceKeyStorePersistsAcrossRestarts verifies a fresh store loads
+// the key written by an earlier one, and rejects a world-readable key file.
+func TestDeviceKeyStorePersistsAcrossRestarts(t *testing.T) {
+	keyDir := filepath.Join(t.TempDir(), persist.DefaultDeviceKeyDirName)
+	keyPath := filepath.Join(keyDir, persist.DefaultDeviceKeyFileName)
+
+	pubKey1, _, err := persist.NewDeviceKeyStore(keyPath).EnsureKeypair()
+	if err != nil {
+		t.Fatalf("EnsureKeypair (first run) failed: %v", err)
+	}
+
+	dirInfo, err := os.Stat(keyDir)
+	if err != nil {
+		t.Fatalf("Key dir stat failed: %v", err)
+	}
+	if dirInfo.Mode().Perm() != 0700 {
+		t.Errorf("Key dir permissions = %o, want 0700", dirInfo.Mode().Perm())
+	}
+
+	// A new store simulates the next process start
+	pubKey2, _, err := persist.NewDeviceKeyStore(keyPath).EnsureKeypair()
+	if err != nil {
+		t.Fatalf("EnsureKeypair (second run) failed: %v", err)
+	}
+	if pubKey1 != pubKey2 {
+		t.Errorf("PublicKey changed across restarts")
+	}
+
+	if err := os.Chmod(keyPath, 0644); err != nil {
+		t.Fatalf("Chmod failed: %v", err)
+	}
+	if _, _, err := persist.NewDeviceKeyStore(keyPath).EnsureKeypair(); !errors.Is(err, persist.ErrInsecureKeyPermissions) {
+		t.Errorf("EnsureKeypair on 0644 key = %v, want ErrInsecureKeyPermissions", err)
+	}
+}
+
+// TestDefaultDeviceKeyPath verifies the key lives in the config directory.
+func TestDefaultDeviceKeyPath(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+	path, err := persist.DefaultDeviceKeyPath()
+	if err != nil {
+		t.Skipf("no user config directory: %v", err)
+	}
+	want := filepath.Join(persist.DefaultDeviceKeyDirName, persist.DefaultDeviceKeyFileName)
+	if !strings.HasSuffix(path, want) {
+		t.Errorf("DefaultDeviceKeyPath = %q, want suffix %q", path, want)
+	}
+}
+
 // TestCircleBindingStoreMaxDevices verifies max devices per circle enforcement.
 func TestCircleBindingStoreMaxDevices(t *testing.T) {
 	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
@@ -553,3 +607,250 @@ func (m *mockStorelog) ListByCircle(circleID identity.EntityID) ([]*storelog.Log
 func (m *mockStorelog) Count() int    { return 0 }
 func (m *mockStorelog) Verify() error { return nil }
 func (m *mockStorelog) Flush() error  { return nil }
+
+// recordStorelog returns fixed records for one circle.
+type recordStorelog struct {
+	mockStorelog
+	records []*storelog.LogRecord
+}
+
+func (m *recordStorelog) ListByCircle(circleID identity.EntityID) ([]*storelog.LogRecord, error) {
+	return m.records, nil
+}
+
+// newSigningKeyStore creates a device key store with a fresh keypair.
+func newSigningKeyStore(t *testing.T) *persist.DeviceKeyStore {
+	t.Helper()
+	store := persist.NewDeviceKeyStore(filepath.Join(t.TempDir(), "device.key"))
+	if _, _, err := store.EnsureKeypair(); err != nil {
+		t.Fatalf("EnsureKeypair failed: %v", err)
+	}
+	return store
+}
+
+// exportSignedBundle exports a two-record bundle signed by signer.
+func exportSignedBundle(t *testing.T, now time.Time, circleID string, signer *persist.DeviceKeyStore) string {
+	t.Helper()
+	log := &recordStorelog{records: []*storelog.LogRecord{
+		storelog.NewRecord("REALITY_ACK", now.Add(-time.Hour), identity.EntityID(circleID), "v1|reality_ack|abc"),
+		storelog.NewRecord("SHADOW_RECEIPT_ACK", now.Add(-2*time.Hour), identity.EntityID(circleID), "v1|receipt_ack|def"),
+	}}
+	result, err := replay.NewEngine(testClock(now), log).WithBundleSigner(signer).BuildBundle(circleID, 30)
+	if err != nil || !result.Success {
+		t.Fatalf("BuildBundle failed: %v %s", err, result.Error)
+	}
+	if result.Bundle.Signature == nil {
+		t.Fatal("expected exported bundle to be signed")
+	}
+	return result.BundleText
+}
+
+// TestReplayBundleSignatureVerification verifies imports require a valid
+// signature from a trusted device over the unaltered bundle content.
+func TestReplayBundleSignatureVerification(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	circleID := "test-circle"
+	deviceA := newSigningKeyStore(t)
+	deviceB := newSigningKeyStore(t)
+	keyA, _ := deviceA.GetPublicKey()
+
+	text := exportSignedBundle(t, now, circleID, deviceA)
+
+	// Round trip: the exporting device trusts its own key
+	importer := replay.NewEngine(testClock(now), &mockStorelog{}).WithBundleSigner(deviceA)
+	result, err := importer.ImportBundle(text, circleID)
+	if err != nil || !result.Success {
+		t.Fatalf("expected signed bundle to import: %v %s", err, result.Error)
+	}
+	bundle, _ := domainreplay.ParseReplayBundle(text)
+	verified, err := importer.Verify(bundle)
+	if err != nil || !verified.Verified || verified.Fingerprint != keyA.Fingerprint() {
+		t.Errorf("expected verified result for device A, got %+v, %v", verified, err)
+	}
+
+	// Another device trusts A only when told to
+	other := replay.NewEngine(testClock(now), &mockStorelog{}).WithBundleSigner(deviceB)
+	if _, err := other.Verify(bundle); err != domainreplay.ErrUntrustedBundleKey {
+		t.Errorf("expected untrusted key, got %v", err)
+	}
+	result, _ = other.ImportBundle(text, circleID)
+	if result.Success || !strings.Contains(result.Error, "untrusted key") {
+		t.Errorf("expected clear untrusted rejection, got %+v", result)
+	}
+	other.WithTrustedKeys(keyA)
+	if result, _ := other.ImportBundle(text, circleID); !result.Success {
+		t.Errorf("expected import with trusted key, got %s", result.Error)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(string) string
+		wantErr error
+	}{
+		{
+			name: "tampered payload",
+			mutate: func(s string) string {
+				return strings.Replace(s, "REALITY_ACK|", "SHADOW_RECEIPT_VOTE|", 1)
+			},
+			wantErr: domainreplay.ErrBundleHashMismatch,
+		},
+		{
+			name: "wrong-key signature",
+			mutate: func(s string) string {
+				keyB, _ := deviceB.GetPublicKey()
+				return strings.Replace(s, "public_key: "+string(keyA), "public_key: "+string(keyB), 1)
+			},
+			wantErr: domainreplay.ErrInvalidBundleSignature,
+		},
+		{
+			name: "unsigned",
+			mutate: func(s string) string {
+				return s[:strings.Index(s, domainreplay.SignatureSeparator)]
+			},
+			wantErr: domainreplay.ErrBundleUnsigned,
+		},
+	}
+	for _, tt := range tests {
+		mutated := tt.mutate(text)
+		if mutated == text {
+			t.Fatalf("%s: mutation did not change the bundle", tt.name)
+		}
+		bundle, err := domainreplay.ParseReplayBundle(mutated)
+		if err != nil {
+			t.Fatalf("%s: parse failed: %v", tt.name, err)
+		}
+		if _, err := importer.Verify(bundle); err != tt.wantErr {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+		result, _ := importer.ImportBundle(mutated, circleID)
+		if result.Success || !strings.Contains(result.Error, tt.wantErr.Error()) {
+			t.Errorf("%s: expected rejection naming %q, got %+v", tt.name, tt.wantErr, result)
+		}
+	}
+}
+
+// TestReplayBundleDiff verifies diffs are keyed by record hash and do not
+// depend on record order.
+func TestReplayBundleDiff(t *testing.T) {
+	line := func(recordType, hash, period string) domainreplay.CanonicalRecordLine {
+		return domainreplay.CanonicalRecordLine{RecordType: recordType, RecordHash: hash, PeriodBucket: period, PayloadHash: "p-" + hash}
+	}
+	kept := line("REALITY_ACK", "h1", "2025-01-14")
+	dropped := line("SHADOW_RECEIPT_ACK", "h2", "2025-01-13")
+	before := line("TRUST_ACTION_RECEIPT", "h3", "2025-01-14")
+	after := before
+	after.PayloadHash = "p-h3-updated"
+	added := line("JOURNEY_DISMISSAL", "h4", "2025-01-15")
+
+	from := &domainreplay.ReplayBundle{Records: []domainreplay.CanonicalRecordLine{kept, dropped, before}}
+	to := &domainreplay.ReplayBundle{Records: []domainreplay.CanonicalRecordLine{added, after, kept}}
+	reordered := &domainreplay.ReplayBundle{Records: []domainreplay.CanonicalRecordLine{kept, after, added}}
+
+	engine := replay.NewEngine(testClock(time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)), &mockStorelog{})
+	diff := engine.Diff(from, to)
+
+	if len(diff.Added) != 1 || diff.Added[0] != added {
+		t.Errorf("expected h4 added, got %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != dropped {
+		t.Errorf("expected h2 removed, got %+v", diff.Removed)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Before != before || diff.Changed[0].After != after {
+		t.Errorf("expected h3 changed, got %+v", diff.Changed)
+	}
+	if diff.Unchanged != 1 || diff.IsEmpty() {
+		t.Errorf("expected one unchanged record, got %d", diff.Unchanged)
+	}
+
+	if again := engine.Diff(from, reordered); again.Hash() != diff.Hash() {
+		t.Error("diff depends on record order")
+	}
+	if self := engine.Diff(from, from); !self.IsEmpty() || self.Unchanged != 3 {
+		t.Errorf("expected empty self-diff, got %+v", self)
+	}
+
+	// Only abstract fields reach the canonical diff
+	if found, pattern := domainreplay.ContainsForbiddenPattern(diff.CanonicalString()); found {
+		t.Errorf("diff contains forbidden pattern %q", pattern)
+	}
+}
+
+// TestReplayBundleCompression verifies compressed exports import, legacy
+// text still imports, and compression leaves the signed hash unchanged.
+func TestReplayBundleCompression(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	circleID := "test-circle"
+	device := newSigningKeyStore(t)
+
+	var records []*storelog.LogRecord
+	for i := 0; i < 50; i++ {
+		records = append(records, storelog.NewRecord("REALITY_ACK", now.Add(-time.Duration(i)*time.Hour), identity.EntityID(circleID), fmt.Sprintf("v1|reality_ack|%d", i)))
+	}
+	exporter := replay.NewEngine(testClock(now), &recordStorelog{records: records}).WithBundleSigner(device)
+
+	result, err := exporter.Export(circleID, 30)
+	if err != nil || !result.Success {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !domainreplay.IsCompressedBundle(result.Compressed) {
+		t.Fatal("expected compressed bundle to start with magic header")
+	}
+	if result.Compressed[len(domainreplay.CompressedMagic)] != domainreplay.CompressedVersion {
+		t.Error("expected version byte after magic header")
+	}
+	if result.Compression == domainreplay.CompressionNone {
+		t.Errorf("expected compression to save space, got %s", result.Compression)
+	}
+
+	// Same inputs produce the same compressed bytes
+	again, _ := exporter.Export(circleID, 30)
+	if !bytes.Equal(again.Compressed, result.Compressed) {
+		t.Error("compressed export is not deterministic")
+	}
+
+	// Decompressing restores the signed text, so the signed hash is unchanged
+	text, err := domainreplay.DecodeBundleBytes(result.Compressed)
+	if err != nil || text != result.BundleText {
+		t.Fatalf("decoded bundle differs from signed text: %v", err)
+	}
+
+	importer := replay.NewEngine(testClock(now), &mockStorelog{}).WithBundleSigner(device)
+	for name, data := range map[string][]byte{
+		"compressed": result.Compressed,
+		"legacy":     []byte(result.BundleText),
+	} {
+		imported, err := importer.Import(data, circleID)
+		if err != nil || !imported.Success || imported.RecordsAdded != 50 {
+			t.Errorf("%s import failed: %v %+v", name, err, imported)
+		}
+	}
+
+	badVersion := append([]byte(domainreplay.CompressedMagic), 9)
+	if _, err := domainreplay.DecodeBundleBytes(badVersion); !errors.Is(err, domainreplay.ErrUnsupportedCompressedVersion) {
+		t.Errorf("expected unsupported version, got %v", err)
+	}
+	truncated := result.Compressed[:len(result.Compressed)/2]
+	if imported, _ := importer.Import(truncated, circleID); imported.Success {
+		t.Error("expected truncated compressed bundle to be rejected")
+	}
+}
+
+// TestCompressionBucket verifies compression ratios map to abstract buckets.
+func TestCompressionBucket(t *testing.T) {
+	tests := []struct {
+		raw, compressed int
+		want            domainreplay.CompressionBucket
+	}{
+		{0, 0, domainreplay.CompressionNone},
+		{100, 120, domainreplay.CompressionNone},
+		{100, 95, domainreplay.CompressionNone},
+		{100, 70, domainreplay.CompressionSlight},
+		{100, 40, domainreplay.CompressionSubstantial},
+		{100, 10, domainreplay.CompressionHigh},
+	}
+	for _, tt := range tests {
+		if got := domainreplay.ComputeCompressionBucket(tt.raw, tt.compressed); got != tt.want {
+			t.Errorf("ComputeCompressionBucket(%d, %d) = %s, want %s", tt.raw, tt.compressed, got, tt.want)
+		}
+	}
+}
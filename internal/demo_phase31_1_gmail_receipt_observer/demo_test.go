@@ -458,3 +458,44 @@ func pow10(n int) int {
 	}
 	return result
 }
+
+// TestNonGmailSourceTagsObservations verifies a non-Gmail source feeds the
+// same pipeline and tags observations with that source.
+func TestNonGmailSourceTagsObservations(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return fixedTime }
+
+	engine := commerceingest.NewEngine(clock)
+
+	messageData := []commerceingest.MessageData{
+		{MessageID: "msg1", SenderDomain: "deliveroo.co.uk", Subject: "Your order is on the way", Snippet: "Your food will arrive soon"},
+		{MessageID: "msg2", SenderDomain: "uber.com", Subject: "Trip receipt", Snippet: "Thanks for riding with us"},
+	}
+
+	result := engine.BuildFromMessages(commerceobserver.SourceOutlookReceipt, "circle_test", "2025-W03", "sync_hash", messageData)
+	if len(result.Observations) == 0 {
+		t.Fatal("Expected observations from outlook source")
+	}
+	for i, obs := range result.Observations {
+		if obs.Source != commerceobserver.SourceOutlookReceipt {
+			t.Errorf("Observation %d: expected source %s, got %s", i, commerceobserver.SourceOutlookReceipt, obs.Source)
+		}
+		if err := obs.Validate(); err != nil {
+			t.Errorf("Observation %d invalid: %v", i, err)
+		}
+	}
+
+	// Gmail wrapper still tags gmail_receipt
+	gmail := engine.BuildFromGmailMessages("circle_test", "2025-W03", "sync_hash", messageData)
+	for i, obs := range gmail.Observations {
+		if obs.Source != commerceobserver.SourceGmailReceipt {
+			t.Errorf("Gmail observation %d: expected gmail_receipt, got %s", i, obs.Source)
+		}
+	}
+
+	// Unknown sources are rejected rather than mis-tagged
+	invalid := engine.BuildFromMessages(commerceobserver.SourceKind("fax"), "circle_test", "2025-W03", "sync_hash", messageData)
+	if len(invalid.Observations) != 0 || invalid.StatusHash != "invalid_input" {
+		t.Errorf("Expected invalid input for unknown source, got %+v", invalid)
+	}
+}
@@ -19,9 +19,11 @@ import (
 	"testing"
 	"time"
 
+	"quantumlife/internal/config"
 	"quantumlife/internal/connectors/finance/read/providers/truelayer"
 	"quantumlife/internal/financetxscan"
 	"quantumlife/internal/persist"
+	"quantumlife/pkg/domain/connection"
 	"quantumlife/pkg/domain/financemirror"
 )
 
@@ -528,6 +530,83 @@ func TestSync_NoToken(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Test: Configurable Lookback
+// =============================================================================
+
+func TestSync_WindowDaysFlowsIntoTransactionsCall(t *testing.T) {
+	fixedTime := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+
+	cfg, err := config.LoadFromString(`
+[circle:personal]
+name = Personal
+
+[sync]
+finance_lookback_days = 45
+`, fixedTime)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	windowDays := cfg.Sync.EffectiveLookbackDays(connection.KindFinance)
+	if windowDays == truelayer.SyncWindowDays {
+		t.Fatalf("expected a non-default finance lookback, got %d", windowDays)
+	}
+
+	var gotFrom string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/data/v1/accounts" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"results": []map[string]interface{}{{"account_id": "acc-1", "account_type": "TRANSACTION", "currency": "GBP"}},
+				"status":  "Succeeded",
+			})
+			return
+		}
+		gotFrom = r.URL.Query().Get("from")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"results": []interface{}{},
+			"status":  "Succeeded",
+		})
+	}))
+	defer server.Close()
+
+	client, _ := truelayer.NewClient(truelayer.ClientConfig{
+		Environment:  "sandbox",
+		ClientID:     "test-client-id",
+		ClientSecret: "test-secret",
+		HTTPClient:   server.Client(),
+	})
+	client.SetBaseURL(server.URL)
+
+	syncService := truelayer.NewSyncService(truelayer.SyncServiceConfig{
+		Client: client,
+		Clock:  func() time.Time { return fixedTime },
+	})
+
+	_, err = syncService.Sync(context.Background(), truelayer.SyncInput{
+		CircleID:    "circle-1",
+		AccessToken: "test-token",
+		WindowDays:  windowDays,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantFrom := fixedTime.AddDate(0, 0, -45).Format(time.RFC3339)
+	if gotFrom != wantFrom {
+		t.Errorf("expected from=%s, got %s", wantFrom, gotFrom)
+	}
+}
+
+func TestSync_LookbackClampedToCaps(t *testing.T) {
+	if days := (truelayer.SyncInput{}).EffectiveWindowDays(); days != truelayer.SyncWindowDays {
+		t.Errorf("expected default window %d, got %d", truelayer.SyncWindowDays, days)
+	}
+	if days := (truelayer.SyncInput{WindowDays: 365}).EffectiveWindowDays(); days != truelayer.MaxSyncWindowDays {
+		t.Errorf("expected window clamped to %d, got %d", truelayer.MaxSyncWindowDays, days)
+	}
+}
+
 // =============================================================================
 // Test: Constants Verification
 // =============================================================================
@@ -0,0 +1,94 @@
+package digest
+
+import (
+	"html"
+	"sort"
+	"strings"
+
+	"quantumlife/pkg/domain/identity"
+)
+
+// EmailPreview is how a weekly digest would appear as an email.
+//
+// CRITICAL: Preview only. Nothing is ever sent.
+// CRITICAL: Abstract only - magnitude words and circle names, never raw
+// counts, item summaries or percentages.
+type EmailPreview struct {
+	// Title is the email subject line. Named Title so templates never
+	// reference a Subject field (reserved for real email metadata).
+	Title string
+
+	// Text is the plain-text body.
+	Text string
+
+	// HTML is a simple HTML body with the same lines as Text.
+	HTML string
+
+	// DigestHash is the hash of the digest this preview was built from.
+	DigestHash string
+}
+
+// previewFooter states that the preview was never delivered.
+const previewFooter = "Preview only. Nothing was sent."
+
+// BuildEmailPreview renders a weekly digest as a plain-text and HTML email.
+// Deterministic: the same digest always yields the same preview.
+func BuildEmailPreview(d *WeeklyDigest) EmailPreview {
+	week := d.WeekStart.Format("Jan 2") + " to " + d.WeekEnd.Format("Jan 2, 2006")
+
+	lines := []string{
+		"Week of " + week,
+		"",
+		"Interruptions this week: " + magnitudeText(d.TotalInterruptions) + ".",
+	}
+
+	// Circles sorted by name for determinism
+	ids := make([]identity.EntityID, 0, len(d.CircleSummaries))
+	for id := range d.CircleSummaries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := d.CircleSummaries[ids[i]], d.CircleSummaries[ids[j]]
+		if a.CircleName != b.CircleName {
+			return a.CircleName < b.CircleName
+		}
+		return a.CircleID < b.CircleID
+	})
+	if len(ids) > 0 {
+		lines = append(lines, "")
+		for _, id := range ids {
+			summary := d.CircleSummaries[id]
+			lines = append(lines, summary.CircleName+": "+magnitudeText(summary.Total))
+		}
+	}
+	lines = append(lines, "", previewFooter)
+
+	var h strings.Builder
+	h.WriteString("<html><body>\n")
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		h.WriteString("<p>" + html.EscapeString(line) + "</p>\n")
+	}
+	h.WriteString("</body></html>\n")
+
+	return EmailPreview{
+		Title:      "Your week, held quietly: " + week,
+		Text:       strings.Join(lines, "\n") + "\n",
+		HTML:       h.String(),
+		DigestHash: d.Hash,
+	}
+}
+
+// magnitudeText converts a count to an abstract magnitude word.
+func magnitudeText(n int) string {
+	switch {
+	case n == 0:
+		return "nothing"
+	case n <= 3:
+		return "a few"
+	default:
+		return "several"
+	}
+}
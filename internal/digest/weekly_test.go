@@ -467,6 +467,53 @@ func TestDigestRollupTrendIndicators(t *testing.T) {
 	}
 }
 
+func TestEmailPreviewMatchesDigest(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+	weekStart := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	circleNames := map[identity.EntityID]string{
+		"circle-work":    "Work",
+		"circle-family":  "Family",
+		"circle-finance": "Finance",
+	}
+
+	gen := NewGenerator(clock.NewFixed(fixedTime))
+	digest := gen.Generate(weekStart, createTestBuckets(weekStart), circleNames)
+
+	preview1 := BuildEmailPreview(digest)
+	preview2 := BuildEmailPreview(gen.Generate(weekStart, createTestBuckets(weekStart), circleNames))
+	if preview1 != preview2 {
+		t.Error("Expected identical previews for identical digests")
+	}
+	if preview1.DigestHash != digest.Hash {
+		t.Errorf("Expected preview digest hash %s, got %s", digest.Hash, preview1.DigestHash)
+	}
+
+	wantText := "Week of Jan 13 to Jan 19, 2025\n" +
+		"\n" +
+		"Interruptions this week: several.\n" +
+		"\n" +
+		"Family: several\n" +
+		"Finance: several\n" +
+		"Work: several\n" +
+		"\n" +
+		"Preview only. Nothing was sent.\n"
+	if preview1.Text != wantText {
+		t.Errorf("Unexpected preview text:\n%s", preview1.Text)
+	}
+	for _, name := range []string{"Family", "Finance", "Work"} {
+		if !strings.Contains(preview1.HTML, "<p>"+name+": several</p>") {
+			t.Errorf("Expected HTML to contain circle line for %s", name)
+		}
+	}
+
+	// No raw counts or item summaries leak into the email
+	for _, body := range []string{preview1.Title, preview1.Text, preview1.HTML} {
+		if strings.Contains(body, "21") || strings.Contains(body, "Work item") {
+			t.Errorf("Preview must stay abstract, got: %s", body)
+		}
+	}
+}
+
 // Helper functions
 
 func createTestBuckets(weekStart time.Time) []DailyBucket {
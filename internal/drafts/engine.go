@@ -5,9 +5,11 @@
 package drafts
 
 import (
+	"sync"
 	"time"
 
 	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/obligation"
 )
@@ -18,16 +20,42 @@ type Engine struct {
 	store        draft.Store
 	policy       draft.DraftPolicy
 	quotaTracker *draft.DraftQuotaTracker
+
+	mu         sync.RWMutex
+	thresholds execintent.ApprovalThresholds
 }
 
 // NewEngine creates a new draft orchestration engine.
+// Approval thresholds start at execintent.DefaultApprovalThresholds.
 func NewEngine(store draft.Store, policy draft.DraftPolicy, generators ...draft.DraftGenerator) *Engine {
 	return &Engine{
 		generators:   generators,
 		store:        store,
 		policy:       policy,
 		quotaTracker: draft.NewDraftQuotaTracker(policy),
+		thresholds:   execintent.DefaultApprovalThresholds(),
+	}
+}
+
+// SetApprovalThresholds replaces the per-action-class approval thresholds.
+func (e *Engine) SetApprovalThresholds(thresholds execintent.ApprovalThresholds) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.thresholds = thresholds
+}
+
+// RequiredApprovals returns how many approvals the draft needs through the
+// approval-token flow before it executes, keyed by the action class it
+// executes as. 0 means the user's confirm is enough. Drafts that map to
+// no action class get execintent.DefaultRequiredApprovals.
+func (e *Engine) RequiredApprovals(d draft.Draft) int {
+	action, ok := execintent.ActionClassForDraftType(d.DraftType)
+	if !ok {
+		return execintent.DefaultRequiredApprovals
 	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.thresholds.Required(action)
 }
 
 // ProcessResult contains the result of processing an obligation.
@@ -0,0 +1,31 @@
+package drafts
+
+import (
+	"testing"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
+)
+
+func TestRequiredApprovals(t *testing.T) {
+	engine := NewEngine(draft.NewInMemoryStore(), draft.DefaultDraftPolicy())
+
+	for draftType, want := range map[draft.DraftType]int{
+		draft.DraftTypeCalendarResponse: 0,
+		draft.DraftTypeEmailReply:       1,
+		draft.DraftTypeRefundFollowUp:   1,
+		draft.DraftTypePayment:          2,
+		draft.DraftType("unknown"):      execintent.DefaultRequiredApprovals,
+	} {
+		if got := engine.RequiredApprovals(draft.Draft{DraftType: draftType}); got != want {
+			t.Errorf("%s: expected %d approvals by default, got %d", draftType, want, got)
+		}
+	}
+
+	engine.SetApprovalThresholds(execintent.DefaultApprovalThresholds().WithOverrides(map[execintent.ActionClass]int{
+		execintent.ActionEmailSend: 0,
+	}))
+	if got := engine.RequiredApprovals(draft.Draft{DraftType: draft.DraftTypeEmailReply}); got != 0 {
+		t.Errorf("expected configured email threshold 0, got %d", got)
+	}
+}
@@ -0,0 +1,201 @@
+package drafts
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"quantumlife/pkg/domain/draft"
+)
+
+// Regenerate produces a new version of a proposed draft by rewriting the
+// selected version's body with the given hints. Hints apply in a fixed
+// order (shorter, warmer, formal), so the same hints always give the same
+// version. The new version is selected.
+//
+// Rule-based, like the generators. LLM rewriting would be a hook here.
+func (e *Engine) Regenerate(id draft.DraftID, hints []draft.RegenerateHint, now time.Time) (draft.DraftVersion, error) {
+	vs, ok := e.store.(draft.VersionStore)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrVersionsUnsupported
+	}
+	if len(hints) == 0 {
+		return draft.DraftVersion{}, fmt.Errorf("no regenerate hints")
+	}
+	requested := make(map[draft.RegenerateHint]bool, len(hints))
+	for _, h := range hints {
+		if !h.Valid() {
+			return draft.DraftVersion{}, fmt.Errorf("unknown regenerate hint: %s", h)
+		}
+		requested[h] = true
+	}
+
+	d, ok := e.store.Get(id)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrDraftNotFound
+	}
+	body, ok := draft.ContentBody(d.Content)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrBodyNotEditable
+	}
+
+	var applied []draft.RegenerateHint
+	for _, h := range draft.AllRegenerateHints() {
+		if requested[h] {
+			body = rewriteBody(body, h)
+			applied = append(applied, h)
+		}
+	}
+
+	content, _ := draft.WithBody(d.Content, body)
+	return vs.PutVersion(id, content, draft.VersionRegenerated, applied, now)
+}
+
+// EditBody records a user-edited body as a new, selected version.
+func (e *Engine) EditBody(id draft.DraftID, body string, now time.Time) (draft.DraftVersion, error) {
+	vs, ok := e.store.(draft.VersionStore)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrVersionsUnsupported
+	}
+	d, ok := e.store.Get(id)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrDraftNotFound
+	}
+	content, ok := draft.WithBody(d.Content, normalizeBody(body))
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrBodyNotEditable
+	}
+	return vs.PutVersion(id, content, draft.VersionEdited, nil, now)
+}
+
+// SelectVersion reverts a proposed draft to an earlier (or later) version.
+// Execution always uses the selected version.
+func (e *Engine) SelectVersion(id draft.DraftID, number int) (draft.DraftVersion, error) {
+	vs, ok := e.store.(draft.VersionStore)
+	if !ok {
+		return draft.DraftVersion{}, draft.ErrVersionsUnsupported
+	}
+	return vs.SelectVersion(id, number)
+}
+
+// History returns a draft's versions. Stores without versions report the
+// draft's content as its only version.
+func (e *Engine) History(id draft.DraftID) (draft.VersionHistory, bool) {
+	if vs, ok := e.store.(draft.VersionStore); ok {
+		return vs.History(id)
+	}
+	d, ok := e.store.Get(id)
+	if !ok {
+		return draft.VersionHistory{}, false
+	}
+	return draft.VersionHistory{
+		DraftID: id,
+		Versions: []draft.DraftVersion{{
+			Number:      1,
+			Content:     d.Content,
+			ContentHash: draft.ContentHash(d.Content),
+			Source:      draft.VersionGenerated,
+			CreatedAt:   d.CreatedAt,
+		}},
+		Selected: 1,
+	}, true
+}
+
+// shorterPhrases trims wordy phrases, in order.
+var shorterPhrases = []struct{ from, to string }{
+	{"Thank you for your email regarding", "Thanks for your email about"},
+	{"Thank you for your email", "Thanks for your email"},
+	{"at your earliest convenience", "soon"},
+	{"Please let me know", "Let me know"},
+	{"I would like to", "I'd like to"},
+	{"in order to", "to"},
+	{"I am unable to", "I can't"},
+	{"I will", "I'll"},
+}
+
+// formalPhrases undo contractions and casual phrasing, in order.
+var formalPhrases = []struct{ from, to string }{
+	{"Thanks so much!", "Thank you."},
+	{"Thanks for", "Thank you for"},
+	{"Let me know", "Please let me know"},
+	{"I'd", "I would"},
+	{"I'll", "I will"},
+	{"I'm", "I am"},
+	{"can't", "cannot"},
+	{"won't", "will not"},
+	{"don't", "do not"},
+	{"Hi,", "Hello,"},
+}
+
+// rewriteBody applies one hint to a body.
+func rewriteBody(body string, hint draft.RegenerateHint) string {
+	switch hint {
+	case draft.HintShorter:
+		for _, p := range shorterPhrases {
+			body = strings.ReplaceAll(body, p.from, p.to)
+		}
+		// Keep the first sentence of each paragraph.
+		paragraphs := splitParagraphs(body)
+		for i, p := range paragraphs {
+			paragraphs[i] = firstSentence(p)
+		}
+		return strings.Join(paragraphs, "\n\n")
+
+	case draft.HintWarmer:
+		paragraphs := splitParagraphs(body)
+		if len(paragraphs) == 0 || !isGreeting(paragraphs[0]) {
+			paragraphs = append([]string{"Hi,"}, paragraphs...)
+		}
+		if !strings.Contains(body, "Thanks so much") {
+			paragraphs = append(paragraphs, "Thanks so much!")
+		}
+		return strings.Join(paragraphs, "\n\n")
+
+	case draft.HintFormal:
+		for _, p := range formalPhrases {
+			body = strings.ReplaceAll(body, p.from, p.to)
+		}
+		return strings.ReplaceAll(body, "!", ".")
+	}
+	return body
+}
+
+// splitParagraphs splits on blank lines, dropping empty paragraphs.
+func splitParagraphs(body string) []string {
+	var out []string
+	for _, p := range strings.Split(normalizeBody(body), "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// firstSentence returns the text up to and including the first sentence end.
+func firstSentence(p string) string {
+	for i := 0; i < len(p)-1; i++ {
+		switch p[i] {
+		case '.', '!', '?':
+			if p[i+1] == ' ' || p[i+1] == '\n' {
+				return p[:i+1]
+			}
+		}
+	}
+	return p
+}
+
+// isGreeting reports whether a paragraph opens with a salutation.
+func isGreeting(p string) bool {
+	for _, g := range []string{"Hi", "Hello", "Dear", "Hey"} {
+		if strings.HasPrefix(p, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeBody converts CRLF (as posted by browsers) to LF and trims
+// trailing whitespace.
+func normalizeBody(body string) string {
+	return strings.TrimRight(strings.ReplaceAll(body, "\r\n", "\n"), " \n\t")
+}
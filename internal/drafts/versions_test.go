@@ -0,0 +1,83 @@
+package drafts
+
+import (
+	"testing"
+	"time"
+
+	"quantumlife/pkg/domain/draft"
+)
+
+func TestRegenerate(t *testing.T) {
+	store := draft.NewInMemoryStore()
+	engine := NewEngine(store, draft.DefaultDraftPolicy())
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	_ = store.Put(draft.Draft{
+		DraftID:   "d1",
+		DraftType: draft.DraftTypeEmailReply,
+		Status:    draft.StatusProposed,
+		CreatedAt: now,
+		ExpiresAt: now.Add(24 * time.Hour),
+		Content: draft.EmailDraftContent{
+			To:   "alice@example.com",
+			Body: "Thank you for your email regarding \"Plans\". I will reply in full tomorrow.\n\nPlease let me know if that works.\n\n",
+		},
+	})
+
+	v, err := engine.Regenerate("d1", []draft.RegenerateHint{draft.HintWarmer, draft.HintShorter}, now)
+	if err != nil {
+		t.Fatalf("Regenerate failed: %v", err)
+	}
+	want := "Hi,\n\nThanks for your email about \"Plans\".\n\nLet me know if that works.\n\nThanks so much!"
+	if body, _ := draft.ContentBody(v.Content); body != want {
+		t.Errorf("Unexpected body:\n%q\nwant:\n%q", body, want)
+	}
+	if len(v.Hints) != 2 || v.Hints[0] != draft.HintShorter {
+		t.Errorf("Hints should be recorded in canonical order, got %v", v.Hints)
+	}
+
+	// Same hints on the same version are deterministic.
+	store2 := draft.NewInMemoryStore()
+	d, _ := store.Get("d1")
+	hist, _ := store.History("d1")
+	d.Content = hist.Versions[0].Content
+	_ = store2.Put(d)
+	v2, _ := NewEngine(store2, draft.DefaultDraftPolicy()).Regenerate("d1", []draft.RegenerateHint{draft.HintShorter, draft.HintWarmer}, now)
+	if v2.ContentHash != v.ContentHash {
+		t.Errorf("Regenerate is not deterministic")
+	}
+
+	if _, err := engine.Regenerate("d1", []draft.RegenerateHint{"louder"}, now); err == nil {
+		t.Errorf("Expected error for unknown hint")
+	}
+}
+
+func TestEditBodyAndRevert(t *testing.T) {
+	store := draft.NewInMemoryStore()
+	engine := NewEngine(store, draft.DefaultDraftPolicy())
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	_ = store.Put(draft.Draft{
+		DraftID:   "d1",
+		Status:    draft.StatusProposed,
+		CreatedAt: now,
+		ExpiresAt: now.Add(24 * time.Hour),
+		Content:   draft.CalendarDraftContent{EventID: "ev-1", Message: "I will attend."},
+	})
+
+	if _, err := engine.EditBody("d1", "I will attend, a few minutes late.\r\n", now); err != nil {
+		t.Fatalf("EditBody failed: %v", err)
+	}
+	d, _ := engine.GetDraft("d1")
+	if body, _ := draft.ContentBody(d.Content); body != "I will attend, a few minutes late." {
+		t.Errorf("Unexpected edited message %q", body)
+	}
+
+	if _, err := engine.SelectVersion("d1", 1); err != nil {
+		t.Fatalf("SelectVersion failed: %v", err)
+	}
+	d, _ = engine.GetDraft("d1")
+	if body, _ := draft.ContentBody(d.Content); body != "I will attend." {
+		t.Errorf("Expected reverted message, got %q", body)
+	}
+}
@@ -26,6 +26,12 @@ const (
 	EnvelopeStatusExecuted EnvelopeStatus = "executed"
 	EnvelopeStatusFailed   EnvelopeStatus = "failed"
 	EnvelopeStatusBlocked  EnvelopeStatus = "blocked"
+
+	// EnvelopeStatusScheduled is a reply held by the provider until SendAt.
+	EnvelopeStatusScheduled EnvelopeStatus = "scheduled"
+
+	// EnvelopeStatusCancelled is a held reply cancelled before dispatch.
+	EnvelopeStatusCancelled EnvelopeStatus = "cancelled"
 )
 
 // Envelope wraps an approved email draft for execution.
@@ -74,6 +80,9 @@ type Envelope struct {
 	// Execution result (populated after execution)
 	ExecutedAt      *time.Time
 	ExecutionResult *ExecutionResult
+
+	// SendAt is when a scheduled reply is dispatched (scheduled sends only).
+	SendAt *time.Time
 }
 
 // ExecutionResult contains the result of email execution.
@@ -228,5 +237,6 @@ func NewEnvelopeFromDraft(
 func (e *Envelope) IsTerminal() bool {
 	return e.Status == EnvelopeStatusExecuted ||
 		e.Status == EnvelopeStatusFailed ||
-		e.Status == EnvelopeStatusBlocked
+		e.Status == EnvelopeStatusBlocked ||
+		e.Status == EnvelopeStatusCancelled
 }
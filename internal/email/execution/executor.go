@@ -105,6 +105,27 @@ func NewExecutor(opts ...ExecutorOption) *Executor {
 // CRITICAL: No auto-retries on failure.
 // CRITICAL: Idempotent - same IdempotencyKey returns same result.
 func (e *Executor) Execute(ctx context.Context, envelope Envelope) (*Envelope, error) {
+	return e.execute(ctx, envelope, nil)
+}
+
+// ExecuteScheduled executes an email send that the provider holds until
+// sendAt, with the same boundary enforcement as Execute. Until then the
+// send can be cancelled with CancelScheduled.
+//
+// CRITICAL: Blocked when the provider cannot delay a send.
+func (e *Executor) ExecuteScheduled(ctx context.Context, envelope Envelope, sendAt time.Time) (*Envelope, error) {
+	return e.execute(ctx, envelope, &sendAt)
+}
+
+// CanSchedule reports whether the provider's writer can hold a send.
+func (e *Executor) CanSchedule(provider string) bool {
+	_, ok := e.writerRegistry[provider].(write.ScheduledWriter)
+	return ok
+}
+
+// execute runs the boundary checks and the write. A nil sendAt sends
+// immediately; otherwise the provider holds the reply until sendAt.
+func (e *Executor) execute(ctx context.Context, envelope Envelope, sendAt *time.Time) (*Envelope, error) {
 	now := e.clock()
 
 	// Emit execution attempt event
@@ -186,7 +207,17 @@ func (e *Executor) Execute(ctx context.Context, envelope Envelope) (*Envelope, e
 
 	// CRITICAL: Execute the external write
 	// NO AUTO-RETRIES. Single attempt only.
-	receipt, err := writer.SendReply(ctx, sendReq)
+	var receipt write.SendReplyReceipt
+	var err error
+	if sendAt == nil {
+		receipt, err = writer.SendReply(ctx, sendReq)
+	} else {
+		scheduler, ok := writer.(write.ScheduledWriter)
+		if !ok {
+			return e.block(envelope, fmt.Sprintf("provider cannot schedule sends: %s", envelope.Provider), now)
+		}
+		receipt, err = scheduler.ScheduleReply(ctx, sendReq, *sendAt)
+	}
 	if err != nil {
 		return e.fail(envelope, fmt.Sprintf("send failed: %v", err), now)
 	}
@@ -197,6 +228,12 @@ func (e *Executor) Execute(ctx context.Context, envelope Envelope) (*Envelope, e
 
 	// Success - update envelope
 	envelope.Status = EnvelopeStatusExecuted
+	successType := events.EmailExecutionSucceeded
+	if sendAt != nil {
+		envelope.Status = EnvelopeStatusScheduled
+		envelope.SendAt = sendAt
+		successType = events.EmailExecutionScheduled
+	}
 	executedAt := now
 	envelope.ExecutedAt = &executedAt
 	envelope.ExecutionResult = &ExecutionResult{
@@ -220,7 +257,7 @@ func (e *Executor) Execute(ctx context.Context, envelope Envelope) (*Envelope, e
 
 	// Emit success event
 	e.emit(events.Event{
-		Type:      events.EmailExecutionSucceeded,
+		Type:      successType,
 		Timestamp: now,
 		Metadata: map[string]string{
 			"envelope_id":          envelope.EnvelopeID,
@@ -236,6 +273,58 @@ func (e *Executor) Execute(ctx context.Context, envelope Envelope) (*Envelope, e
 	return &envelope, nil
 }
 
+// CancelScheduled cancels a scheduled send before the provider
+// dispatches it. Cancelling an already cancelled envelope is a no-op.
+//
+// CRITICAL: Single attempt only. Fails once the send has gone out.
+func (e *Executor) CancelScheduled(ctx context.Context, envelopeID string) (*Envelope, error) {
+	now := e.clock()
+
+	envelope, found := e.store.Get(envelopeID)
+	if !found {
+		return nil, fmt.Errorf("envelope not found: %s", envelopeID)
+	}
+	if envelope.Status == EnvelopeStatusCancelled {
+		return &envelope, nil
+	}
+	if envelope.Status != EnvelopeStatusScheduled || envelope.ExecutionResult == nil {
+		return nil, fmt.Errorf("envelope is not scheduled: %s", envelope.Status)
+	}
+
+	scheduler, ok := e.writerRegistry[envelope.Provider].(write.ScheduledWriter)
+	if !ok {
+		return nil, fmt.Errorf("provider cannot cancel scheduled sends: %s", envelope.Provider)
+	}
+	if err := scheduler.CancelScheduledReply(ctx, envelope.ExecutionResult.MessageID); err != nil {
+		return nil, fmt.Errorf("cancel failed: %w", err)
+	}
+
+	envelope.Status = EnvelopeStatusCancelled
+	if err := e.store.Put(envelope); err != nil {
+		// Log but don't fail - the cancel succeeded
+		e.emit(events.Event{
+			Type:      events.EmailExecutionStoreError,
+			Timestamp: now,
+			Metadata: map[string]string{
+				"envelope_id": envelope.EnvelopeID,
+				"error":       err.Error(),
+			},
+		})
+	}
+
+	e.emit(events.Event{
+		Type:      events.EmailExecutionCancelled,
+		Timestamp: now,
+		Metadata: map[string]string{
+			"envelope_id": envelope.EnvelopeID,
+			"draft_id":    string(envelope.DraftID),
+			"circle_id":   string(envelope.CircleID),
+		},
+	})
+
+	return &envelope, nil
+}
+
 // block marks an envelope as blocked and returns it.
 func (e *Executor) block(envelope Envelope, reason string, now time.Time) (*Envelope, error) {
 	envelope.Status = EnvelopeStatusBlocked
@@ -57,6 +57,15 @@ func NewMemoryStore() *MemoryStore {
 	}
 }
 
+// Clear removes all envelopes.
+func (s *MemoryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.envelopes = make(map[string]Envelope)
+	s.byDraftID = make(map[draft.DraftID]string)
+	s.byIdempotencyKey = make(map[string]string)
+}
+
 // Put stores an envelope.
 func (s *MemoryStore) Put(envelope Envelope) error {
 	s.mu.Lock()
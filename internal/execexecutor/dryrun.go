@@ -0,0 +1,69 @@
+package execexecutor
+
+import (
+	"time"
+
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/events"
+)
+
+// SkipRecorder is told about each write a dry run skipped.
+// Implemented by the mock writers, so tests and demos can see what would
+// have been written.
+type SkipRecorder interface {
+	// RecordSkipped records a skipped write by its idempotency key
+	// (the envelope ID for payments).
+	RecordSkipped(key string)
+}
+
+// DryRunBlockedReason is the error the trust-action and undoable engines
+// report for a write they refused because dry-run mode is on.
+const DryRunBlockedReason = "dry run: no external write"
+
+// SetDryRun turns dry-run mode on or off for every action class.
+//
+// In dry-run mode ExecuteIntent still validates the intent, checks
+// approvals, routes it and builds the envelope, then returns a simulated
+// outcome (DryRun=true) without calling any boundary executor or writer.
+// The trust-action and undoable engines write outside ExecuteIntent; they
+// consult the same switch through EngineConfig.DryRun (pass DryRun) and
+// refuse every write, undos included, with DryRunBlockedReason.
+// CRITICAL: Nothing is sent, no calendar is changed and no money moves.
+func (e *Executor) SetDryRun(dryRun bool) {
+	e.dryRun.Store(dryRun)
+}
+
+// DryRun reports whether dry-run mode is on.
+func (e *Executor) DryRun() bool {
+	return e.dryRun.Load()
+}
+
+// WithSkipRecorder registers a recorder for writes an action class skips
+// in dry-run mode.
+func (e *Executor) WithSkipRecorder(action execintent.ActionClass, recorder SkipRecorder) *Executor {
+	if e.skipRecorders == nil {
+		e.skipRecorders = make(map[execintent.ActionClass][]SkipRecorder)
+	}
+	e.skipRecorders[action] = append(e.skipRecorders[action], recorder)
+	return e
+}
+
+// skipWrite records a write skipped by a dry run and returns its
+// simulated outcome. route names the boundary ("email", "calendar",
+// "finance").
+func (e *Executor) skipWrite(intent *execintent.ExecutionIntent, route, envelopeID, key string, now time.Time) ExecutionOutcome {
+	for _, recorder := range e.skipRecorders[intent.Action] {
+		recorder.RecordSkipped(key)
+	}
+	e.emitEvent(events.Phase10ExecutionDryRun, intent, route)
+	return ExecutionOutcome{
+		IntentID:     intent.IntentID,
+		Success:      true,
+		ExecutedAt:   now,
+		EnvelopeID:   envelopeID,
+		Simulated:    true,
+		MoneyMoved:   false,
+		ProviderUsed: "dry-run",
+		DryRun:       true,
+	}
+}
@@ -0,0 +1,164 @@
+package execexecutor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockcal "quantumlife/internal/connectors/calendar/write/providers/mock"
+	mockemail "quantumlife/internal/connectors/email/write/providers/mock"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/events"
+)
+
+func assertDryRunOutcome(t *testing.T, outcome ExecutionOutcome) {
+	t.Helper()
+	if !outcome.DryRun || !outcome.Simulated || !outcome.Success {
+		t.Errorf("expected a successful simulated dry run, got dryRun=%t simulated=%t success=%t (blocked=%q error=%q)",
+			outcome.DryRun, outcome.Simulated, outcome.Success, outcome.BlockedReason, outcome.Error)
+	}
+	if outcome.MoneyMoved {
+		t.Error("a dry run must never move money")
+	}
+	if outcome.EnvelopeID == "" {
+		t.Error("expected the dry run to build an envelope")
+	}
+}
+
+func dryRunEvents(emitter *mockEmitter) []events.Event {
+	var out []events.Event
+	for _, e := range emitter.events {
+		if e.Type == events.Phase10ExecutionDryRun {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestExecutor_DryRun_EmailAndCalendar(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	emitter := &mockEmitter{}
+	emailMock := successfulEmailMock()
+	calendarMock := &mockCalendarExecutor{}
+	emailWriter := mockemail.NewWriter(mockemail.WithClock(func() time.Time { return now }))
+	calendarWriter := mockcal.NewWriter(mockcal.WithClock(func() time.Time { return now }))
+
+	executor := NewExecutor(clock.NewFixed(now), emitter).
+		WithEmailExecutor(emailMock).
+		WithCalendarExecutor(calendarMock).
+		WithSkipRecorder(execintent.ActionEmailSend, emailWriter).
+		WithSkipRecorder(execintent.ActionCalendarRespond, calendarWriter)
+	executor.SetDryRun(true)
+	if !executor.DryRun() {
+		t.Fatal("expected dry-run mode to be on")
+	}
+
+	assertDryRunOutcome(t, executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-1"))
+	assertDryRunOutcome(t, executor.ExecuteIntent(context.Background(), &execintent.ExecutionIntent{
+		IntentID:           "intent-cal-001",
+		DraftID:            "draft-cal-001",
+		CircleID:           "circle-001",
+		Action:             execintent.ActionCalendarRespond,
+		CalendarEventID:    "event-001",
+		CalendarResponse:   "accept",
+		PolicySnapshotHash: "policy-hash-123",
+		ViewSnapshotHash:   "view-hash-456",
+		CreatedAt:          now,
+	}, "trace-2"))
+
+	if emailMock.called || calendarMock.called {
+		t.Error("no boundary executor may be called in dry-run mode")
+	}
+	if emailWriter.GetSkippedCount() != 1 || emailWriter.GetSentCount() != 0 {
+		t.Errorf("email writer: skipped=%d sent=%d, want 1 and 0", emailWriter.GetSkippedCount(), emailWriter.GetSentCount())
+	}
+	if calendarWriter.GetSkippedCount() != 1 || calendarWriter.GetCallCount("event-001") != 0 {
+		t.Errorf("calendar writer: skipped=%d calls=%d, want 1 and 0", calendarWriter.GetSkippedCount(), calendarWriter.GetCallCount("event-001"))
+	}
+
+	dryRuns := dryRunEvents(emitter)
+	if len(dryRuns) != 2 {
+		t.Fatalf("expected 2 dry-run events, got %d", len(dryRuns))
+	}
+	if dryRuns[0].Metadata["detail"] != "email" || dryRuns[1].Metadata["detail"] != "calendar" {
+		t.Errorf("unexpected dry-run routes: %s, %s", dryRuns[0].Metadata["detail"], dryRuns[1].Metadata["detail"])
+	}
+}
+
+func TestExecutor_DryRun_Finance(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(now)
+	financeAdapter := NewFinanceExecutorAdapter(clk, nil, func() string { return "test-id-dry" }, DefaultFinanceExecutorAdapterConfig())
+	executor := NewExecutor(clk, &mockEmitter{}).
+		WithFinanceExecutor(financeAdapter).
+		WithSkipRecorder(execintent.ActionFinancePayment, financeAdapter)
+	executor.SetDryRun(true)
+
+	intent := &execintent.ExecutionIntent{
+		DraftID:            "draft-payment-dry",
+		CircleID:           "circle-satish",
+		Action:             execintent.ActionFinancePayment,
+		FinancePayeeID:     "sandbox-utility",
+		FinanceAmountCents: 50,
+		FinanceCurrency:    "GBP",
+		FinanceDescription: "Dry run test",
+		PolicySnapshotHash: financeAdapter.GetExpectedPolicyHash(),
+		ViewSnapshotHash:   financeAdapter.GetExpectedViewHash(),
+		CreatedAt:          now,
+	}
+	intent.Finalize()
+
+	outcome := executor.ExecuteIntent(context.Background(), intent, "trace-dry")
+	assertDryRunOutcome(t, outcome)
+	if outcome.ProviderUsed != "dry-run" {
+		t.Errorf("ProviderUsed = %q, want dry-run", outcome.ProviderUsed)
+	}
+	if financeAdapter.GetSkippedCount() != 1 {
+		t.Errorf("finance connector skipped %d payments, want 1", financeAdapter.GetSkippedCount())
+	}
+}
+
+func TestExecutor_DryRun_StillValidates(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	emailMock := successfulEmailMock()
+	emailWriter := mockemail.NewWriter(mockemail.WithClock(func() time.Time { return now }))
+	policy := &mockApprovalPolicy{
+		draft:    draft.Draft{DraftID: "draft-001", DraftType: draft.DraftTypeEmailReply},
+		required: 1,
+	}
+	records := &mockApprovalRecords{}
+	executor := NewExecutor(clock.NewFixed(now), &mockEmitter{}).
+		WithEmailExecutor(emailMock).
+		WithApprovalGate(policy, records).
+		WithSkipRecorder(execintent.ActionEmailSend, emailWriter)
+	executor.SetDryRun(true)
+
+	invalid := idempotencyEmailIntent("draft-001", now)
+	invalid.PolicySnapshotHash = ""
+	if outcome := executor.ExecuteIntent(context.Background(), invalid, "trace-1"); !outcome.Blocked || outcome.DryRun {
+		t.Error("expected an invalid intent to be blocked in dry-run mode")
+	}
+	if outcome := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-2"); !outcome.Blocked || outcome.RequiredApprovals != 1 {
+		t.Error("expected the approval gate to apply in dry-run mode")
+	}
+	if emailWriter.GetSkippedCount() != 0 {
+		t.Error("blocked intents are not routed, so no write is skipped")
+	}
+}
+
+func TestExecutor_DryRun_NotRemembered(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	emailMock := successfulEmailMock()
+	executor := NewExecutor(clock.NewFixed(now), &mockEmitter{}).WithEmailExecutor(emailMock)
+
+	executor.SetDryRun(true)
+	executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-1")
+	executor.SetDryRun(false)
+	outcome := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-2")
+
+	if emailMock.calls != 1 || outcome.DryRun || outcome.Replayed {
+		t.Errorf("expected a real execution after the dry run, calls=%d dryRun=%t replayed=%t", emailMock.calls, outcome.DryRun, outcome.Replayed)
+	}
+}
@@ -7,6 +7,11 @@
 // CRITICAL: All writes flow through the boundary executors.
 // CRITICAL: No auto-retries. No background execution.
 // CRITICAL: Must be idempotent via boundary executor idempotency.
+// CRITICAL: Repeat requests for a draft within the idempotency window
+// return the prior outcome without reaching a boundary executor.
+// CRITICAL: In dry-run mode no boundary executor is called.
+// CRITICAL: Failed envelopes are retried only on request, a bounded number
+// of times.
 //
 // Reference: Phase 10 - Approved Draft → Execution Routing
 package execexecutor
@@ -16,11 +21,13 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	calexec "quantumlife/internal/calendar/execution"
 	emailexec "quantumlife/internal/email/execution"
 	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/approvalflow"
 	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/domain/identity"
@@ -63,6 +70,29 @@ type ExecutionOutcome struct {
 
 	// ProviderUsed is the provider identifier used for execution.
 	ProviderUsed string
+
+	// RequiredApprovals is the number of approvals the draft's action class
+	// needs (set when the approval gate is configured).
+	RequiredApprovals int
+
+	// RecordedApprovals is the number of fresh approvals recorded for the
+	// draft through the approval-token flow.
+	RecordedApprovals int
+
+	// Replayed indicates the outcome is a prior execution's, returned to a
+	// repeat request within the idempotency window. Nothing was executed.
+	Replayed bool
+
+	// DryRun indicates the executor is in dry-run mode: the intent was
+	// validated and routed but no writer was called (Simulated=true).
+	DryRun bool
+
+	// RetryOf is the failed envelope this execution retried, if any.
+	RetryOf string
+
+	// RetryAttempt numbers the retry among all retries that trace back to
+	// the same first failed envelope, starting at 1.
+	RetryAttempt int
 }
 
 // EmailExecutor is the interface for email boundary execution.
@@ -159,6 +189,21 @@ type FinanceExecutor interface {
 	ExecuteFromIntent(ctx context.Context, req FinanceExecuteRequest) FinanceExecuteResult
 }
 
+// ApprovalPolicy says how many approvals a draft needs before it executes.
+// Implemented by drafts.Engine.
+type ApprovalPolicy interface {
+	GetDraft(id draft.DraftID) (draft.Draft, bool)
+	RequiredApprovals(d draft.Draft) int
+}
+
+// ApprovalRecords looks up approvals recorded through the approval-token
+// flow. States are bound to the exact action they approve, the intent's
+// content hash, so editing a draft needs fresh approvals.
+// Implemented by persist.ApprovalLedger.
+type ApprovalRecords interface {
+	GetApprovalStateForAction(targetType approvalflow.TargetType, targetID, actionHash string) *approvalflow.ApprovalState
+}
+
 // Executor adapts ExecutionIntents to boundary executors.
 //
 // CRITICAL: Routes intents to the correct boundary executor.
@@ -168,6 +213,12 @@ type Executor struct {
 	emailExecutor    EmailExecutor
 	calendarExecutor CalendarExecutor
 	financeExecutor  FinanceExecutor
+	approvalPolicy   ApprovalPolicy
+	approvalRecords  ApprovalRecords
+	idempotency      *idempotencyCache
+	retries          *retryTracker
+	dryRun           atomic.Bool
+	skipRecorders    map[execintent.ActionClass][]SkipRecorder
 	clock            clock.Clock
 	emitter          events.Emitter
 }
@@ -175,11 +226,20 @@ type Executor struct {
 // NewExecutor creates a new execution adapter.
 func NewExecutor(clk clock.Clock, emitter events.Emitter) *Executor {
 	return &Executor{
-		clock:   clk,
-		emitter: emitter,
+		idempotency: newIdempotencyCache(DefaultIdempotencyWindow),
+		retries:     newRetryTracker(),
+		clock:       clk,
+		emitter:     emitter,
 	}
 }
 
+// Clear forgets remembered executions and retry counts. Boundary
+// executors keep their envelopes.
+func (e *Executor) Clear() {
+	e.idempotency.clear()
+	e.retries.clear()
+}
+
 // WithEmailExecutor sets the email boundary executor.
 func (e *Executor) WithEmailExecutor(exec EmailExecutor) *Executor {
 	e.emailExecutor = exec
@@ -199,6 +259,63 @@ func (e *Executor) WithFinanceExecutor(exec FinanceExecutor) *Executor {
 	return e
 }
 
+// WithApprovalGate makes execution check approvals per action class.
+// Drafts that need no approvals execute on the user's confirm; the rest
+// execute only once records hold enough fresh approvals for the draft.
+// A nil records blocks every draft that needs approvals.
+func (e *Executor) WithApprovalGate(policy ApprovalPolicy, records ApprovalRecords) *Executor {
+	e.approvalPolicy = policy
+	e.approvalRecords = records
+	return e
+}
+
+// WithIdempotencyWindow sets how long a draft's outcome is returned to
+// repeat requests. Zero or less disables the check.
+func (e *Executor) WithIdempotencyWindow(window time.Duration) *Executor {
+	e.idempotency = newIdempotencyCache(window)
+	return e
+}
+
+// checkApprovals returns the required and recorded approvals for the
+// intent's draft, and a reason when they are not enough.
+//
+// Only a state bound to this exact intent counts, and the higher of the
+// current threshold and the one stored with the state applies.
+func (e *Executor) checkApprovals(intent *execintent.ExecutionIntent, now time.Time) (required, recorded int, blocked string) {
+	if e.approvalPolicy == nil {
+		return 0, 0, ""
+	}
+	d, ok := e.approvalPolicy.GetDraft(intent.DraftID)
+	if !ok {
+		return 0, 0, "draft not found for approval check"
+	}
+	required = e.approvalPolicy.RequiredApprovals(d)
+	if required == 0 {
+		return 0, 0, ""
+	}
+	if e.approvalRecords == nil {
+		return required, 0, fmt.Sprintf("requires %d approvals; approval ledger not configured", required)
+	}
+	state := e.approvalRecords.GetApprovalStateForAction(approvalflow.TargetTypeDraft, string(intent.DraftID), intent.Hash())
+	if state == nil {
+		return required, 0, fmt.Sprintf("requires %d approvals; none requested for this action", required)
+	}
+	if state.Threshold > required {
+		required = state.Threshold
+	}
+	switch state.ComputeStatus(now) {
+	case approvalflow.StatusRejected:
+		return required, 0, "approval rejected"
+	case approvalflow.StatusExpired:
+		return required, 0, "approval request expired"
+	}
+	recorded = state.ApprovedCount(now)
+	if recorded < required {
+		return required, recorded, fmt.Sprintf("requires %d approvals; %d recorded", required, recorded)
+	}
+	return required, recorded, ""
+}
+
 // ExecuteIntent routes an ExecutionIntent to the appropriate boundary executor.
 //
 // CRITICAL: This is the single entry point for intent execution.
@@ -222,19 +339,62 @@ func (e *Executor) ExecuteIntent(ctx context.Context, intent *execintent.Executi
 		}
 	}
 
+	// A repeat within the idempotency window returns the prior outcome.
+	// Dry runs write nothing, so they neither use nor fill the cache.
+	var prior *idempotencyEntry
+	var idemKey string
+	if !e.DryRun() {
+		prior, idemKey = e.idempotency.begin(intent.DraftID, now)
+	}
+	if prior != nil {
+		if prior.pending {
+			e.emitEvent(events.Phase10ExecutionBlocked, intent, "execution_in_progress")
+			return ExecutionOutcome{
+				IntentID:      intent.IntentID,
+				Success:       false,
+				Blocked:       true,
+				BlockedReason: "execution already in progress for this draft",
+				ExecutedAt:    now,
+			}
+		}
+		e.emitEvent(events.Phase10ExecutionDeduplicated, intent, "")
+		outcome := prior.outcome
+		outcome.Replayed = true
+		return outcome
+	}
+
+	// Check approvals for the action class before any boundary is reached
+	required, recorded, reason := e.checkApprovals(intent, now)
+	if reason != "" {
+		e.idempotency.finish(idemKey, ExecutionOutcome{Blocked: true})
+		// The reason is free text; events carry only the label.
+		e.emitEvent(events.Phase10ExecutionBlocked, intent, "approvals_pending")
+		return ExecutionOutcome{
+			IntentID:          intent.IntentID,
+			Success:           false,
+			Blocked:           true,
+			BlockedReason:     reason,
+			ExecutedAt:        now,
+			RequiredApprovals: required,
+			RecordedApprovals: recorded,
+		}
+	}
+
 	// Route based on action type
+	var outcome ExecutionOutcome
 	switch intent.Action {
 	case execintent.ActionEmailSend:
-		return e.executeEmail(ctx, intent, traceID, now)
+		outcome = e.executeEmail(ctx, intent, traceID, now)
 
 	case execintent.ActionCalendarRespond:
-		return e.executeCalendar(ctx, intent, traceID, now)
+		outcome = e.executeCalendar(ctx, intent, traceID, now)
 
 	case execintent.ActionFinancePayment:
 		// Phase 17b: Route finance payments to finance execution boundary
-		return e.executeFinance(ctx, intent, traceID, now)
+		outcome = e.executeFinance(ctx, intent, traceID, now)
 
 	default:
+		e.idempotency.finish(idemKey, ExecutionOutcome{Blocked: true})
 		e.emitEvent(events.Phase10ExecutionBlocked, intent, fmt.Sprintf("unknown action: %s", intent.Action))
 		return ExecutionOutcome{
 			IntentID:      intent.IntentID,
@@ -244,6 +404,10 @@ func (e *Executor) ExecuteIntent(ctx context.Context, intent *execintent.Executi
 			ExecutedAt:    now,
 		}
 	}
+	outcome.RequiredApprovals = required
+	outcome.RecordedApprovals = recorded
+	e.idempotency.finish(idemKey, outcome)
+	return outcome
 }
 
 // executeEmail routes an email intent to the email boundary executor.
@@ -266,6 +430,10 @@ func (e *Executor) executeEmail(ctx context.Context, intent *execintent.Executio
 	// Emit routing event
 	e.emitEvent(events.Phase10ExecutionRouted, intent, "email")
 
+	if e.DryRun() {
+		return e.skipWrite(intent, "email", envelope.EnvelopeID, envelope.IdempotencyKey, now)
+	}
+
 	// Execute via boundary executor
 	result, err := e.emailExecutor.Execute(ctx, envelope)
 	if err != nil {
@@ -313,6 +481,10 @@ func (e *Executor) executeCalendar(ctx context.Context, intent *execintent.Execu
 	// Emit routing event
 	e.emitEvent(events.Phase10ExecutionRouted, intent, "calendar")
 
+	if e.DryRun() {
+		return e.skipWrite(intent, "calendar", envelope.EnvelopeID, envelope.IdempotencyKey, now)
+	}
+
 	// Execute via boundary executor
 	result := e.calendarExecutor.Execute(ctx, envelope)
 
@@ -364,6 +536,11 @@ func (e *Executor) executeFinance(ctx context.Context, intent *execintent.Execut
 		Now:                now,
 	}
 
+	if e.DryRun() {
+		envelopeID := computeFinanceEnvelopeID(req)
+		return e.skipWrite(intent, "finance", envelopeID, envelopeID, now)
+	}
+
 	// Execute via finance boundary executor
 	result := e.financeExecutor.ExecuteFromIntent(ctx, req)
 
@@ -8,6 +8,8 @@ import (
 	calexec "quantumlife/internal/calendar/execution"
 	emailexec "quantumlife/internal/email/execution"
 	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/approvalflow"
+	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/events"
 )
@@ -24,11 +26,13 @@ type mockEmailExecutor struct {
 	result   *emailexec.Envelope
 	err      error
 	called   bool
+	calls    int
 	envelope emailexec.Envelope
 }
 
 func (m *mockEmailExecutor) Execute(ctx context.Context, envelope emailexec.Envelope) (*emailexec.Envelope, error) {
 	m.called = true
+	m.calls++
 	m.envelope = envelope
 	return m.result, m.err
 }
@@ -36,11 +40,13 @@ func (m *mockEmailExecutor) Execute(ctx context.Context, envelope emailexec.Enve
 type mockCalendarExecutor struct {
 	result   calexec.ExecuteResult
 	called   bool
+	calls    int
 	envelope *calexec.Envelope
 }
 
 func (m *mockCalendarExecutor) Execute(ctx context.Context, envelope *calexec.Envelope) calexec.ExecuteResult {
 	m.called = true
+	m.calls++
 	m.envelope = envelope
 	return m.result
 }
@@ -521,3 +527,106 @@ func TestExecutor_BuildCalendarEnvelope_Fields(t *testing.T) {
 		t.Errorf("Status = %s, want pending", env.Status)
 	}
 }
+
+type mockApprovalPolicy struct {
+	draft    draft.Draft
+	required int
+}
+
+func (m *mockApprovalPolicy) GetDraft(id draft.DraftID) (draft.Draft, bool) {
+	return m.draft, id == m.draft.DraftID
+}
+
+func (m *mockApprovalPolicy) RequiredApprovals(d draft.Draft) int {
+	return m.required
+}
+
+type mockApprovalRecords struct {
+	state *approvalflow.ApprovalState
+}
+
+func (m *mockApprovalRecords) GetApprovalStateForAction(targetType approvalflow.TargetType, targetID, actionHash string) *approvalflow.ApprovalState {
+	if m.state == nil || m.state.TargetType != targetType || m.state.TargetID != targetID || m.state.ActionHash != actionHash {
+		return nil
+	}
+	return m.state
+}
+
+func TestExecutor_ExecuteIntent_ApprovalGate(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+
+	intent := &execintent.ExecutionIntent{
+		IntentID:           "intent-001",
+		DraftID:            "draft-001",
+		CircleID:           "circle-001",
+		Action:             execintent.ActionCalendarRespond,
+		CalendarEventID:    "event-001",
+		CalendarResponse:   "accepted",
+		PolicySnapshotHash: "policy-hash-123",
+		ViewSnapshotHash:   "view-hash-456",
+		CreatedAt:          fixedTime,
+	}
+	policy := &mockApprovalPolicy{draft: draft.Draft{DraftID: "draft-001", DraftType: draft.DraftTypeCalendarResponse}}
+	records := &mockApprovalRecords{}
+	newExecutor := func() (*Executor, *mockCalendarExecutor) {
+		calMock := &mockCalendarExecutor{result: calexec.ExecuteResult{Success: true}}
+		return NewExecutor(clk, &mockEmitter{}).WithCalendarExecutor(calMock).WithApprovalGate(policy, records), calMock
+	}
+
+	// Below threshold: executes on confirm.
+	executor, calMock := newExecutor()
+	if outcome := executor.ExecuteIntent(context.Background(), intent, "trace-001"); !outcome.Success || !calMock.called {
+		t.Fatalf("expected a no-approval draft to execute, got %+v", outcome)
+	}
+
+	// At threshold: blocked until the approval-token flow records enough approvals.
+	policy.required = 2
+	executor, calMock = newExecutor()
+	outcome := executor.ExecuteIntent(context.Background(), intent, "trace-002")
+	if !outcome.Blocked || calMock.called || outcome.RequiredApprovals != 2 {
+		t.Fatalf("expected block with no approval state, got %+v", outcome)
+	}
+
+	// Approvals for another version of the draft do not count.
+	records.state = approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-001", "", "calendar_respond", nil, 2, 60, fixedTime)
+	records.state.BindAction("hash-of-an-earlier-version")
+	records.state.RecordApproval(approvalflow.ApprovalRecord{PersonID: "person-a", Decision: approvalflow.DecisionApproved, Timestamp: fixedTime})
+	records.state.RecordApproval(approvalflow.ApprovalRecord{PersonID: "person-b", Decision: approvalflow.DecisionApproved, Timestamp: fixedTime})
+	executor, calMock = newExecutor()
+	if outcome := executor.ExecuteIntent(context.Background(), intent, "trace-003"); !outcome.Blocked || calMock.called {
+		t.Fatalf("expected block when approvals cover another action, got %+v", outcome)
+	}
+
+	records.state = approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-001", "", "calendar_respond", nil, 2, 60, fixedTime)
+	records.state.BindAction(intent.Hash())
+	records.state.RecordApproval(approvalflow.ApprovalRecord{PersonID: "person-a", Decision: approvalflow.DecisionApproved, Timestamp: fixedTime})
+	executor, calMock = newExecutor()
+	outcome = executor.ExecuteIntent(context.Background(), intent, "trace-003")
+	if !outcome.Blocked || calMock.called || outcome.RecordedApprovals != 1 {
+		t.Fatalf("expected block with 1 of 2 approvals, got %+v", outcome)
+	}
+
+	records.state.RecordApproval(approvalflow.ApprovalRecord{PersonID: "person-b", Decision: approvalflow.DecisionApproved, Timestamp: fixedTime})
+	executor, calMock = newExecutor()
+	outcome = executor.ExecuteIntent(context.Background(), intent, "trace-004")
+	if !outcome.Success || !calMock.called || outcome.RecordedApprovals != 2 {
+		t.Fatalf("expected execution with 2 of 2 approvals, got %+v", outcome)
+	}
+
+	// The threshold stored with the state applies even if policy lowers it.
+	policy.required = 1
+	records.state.Threshold = 3
+	executor, calMock = newExecutor()
+	outcome = executor.ExecuteIntent(context.Background(), intent, "trace-005")
+	if !outcome.Blocked || calMock.called || outcome.RequiredApprovals != 3 {
+		t.Fatalf("expected the stored threshold of 3 to apply, got %+v", outcome)
+	}
+	policy.required = 2
+
+	// No ledger: drafts that need approvals never execute.
+	executor = NewExecutor(clk, &mockEmitter{}).WithCalendarExecutor(&mockCalendarExecutor{}).WithApprovalGate(policy, nil)
+	if outcome := executor.ExecuteIntent(context.Background(), intent, "trace-006"); !outcome.Blocked {
+		t.Errorf("expected block without an approval ledger, got %+v", outcome)
+	}
+}
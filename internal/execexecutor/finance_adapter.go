@@ -35,6 +35,7 @@ import (
 // - Emits Phase 17 audit events
 type FinanceExecutorAdapter struct {
 	v96Executor *execution.V96Executor
+	connector   *mock.Connector
 	clock       clock.Clock
 	emitter     events.Emitter
 	idGenerator func() string
@@ -192,6 +193,7 @@ func NewFinanceExecutorAdapter(
 
 	return &FinanceExecutorAdapter{
 		v96Executor:   v96Executor,
+		connector:     mockConnector,
 		clock:         clk,
 		emitter:       emitter,
 		idGenerator:   idGen,
@@ -204,6 +206,16 @@ func (a *FinanceExecutorAdapter) SetEnvelopeStore(store EnvelopeStore) {
 	a.envelopeStore = store
 }
 
+// RecordSkipped reports a payment skipped by a dry run to the mock connector.
+func (a *FinanceExecutorAdapter) RecordSkipped(envelopeID string) {
+	a.connector.RecordSkipped(envelopeID)
+}
+
+// GetSkippedCount returns the number of payments dry runs skipped.
+func (a *FinanceExecutorAdapter) GetSkippedCount() int {
+	return a.connector.GetSkippedCount()
+}
+
 // GetExpectedPolicyHash returns the policy hash that V96Executor expects.
 // Tests should use this to get the correct PolicySnapshotHash value.
 func (a *FinanceExecutorAdapter) GetExpectedPolicyHash() string {
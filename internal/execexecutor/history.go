@@ -0,0 +1,199 @@
+package execexecutor
+
+import (
+	"sort"
+	"time"
+
+	calexec "quantumlife/internal/calendar/execution"
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/pkg/domain/draft"
+)
+
+// HistoryKind is the kind of external write an envelope performs.
+type HistoryKind string
+
+const (
+	HistoryKindEmail    HistoryKind = "email"
+	HistoryKindCalendar HistoryKind = "calendar"
+)
+
+// AllHistoryKinds returns the kinds in display order.
+func AllHistoryKinds() []HistoryKind {
+	return []HistoryKind{HistoryKindEmail, HistoryKindCalendar}
+}
+
+// Valid returns true if the kind is known.
+func (k HistoryKind) Valid() bool {
+	for _, known := range AllHistoryKinds() {
+		if k == known {
+			return true
+		}
+	}
+	return false
+}
+
+// HistoryStatus is an envelope's status across email and calendar.
+type HistoryStatus string
+
+const (
+	HistorySucceeded HistoryStatus = "succeeded"
+	HistoryFailed    HistoryStatus = "failed"
+	HistoryBlocked   HistoryStatus = "blocked"
+	HistoryPending   HistoryStatus = "pending" // not yet executed, including scheduled sends
+	HistoryCancelled HistoryStatus = "cancelled"
+)
+
+// AllHistoryStatuses returns the statuses in display order.
+func AllHistoryStatuses() []HistoryStatus {
+	return []HistoryStatus{HistorySucceeded, HistoryFailed, HistoryBlocked, HistoryPending, HistoryCancelled}
+}
+
+// Valid returns true if the status is known.
+func (s HistoryStatus) Valid() bool {
+	for _, known := range AllHistoryStatuses() {
+		if s == known {
+			return true
+		}
+	}
+	return false
+}
+
+// HistoryEntry is one envelope in the execution history.
+// CRITICAL: Abstract only - no recipients, subjects, bodies or messages.
+type HistoryEntry struct {
+	EnvelopeID string
+	DraftID    draft.DraftID
+	CircleID   string
+	Kind       HistoryKind
+	Status     HistoryStatus
+	Provider   string
+
+	// At is when the envelope executed, or when it was created if it has
+	// not executed.
+	At time.Time
+}
+
+// HistoryFilter selects history entries. Zero fields match everything.
+type HistoryFilter struct {
+	CircleID string
+	Kind     HistoryKind
+	Status   HistoryStatus
+
+	// From is inclusive, Until exclusive.
+	From  time.Time
+	Until time.Time
+}
+
+// Matches reports whether an entry passes the filter.
+func (f HistoryFilter) Matches(entry HistoryEntry) bool {
+	switch {
+	case f.CircleID != "" && entry.CircleID != f.CircleID:
+		return false
+	case f.Kind != "" && entry.Kind != f.Kind:
+		return false
+	case f.Status != "" && entry.Status != f.Status:
+		return false
+	case !f.From.IsZero() && entry.At.Before(f.From):
+		return false
+	case !f.Until.IsZero() && !entry.At.Before(f.Until):
+		return false
+	}
+	return true
+}
+
+// EmailHistorySource lists email envelopes. Implemented by the email
+// boundary executor.
+type EmailHistorySource interface {
+	ListEnvelopes(filter emailexec.ListFilter) []emailexec.Envelope
+}
+
+// CalendarHistorySource lists calendar envelopes. Implemented by the
+// calendar boundary executor.
+type CalendarHistorySource interface {
+	ListEnvelopes(filter calexec.ListFilter) []calexec.Envelope
+}
+
+// History returns the email and calendar envelopes matching the filter,
+// newest first; envelopes at the same time are ordered by ID.
+// Boundary executors that cannot list envelopes contribute nothing.
+func (e *Executor) History(filter HistoryFilter) []HistoryEntry {
+	var entries []HistoryEntry
+	if source, ok := e.emailExecutor.(EmailHistorySource); ok {
+		for _, env := range source.ListEnvelopes(emailexec.ListFilter{IncludeAll: true}) {
+			entries = append(entries, HistoryEntry{
+				EnvelopeID: env.EnvelopeID,
+				DraftID:    env.DraftID,
+				CircleID:   string(env.CircleID),
+				Kind:       HistoryKindEmail,
+				Status:     emailHistoryStatus(env.Status),
+				Provider:   env.Provider,
+				At:         historyTime(env.CreatedAt, env.ExecutedAt),
+			})
+		}
+	}
+	if source, ok := e.calendarExecutor.(CalendarHistorySource); ok {
+		for _, env := range source.ListEnvelopes(calexec.ListFilter{IncludeAll: true}) {
+			entries = append(entries, HistoryEntry{
+				EnvelopeID: env.EnvelopeID,
+				DraftID:    env.DraftID,
+				CircleID:   string(env.CircleID),
+				Kind:       HistoryKindCalendar,
+				Status:     calendarHistoryStatus(env.Status),
+				Provider:   env.Provider,
+				At:         historyTime(env.CreatedAt, env.ExecutedAt),
+			})
+		}
+	}
+
+	matched := entries[:0]
+	for _, entry := range entries {
+		if filter.Matches(entry) {
+			matched = append(matched, entry)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].At.Equal(matched[j].At) {
+			return matched[i].At.After(matched[j].At)
+		}
+		return matched[i].EnvelopeID < matched[j].EnvelopeID
+	})
+	return matched
+}
+
+// historyTime returns executedAt when set, otherwise createdAt.
+func historyTime(createdAt time.Time, executedAt *time.Time) time.Time {
+	if executedAt != nil {
+		return *executedAt
+	}
+	return createdAt
+}
+
+// emailHistoryStatus maps an email envelope status.
+func emailHistoryStatus(status emailexec.EnvelopeStatus) HistoryStatus {
+	switch status {
+	case emailexec.EnvelopeStatusExecuted:
+		return HistorySucceeded
+	case emailexec.EnvelopeStatusFailed:
+		return HistoryFailed
+	case emailexec.EnvelopeStatusBlocked:
+		return HistoryBlocked
+	case emailexec.EnvelopeStatusCancelled:
+		return HistoryCancelled
+	}
+	return HistoryPending
+}
+
+// calendarHistoryStatus maps a calendar envelope status.
+func calendarHistoryStatus(status calexec.EnvelopeStatus) HistoryStatus {
+	switch status {
+	case calexec.EnvelopeStatusExecuted:
+		return HistorySucceeded
+	case calexec.EnvelopeStatusFailed:
+		return HistoryFailed
+	case calexec.EnvelopeStatusBlocked:
+		return HistoryBlocked
+	case calexec.EnvelopeStatusCancelled:
+		return HistoryCancelled
+	}
+	return HistoryPending
+}
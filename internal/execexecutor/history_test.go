@@ -0,0 +1,97 @@
+package execexecutor
+
+import (
+	"testing"
+	"time"
+
+	calexec "quantumlife/internal/calendar/execution"
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/pkg/clock"
+)
+
+type historyEmailExecutor struct {
+	mockEmailExecutor
+	envelopes []emailexec.Envelope
+}
+
+func (m *historyEmailExecutor) ListEnvelopes(filter emailexec.ListFilter) []emailexec.Envelope {
+	return m.envelopes
+}
+
+type historyCalendarExecutor struct {
+	mockCalendarExecutor
+	envelopes []calexec.Envelope
+}
+
+func (m *historyCalendarExecutor) ListEnvelopes(filter calexec.ListFilter) []calexec.Envelope {
+	return m.envelopes
+}
+
+func TestExecutor_History(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		t := now.Add(time.Duration(minutes) * time.Minute)
+		return &t
+	}
+
+	email := &historyEmailExecutor{envelopes: []emailexec.Envelope{
+		{EnvelopeID: "e-2", DraftID: "d-2", CircleID: "work", Provider: "mock", Subject: "Re: hidden", Status: emailexec.EnvelopeStatusExecuted, CreatedAt: now, ExecutedAt: at(5)},
+		{EnvelopeID: "e-1", DraftID: "d-1", CircleID: "personal", Status: emailexec.EnvelopeStatusFailed, CreatedAt: now, ExecutedAt: at(5)},
+		{EnvelopeID: "e-3", DraftID: "d-3", CircleID: "work", Status: emailexec.EnvelopeStatusScheduled, CreatedAt: *at(30)},
+	}}
+	calendar := &historyCalendarExecutor{envelopes: []calexec.Envelope{
+		{EnvelopeID: "c-1", DraftID: "d-4", CircleID: "work", Provider: "mock", Status: calexec.EnvelopeStatusBlocked, CreatedAt: now, ExecutedAt: at(10)},
+	}}
+	executor := NewExecutor(clock.NewFixed(now), nil).
+		WithEmailExecutor(email).
+		WithCalendarExecutor(calendar)
+
+	ids := func(entries []HistoryEntry) []string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.EnvelopeID)
+		}
+		return out
+	}
+	tests := []struct {
+		name   string
+		filter HistoryFilter
+		want   []string
+	}{
+		{"all, newest first then by ID", HistoryFilter{}, []string{"e-3", "c-1", "e-1", "e-2"}},
+		{"circle", HistoryFilter{CircleID: "work"}, []string{"e-3", "c-1", "e-2"}},
+		{"kind", HistoryFilter{Kind: HistoryKindCalendar}, []string{"c-1"}},
+		{"succeeded", HistoryFilter{Status: HistorySucceeded}, []string{"e-2"}},
+		{"failed", HistoryFilter{Status: HistoryFailed}, []string{"e-1"}},
+		{"scheduled is pending", HistoryFilter{Status: HistoryPending}, []string{"e-3"}},
+		{"from is inclusive", HistoryFilter{From: *at(10)}, []string{"e-3", "c-1"}},
+		{"until is exclusive", HistoryFilter{Until: *at(10)}, []string{"e-1", "e-2"}},
+		{"combined", HistoryFilter{CircleID: "work", Kind: HistoryKindEmail, From: now, Until: *at(30)}, []string{"e-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(executor.History(tt.filter))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	entries := executor.History(HistoryFilter{Status: HistorySucceeded})
+	if entries[0].Kind != HistoryKindEmail || entries[0].Provider != "mock" || !entries[0].At.Equal(*at(5)) {
+		t.Errorf("unexpected entry: %+v", entries[0])
+	}
+}
+
+func TestExecutor_History_WithoutSources(t *testing.T) {
+	executor := NewExecutor(clock.NewFixed(time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)), nil).
+		WithEmailExecutor(successfulEmailMock())
+	if entries := executor.History(HistoryFilter{}); len(entries) != 0 {
+		t.Errorf("expected no history from executors that cannot list envelopes, got %d", len(entries))
+	}
+}
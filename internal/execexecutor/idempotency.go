@@ -0,0 +1,171 @@
+package execexecutor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"quantumlife/pkg/domain/draft"
+)
+
+// DefaultIdempotencyWindow is how long a draft's execution outcome is
+// returned to repeat requests (e.g. a resubmitted form) instead of
+// executing again.
+const DefaultIdempotencyWindow = 10 * time.Minute
+
+// MaxIdempotencyEntries bounds the idempotency cache. The oldest entries
+// are evicted first.
+const MaxIdempotencyEntries = 256
+
+// idempotencyEntry is one draft execution within a period.
+type idempotencyEntry struct {
+	recordedAt time.Time
+
+	// pending is set while the execution is in flight.
+	pending bool
+	outcome ExecutionOutcome
+}
+
+// idempotencyCache remembers execution outcomes by idempotency key.
+// Time comes from the caller (the executor's injected clock).
+type idempotencyCache struct {
+	mu      sync.Mutex
+	window  time.Duration
+	entries map[string]*idempotencyEntry
+	order   []string // keys, oldest first
+}
+
+// newIdempotencyCache creates a cache. A window of zero or less disables it.
+func newIdempotencyCache(window time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		window:  window,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+// computeExecutionIdempotencyKey hashes a draft ID with the start of its
+// period. Every request for the draft within the period has the same key.
+func computeExecutionIdempotencyKey(draftID draft.DraftID, period time.Time) string {
+	canonical := fmt.Sprintf("exec-idem|%s|%s", draftID, period.UTC().Format(time.RFC3339))
+	hash := sha256.Sum256([]byte(canonical))
+	return hex.EncodeToString(hash[:16])
+}
+
+// begin looks up a prior execution of the draft within the window.
+// It returns the prior entry if there is one; otherwise it reserves the
+// key for this execution and returns nil and the key to finish with.
+func (c *idempotencyCache) begin(draftID draft.DraftID, now time.Time) (*idempotencyEntry, string) {
+	if c.window <= 0 {
+		return nil, ""
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// A request just after a period boundary still matches the previous
+	// period's entry while that entry is within the window.
+	period := now.UTC().Truncate(c.window)
+	key := computeExecutionIdempotencyKey(draftID, period)
+	for _, k := range []string{key, computeExecutionIdempotencyKey(draftID, period.Add(-c.window))} {
+		if entry, ok := c.entries[k]; ok && now.Sub(entry.recordedAt) < c.window {
+			prior := *entry
+			return &prior, ""
+		}
+	}
+
+	c.evictLocked(now)
+	c.entries[key] = &idempotencyEntry{recordedAt: now, pending: true}
+	c.order = append(c.order, key)
+	return nil, key
+}
+
+// finish records the outcome for a reserved key. Failed outcomes are kept:
+// the external write may have happened before the failure. Blocked
+// outcomes never reached one and are forgotten, so the draft can be
+// executed again once the block is lifted.
+func (c *idempotencyCache) finish(key string, outcome ExecutionOutcome) {
+	if key == "" {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	if outcome.Blocked {
+		c.removeLocked(key)
+		return
+	}
+	entry.pending = false
+	entry.outcome = outcome
+}
+
+// forget drops the draft's finished entries, so its next execution runs
+// instead of replaying them. In-flight executions are kept.
+func (c *idempotencyCache) forget(draftID draft.DraftID, now time.Time) {
+	if c.window <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	period := now.UTC().Truncate(c.window)
+	for _, k := range []string{computeExecutionIdempotencyKey(draftID, period), computeExecutionIdempotencyKey(draftID, period.Add(-c.window))} {
+		if entry, ok := c.entries[k]; ok && !entry.pending {
+			c.removeLocked(k)
+		}
+	}
+}
+
+// evictLocked drops entries older than the window (including executions
+// that never finished), then the oldest entries beyond MaxIdempotencyEntries
+// (leaving room for one more).
+// Must be called with lock held.
+func (c *idempotencyCache) evictLocked(now time.Time) {
+	keep := c.order[:0]
+	for _, k := range c.order {
+		if now.Sub(c.entries[k].recordedAt) >= c.window {
+			delete(c.entries, k)
+			continue
+		}
+		keep = append(keep, k)
+	}
+	if len(keep) >= MaxIdempotencyEntries {
+		evictCount := len(keep) - MaxIdempotencyEntries + 1
+		for _, k := range keep[:evictCount] {
+			delete(c.entries, k)
+		}
+		keep = keep[evictCount:]
+	}
+	c.order = keep
+}
+
+// removeLocked drops one entry.
+// Must be called with lock held.
+func (c *idempotencyCache) removeLocked(key string) {
+	delete(c.entries, key)
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// clear drops every entry.
+func (c *idempotencyCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*idempotencyEntry)
+	c.order = nil
+}
+
+// size returns the number of entries.
+func (c *idempotencyCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
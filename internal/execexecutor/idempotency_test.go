@@ -0,0 +1,187 @@
+package execexecutor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	calexec "quantumlife/internal/calendar/execution"
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/events"
+)
+
+func idempotencyEmailIntent(draftID draft.DraftID, createdAt time.Time) *execintent.ExecutionIntent {
+	return &execintent.ExecutionIntent{
+		IntentID:           execintent.IntentID("intent-" + string(draftID)),
+		DraftID:            draftID,
+		CircleID:           "circle-001",
+		Action:             execintent.ActionEmailSend,
+		EmailThreadID:      "thread-001",
+		EmailMessageID:     "msg-000",
+		EmailTo:            "test@example.com",
+		EmailSubject:       "Test Subject",
+		EmailBody:          "Test Body",
+		PolicySnapshotHash: "policy-hash-123",
+		ViewSnapshotHash:   "view-hash-456",
+		CreatedAt:          createdAt,
+	}
+}
+
+func successfulEmailMock() *mockEmailExecutor {
+	return &mockEmailExecutor{
+		result: &emailexec.Envelope{
+			EnvelopeID: "env-001",
+			Status:     emailexec.EnvelopeStatusExecuted,
+			ExecutionResult: &emailexec.ExecutionResult{
+				Success:            true,
+				MessageID:          "msg-001",
+				ProviderResponseID: "prov-resp-001",
+			},
+		},
+	}
+}
+
+// TestExecutor_ExecuteIntent_DoublePost simulates a resubmitted execute form:
+// the web layer builds a fresh intent and trace ID for each POST.
+func TestExecutor_ExecuteIntent_DoublePost(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFunc(func() time.Time { return now })
+	emitter := &mockEmitter{}
+	emailMock := successfulEmailMock()
+	executor := NewExecutor(clk, emitter).WithEmailExecutor(emailMock)
+
+	first := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "web-exec-1")
+	now = now.Add(2 * time.Second)
+	second := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "web-exec-2")
+
+	if emailMock.calls != 1 {
+		t.Fatalf("email executor called %d times, want 1", emailMock.calls)
+	}
+	if !first.Success || first.Replayed {
+		t.Errorf("first outcome: success=%t replayed=%t, want success, not replayed", first.Success, first.Replayed)
+	}
+	if !second.Success || !second.Replayed {
+		t.Errorf("second outcome: success=%t replayed=%t, want success, replayed", second.Success, second.Replayed)
+	}
+	if second.ProviderResponseID != first.ProviderResponseID || !second.ExecutedAt.Equal(first.ExecutedAt) {
+		t.Error("expected the repeat to return the prior outcome")
+	}
+
+	deduplicated := 0
+	for _, e := range emitter.events {
+		if e.Type == events.Phase10ExecutionDeduplicated {
+			deduplicated++
+		}
+	}
+	if deduplicated != 1 {
+		t.Errorf("expected 1 deduplicated event, got %d", deduplicated)
+	}
+
+	// A different draft is not affected
+	executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-002", now), "web-exec-3")
+	if emailMock.calls != 2 {
+		t.Errorf("email executor called %d times, want 2", emailMock.calls)
+	}
+
+	// After the window the draft executes again
+	now = now.Add(DefaultIdempotencyWindow)
+	third := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "web-exec-4")
+	if emailMock.calls != 3 || third.Replayed {
+		t.Errorf("expected a new execution after the window, calls=%d replayed=%t", emailMock.calls, third.Replayed)
+	}
+}
+
+func TestExecutor_ExecuteIntent_IdempotencyAcrossPeriodBoundary(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 9, 59, 0, time.UTC)
+	clk := clock.NewFunc(func() time.Time { return now })
+	emailMock := successfulEmailMock()
+	executor := NewExecutor(clk, &mockEmitter{}).WithEmailExecutor(emailMock)
+
+	executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-1")
+	now = now.Add(2 * time.Second) // the next period
+	outcome := executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-2")
+
+	if emailMock.calls != 1 || !outcome.Replayed {
+		t.Errorf("expected the repeat to be replayed, calls=%d replayed=%t", emailMock.calls, outcome.Replayed)
+	}
+}
+
+func TestExecutor_ExecuteIntent_BlockedOutcomeNotRemembered(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	calendarMock := &mockCalendarExecutor{
+		result: calexec.ExecuteResult{Blocked: true, BlockedReason: "view snapshot stale", ExecutedAt: now},
+	}
+	executor := NewExecutor(clock.NewFixed(now), &mockEmitter{}).WithCalendarExecutor(calendarMock)
+	intent := &execintent.ExecutionIntent{
+		IntentID:           "intent-cal-001",
+		DraftID:            "draft-cal-001",
+		CircleID:           "circle-001",
+		Action:             execintent.ActionCalendarRespond,
+		CalendarEventID:    "event-001",
+		CalendarResponse:   "accept",
+		PolicySnapshotHash: "policy-hash-123",
+		ViewSnapshotHash:   "view-hash-456",
+		CreatedAt:          now,
+	}
+
+	if outcome := executor.ExecuteIntent(context.Background(), intent, "trace-1"); !outcome.Blocked {
+		t.Fatal("expected the first attempt to be blocked")
+	}
+	calendarMock.result = calexec.ExecuteResult{Success: true, ProviderResponseID: "prov-cal-001", ExecutedAt: now}
+	outcome := executor.ExecuteIntent(context.Background(), intent, "trace-2")
+
+	if calendarMock.calls != 2 {
+		t.Errorf("calendar executor called %d times, want 2", calendarMock.calls)
+	}
+	if !outcome.Success || outcome.Replayed {
+		t.Errorf("expected a fresh successful execution, success=%t replayed=%t", outcome.Success, outcome.Replayed)
+	}
+}
+
+func TestExecutor_ExecuteIntent_IdempotencyDisabled(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	emailMock := successfulEmailMock()
+	executor := NewExecutor(clock.NewFixed(now), &mockEmitter{}).
+		WithEmailExecutor(emailMock).
+		WithIdempotencyWindow(0)
+
+	executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-1")
+	executor.ExecuteIntent(context.Background(), idempotencyEmailIntent("draft-001", now), "trace-2")
+
+	if emailMock.calls != 2 {
+		t.Errorf("email executor called %d times, want 2", emailMock.calls)
+	}
+}
+
+func TestIdempotencyCache_Bounded(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	cache := newIdempotencyCache(DefaultIdempotencyWindow)
+
+	for i := 0; i < MaxIdempotencyEntries+10; i++ {
+		_, key := cache.begin(draft.DraftID(fmt.Sprintf("draft-%03d", i)), now)
+		cache.finish(key, ExecutionOutcome{Success: true})
+	}
+	if got := cache.size(); got != MaxIdempotencyEntries {
+		t.Errorf("cache size = %d, want %d", got, MaxIdempotencyEntries)
+	}
+
+	// The oldest drafts were evicted first
+	if prior, _ := cache.begin("draft-000", now); prior != nil {
+		t.Error("expected the oldest entry to be evicted")
+	}
+	if prior, _ := cache.begin(draft.DraftID(fmt.Sprintf("draft-%03d", MaxIdempotencyEntries+9)), now); prior == nil {
+		t.Error("expected the newest entry to be kept")
+	}
+
+	// Expired entries are dropped on the next insert
+	later := now.Add(DefaultIdempotencyWindow)
+	_, key := cache.begin("draft-new", later)
+	cache.finish(key, ExecutionOutcome{Success: true})
+	if got := cache.size(); got != 1 {
+		t.Errorf("cache size after the window = %d, want 1", got)
+	}
+}
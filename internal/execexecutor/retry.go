@@ -0,0 +1,195 @@
+package execexecutor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/events"
+)
+
+// MaxRetriesPerEnvelope caps how often a failed envelope is retried. Retries
+// of a retry count against the envelope that failed first.
+const MaxRetriesPerEnvelope = 3
+
+// retryTracker counts retries by the first failed envelope of each chain.
+type retryTracker struct {
+	mu       sync.Mutex
+	roots    map[string]string      // retry envelope ID -> first failed envelope ID
+	attempts map[string]int         // first failed envelope ID -> retries so far
+	inFlight map[draft.DraftID]bool // drafts with a reserved retry not yet released or linked
+}
+
+// newRetryTracker creates an empty tracker.
+func newRetryTracker() *retryTracker {
+	return &retryTracker{
+		roots:    make(map[string]string),
+		attempts: make(map[string]int),
+		inFlight: make(map[draft.DraftID]bool),
+	}
+}
+
+// rootLocked returns the first failed envelope of the chain envelopeID is in.
+// Must be called with lock held.
+func (t *retryTracker) rootLocked(envelopeID string) string {
+	if root, ok := t.roots[envelopeID]; ok {
+		return root
+	}
+	return envelopeID
+}
+
+// reserve counts a retry of envelopeID and marks its draft in flight.
+// check runs under the same lock, so two retries of one draft can never
+// both pass it. It returns the attempt number, or 0 and the event detail
+// and reason when a retry of the draft is in flight, check blocks, or the
+// chain has used MaxRetriesPerEnvelope.
+func (t *retryTracker) reserve(envelopeID string, draftID draft.DraftID, check func() (detail, reason string)) (int, string, string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.inFlight[draftID] {
+		return 0, "retry_in_progress", "a retry of this draft is already in progress"
+	}
+	if detail, reason := check(); detail != "" {
+		return 0, detail, reason
+	}
+	root := t.rootLocked(envelopeID)
+	if t.attempts[root] >= MaxRetriesPerEnvelope {
+		return 0, "retry_limit_reached", fmt.Sprintf("retry limit of %d reached", MaxRetriesPerEnvelope)
+	}
+	t.attempts[root]++
+	t.inFlight[draftID] = true
+	return t.attempts[root], "", ""
+}
+
+// release gives back a reserved retry that never reached a boundary.
+func (t *retryTracker) release(envelopeID string, draftID draft.DraftID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	root := t.rootLocked(envelopeID)
+	if t.attempts[root] > 0 {
+		t.attempts[root]--
+	}
+	delete(t.inFlight, draftID)
+}
+
+// link records that retryEnvelopeID retried envelopeID and ends the
+// draft's retry.
+func (t *retryTracker) link(envelopeID, retryEnvelopeID string, draftID draft.DraftID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.roots[retryEnvelopeID] = t.rootLocked(envelopeID)
+	delete(t.inFlight, draftID)
+}
+
+// clear forgets every chain.
+func (t *retryTracker) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.roots = make(map[string]string)
+	t.attempts = make(map[string]int)
+	t.inFlight = make(map[draft.DraftID]bool)
+}
+
+// RetryFailed executes intent again for a failed envelope of its draft.
+//
+// Only envelopes with status failed are retried, and only while no other
+// envelope of the draft has succeeded or is pending and no other retry of
+// the draft is in flight. The retry bypasses
+// the idempotency window and runs under a fresh trace ID, so the boundary
+// executor builds a new envelope with a new idempotency key instead of
+// returning the failed one. Each chain of retries is capped at
+// MaxRetriesPerEnvelope.
+//
+// CRITICAL: Only ever called on an explicit user request. No auto-retries.
+func (e *Executor) RetryFailed(ctx context.Context, envelopeID string, intent *execintent.ExecutionIntent, traceID string) ExecutionOutcome {
+	now := e.clock.Now()
+	blocked := func(detail, reason string) ExecutionOutcome {
+		e.emitEvent(events.Phase10ExecutionBlocked, intent, detail)
+		return ExecutionOutcome{
+			IntentID:      intent.IntentID,
+			Success:       false,
+			Blocked:       true,
+			BlockedReason: reason,
+			ExecutedAt:    now,
+			RetryOf:       envelopeID,
+		}
+	}
+
+	failed, found := e.HistoryEntry(envelopeID)
+	switch {
+	case !found:
+		return blocked("retry_not_found", "envelope not found")
+	case failed.Status != HistoryFailed:
+		return blocked("retry_not_failed", fmt.Sprintf("only failed executions can be retried; envelope is %s", failed.Status))
+	case failed.DraftID != intent.DraftID:
+		return blocked("retry_draft_mismatch", "intent is for a different draft than the envelope")
+	}
+	attempt, detail, reason := e.retries.reserve(envelopeID, failed.DraftID, func() (string, string) {
+		for _, entry := range e.History(HistoryFilter{}) {
+			if entry.DraftID != failed.DraftID {
+				continue
+			}
+			if entry.Status == HistorySucceeded || entry.Status == HistoryPending {
+				return "retry_draft_executed", fmt.Sprintf("draft already has a %s execution", entry.Status)
+			}
+		}
+		return "", ""
+	})
+	if attempt == 0 {
+		return blocked(detail, reason)
+	}
+
+	e.idempotency.forget(intent.DraftID, now)
+	retryTraceID := fmt.Sprintf("%s-retry-%s-%d", traceID, envelopeID, attempt)
+	outcome := e.ExecuteIntent(ctx, intent, retryTraceID)
+	outcome.RetryOf = envelopeID
+	outcome.RetryAttempt = attempt
+
+	// Blocked retries and dry runs never reached a boundary executor.
+	if outcome.Blocked || outcome.DryRun || outcome.EnvelopeID == "" {
+		e.retries.release(envelopeID, failed.DraftID)
+		return outcome
+	}
+	e.retries.link(envelopeID, outcome.EnvelopeID, failed.DraftID)
+	e.emitRetried(intent, envelopeID, outcome.EnvelopeID, attempt)
+	return outcome
+}
+
+// HistoryEntry returns the history entry for an envelope.
+func (e *Executor) HistoryEntry(envelopeID string) (HistoryEntry, bool) {
+	for _, entry := range e.History(HistoryFilter{}) {
+		if entry.EnvelopeID == envelopeID {
+			return entry, true
+		}
+	}
+	return HistoryEntry{}, false
+}
+
+// emitRetried emits the event linking a failed envelope to its retry.
+func (e *Executor) emitRetried(intent *execintent.ExecutionIntent, retryOf, envelopeID string, attempt int) {
+	if e.emitter == nil {
+		return
+	}
+
+	e.emitter.Emit(events.Event{
+		Type:      events.Phase10ExecutionRetried,
+		Timestamp: e.clock.Now(),
+		CircleID:  intent.CircleID,
+		SubjectID: envelopeID,
+		Metadata: events.NewSafeMetadata().
+			ID("intent_id", string(intent.IntentID)).
+			ID("draft_id", string(intent.DraftID)).
+			ID("circle_id", intent.CircleID).
+			Label("action", string(intent.Action)).
+			ID("retry_of", retryOf).
+			ID("envelope_id", envelopeID).
+			Magnitude("attempt", string(shadowllm.MagnitudeFromCount(attempt))).
+			Map(),
+	})
+}
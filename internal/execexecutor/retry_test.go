@@ -0,0 +1,217 @@
+package execexecutor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/draft"
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/events"
+)
+
+// retryEmailExecutor stores every envelope it executes with status.
+type retryEmailExecutor struct {
+	status    emailexec.EnvelopeStatus
+	envelopes []emailexec.Envelope
+}
+
+func (m *retryEmailExecutor) Execute(ctx context.Context, envelope emailexec.Envelope) (*emailexec.Envelope, error) {
+	envelope.Status = m.status
+	m.envelopes = append(m.envelopes, envelope)
+	return &envelope, nil
+}
+
+func (m *retryEmailExecutor) ListEnvelopes(filter emailexec.ListFilter) []emailexec.Envelope {
+	return m.envelopes
+}
+
+func TestExecutor_RetryFailed(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	writer := &retryEmailExecutor{status: emailexec.EnvelopeStatusFailed}
+	emitter := &mockEmitter{}
+	executor := NewExecutor(clock.NewFixed(now), emitter).WithEmailExecutor(writer)
+	intent := idempotencyEmailIntent("draft-retry", now)
+
+	first := executor.ExecuteIntent(context.Background(), intent, "trace-1")
+	if first.Success || first.EnvelopeID == "" {
+		t.Fatalf("expected a failed envelope, got %+v", first)
+	}
+
+	// The writer recovers; the retry runs despite the idempotency window.
+	writer.status = emailexec.EnvelopeStatusExecuted
+	retry := executor.RetryFailed(context.Background(), first.EnvelopeID, intent, "trace-2")
+	if !retry.Success || retry.Replayed {
+		t.Fatalf("expected the retry to execute and succeed, got %+v", retry)
+	}
+	if retry.EnvelopeID == first.EnvelopeID || retry.RetryOf != first.EnvelopeID || retry.RetryAttempt != 1 {
+		t.Errorf("expected a new envelope retrying %s as attempt 1, got %+v", first.EnvelopeID, retry)
+	}
+	if len(writer.envelopes) != 2 || writer.envelopes[0].IdempotencyKey == writer.envelopes[1].IdempotencyKey {
+		t.Fatalf("expected a second envelope with a fresh idempotency key, got %d envelopes", len(writer.envelopes))
+	}
+
+	var retried *events.Event
+	for i := range emitter.events {
+		if emitter.events[i].Type == events.Phase10ExecutionRetried {
+			retried = &emitter.events[i]
+		}
+	}
+	if retried == nil {
+		t.Fatal("expected a retried event")
+	}
+	if retried.Metadata["retry_of"] != first.EnvelopeID || retried.Metadata["envelope_id"] != retry.EnvelopeID || retried.Metadata["attempt"] != string(shadowllm.MagnitudeFromCount(1)) {
+		t.Errorf("retried event metadata = %v", retried.Metadata)
+	}
+
+	// Neither the succeeded envelope nor the failed one can be retried now.
+	for _, envelopeID := range []string{retry.EnvelopeID, first.EnvelopeID} {
+		if outcome := executor.RetryFailed(context.Background(), envelopeID, intent, "trace-3"); !outcome.Blocked {
+			t.Errorf("retry of %s: expected blocked, got %+v", envelopeID, outcome)
+		}
+	}
+	if len(writer.envelopes) != 2 {
+		t.Errorf("blocked retries must not reach the writer, got %d envelopes", len(writer.envelopes))
+	}
+}
+
+func TestExecutor_RetryFailed_Capped(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	writer := &retryEmailExecutor{status: emailexec.EnvelopeStatusFailed}
+	executor := NewExecutor(clock.NewFixed(now), nil).WithEmailExecutor(writer)
+	intent := idempotencyEmailIntent("draft-capped", now)
+
+	failed := executor.ExecuteIntent(context.Background(), intent, "trace-1").EnvelopeID
+	first := failed
+
+	// Retries of retries count against the first failed envelope.
+	for attempt := 1; attempt <= MaxRetriesPerEnvelope; attempt++ {
+		outcome := executor.RetryFailed(context.Background(), failed, intent, "trace-retry")
+		if outcome.Blocked || outcome.RetryAttempt != attempt {
+			t.Fatalf("attempt %d: got %+v", attempt, outcome)
+		}
+		failed = outcome.EnvelopeID
+	}
+	for _, envelopeID := range []string{failed, first} {
+		if outcome := executor.RetryFailed(context.Background(), envelopeID, intent, "trace-retry"); !outcome.Blocked {
+			t.Errorf("retry of %s beyond the cap: expected blocked, got %+v", envelopeID, outcome)
+		}
+	}
+	if want := 1 + MaxRetriesPerEnvelope; len(writer.envelopes) != want {
+		t.Errorf("expected %d writes, got %d", want, len(writer.envelopes))
+	}
+}
+
+func TestExecutor_RetryFailed_Guards(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	writer := &retryEmailExecutor{status: emailexec.EnvelopeStatusFailed}
+	executor := NewExecutor(clock.NewFixed(now), nil).WithEmailExecutor(writer)
+	intent := idempotencyEmailIntent("draft-a", now)
+	failed := executor.ExecuteIntent(context.Background(), intent, "trace-1").EnvelopeID
+
+	tests := []struct {
+		name       string
+		envelopeID string
+		draftID    draft.DraftID
+	}{
+		{"unknown envelope", "env-missing", "draft-a"},
+		{"other draft", failed, "draft-b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome := executor.RetryFailed(context.Background(), tt.envelopeID, idempotencyEmailIntent(tt.draftID, now), "trace-2")
+			if !outcome.Blocked {
+				t.Errorf("expected blocked, got %+v", outcome)
+			}
+		})
+	}
+	if len(writer.envelopes) != 1 {
+		t.Errorf("blocked retries must not reach the writer, got %d envelopes", len(writer.envelopes))
+	}
+
+	// Dry-run retries reach no writer and use up no attempts.
+	executor.SetDryRun(true)
+	for i := 0; i < MaxRetriesPerEnvelope+1; i++ {
+		if outcome := executor.RetryFailed(context.Background(), failed, intent, "trace-dry"); !outcome.DryRun {
+			t.Fatalf("expected a dry run, got %+v", outcome)
+		}
+	}
+	executor.SetDryRun(false)
+	if outcome := executor.RetryFailed(context.Background(), failed, intent, "trace-3"); outcome.Blocked || outcome.RetryAttempt != 1 {
+		t.Errorf("expected dry runs not to count, got %+v", outcome)
+	}
+}
+
+// heldEmailExecutor fails every envelope. Once hold is set, the next
+// Execute signals entered and waits for proceed before storing its envelope.
+type heldEmailExecutor struct {
+	mu        sync.Mutex
+	envelopes []emailexec.Envelope
+	hold      bool
+	entered   chan struct{}
+	proceed   chan struct{}
+}
+
+func (m *heldEmailExecutor) Execute(ctx context.Context, envelope emailexec.Envelope) (*emailexec.Envelope, error) {
+	m.mu.Lock()
+	hold := m.hold
+	m.hold = false
+	m.mu.Unlock()
+	if hold {
+		m.entered <- struct{}{}
+		<-m.proceed
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	envelope.Status = emailexec.EnvelopeStatusFailed
+	m.envelopes = append(m.envelopes, envelope)
+	return &envelope, nil
+}
+
+func (m *heldEmailExecutor) ListEnvelopes(filter emailexec.ListFilter) []emailexec.Envelope {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]emailexec.Envelope(nil), m.envelopes...)
+}
+
+func TestExecutor_RetryFailed_Concurrent(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	writer := &heldEmailExecutor{entered: make(chan struct{}), proceed: make(chan struct{})}
+	// Without the idempotency cache only the retry tracker keeps two
+	// retries of one draft apart.
+	executor := NewExecutor(clock.NewFixed(now), nil).WithEmailExecutor(writer).WithIdempotencyWindow(0)
+	intent := idempotencyEmailIntent("draft-concurrent", now)
+	failed := executor.ExecuteIntent(context.Background(), intent, "trace-1").EnvelopeID
+
+	writer.hold = true
+	var first ExecutionOutcome
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		first = executor.RetryFailed(context.Background(), failed, intent, "trace-a")
+	}()
+	<-writer.entered
+
+	// The first retry is at the boundary; a second one must not pass.
+	if second := executor.RetryFailed(context.Background(), failed, intent, "trace-b"); !second.Blocked {
+		t.Errorf("expected the concurrent retry to be blocked, got %+v", second)
+	}
+	close(writer.proceed)
+	<-done
+
+	if first.Blocked || first.RetryAttempt != 1 {
+		t.Errorf("expected the first retry to run as attempt 1, got %+v", first)
+	}
+	if got := len(writer.ListEnvelopes(emailexec.ListFilter{})); got != 2 {
+		t.Errorf("expected one retry to reach the writer, got %d envelopes", got)
+	}
+
+	// Once it has finished, the next retry runs.
+	if next := executor.RetryFailed(context.Background(), first.EnvelopeID, intent, "trace-c"); next.Blocked || next.RetryAttempt != 2 {
+		t.Errorf("expected a later retry to run as attempt 2, got %+v", next)
+	}
+}
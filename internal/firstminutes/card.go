@@ -0,0 +1,112 @@
+package firstminutes
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"quantumlife/pkg/domain/firstminutes"
+)
+
+// Card dimensions in SVG user units.
+const (
+	cardWidth  = 480
+	cardHeight = 280
+	cardCells  = 8
+	cardCell   = 12
+)
+
+// cardShades are the calm tones used for the hash pattern.
+var cardShades = []string{"#e8ecef", "#d5dde3", "#bfcad3", "#a9b8c4"}
+
+// RenderCardSVG renders the summary as a static, shareable SVG card.
+//
+// The card is derived ONLY from the period, signal buckets, calm line and
+// status hash, so identical summaries always produce identical bytes.
+// No identifiers, no external assets, no scripts.
+func RenderCardSVG(summary *firstminutes.FirstMinutesSummary) []byte {
+	if summary == nil {
+		return nil
+	}
+
+	signals := make([]firstminutes.FirstMinutesSignal, len(summary.Signals))
+	copy(signals, summary.Signals)
+	sort.Slice(signals, func(i, j int) bool {
+		return string(signals[i].Kind) < string(signals[j].Kind)
+	})
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, cardWidth, cardHeight, cardWidth, cardHeight)
+	b.WriteString("\n")
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" rx="12" fill="#f7f8f9"/>`, cardWidth, cardHeight)
+	b.WriteString("\n")
+
+	writeCardPattern(&b, summary.StatusHash)
+
+	writeCardText(&b, 24, 40, 13, "#8a949c", "First minutes")
+	writeCardText(&b, 24, 64, 11, "#8a949c", string(summary.Period))
+	writeCardText(&b, 24, 104, 16, "#3c4650", summary.CalmLine)
+
+	y := 136
+	for _, sig := range signals {
+		writeCardText(&b, 24, y, 11, "#5f6a73", signalLabel(sig))
+		y += 16
+	}
+
+	hashPrefix := summary.StatusHash
+	if len(hashPrefix) > 8 {
+		hashPrefix = hashPrefix[:8]
+	}
+	writeCardText(&b, 24, cardHeight-16, 10, "#a0a8ae", hashPrefix)
+
+	b.WriteString("</svg>\n")
+	return b.Bytes()
+}
+
+// writeCardPattern draws a symmetric grid derived from the status hash.
+func writeCardPattern(b *bytes.Buffer, statusHash string) {
+	raw, err := hex.DecodeString(statusHash)
+	if err != nil || len(raw) == 0 {
+		return
+	}
+
+	originX := cardWidth - 24 - cardCells*cardCell
+	originY := 24
+	half := cardCells / 2
+	for row := 0; row < cardCells; row++ {
+		for col := 0; col < half; col++ {
+			v := raw[(row*half+col)%len(raw)]
+			if v&0x01 == 0 {
+				continue
+			}
+			shade := cardShades[int(v>>1)%len(cardShades)]
+			for _, c := range []int{col, cardCells - 1 - col} {
+				fmt.Fprintf(b, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`,
+					originX+c*cardCell, originY+row*cardCell, cardCell, cardCell, shade)
+				b.WriteString("\n")
+			}
+		}
+	}
+}
+
+// writeCardText writes an escaped text element.
+func writeCardText(b *bytes.Buffer, x, y, size int, fill, text string) {
+	fmt.Fprintf(b, `<text x="%d" y="%d" font-family="sans-serif" font-size="%d" fill="%s">`, x, y, size, fill)
+	_ = xml.EscapeText(b, []byte(text))
+	b.WriteString("</text>\n")
+}
+
+// signalLabel returns the abstract label for a signal.
+func signalLabel(sig firstminutes.FirstMinutesSignal) string {
+	label := strings.ReplaceAll(string(sig.Kind), "_", " ")
+	switch sig.Magnitude {
+	case firstminutes.MagnitudeAFew:
+		label += " · a few"
+	case firstminutes.MagnitudeSeveral:
+		label += " · several"
+	}
+	return label
+}
@@ -103,13 +103,10 @@ func (e *Engine) ComputeSummary(inputs *firstminutes.FirstMinutesInputs) *firstm
 
 	// If dismissed with matching state, return nil
 	if inputs.DismissedSummaryHash != "" {
-		// Compute what the current hash would be
+		// Compute the summary the dismissal has to match
 		testSummary := e.computeSummaryInternal(inputs)
-		if testSummary != nil {
-			testSummary.StatusHash = testSummary.ComputeStatusHash()
-			if testSummary.StatusHash == inputs.DismissedSummaryHash {
-				return nil // Already dismissed
-			}
+		if testSummary != nil && testSummary.MatchesStatusHash(inputs.DismissedSummaryHash) {
+			return nil // Already dismissed
 		}
 	}
 
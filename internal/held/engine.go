@@ -37,8 +37,18 @@ func (e *Engine) Generate(input HeldInput) HeldSummary {
 	// Compute total held count (abstract)
 	totalHeld := input.SuppressedObligationCount + input.PolicyBlockedCount
 
+	// Per-category counts sum to the aggregate by construction
+	counts := categoryCounts(input)
+	if len(input.HeldByCategory) > 0 {
+		totalHeld = 0
+		for _, n := range counts {
+			totalHeld += n
+		}
+	}
+
 	// Determine magnitude (bucketed, never specific)
 	summary.Magnitude = computeMagnitude(totalHeld)
+	summary.CategoryMagnitudes = buildCategoryMagnitudes(counts)
 
 	// Select statement based on magnitude
 	summary.Statement = statements[summary.Magnitude]
@@ -69,6 +79,36 @@ func computeMagnitude(count int) string {
 	}
 }
 
+// allCategories lists categories in alphabetical order.
+var allCategories = []Category{CategoryHome, CategoryMoney, CategoryPeople, CategoryTime, CategoryWork}
+
+// categoryCounts returns held counts per category from HeldByCategory.
+// Without per-category counts it returns none: the aggregate alone says
+// nothing about how held items split across categories.
+func categoryCounts(input HeldInput) map[Category]int {
+	counts := make(map[Category]int)
+	for _, c := range allCategories {
+		if n := input.HeldByCategory[c]; n > 0 {
+			counts[c] = n
+		}
+	}
+	return counts
+}
+
+// buildCategoryMagnitudes buckets per-category counts in alphabetical order.
+func buildCategoryMagnitudes(counts map[Category]int) []CategoryMagnitude {
+	var result []CategoryMagnitude
+	for _, c := range allCategories {
+		if n := counts[c]; n > 0 {
+			result = append(result, CategoryMagnitude{
+				Category:  c,
+				Magnitude: computeMagnitude(n),
+			})
+		}
+	}
+	return result
+}
+
 // buildCategories constructs abstract category summaries.
 func (e *Engine) buildCategories(input HeldInput) []CategorySummary {
 	var categories []CategorySummary
@@ -155,6 +195,16 @@ func EmptyInput() HeldInput {
 	}
 }
 
+// MagnitudeDisplayName returns a human-friendly magnitude label.
+func MagnitudeDisplayName(m string) string {
+	switch m {
+	case "a_few":
+		return "a few"
+	default:
+		return m
+	}
+}
+
 // CategoryDisplayName returns a human-friendly name for a category.
 func CategoryDisplayName(c Category) string {
 	switch c {
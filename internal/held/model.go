@@ -20,6 +20,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // Category represents an abstract held category.
@@ -87,6 +89,21 @@ type CategorySummary struct {
 	PrimaryReason ReasonHeld
 }
 
+// CategoryMagnitude is the bucketed magnitude of held items in one category.
+// CRITICAL: Magnitude is a bucket, never a count.
+type CategoryMagnitude struct {
+	// Category is the abstract category.
+	Category Category
+
+	// Magnitude is a bucketed indicator: "a_few", "several".
+	Magnitude string
+}
+
+// Label returns the chip label, e.g. "Money: a few".
+func (c CategoryMagnitude) Label() string {
+	return CategoryDisplayName(c.Category) + ": " + MagnitudeDisplayName(c.Magnitude)
+}
+
 // HeldSummary is the deterministic projection shown to the person.
 // CRITICAL: No counts tied to specific items.
 // CRITICAL: No identifiers, names, vendors, or entities.
@@ -102,6 +119,12 @@ type HeldSummary struct {
 	// Never a specific number.
 	Magnitude string
 
+	// CategoryMagnitudes is the per-category magnitude map for the period,
+	// in alphabetical category order. Categories with nothing held are omitted.
+	// Counts behind these buckets sum to the count behind Magnitude. Empty
+	// when the input has no per-category counts.
+	CategoryMagnitudes []CategoryMagnitude
+
 	// Hash is the deterministic hash of this summary.
 	Hash string
 
@@ -136,6 +159,11 @@ type HeldInput struct {
 	// HasHomeItems indicates home-related items exist.
 	HasHomeItems bool
 
+	// HeldByCategory optionally gives held counts per category.
+	// When set, it replaces the Has*Items flags and the aggregate is its sum.
+	// When empty, the summary has no per-category magnitudes.
+	HeldByCategory map[Category]int
+
 	// CircleID is the circle context (for store records).
 	CircleID string
 
@@ -158,6 +186,12 @@ func (h *HeldInput) Hash() string {
 		h.CircleID,
 		h.Now.Format(time.RFC3339),
 	)
+	// Per-category counts, in alphabetical order, only when set
+	for _, c := range allCategories {
+		if n := h.HeldByCategory[c]; n > 0 {
+			canonical += fmt.Sprintf("|held_%s:%d", c, n)
+		}
+	}
 	hash := sha256.Sum256([]byte(canonical))
 	return hex.EncodeToString(hash[:])
 }
@@ -176,11 +210,14 @@ func (s *HeldSummary) ComputeHash() string {
 	sort.Strings(cats)
 	parts = append(parts, cats...)
 
+	for _, cm := range s.CategoryMagnitudes {
+		parts = append(parts, fmt.Sprintf("magnitude:%s:%s", cm.Category, cm.Magnitude))
+	}
+
 	parts = append(parts, s.GeneratedAt.Format(time.RFC3339))
 
 	canonical := strings.Join(parts, "|")
-	hash := sha256.Sum256([]byte(canonical))
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("held.HeldSummary", canonical)
 }
 
 // SummaryRecord is what gets stored (hash only, no data).
@@ -0,0 +1,31 @@
+package held
+
+import (
+	"quantumlife/internal/obligations"
+	"quantumlife/pkg/domain/obligation"
+)
+
+// InputFromObligations builds a held input from the obligations a loop run
+// held rather than interrupted for (see loop.RunResult.HeldObligations).
+// Only per-category counts are kept; IDs, sources and content never leave
+// this function. Counts do not depend on obligation order, so the summary
+// hash is stable for a given obligation set.
+func InputFromObligations(held []*obligation.Obligation) HeldInput {
+	input := HeldInput{
+		HeldByCategory: make(map[Category]int),
+	}
+	for _, obl := range held {
+		if obl == nil {
+			continue
+		}
+		input.HeldByCategory[Category(obligations.CategoryOf(obl))]++
+		input.SuppressedObligationCount++
+	}
+
+	input.HasHomeItems = input.HeldByCategory[CategoryHome] > 0
+	input.HasMoneyItems = input.HeldByCategory[CategoryMoney] > 0
+	input.HasPeopleItems = input.HeldByCategory[CategoryPeople] > 0
+	input.HasTimeItems = input.HeldByCategory[CategoryTime] > 0
+	input.HasWorkItems = input.HeldByCategory[CategoryWork] > 0
+	return input
+}
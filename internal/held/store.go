@@ -185,3 +185,10 @@ func (s *SummaryStore) VerifyReplay(hash string) bool {
 	}
 	return false
 }
+
+// Clear removes all summary records.
+func (s *SummaryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]SummaryRecord, 0)
+}
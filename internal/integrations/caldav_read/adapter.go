@@ -0,0 +1,261 @@
+// Package caldav_read provides a read-only adapter for CalDAV calendars
+// (Fastmail, Nextcloud and other app-password servers).
+//
+// CRITICAL: This adapter is READ-ONLY. It only issues REPORT requests.
+// CRITICAL: Events are abstracted like gcal_read.FetchUpcoming: title, times,
+// attendee count and our own RSVP status. No attendee identities.
+// CRITICAL: Credentials come only from auth.TokenBroker and are never logged.
+//
+// Reference: docs/INTEGRATIONS_MATRIX_V1.md
+package caldav_read
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	gcalread "quantumlife/internal/integrations/gcal_read"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/events"
+	"quantumlife/pkg/hashutil"
+)
+
+// Vendor is the vendor name on every event this adapter produces.
+const Vendor = "caldav"
+
+// Bounded sync limits, shared with Google Calendar.
+const (
+	WindowDays = gcalread.UpcomingWindowDays
+	MaxEvents  = gcalread.MaxUpcomingEvents
+)
+
+// maxResponseBytes bounds the REPORT response read from the server.
+const maxResponseBytes = 4 << 20
+
+// ErrInvalidCredentials is returned for incomplete or non-HTTPS credentials.
+var ErrInvalidCredentials = errors.New("invalid caldav credentials")
+
+// Credentials identify a CalDAV calendar collection and its app password.
+// SENSITIVE: Only ever stored through auth.TokenBroker as an opaque secret.
+type Credentials struct {
+	// ServerURL is the calendar collection URL. Must be HTTPS.
+	ServerURL string `json:"server_url"`
+
+	// Username is the account login, also used to find our own RSVP.
+	Username string `json:"username"`
+
+	// AppPassword is a server-issued app password, never the account password.
+	AppPassword string `json:"app_password"`
+}
+
+// Validate checks the credentials are complete and use HTTPS.
+func (c Credentials) Validate() error {
+	if c.ServerURL == "" || c.Username == "" || c.AppPassword == "" {
+		return fmt.Errorf("%w: missing field", ErrInvalidCredentials)
+	}
+	u, err := url.Parse(c.ServerURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: bad server url", ErrInvalidCredentials)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("%w: server url must be https", ErrInvalidCredentials)
+	}
+	return nil
+}
+
+// Secret encodes the credentials as the opaque broker secret.
+func (c Credentials) Secret() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// ParseSecret decodes a broker secret produced by Credentials.Secret.
+func ParseSecret(secret string) (Credentials, error) {
+	var c Credentials
+	if err := json.Unmarshal([]byte(secret), &c); err != nil {
+		return Credentials{}, fmt.Errorf("%w: undecodable secret", ErrInvalidCredentials)
+	}
+	if err := c.Validate(); err != nil {
+		return Credentials{}, err
+	}
+	return c, nil
+}
+
+// TokenMinter mints access tokens for read-only operations.
+// This interface allows injection for testing.
+type TokenMinter interface {
+	MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error)
+}
+
+// RealAdapter reads a CalDAV calendar collection over HTTP.
+type RealAdapter struct {
+	broker     TokenMinter
+	httpClient *http.Client
+	clock      clock.Clock
+	circleID   string
+}
+
+// NewRealAdapter creates a new CalDAV adapter for a circle.
+func NewRealAdapter(broker TokenMinter, clk clock.Clock, circleID string) *RealAdapter {
+	return NewRealAdapterWithClient(broker, &http.Client{Timeout: 30 * time.Second}, clk, circleID)
+}
+
+// NewRealAdapterWithClient creates a CalDAV adapter with a custom HTTP client (for testing).
+func NewRealAdapterWithClient(broker TokenMinter, httpClient *http.Client, clk clock.Clock, circleID string) *RealAdapter {
+	return &RealAdapter{
+		broker:     broker,
+		httpClient: httpClient,
+		clock:      clk,
+		circleID:   circleID,
+	}
+}
+
+func (a *RealAdapter) Name() string {
+	return "caldav_real"
+}
+
+// FetchEvents retrieves at most max events (capped at MaxEvents) starting
+// within WindowDays of since, abstracted like gcal_read.FetchUpcoming.
+// Recurring events are expanded by the server into instances.
+// CRITICAL: Single REPORT request. No retries.
+func (a *RealAdapter) FetchEvents(since time.Time, max int) ([]*events.CalendarEventEvent, error) {
+	if max <= 0 || max > MaxEvents {
+		max = MaxEvents
+	}
+
+	ctx := context.Background()
+
+	// Mint read-only secret
+	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderCalDAV, []string{"calendar:read"})
+	if err != nil {
+		return nil, fmt.Errorf("mint token: %w", err)
+	}
+	creds, err := ParseSecret(token.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	from := since.UTC()
+	to := from.AddDate(0, 0, WindowDays)
+
+	payloads, err := a.calendarQuery(ctx, creds, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("calendar query: %w", err)
+	}
+
+	var vevents []vevent
+	for _, p := range payloads {
+		vevents = append(vevents, parseVEvents(p, creds.Username)...)
+	}
+
+	// Keep only events starting in the window; order deterministically
+	inWindow := vevents[:0]
+	for _, v := range vevents {
+		if !v.start.Before(from) && v.start.Before(to) {
+			inWindow = append(inWindow, v)
+		}
+	}
+	sort.SliceStable(inWindow, func(i, j int) bool {
+		if !inWindow[i].start.Equal(inWindow[j].start) {
+			return inWindow[i].start.Before(inWindow[j].start)
+		}
+		return inWindow[i].instanceID() < inWindow[j].instanceID()
+	})
+	if len(inWindow) > max {
+		inWindow = inWindow[:max]
+	}
+
+	now := a.clock.Now()
+	calendarID := hashutil.HashString("caldav.calendar", creds.ServerURL)[:16]
+	result := make([]*events.CalendarEventEvent, 0, len(inWindow))
+	for _, v := range inWindow {
+		result = append(result, v.toCanonical(calendarID, now))
+	}
+	return result, nil
+}
+
+// calendarQueryBody is a CalDAV calendar-query REPORT (RFC 4791 §7.8).
+const calendarQueryBody = `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop>
+    <C:calendar-data><C:expand start="%[1]s" end="%[2]s"/></C:calendar-data>
+  </D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT">
+        <C:time-range start="%[1]s" end="%[2]s"/>
+      </C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`
+
+// davMultistatus is the REPORT response. Element names match any namespace.
+type davMultistatus struct {
+	Responses []struct {
+		Propstats []struct {
+			Status string `xml:"status"`
+			Prop   struct {
+				CalendarData string `xml:"calendar-data"`
+			} `xml:"prop"`
+		} `xml:"propstat"`
+	} `xml:"response"`
+}
+
+// calendarQuery issues the REPORT and returns the iCalendar payloads.
+func (a *RealAdapter) calendarQuery(ctx context.Context, creds Credentials, from, to time.Time) ([]string, error) {
+	body := fmt.Sprintf(calendarQueryBody, from.Format(icalUTCLayout), to.Format(icalUTCLayout))
+
+	req, err := http.NewRequestWithContext(ctx, "REPORT", creds.ServerURL, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(creds.Username, creds.AppPassword)
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	req.Header.Set("Depth", "1")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		// Drop the URL from transport errors; it may identify the account
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Never echo the body - servers may reflect credentials or URLs
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, fmt.Errorf("caldav server error: %d", resp.StatusCode)
+	}
+
+	var ms davMultistatus
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&ms); err != nil {
+		return nil, fmt.Errorf("decode multistatus: %w", err)
+	}
+
+	var payloads []string
+	for _, r := range ms.Responses {
+		for _, ps := range r.Propstats {
+			if ps.Prop.CalendarData != "" && strings.Contains(ps.Status, " 200 ") {
+				payloads = append(payloads, ps.Prop.CalendarData)
+			}
+		}
+	}
+	return payloads, nil
+}
@@ -0,0 +1,141 @@
+// Package caldav_read provides a read-only adapter for CalDAV calendars.
+// This file contains tests against a stub CalDAV server.
+package caldav_read
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	"quantumlife/internal/connectors/auth/impl_inmem"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/events"
+)
+
+// stubCalendar returns a VEVENT starting offset hours after base.
+func stubCalendar(base time.Time, i int, extra string) string {
+	start := base.Add(time.Duration(i) * time.Hour).UTC()
+	return fmt.Sprintf("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:evt-%03d\r\nSUMMARY:Planning\\, round %d\r\n"+
+		"DTSTART:%s\r\nDTEND:%s\r\n%sEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		i, i, start.Format(icalUTCLayout), start.Add(time.Hour).Format(icalUTCLayout), extra)
+}
+
+func newStubServer(t *testing.T, calendars []string, gotAuth *string, gotMethod *string) *httptest.Server {
+	t.Helper()
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*gotMethod = r.Method
+		user, pass, _ := r.BasicAuth()
+		*gotAuth = user + ":" + pass
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "calendar-query") {
+			t.Errorf("expected calendar-query REPORT body")
+		}
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, `<?xml version="1.0"?><d:multistatus xmlns:d="DAV:" xmlns:cal="urn:ietf:params:xml:ns:caldav">`)
+		for i, c := range calendars {
+			fmt.Fprintf(w, `<d:response><d:href>/cal/%d.ics</d:href><d:propstat><d:prop><cal:calendar-data><![CDATA[%s]]></cal:calendar-data></d:prop><d:status>HTTP/1.1 200 OK</d:status></d:propstat></d:response>`, i, c)
+		}
+		fmt.Fprint(w, `</d:multistatus>`)
+	}))
+}
+
+func connect(t *testing.T, broker *impl_inmem.Broker, serverURL string) {
+	t.Helper()
+	secret, err := Credentials{ServerURL: serverURL, Username: "me@example.com", AppPassword: "app-pass"}.Secret()
+	if err != nil {
+		t.Fatalf("Secret failed: %v", err)
+	}
+	handle, err := broker.ExchangeCodeForCircle(context.Background(), "circle-1", auth.ProviderCalDAV, secret, "")
+	if err != nil {
+		t.Fatalf("store secret failed: %v", err)
+	}
+	if len(handle.Scopes) != 1 || handle.Scopes[0] != "calendar:read" {
+		t.Fatalf("expected calendar:read only, got %v", handle.Scopes)
+	}
+}
+
+func TestRealAdapter_FetchEventsAbstractAndBounded(t *testing.T) {
+	now := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	attendees := "ATTENDEE;CN=Alice;PARTSTAT=ACCEPTED:mailto:alice@example.com\r\n" +
+		"ATTENDEE;PARTSTAT=TENTATIVE:mailto:ME@example.com\r\n" +
+		"ORGANIZER;CN=Alice:mailto:alice@example.com\r\nLOCATION:Room 4\r\n"
+
+	var calendars []string
+	// Out of window: before since and after the 30-day window
+	calendars = append(calendars, stubCalendar(now, -2, ""))
+	calendars = append(calendars, stubCalendar(now, 24*(WindowDays+1), ""))
+	// 40 in-window events, listed in reverse to exercise ordering
+	for i := 40; i >= 1; i-- {
+		extra := ""
+		if i == 1 {
+			extra = attendees
+		}
+		calendars = append(calendars, stubCalendar(now, i, extra))
+	}
+
+	var gotAuth, gotMethod string
+	srv := newStubServer(t, calendars, &gotAuth, &gotMethod)
+	defer srv.Close()
+
+	broker := impl_inmem.NewBroker(auth.Config{}, nil)
+	connect(t, broker, srv.URL+"/cal/")
+
+	adapter := NewRealAdapterWithClient(broker, srv.Client(), clock.NewFixed(now), "circle-1")
+	got, err := adapter.FetchEvents(now, 100)
+	if err != nil {
+		t.Fatalf("FetchEvents failed: %v", err)
+	}
+
+	if gotMethod != "REPORT" {
+		t.Errorf("expected REPORT, got %s", gotMethod)
+	}
+	if gotAuth != "me@example.com:app-pass" {
+		t.Errorf("expected app password basic auth")
+	}
+	if len(got) != MaxEvents {
+		t.Fatalf("expected %d events, got %d", MaxEvents, len(got))
+	}
+
+	first := got[0]
+	if first.EventUID != "evt-001" || first.Title != "Planning, round 1" {
+		t.Errorf("expected earliest in-window event first, got %s %q", first.EventUID, first.Title)
+	}
+	if first.AttendeeCount != 2 || first.MyResponseStatus != events.RSVPTentative {
+		t.Errorf("expected 2 attendees and tentative RSVP, got %d %s", first.AttendeeCount, first.MyResponseStatus)
+	}
+	if first.Attendees != nil || first.Organizer != nil || first.Location != "" || first.AccountEmail != Vendor {
+		t.Errorf("expected identities stripped, got %+v", first)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].StartTime.Before(got[i-1].StartTime) {
+			t.Fatalf("events not ordered by start time")
+		}
+	}
+}
+
+func TestRealAdapter_FetchEventsRequiresConnection(t *testing.T) {
+	broker := impl_inmem.NewBroker(auth.Config{}, nil)
+	adapter := NewRealAdapter(broker, clock.NewFixed(time.Now()), "circle-1")
+
+	if _, err := adapter.FetchEvents(time.Now(), 0); !errors.Is(err, auth.ErrNoToken) {
+		t.Errorf("expected ErrNoToken, got %v", err)
+	}
+}
+
+func TestCredentials_RequireHTTPS(t *testing.T) {
+	creds := Credentials{ServerURL: "http://dav.example.com/cal/", Username: "me", AppPassword: "pw"}
+	if _, err := creds.Secret(); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials for http, got %v", err)
+	}
+	if _, err := ParseSecret("not-json"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials for garbage secret, got %v", err)
+	}
+}
@@ -0,0 +1,202 @@
+// Package caldav_read provides a read-only adapter for CalDAV calendars.
+// This file contains the minimal iCalendar (RFC 5545) VEVENT parser.
+package caldav_read
+
+import (
+	"strings"
+	"time"
+
+	"quantumlife/pkg/domain/events"
+)
+
+const (
+	icalUTCLayout   = "20060102T150405Z"
+	icalLocalLayout = "20060102T150405"
+	icalDateLayout  = "20060102"
+)
+
+// vevent holds only the VEVENT properties the abstraction keeps.
+type vevent struct {
+	uid           string
+	recurrenceID  string
+	summary       string
+	start         time.Time
+	end           time.Time
+	allDay        bool
+	timezone      string
+	status        string
+	transparent   bool
+	rrule         string
+	attendeeCount int
+	myPartstat    string
+}
+
+// instanceID identifies one occurrence of a possibly recurring event.
+func (v vevent) instanceID() string {
+	if v.recurrenceID == "" {
+		return v.uid
+	}
+	return v.uid + "|" + v.recurrenceID
+}
+
+// toCanonical converts the VEVENT to an abstract canonical event.
+// CRITICAL: No attendee, organizer, description or location fields are set.
+func (v vevent) toCanonical(calendarID string, capturedAt time.Time) *events.CalendarEventEvent {
+	event := events.NewCalendarEventEvent(Vendor, calendarID, v.instanceID(), Vendor, capturedAt, v.start)
+	event.CalendarName = Vendor
+	event.Title = v.summary
+	event.StartTime = v.start
+	event.EndTime = v.end
+	event.IsAllDay = v.allDay
+	event.Timezone = v.timezone
+	event.IsCancelled = v.status == "CANCELLED"
+	event.IsBusy = !v.transparent
+	event.IsRecurring = v.rrule != "" || v.recurrenceID != ""
+	event.RecurrenceRule = v.rrule
+	event.AttendeeCount = v.attendeeCount
+	event.MyResponseStatus = mapPartstat(v.myPartstat)
+	return event
+}
+
+// parseVEvents parses every VEVENT in an iCalendar payload.
+// username identifies our own ATTENDEE line for the RSVP status.
+// Malformed events without a UID or start time are skipped.
+func parseVEvents(payload, username string) []vevent {
+	var result []vevent
+	var cur *vevent
+	var self string
+
+	for _, line := range unfold(payload) {
+		name, params, value := splitProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			cur = &vevent{}
+			self = ""
+			continue
+		case name == "END" && value == "VEVENT":
+			if cur != nil && cur.uid != "" && !cur.start.IsZero() {
+				if cur.end.IsZero() {
+					cur.end = cur.start
+				}
+				cur.myPartstat = self
+				result = append(result, *cur)
+			}
+			cur = nil
+			continue
+		case cur == nil:
+			continue
+		}
+
+		switch name {
+		case "UID":
+			cur.uid = value
+		case "RECURRENCE-ID":
+			cur.recurrenceID = value
+		case "SUMMARY":
+			cur.summary = unescapeText(value)
+		case "DTSTART":
+			cur.start, cur.allDay = parseICalTime(value, params)
+			cur.timezone = params["TZID"]
+		case "DTEND":
+			cur.end, _ = parseICalTime(value, params)
+		case "STATUS":
+			cur.status = strings.ToUpper(value)
+		case "TRANSP":
+			cur.transparent = strings.EqualFold(value, "TRANSPARENT")
+		case "RRULE":
+			cur.rrule = value
+		case "ATTENDEE":
+			// Count only; the address is compared to find our own RSVP
+			cur.attendeeCount++
+			if username != "" && strings.EqualFold(strings.TrimPrefix(strings.ToLower(value), "mailto:"), username) {
+				self = params["PARTSTAT"]
+			}
+		}
+	}
+	return result
+}
+
+// unfold splits a payload into lines, joining RFC 5545 folded lines.
+func unfold(payload string) []string {
+	raw := strings.Split(strings.ReplaceAll(payload, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(raw))
+	for _, l := range raw {
+		if (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// splitProperty splits "NAME;P1=a;P2=b:value" into its parts.
+// Parameter names are upper-cased; quoted parameter values are unquoted.
+func splitProperty(line string) (string, map[string]string, string) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseICalTime parses a DATE or DATE-TIME value.
+// Floating times and unknown TZIDs are read as UTC.
+func parseICalTime(value string, params map[string]string) (time.Time, bool) {
+	if params["VALUE"] == "DATE" || len(value) == len(icalDateLayout) {
+		t, err := time.Parse(icalDateLayout, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icalUTCLayout, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, false
+	}
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation(icalLocalLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.UTC(), false
+}
+
+// unescapeText reverses RFC 5545 TEXT escaping.
+func unescapeText(s string) string {
+	r := strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return r.Replace(s)
+}
+
+// mapPartstat maps an iCalendar PARTSTAT to canonical RSVP status.
+// No matching attendee means we organised it, as in gcal_read.
+func mapPartstat(partstat string) events.RSVPStatus {
+	switch strings.ToUpper(partstat) {
+	case "", "ACCEPTED":
+		return events.RSVPAccepted
+	case "DECLINED":
+		return events.RSVPDeclined
+	case "TENTATIVE":
+		return events.RSVPTentative
+	default:
+		return events.RSVPNeedsAction
+	}
+}
@@ -26,6 +26,15 @@ import (
 const (
 	// Google Calendar API base URL
 	calendarAPIBase = "https://www.googleapis.com/calendar/v3"
+
+	// Default max results per request
+	defaultMaxResults = 250
+
+	// UpcomingWindowDays is the forward window for FetchUpcoming.
+	UpcomingWindowDays = 30
+
+	// MaxUpcomingEvents caps the events returned by FetchUpcoming.
+	MaxUpcomingEvents = 25
 )
 
 // TokenMinter mints access tokens for read-only operations.
@@ -82,7 +91,7 @@ func (a *RealAdapter) FetchEvents(calendarID string, from, to time.Time) ([]*eve
 	}
 
 	// List events
-	gcalEvents, err := a.listEvents(ctx, token.Token, calendarID, from, to)
+	gcalEvents, err := a.listEvents(ctx, token.Token, calendarID, from, to, defaultMaxResults)
 	if err != nil {
 		return nil, fmt.Errorf("list events: %w", err)
 	}
@@ -117,7 +126,7 @@ func (a *RealAdapter) FetchUpcomingCount(calendarID string, days int) (int, erro
 	now := a.clock.Now()
 	to := now.AddDate(0, 0, days)
 
-	gcalEvents, err := a.listEvents(ctx, token.Token, calendarID, now, to)
+	gcalEvents, err := a.listEvents(ctx, token.Token, calendarID, now, to, defaultMaxResults)
 	if err != nil {
 		return 0, fmt.Errorf("list events: %w", err)
 	}
@@ -125,8 +134,58 @@ func (a *RealAdapter) FetchUpcomingCount(calendarID string, days int) (int, erro
 	return len(gcalEvents), nil
 }
 
-// listEvents lists calendar events in a time range.
-func (a *RealAdapter) listEvents(ctx context.Context, accessToken, calendarID string, from, to time.Time) ([]gcalEvent, error) {
+// FetchUpcoming retrieves at most max events (capped at MaxUpcomingEvents)
+// starting within UpcomingWindowDays of since, abstracted for the loop.
+//
+// CRITICAL: Attendee and organizer identities are dropped. Only the title,
+// times, attendee count and our own RSVP status are kept.
+func (a *RealAdapter) FetchUpcoming(account string, since time.Time, max int) ([]*events.CalendarEventEvent, error) {
+	if account == "" {
+		account = "primary"
+	}
+	if max <= 0 || max > MaxUpcomingEvents {
+		max = MaxUpcomingEvents
+	}
+
+	ctx := context.Background()
+
+	// Mint read-only access token
+	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderGoogle, []string{"calendar:read"})
+	if err != nil {
+		return nil, fmt.Errorf("mint token: %w", err)
+	}
+
+	gcalEvents, err := a.listEvents(ctx, token.Token, account, since, since.AddDate(0, 0, UpcomingWindowDays), max)
+	if err != nil {
+		return nil, fmt.Errorf("list events: %w", err)
+	}
+	if len(gcalEvents) > max {
+		gcalEvents = gcalEvents[:max]
+	}
+
+	now := a.clock.Now()
+	result := make([]*events.CalendarEventEvent, 0, len(gcalEvents))
+	for _, evt := range gcalEvents {
+		result = append(result, abstractEvent(a.eventToCanonical(account, evt, now), account))
+	}
+	return result, nil
+}
+
+// abstractEvent strips identities and free text from a canonical event.
+// The event ID is unchanged, so re-syncs deduplicate.
+func abstractEvent(event *events.CalendarEventEvent, account string) *events.CalendarEventEvent {
+	event.AccountEmail = account
+	event.CalendarName = account
+	event.Description = ""
+	event.Location = ""
+	event.ConferenceURL = ""
+	event.Organizer = nil
+	event.Attendees = nil
+	return event
+}
+
+// listEvents lists up to maxResults calendar events in a time range.
+func (a *RealAdapter) listEvents(ctx context.Context, accessToken, calendarID string, from, to time.Time, maxResults int) ([]gcalEvent, error) {
 	endpoint := fmt.Sprintf("%s/calendars/%s/events", calendarAPIBase, url.PathEscape(calendarID))
 
 	params := url.Values{}
@@ -134,7 +193,7 @@ func (a *RealAdapter) listEvents(ctx context.Context, accessToken, calendarID st
 	params.Set("timeMax", to.Format(time.RFC3339))
 	params.Set("singleEvents", "true") // Expand recurring events
 	params.Set("orderBy", "startTime")
-	params.Set("maxResults", "250")
+	params.Set("maxResults", fmt.Sprintf("%d", maxResults))
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
 	if err != nil {
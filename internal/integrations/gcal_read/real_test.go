@@ -5,6 +5,7 @@ package gcal_read
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -371,3 +372,62 @@ func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.URL.Host = t.server.Listener.Addr().String()
 	return http.DefaultTransport.RoundTrip(req)
 }
+
+func TestRealAdapter_FetchUpcoming(t *testing.T) {
+	since := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("maxResults") != "25" {
+			t.Errorf("expected maxResults=25, got %s", q.Get("maxResults"))
+		}
+		if want := "2024-02-14T12:00:00Z"; q.Get("timeMax") != want {
+			t.Errorf("expected timeMax %s, got %s", want, q.Get("timeMax"))
+		}
+
+		resp := gcalListResponse{}
+		for i := 0; i < 30; i++ {
+			resp.Items = append(resp.Items, gcalEvent{
+				ID:          fmt.Sprintf("event-%d", i),
+				Status:      "confirmed",
+				Summary:     "Design Review",
+				Description: "Agenda with private notes",
+				Location:    "Room 4",
+				Start:       gcalDateTime{DateTime: "2024-01-16T09:00:00Z"},
+				End:         gcalDateTime{DateTime: "2024-01-16T10:00:00Z"},
+				Organizer:   gcalPerson{Email: "lead@example.com", DisplayName: "Lead"},
+				Attendees: []gcalAttendee{
+					{Email: "me@example.com", Self: true, ResponseStatus: "needsAction"},
+					{Email: "other@example.com", DisplayName: "Other", ResponseStatus: "accepted"},
+				},
+				HangoutLink: "https://meet.google.com/abc-defg-hij",
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	minter := &mockTokenMinter{token: auth.AccessToken{Token: "test-token", Provider: auth.ProviderGoogle}}
+	client := &http.Client{Transport: &testTransport{server: server}}
+	adapter := NewRealAdapterWithClient(minter, client, clock.NewFixed(since), "test-circle")
+
+	calEvents, err := adapter.FetchUpcoming("primary", since, 100)
+	if err != nil {
+		t.Fatalf("FetchUpcoming failed: %v", err)
+	}
+	if len(calEvents) != MaxUpcomingEvents {
+		t.Fatalf("expected %d events, got %d", MaxUpcomingEvents, len(calEvents))
+	}
+
+	evt := calEvents[0]
+	if evt.Title != "Design Review" || evt.AttendeeCount != 2 || evt.MyResponseStatus != events.RSVPNeedsAction {
+		t.Errorf("expected title, count and RSVP kept, got %q %d %s", evt.Title, evt.AttendeeCount, evt.MyResponseStatus)
+	}
+	if evt.Attendees != nil || evt.Organizer != nil {
+		t.Error("attendee and organizer identities must be dropped")
+	}
+	if evt.Description != "" || evt.Location != "" || evt.ConferenceURL != "" || evt.AccountEmail != "primary" {
+		t.Errorf("free text and addresses must be dropped, got %+v", evt)
+	}
+}
@@ -0,0 +1,248 @@
+// Package gmail_read provides a read-only adapter for Gmail integration.
+//
+// This file defines the manual sync policy: how many messages, how many
+// days and which labels a single explicit sync may pull.
+//
+// CRITICAL: Hard ceilings are enforced here; config can never exceed them.
+package gmail_read
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"quantumlife/pkg/domain/config"
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/events"
+	"quantumlife/pkg/domain/identity"
+)
+
+const (
+	// DefaultSyncMaxMessages is the default per-sync message cap.
+	DefaultSyncMaxMessages = 25
+
+	// DefaultSyncWindowDays is the default per-sync window, the [sync]
+	// default lookback.
+	DefaultSyncWindowDays = config.DefaultSyncLookbackDays
+
+	// MaxSyncMaxMessages is the hard ceiling on messages per sync.
+	MaxSyncMaxMessages = 100
+
+	// MaxSyncWindowDays is the hard ceiling on the sync window.
+	MaxSyncWindowDays = 30
+
+	// MaxSyncLabels is the hard ceiling on label filter entries.
+	MaxSyncLabels = 10
+)
+
+// DefaultSyncLabels skips Gmail's Promotions and Social categories.
+var DefaultSyncLabels = []string{"-CATEGORY_PROMOTIONS", "-CATEGORY_SOCIAL"}
+
+// Label filter descriptors recorded in sync receipts.
+// CRITICAL: Receipts carry only these - never raw label names.
+const (
+	LabelFilterDefault     = "default"      // DefaultSyncLabels
+	LabelFilterNone        = "none"         // every inbox message
+	LabelFilterExcludeOnly = "exclude_only" // custom exclusions only
+	LabelFilterInclude     = "include"      // at least one required label
+)
+
+// SyncPolicy bounds a single explicit Gmail sync.
+type SyncPolicy struct {
+	// MaxMessages is the maximum number of messages fetched.
+	MaxMessages int
+
+	// WindowDays is how many days back the sync reaches.
+	WindowDays int
+
+	// Labels filters by Gmail label ID. "LABEL" requires the label and
+	// "-LABEL" excludes it. Nil means DefaultSyncLabels; empty means no filter.
+	Labels []string
+}
+
+// DefaultSyncPolicy returns the default 25 message / 7 day policy that
+// skips Promotions and Social.
+func DefaultSyncPolicy() SyncPolicy {
+	return SyncPolicy{
+		MaxMessages: DefaultSyncMaxMessages,
+		WindowDays:  DefaultSyncWindowDays,
+		Labels:      DefaultSyncLabels,
+	}
+}
+
+// SyncPolicyForCircle returns the configured policy for a circle.
+// Unset values fall back to the defaults; the window falls back to the
+// [sync] email lookback. The result is always clamped.
+func SyncPolicyForCircle(cfg *config.MultiCircleConfig, circleID identity.EntityID) SyncPolicy {
+	p := DefaultSyncPolicy()
+	if cfg == nil {
+		return p
+	}
+	p.WindowDays = cfg.Sync.EffectiveLookbackDays(connection.KindEmail)
+	if circle, ok := cfg.Circles[circleID]; ok {
+		if circle.EmailSyncMaxMessages > 0 {
+			p.MaxMessages = circle.EmailSyncMaxMessages
+		}
+		if circle.EmailSyncWindowDays > 0 {
+			p.WindowDays = circle.EmailSyncWindowDays
+		}
+		if circle.EmailSyncLabels != nil {
+			p.Labels = circle.EmailSyncLabels
+		}
+	}
+	return p.Effective()
+}
+
+// Effective returns the policy with defaults applied and hard ceilings enforced.
+func (p SyncPolicy) Effective() SyncPolicy {
+	if p.MaxMessages <= 0 {
+		p.MaxMessages = DefaultSyncMaxMessages
+	}
+	if p.MaxMessages > MaxSyncMaxMessages {
+		p.MaxMessages = MaxSyncMaxMessages
+	}
+	if p.WindowDays <= 0 {
+		p.WindowDays = DefaultSyncWindowDays
+	}
+	if p.WindowDays > MaxSyncWindowDays {
+		p.WindowDays = MaxSyncWindowDays
+	}
+	if p.Labels == nil {
+		p.Labels = DefaultSyncLabels
+	}
+	labels := make([]string, 0, len(p.Labels))
+	for _, l := range p.Labels {
+		if IsValidLabelFilter(l) && len(labels) < MaxSyncLabels {
+			labels = append(labels, l)
+		}
+	}
+	p.Labels = labels
+	return p
+}
+
+// IsValidLabelFilter reports whether l is a label ID, optionally prefixed
+// with "-". Only letters, digits, '_', '-' and '/' are allowed, so entries
+// can never inject search operators.
+func IsValidLabelFilter(l string) bool {
+	l = strings.TrimPrefix(l, "-")
+	if l == "" {
+		return false
+	}
+	for _, c := range l {
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-', c == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Since returns the start of the sync window relative to now.
+func (p SyncPolicy) Since(now time.Time) time.Time {
+	return now.Add(-time.Duration(p.Effective().WindowDays) * 24 * time.Hour)
+}
+
+// IsDefault reports whether the effective policy is the default one.
+func (p SyncPolicy) IsDefault() bool {
+	eff, def := p.Effective(), DefaultSyncPolicy()
+	return eff.MaxMessages == def.MaxMessages && eff.WindowDays == def.WindowDays &&
+		p.LabelFilterDescriptor() == LabelFilterDefault
+}
+
+// LabelFilterDescriptor returns the label filter as an abstract descriptor.
+func (p SyncPolicy) LabelFilterDescriptor() string {
+	labels := p.Effective().Labels
+	if len(labels) == 0 {
+		return LabelFilterNone
+	}
+	if strings.Join(labels, ",") == strings.Join(DefaultSyncLabels, ",") {
+		return LabelFilterDefault
+	}
+	for _, l := range labels {
+		if !strings.HasPrefix(l, "-") {
+			return LabelFilterInclude
+		}
+	}
+	return LabelFilterExcludeOnly
+}
+
+// labelQuery splits label filters into Gmail search exclusions and
+// required labelIds. Category labels use the category: operator.
+func labelQuery(labels []string) (exclude string, labelIDs []string) {
+	var terms []string
+	for _, l := range labels {
+		id, excluded := strings.CutPrefix(l, "-")
+		if !excluded {
+			labelIDs = append(labelIDs, id)
+			continue
+		}
+		if category, ok := strings.CutPrefix(id, "CATEGORY_"); ok {
+			terms = append(terms, fmt.Sprintf("-category:%s", strings.ToLower(category)))
+		} else {
+			terms = append(terms, fmt.Sprintf("-label:%s", id))
+		}
+	}
+	return strings.Join(terms, " "), labelIDs
+}
+
+// MaxMessagesBucket returns the message cap as an abstract magnitude.
+func (p SyncPolicy) MaxMessagesBucket() string {
+	switch n := p.Effective().MaxMessages; {
+	case n <= DefaultSyncMaxMessages:
+		return "a_few"
+	case n <= 2*DefaultSyncMaxMessages:
+		return "several"
+	default:
+		return "many"
+	}
+}
+
+// WindowBucket returns the sync window as an abstract magnitude, using
+// the shared config.LookbackBucket scheme.
+func (p SyncPolicy) WindowBucket() string {
+	return config.LookbackBucket(p.Effective().WindowDays)
+}
+
+// FetchMessagesWithPolicy fetches messages within the effective policy,
+// including its label filter.
+func (a *RealAdapter) FetchMessagesWithPolicy(accountEmail string, policy SyncPolicy) ([]*events.EmailMessageEvent, error) {
+	eff := policy.Effective()
+	return a.FetchMessagesFiltered(accountEmail, eff.Since(a.clock.Now()), eff.MaxMessages, eff.Labels)
+}
+
+// FetchAccountsWithPolicy fetches every bound account of a circle under
+// the policy. Each account is keyed by its resolved address, so messages
+// from different accounts never collide, while an account bound twice
+// yields each message once. It returns the merged messages, how many
+// accounts were fetched, and the last per-account error.
+func FetchAccountsWithPolicy(adapters []*RealAdapter, policy SyncPolicy) ([]*events.EmailMessageEvent, int, error) {
+	var (
+		messages []*events.EmailMessageEvent
+		fetched  int
+		lastErr  error
+	)
+	seen := make(map[string]bool)
+	for _, adapter := range adapters {
+		accountEmail, err := adapter.FetchAccountEmail()
+		if err != nil {
+			lastErr = fmt.Errorf("%s: resolve account: %w", adapter.circleID, err)
+			continue
+		}
+		accountMessages, err := adapter.FetchMessagesWithPolicy(accountEmail, policy)
+		if err != nil {
+			lastErr = fmt.Errorf("%s: %w", adapter.circleID, err)
+			continue
+		}
+		fetched++
+
+		// Deduplicate by deterministic event ID
+		for _, msg := range accountMessages {
+			if !seen[msg.EventID()] {
+				seen[msg.EventID()] = true
+				messages = append(messages, msg)
+			}
+		}
+	}
+	return messages, fetched, lastErr
+}
@@ -71,8 +71,16 @@ func (a *RealAdapter) Name() string {
 }
 
 // FetchMessages retrieves messages from Gmail and returns canonical events.
+// No label filter is applied; see FetchMessagesFiltered.
 func (a *RealAdapter) FetchMessages(accountEmail string, since time.Time, limit int) ([]*events.EmailMessageEvent, error) {
+	return a.FetchMessagesFiltered(accountEmail, since, limit, []string{})
+}
+
+// FetchMessagesFiltered retrieves messages matching the label filter
+// (see SyncPolicy.Labels). Invalid filter entries are dropped.
+func (a *RealAdapter) FetchMessagesFiltered(accountEmail string, since time.Time, limit int, labels []string) ([]*events.EmailMessageEvent, error) {
 	ctx := context.Background()
+	labels = SyncPolicy{Labels: labels}.Effective().Labels
 
 	// Mint read-only access token
 	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderGoogle, []string{"email:read"})
@@ -86,9 +94,13 @@ func (a *RealAdapter) FetchMessages(accountEmail string, since time.Time, limit
 		// Gmail uses 'after:' with epoch seconds
 		query += fmt.Sprintf(" after:%d", since.Unix())
 	}
+	exclude, labelIDs := labelQuery(labels)
+	if exclude != "" {
+		query += " " + exclude
+	}
 
 	// List message IDs
-	messageIDs, err := a.listMessageIDs(ctx, token.Token, accountEmail, query, limit)
+	messageIDs, err := a.listMessageIDs(ctx, token.Token, accountEmail, query, labelIDs, limit)
 	if err != nil {
 		return nil, fmt.Errorf("list messages: %w", err)
 	}
@@ -122,7 +134,7 @@ func (a *RealAdapter) FetchUnreadCount(accountEmail string) (int, error) {
 	}
 
 	// List unread messages
-	messageIDs, err := a.listMessageIDs(ctx, token.Token, accountEmail, "in:inbox is:unread", 0)
+	messageIDs, err := a.listMessageIDs(ctx, token.Token, accountEmail, "in:inbox is:unread", nil, 0)
 	if err != nil {
 		return 0, fmt.Errorf("list unread: %w", err)
 	}
@@ -130,12 +142,56 @@ func (a *RealAdapter) FetchUnreadCount(accountEmail string) (int, error) {
 	return len(messageIDs), nil
 }
 
-// listMessageIDs lists message IDs matching the query.
-func (a *RealAdapter) listMessageIDs(ctx context.Context, accessToken, accountEmail, query string, limit int) ([]string, error) {
+// FetchAccountEmail returns the address of the account the adapter's token
+// belongs to. Gmail API calls address that account as "me", so the
+// resolved address is what tells bound accounts apart in event IDs.
+func (a *RealAdapter) FetchAccountEmail() (string, error) {
+	ctx := context.Background()
+
+	// Mint read-only access token
+	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderGoogle, []string{"email:read"})
+	if err != nil {
+		return "", fmt.Errorf("mint token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", gmailAPIBase+"/users/me/profile", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("gmail API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var profile gmailProfile
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return "", err
+	}
+	if profile.EmailAddress == "" {
+		return "", fmt.Errorf("gmail profile has no address")
+	}
+
+	return strings.ToLower(profile.EmailAddress), nil
+}
+
+// listMessageIDs lists message IDs matching the query and carrying every
+// label in labelIDs.
+func (a *RealAdapter) listMessageIDs(ctx context.Context, accessToken, accountEmail, query string, labelIDs []string, limit int) ([]string, error) {
 	endpoint := fmt.Sprintf("%s/users/me/messages", gmailAPIBase)
 
 	params := url.Values{}
 	params.Set("q", query)
+	for _, id := range labelIDs {
+		params.Add("labelIds", id)
+	}
 	if limit > 0 {
 		params.Set("maxResults", fmt.Sprintf("%d", limit))
 	} else {
@@ -315,6 +371,10 @@ func (a *RealAdapter) messageToEvent(accountEmail string, msg *gmailMessage, cap
 
 // Gmail API response types
 
+type gmailProfile struct {
+	EmailAddress string `json:"emailAddress"`
+}
+
 type gmailListResponse struct {
 	Messages           []gmailMessageRef `json:"messages"`
 	NextPageToken      string            `json:"nextPageToken"`
@@ -5,13 +5,17 @@ package gmail_read
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"quantumlife/internal/connectors/auth"
 	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/config"
+	"quantumlife/pkg/domain/identity"
 )
 
 // mockTokenMinter implements TokenMinter for testing.
@@ -292,3 +296,188 @@ func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.URL.Host = t.server.Listener.Addr().String()
 	return http.DefaultTransport.RoundTrip(req)
 }
+
+func TestSyncPolicy_ClampsToCeiling(t *testing.T) {
+	cfg := &config.MultiCircleConfig{
+		Circles: map[identity.EntityID]*config.CircleConfig{
+			"personal": {ID: "personal", EmailSyncMaxMessages: 500, EmailSyncWindowDays: 90},
+			"work":     {ID: "work", EmailSyncMaxMessages: 50, EmailSyncWindowDays: 14},
+		},
+	}
+
+	if p := SyncPolicyForCircle(cfg, "personal"); p.MaxMessages != MaxSyncMaxMessages || p.WindowDays != MaxSyncWindowDays {
+		t.Errorf("expected policy clamped to %d/%d, got %d/%d", MaxSyncMaxMessages, MaxSyncWindowDays, p.MaxMessages, p.WindowDays)
+	}
+	work := SyncPolicyForCircle(cfg, "work")
+	if work.MaxMessages != 50 || work.WindowDays != 14 {
+		t.Errorf("expected 50/14, got %d/%d", work.MaxMessages, work.WindowDays)
+	}
+	if work.MaxMessagesBucket() != "several" || work.WindowBucket() != "several" {
+		t.Errorf("expected several/several buckets, got %s/%s", work.MaxMessagesBucket(), work.WindowBucket())
+	}
+
+	unset := SyncPolicyForCircle(cfg, "family")
+	if !unset.IsDefault() {
+		t.Errorf("expected default policy for unconfigured circle, got %+v", unset)
+	}
+	if unset.MaxMessagesBucket() != "a_few" || unset.WindowBucket() != "a_few" {
+		t.Errorf("expected a_few buckets for default policy, got %s/%s", unset.MaxMessagesBucket(), unset.WindowBucket())
+	}
+}
+
+func TestRealAdapter_FetchMessagesWithPolicy(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("maxResults") != "100" {
+			t.Errorf("expected maxResults capped at 100, got %s", q.Get("maxResults"))
+		}
+		wantAfter := fmt.Sprintf("after:%d", now.AddDate(0, 0, -30).Unix())
+		if !strings.Contains(q.Get("q"), wantAfter) {
+			t.Errorf("expected query to contain %s, got %s", wantAfter, q.Get("q"))
+		}
+		if !strings.Contains(q.Get("q"), "-category:promotions -category:social") {
+			t.Errorf("expected default label exclusions, got %s", q.Get("q"))
+		}
+		json.NewEncoder(w).Encode(gmailListResponse{})
+	}))
+	defer server.Close()
+
+	minter := &mockTokenMinter{token: auth.AccessToken{Token: "test-token"}}
+	client := &http.Client{Transport: &testTransport{server: server}}
+	adapter := NewRealAdapterWithClient(minter, client, clock.NewFixed(now), "test-circle")
+
+	if _, err := adapter.FetchMessagesWithPolicy("me", SyncPolicy{MaxMessages: 1000, WindowDays: 365}); err != nil {
+		t.Fatalf("FetchMessagesWithPolicy failed: %v", err)
+	}
+}
+
+func TestRealAdapter_FetchMessagesLabelFilter(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	var gotQuery string
+	var gotLabelIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query().Get("q")
+		gotLabelIDs = r.URL.Query()["labelIds"]
+		json.NewEncoder(w).Encode(gmailListResponse{})
+	}))
+	defer server.Close()
+
+	minter := &mockTokenMinter{token: auth.AccessToken{Token: "test-token"}}
+	client := &http.Client{Transport: &testTransport{server: server}}
+	adapter := NewRealAdapterWithClient(minter, client, clock.NewFixed(now), "test-circle")
+
+	policy := SyncPolicy{Labels: []string{"IMPORTANT", "-Label_42", "-CATEGORY_FORUMS", "bad label"}}
+	if _, err := adapter.FetchMessagesWithPolicy("me", policy); err != nil {
+		t.Fatalf("FetchMessagesWithPolicy failed: %v", err)
+	}
+	if len(gotLabelIDs) != 1 || gotLabelIDs[0] != "IMPORTANT" {
+		t.Errorf("expected labelIds [IMPORTANT], got %v", gotLabelIDs)
+	}
+	if !strings.HasSuffix(gotQuery, " -label:Label_42 -category:forums") || strings.Contains(gotQuery, "bad") {
+		t.Errorf("unexpected query: %s", gotQuery)
+	}
+	if got := policy.LabelFilterDescriptor(); got != LabelFilterInclude {
+		t.Errorf("expected include descriptor, got %s", got)
+	}
+
+	// Unfiltered fetch sends no label terms
+	if _, err := adapter.FetchMessages("me", now.AddDate(0, 0, -1), 10); err != nil {
+		t.Fatalf("FetchMessages failed: %v", err)
+	}
+	if strings.Contains(gotQuery, "category:") || len(gotLabelIDs) != 0 {
+		t.Errorf("expected no label filter, got %s %v", gotQuery, gotLabelIDs)
+	}
+	if got := (SyncPolicy{Labels: []string{}}).LabelFilterDescriptor(); got != LabelFilterNone {
+		t.Errorf("expected none descriptor, got %s", got)
+	}
+}
+
+// slotTokenMinter mints a distinct token per account slot key.
+type slotTokenMinter struct{}
+
+func (slotTokenMinter) MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error) {
+	return auth.AccessToken{Token: "token-" + circleID, Provider: auth.ProviderGoogle}, nil
+}
+
+func TestFetchAccountsWithPolicy_OverlappingMessageIDs(t *testing.T) {
+	// Token per slot; both mailboxes hold a message with the same ID
+	addresses := map[string]string{
+		"Bearer token-circle-1":         "Alex@Home.example",
+		"Bearer token-circle-1/gmail-2": "alex@work.example",
+		"Bearer token-circle-1/gmail-3": "ALEX@HOME.EXAMPLE",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		address, ok := addresses[r.Header.Get("Authorization")]
+		if !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/gmail/v1/users/me/profile":
+			json.NewEncoder(w).Encode(gmailProfile{EmailAddress: address})
+		case "/gmail/v1/users/me/messages":
+			json.NewEncoder(w).Encode(gmailListResponse{Messages: []gmailMessageRef{{ID: "msg-1"}}})
+		case "/gmail/v1/users/me/messages/msg-1":
+			json.NewEncoder(w).Encode(gmailMessage{
+				ID:           "msg-1",
+				InternalDate: 1704067200000,
+				Payload: gmailPayload{Headers: []gmailHeader{
+					{Name: "From", Value: "sender@example.com"},
+					{Name: "Subject", Value: "Hello " + address},
+				}},
+			})
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &testTransport{server: server}}
+	clk := clock.NewFixed(time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC))
+	adapter := func(key string) *RealAdapter {
+		return NewRealAdapterWithClient(slotTokenMinter{}, client, clk, key)
+	}
+
+	// Two accounts: the shared message ID must not collapse them
+	messages, fetched, err := FetchAccountsWithPolicy([]*RealAdapter{
+		adapter("circle-1"),
+		adapter("circle-1/gmail-2"),
+	}, DefaultSyncPolicy())
+	if err != nil || fetched != 2 {
+		t.Fatalf("expected 2 accounts fetched, got %d (%v)", fetched, err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected a message from each account, got %d", len(messages))
+	}
+	if messages[0].EventID() == messages[1].EventID() {
+		t.Error("expected distinct event IDs across accounts")
+	}
+	if messages[0].AccountEmail != "alex@home.example" || messages[1].AccountEmail != "alex@work.example" {
+		t.Errorf("expected resolved addresses, got %s and %s", messages[0].AccountEmail, messages[1].AccountEmail)
+	}
+
+	// The same account bound to two slots yields the message once
+	messages, fetched, err = FetchAccountsWithPolicy([]*RealAdapter{
+		adapter("circle-1"),
+		adapter("circle-1/gmail-3"),
+	}, DefaultSyncPolicy())
+	if err != nil || fetched != 2 {
+		t.Fatalf("expected 2 accounts fetched, got %d (%v)", fetched, err)
+	}
+	if len(messages) != 1 {
+		t.Errorf("expected the duplicate binding to be deduplicated, got %d", len(messages))
+	}
+
+	// An account that cannot be resolved is skipped, not keyed as "me"
+	messages, fetched, err = FetchAccountsWithPolicy([]*RealAdapter{
+		adapter("circle-1"),
+		adapter("circle-1/gmail-4"),
+	}, DefaultSyncPolicy())
+	if err == nil || fetched != 1 || len(messages) != 1 {
+		t.Errorf("expected one account fetched and an error, got %d accounts, %d messages (%v)", fetched, len(messages), err)
+	}
+}
@@ -0,0 +1,25 @@
+// Package graph_read provides a read-only adapter for Outlook / Microsoft 365
+// mail via Microsoft Graph.
+//
+// CRITICAL: This adapter is READ-ONLY. It NEVER writes to the mailbox.
+// All data is transformed to the same canonical EmailMessageEvent format
+// the Gmail adapter produces.
+//
+// Reference: docs/INTEGRATIONS_MATRIX_V1.md
+package graph_read
+
+import (
+	"time"
+
+	"quantumlife/pkg/domain/events"
+)
+
+// Adapter defines the interface for Outlook read operations.
+type Adapter interface {
+	// FetchMessages retrieves inbox messages and returns canonical events.
+	// This is a synchronous operation - no background polling.
+	FetchMessages(accountEmail string, since time.Time, limit int) ([]*events.EmailMessageEvent, error)
+
+	// Name returns the adapter name.
+	Name() string
+}
@@ -0,0 +1,250 @@
+// Package graph_read provides a read-only adapter for Outlook integration.
+//
+// This file implements the real HTTP-based Microsoft Graph adapter.
+// CRITICAL: This adapter is READ-ONLY. It NEVER writes to the mailbox.
+//
+// Reference: docs/INTEGRATIONS_MATRIX_V1.md
+package graph_read
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	"quantumlife/internal/connectors/auth/impl_inmem"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/events"
+)
+
+const (
+	// Microsoft Graph API base URL
+	graphAPIBase = "https://graph.microsoft.com/v1.0"
+
+	// Default max results per request
+	defaultMaxResults = 100
+
+	// messageFields are the only fields requested from Graph.
+	messageFields = "id,conversationId,subject,bodyPreview,from,toRecipients," +
+		"receivedDateTime,isRead,importance,flag,categories,inferenceClassification,hasAttachments"
+)
+
+// TokenMinter mints access tokens for read-only operations.
+// This interface allows injection for testing.
+type TokenMinter interface {
+	MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error)
+}
+
+// RealAdapter implements the Outlook read adapter using real HTTP calls.
+type RealAdapter struct {
+	broker     TokenMinter
+	httpClient *http.Client
+	clock      clock.Clock
+	circleID   string
+}
+
+// NewRealAdapter creates a new real Outlook adapter.
+func NewRealAdapter(broker *impl_inmem.Broker, clk clock.Clock, circleID string) *RealAdapter {
+	return &RealAdapter{
+		broker:     broker,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		clock:      clk,
+		circleID:   circleID,
+	}
+}
+
+// NewRealAdapterWithClient creates a real adapter with custom HTTP client (for testing).
+func NewRealAdapterWithClient(broker TokenMinter, httpClient *http.Client, clk clock.Clock, circleID string) *RealAdapter {
+	return &RealAdapter{
+		broker:     broker,
+		httpClient: httpClient,
+		clock:      clk,
+		circleID:   circleID,
+	}
+}
+
+func (a *RealAdapter) Name() string {
+	return "graph_real"
+}
+
+// FetchMessages retrieves inbox messages from Graph and returns canonical events.
+// An accountEmail of "" or "me" reads the authenticated user's mailbox.
+func (a *RealAdapter) FetchMessages(accountEmail string, since time.Time, limit int) ([]*events.EmailMessageEvent, error) {
+	ctx := context.Background()
+
+	// Mint read-only access token
+	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderMicrosoft, []string{"email:read"})
+	if err != nil {
+		return nil, fmt.Errorf("mint token: %w", err)
+	}
+
+	messages, err := a.listMessages(ctx, token.Token, accountEmail, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list messages: %w", err)
+	}
+
+	now := a.clock.Now()
+	result := make([]*events.EmailMessageEvent, 0, len(messages))
+	for i := range messages {
+		result = append(result, a.messageToEvent(accountEmail, &messages[i], now))
+	}
+
+	return result, nil
+}
+
+// listMessages lists inbox messages received since the given time.
+// Graph returns full message metadata in the list, so no per-message fetch.
+func (a *RealAdapter) listMessages(ctx context.Context, accessToken, accountEmail string, since time.Time, limit int) ([]graphMessage, error) {
+	mailbox := "me"
+	if accountEmail != "" && accountEmail != "me" {
+		mailbox = "users/" + url.PathEscape(accountEmail)
+	}
+	endpoint := fmt.Sprintf("%s/%s/mailFolders/inbox/messages", graphAPIBase, mailbox)
+
+	params := url.Values{}
+	if limit > 0 {
+		params.Set("$top", fmt.Sprintf("%d", limit))
+	} else {
+		params.Set("$top", fmt.Sprintf("%d", defaultMaxResults))
+	}
+	params.Set("$select", messageFields)
+	params.Set("$orderby", "receivedDateTime desc")
+	if !since.IsZero() {
+		params.Set("$filter", "receivedDateTime ge "+since.UTC().Format(time.RFC3339))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("graph API error: %d - %s", resp.StatusCode, string(body))
+	}
+
+	var listResp graphListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&listResp); err != nil {
+		return nil, err
+	}
+
+	// Enforce the cap even if the server ignores $top
+	if limit > 0 && len(listResp.Value) > limit {
+		listResp.Value = listResp.Value[:limit]
+	}
+
+	return listResp.Value, nil
+}
+
+// messageToEvent converts a Graph message to a canonical event.
+func (a *RealAdapter) messageToEvent(accountEmail string, msg *graphMessage, capturedAt time.Time) *events.EmailMessageEvent {
+	occurredAt, err := time.Parse(time.RFC3339, msg.ReceivedDateTime)
+	if err != nil {
+		occurredAt = capturedAt
+	}
+
+	event := events.NewEmailMessageEvent(
+		"outlook",
+		msg.ID,
+		accountEmail,
+		capturedAt,
+		occurredAt,
+	)
+
+	event.ThreadID = msg.ConversationID
+	event.Subject = msg.Subject
+	event.BodyPreview = msg.BodyPreview
+	event.Folder = "INBOX"
+	event.IsRead = msg.IsRead
+	event.IsImportant = msg.Importance == "high"
+	event.IsStarred = msg.Flag.FlagStatus == "flagged"
+	event.HasAttachments = msg.HasAttachments
+
+	if msg.From != nil {
+		event.From = toEmailAddress(msg.From.EmailAddress)
+		event.SenderDomain = extractDomain(event.From.Address)
+	}
+	for _, r := range msg.ToRecipients {
+		event.To = append(event.To, toEmailAddress(r.EmailAddress))
+	}
+
+	// "Other" is Outlook's focused-inbox bucket for bulk and automated mail
+	if msg.InferenceClassification == "other" {
+		event.IsAutomated = true
+	}
+	for _, c := range msg.Categories {
+		event.Labels = append(event.Labels, strings.ToLower(c))
+	}
+
+	return event
+}
+
+// Graph API response types
+
+type graphListResponse struct {
+	Value    []graphMessage `json:"value"`
+	NextLink string         `json:"@odata.nextLink"`
+}
+
+type graphMessage struct {
+	ID                      string           `json:"id"`
+	ConversationID          string           `json:"conversationId"`
+	Subject                 string           `json:"subject"`
+	BodyPreview             string           `json:"bodyPreview"`
+	From                    *graphRecipient  `json:"from"`
+	ToRecipients            []graphRecipient `json:"toRecipients"`
+	ReceivedDateTime        string           `json:"receivedDateTime"`
+	IsRead                  bool             `json:"isRead"`
+	Importance              string           `json:"importance"`
+	Flag                    graphFlag        `json:"flag"`
+	Categories              []string         `json:"categories"`
+	InferenceClassification string           `json:"inferenceClassification"`
+	HasAttachments          bool             `json:"hasAttachments"`
+}
+
+type graphRecipient struct {
+	EmailAddress graphEmailAddress `json:"emailAddress"`
+}
+
+type graphEmailAddress struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+type graphFlag struct {
+	FlagStatus string `json:"flagStatus"`
+}
+
+// Helper functions
+
+// toEmailAddress converts a Graph address to a canonical address.
+func toEmailAddress(a graphEmailAddress) events.EmailAddress {
+	return events.EmailAddress{
+		Name:    strings.TrimSpace(a.Name),
+		Address: strings.TrimSpace(a.Address),
+	}
+}
+
+// extractDomain extracts the domain from an email address.
+func extractDomain(email string) string {
+	idx := strings.LastIndex(email, "@")
+	if idx == -1 {
+		return ""
+	}
+	return email[idx+1:]
+}
+
+// Verify interface compliance.
+var _ Adapter = (*RealAdapter)(nil)
@@ -0,0 +1,176 @@
+// Package graph_read provides a read-only adapter for Outlook integration.
+// This file contains httptest-based tests for the real adapter.
+package graph_read
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	"quantumlife/pkg/clock"
+)
+
+// mockTokenMinter implements TokenMinter for testing.
+type mockTokenMinter struct {
+	token    auth.AccessToken
+	err      error
+	provider auth.ProviderID
+}
+
+func (m *mockTokenMinter) MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error) {
+	m.provider = provider
+	return m.token, m.err
+}
+
+func TestRealAdapter_FetchMessages(t *testing.T) {
+	since := time.Date(2024, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("expected Bearer test-token, got %s", got)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != "GET" || r.URL.Path != "/v1.0/me/mailFolders/inbox/messages" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+
+		q := r.URL.Query()
+		if q.Get("$top") != "25" {
+			t.Errorf("expected $top=25, got %s", q.Get("$top"))
+		}
+		if want := "receivedDateTime ge 2024-01-08T12:00:00Z"; q.Get("$filter") != want {
+			t.Errorf("expected $filter %q, got %q", want, q.Get("$filter"))
+		}
+
+		resp := graphListResponse{
+			Value: []graphMessage{
+				{
+					ID:               "msg-1",
+					ConversationID:   "conv-1",
+					Subject:          "Test Subject",
+					BodyPreview:      "Hello, this is a test email...",
+					From:             &graphRecipient{EmailAddress: graphEmailAddress{Name: "Sender", Address: "sender@example.com"}},
+					ToRecipients:     []graphRecipient{{EmailAddress: graphEmailAddress{Address: "me@example.com"}}},
+					ReceivedDateTime: "2024-01-10T09:30:00Z",
+					IsRead:           false,
+					Importance:       "normal",
+				},
+				{
+					ID:                      "msg-2",
+					ConversationID:          "conv-2",
+					Subject:                 "Weekly newsletter",
+					From:                    &graphRecipient{EmailAddress: graphEmailAddress{Name: "News", Address: "news@shop.example"}},
+					ReceivedDateTime:        "2024-01-11T08:00:00Z",
+					IsRead:                  true,
+					Importance:              "high",
+					Flag:                    graphFlag{FlagStatus: "flagged"},
+					InferenceClassification: "other",
+					Categories:              []string{"Receipts"},
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	minter := &mockTokenMinter{
+		token: auth.AccessToken{
+			Token:    "test-token",
+			Expiry:   time.Now().Add(time.Hour),
+			Provider: auth.ProviderMicrosoft,
+		},
+	}
+	client := &http.Client{Transport: &testTransport{server: server}}
+	fixedClock := clock.NewFixed(time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC))
+	adapter := NewRealAdapterWithClient(minter, client, fixedClock, "test-circle")
+
+	messages, err := adapter.FetchMessages("me", since, 25)
+	if err != nil {
+		t.Fatalf("FetchMessages failed: %v", err)
+	}
+	if minter.provider != auth.ProviderMicrosoft {
+		t.Errorf("expected token minted for microsoft, got %s", minter.provider)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+
+	msg1 := messages[0]
+	if msg1.MessageID != "msg-1" || msg1.ThreadID != "conv-1" {
+		t.Errorf("unexpected ids: %s / %s", msg1.MessageID, msg1.ThreadID)
+	}
+	if msg1.Vendor != "outlook" {
+		t.Errorf("expected vendor outlook, got %s", msg1.Vendor)
+	}
+	if msg1.From.Address != "sender@example.com" || msg1.SenderDomain != "example.com" {
+		t.Errorf("unexpected sender: %+v / %s", msg1.From, msg1.SenderDomain)
+	}
+	if msg1.IsRead {
+		t.Error("expected message to be unread")
+	}
+	if !msg1.OccurredAt().Equal(time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected occurred at: %v", msg1.OccurredAt())
+	}
+
+	msg2 := messages[1]
+	if !msg2.IsStarred || !msg2.IsImportant || !msg2.IsAutomated {
+		t.Errorf("expected flagged, important, automated message, got %+v", msg2)
+	}
+	if len(msg2.Labels) != 1 || msg2.Labels[0] != "receipts" {
+		t.Errorf("expected receipts label, got %v", msg2.Labels)
+	}
+}
+
+func TestRealAdapter_CapsResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Server ignores $top and returns more than asked for
+		var resp graphListResponse
+		for _, id := range []string{"a", "b", "c"} {
+			resp.Value = append(resp.Value, graphMessage{ID: id, ReceivedDateTime: "2024-01-10T09:30:00Z"})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	minter := &mockTokenMinter{token: auth.AccessToken{Token: "test-token"}}
+	client := &http.Client{Transport: &testTransport{server: server}}
+	adapter := NewRealAdapterWithClient(minter, client, clock.NewFixed(time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)), "test-circle")
+
+	messages, err := adapter.FetchMessages("me", time.Time{}, 2)
+	if err != nil {
+		t.Fatalf("FetchMessages failed: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Errorf("expected results capped at 2, got %d", len(messages))
+	}
+}
+
+func TestRealAdapter_TokenError(t *testing.T) {
+	minter := &mockTokenMinter{err: auth.ErrNoToken}
+
+	fixedClock := clock.NewFixed(time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC))
+	adapter := NewRealAdapterWithClient(minter, http.DefaultClient, fixedClock, "test-circle")
+
+	if _, err := adapter.FetchMessages("me", time.Time{}, 10); err == nil {
+		t.Error("expected error when token minting fails")
+	}
+}
+
+// testTransport redirects requests to the test server.
+type testTransport struct {
+	server *httptest.Server
+}
+
+func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = "http"
+	req.URL.Host = t.server.Listener.Addr().String()
+	return http.DefaultTransport.RoundTrip(req)
+}
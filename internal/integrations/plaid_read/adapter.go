@@ -0,0 +1,342 @@
+// Package plaid_read provides a read-only adapter for Plaid finance integration.
+//
+// CRITICAL: This adapter is READ-ONLY. It NEVER initiates payments or writes.
+// CRITICAL: Sync is bounded exactly like TrueLayer: 25 accounts, 25
+// transactions per account, 7-day default window.
+// All data is transformed to canonical BalanceEvent and TransactionEvent formats.
+//
+// Reference: docs/INTEGRATIONS_MATRIX_V1.md, docs/TECHNOLOGY_SELECTION_V8_FINANCIAL_READ.md
+package plaid_read
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	plaid "quantumlife/internal/connectors/finance/read/providers/plaid"
+	truelayer "quantumlife/internal/connectors/finance/read/providers/truelayer"
+	"quantumlife/internal/integrations/finance_read"
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/events"
+	"quantumlife/pkg/domain/financemirror"
+	"quantumlife/pkg/domain/identity"
+)
+
+// Vendor is the vendor name on every event this adapter produces.
+const Vendor = "plaid"
+
+// Bounded sync limits, shared with TrueLayer.
+const (
+	MaxAccounts               = truelayer.MaxAccounts
+	MaxTransactionsPerAccount = truelayer.MaxTransactionsPerAccount
+	SyncWindowDays            = truelayer.SyncWindowDays
+	MaxSyncWindowDays         = truelayer.MaxSyncWindowDays
+)
+
+// TokenMinter mints access tokens for read-only operations.
+// This interface allows injection for testing.
+type TokenMinter interface {
+	MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error)
+}
+
+// Client is the subset of the Plaid client used by the adapter.
+// Only read calls exist.
+type Client interface {
+	GetAccounts(ctx context.Context, accessToken string) (*plaid.AccountsGetResponse, error)
+	GetTransactions(ctx context.Context, accessToken string, startDate, endDate time.Time, opts *plaid.TransactionsGetOptions) (*plaid.TransactionsGetResponse, error)
+}
+
+// RealAdapter implements the finance read adapter against Plaid.
+type RealAdapter struct {
+	broker   TokenMinter
+	client   Client
+	clock    clock.Clock
+	circleID string
+}
+
+// NewRealAdapter creates a new Plaid adapter for a circle.
+func NewRealAdapter(broker TokenMinter, client Client, clk clock.Clock, circleID string) *RealAdapter {
+	return &RealAdapter{
+		broker:   broker,
+		client:   client,
+		clock:    clk,
+		circleID: circleID,
+	}
+}
+
+func (a *RealAdapter) Name() string {
+	return "plaid_real"
+}
+
+// SyncOutput contains the result of a bounded sync.
+// Privacy-preserving: counts become magnitude buckets in the receipt.
+type SyncOutput struct {
+	// Balances are the canonical balance events, one per account.
+	Balances []*events.BalanceEvent
+
+	// AccountsCount is the raw count (converted to magnitude in receipt).
+	AccountsCount int
+
+	// TransactionsCount is the raw count (converted to magnitude in receipt).
+	TransactionsCount int
+
+	// EvidenceTokens for receipt hashing (abstract only).
+	EvidenceTokens []string
+
+	// SyncTime is when the sync occurred.
+	SyncTime time.Time
+}
+
+// Sync performs a bounded sync of balances and transaction counts.
+// windowDays is clamped like truelayer.SyncInput; zero means SyncWindowDays.
+// CRITICAL: No retries. Single attempt per account.
+func (a *RealAdapter) Sync(ctx context.Context, windowDays int) (*SyncOutput, error) {
+	now := a.clock.Now()
+
+	token, err := a.mint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := a.accounts(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	window := truelayer.SyncInput{WindowDays: windowDays}.EffectiveWindowDays()
+	fromDate := now.AddDate(0, 0, -window)
+
+	out := &SyncOutput{
+		AccountsCount: len(accounts),
+		SyncTime:      now,
+	}
+	seen := make(map[string]bool)
+	for _, acc := range accounts {
+		out.Balances = append(out.Balances, balanceEvent(acc, a.circleID, now))
+
+		txResp, err := a.client.GetTransactions(ctx, token, fromDate, now, &plaid.TransactionsGetOptions{
+			AccountIDs: []string{acc.AccountID},
+			Count:      MaxTransactionsPerAccount,
+		})
+		if err != nil {
+			// Continue with partial data on individual account failure
+			continue
+		}
+		n := len(txResp.Transactions)
+		if n > MaxTransactionsPerAccount {
+			n = MaxTransactionsPerAccount
+		}
+		out.TransactionsCount += n
+
+		if acc.Type != "" && !seen[acc.Type] {
+			seen[acc.Type] = true
+			out.EvidenceTokens = append(out.EvidenceTokens, "account_type|"+acc.Type)
+		}
+	}
+	sort.Strings(out.EvidenceTokens)
+
+	return out, nil
+}
+
+// BuildSyncReceipt builds a FinanceSyncReceipt from sync output.
+// A nil output with a fail reason records a failed sync.
+func BuildSyncReceipt(circleID string, output *SyncOutput, at time.Time, failReason string) *financemirror.FinanceSyncReceipt {
+	if output == nil {
+		return financemirror.NewFinanceSyncReceipt(circleID, Vendor, at, 0, 0, nil, false, failReason)
+	}
+	return financemirror.NewFinanceSyncReceipt(
+		circleID,
+		Vendor,
+		output.SyncTime,
+		output.AccountsCount,
+		output.TransactionsCount,
+		output.EvidenceTokens,
+		true,
+		"",
+	)
+}
+
+// FetchTransactions retrieves up to MaxTransactionsPerAccount transactions
+// for one account and returns canonical events.
+func (a *RealAdapter) FetchTransactions(accountID string, since time.Time, limit int) ([]*events.TransactionEvent, error) {
+	ctx := context.Background()
+	now := a.clock.Now()
+
+	token, err := a.mint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > MaxTransactionsPerAccount {
+		limit = MaxTransactionsPerAccount
+	}
+	if earliest := now.AddDate(0, 0, -MaxSyncWindowDays); since.IsZero() || since.Before(earliest) {
+		since = now.AddDate(0, 0, -SyncWindowDays)
+	}
+
+	resp, err := a.client.GetTransactions(ctx, token, since, now, &plaid.TransactionsGetOptions{
+		AccountIDs: []string{accountID},
+		Count:      limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get transactions: %w", err)
+	}
+
+	result := make([]*events.TransactionEvent, 0, len(resp.Transactions))
+	for _, tx := range resp.Transactions {
+		if tx.AccountID != accountID {
+			continue
+		}
+		result = append(result, transactionEvent(tx, a.circleID, now))
+		if len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// FetchBalance retrieves the current balance for an account.
+func (a *RealAdapter) FetchBalance(accountID string) (*events.BalanceEvent, error) {
+	ctx := context.Background()
+
+	token, err := a.mint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := a.accounts(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range accounts {
+		if acc.AccountID == accountID {
+			return balanceEvent(acc, a.circleID, a.clock.Now()), nil
+		}
+	}
+	return nil, fmt.Errorf("account not found")
+}
+
+// FetchPendingCount returns the count of pending transactions in the sync window.
+func (a *RealAdapter) FetchPendingCount(accountID string) (int, error) {
+	txs, err := a.FetchTransactions(accountID, time.Time{}, MaxTransactionsPerAccount)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, tx := range txs {
+		if tx.TransactionStatus == "PENDING" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// mint mints a read-only Plaid access token.
+// CRITICAL: Never log the returned value.
+func (a *RealAdapter) mint(ctx context.Context) (string, error) {
+	token, err := a.broker.MintReadOnlyAccessToken(ctx, a.circleID, auth.ProviderPlaid, []string{"finance:read"})
+	if err != nil {
+		return "", fmt.Errorf("mint token: %w", err)
+	}
+	return token.Token, nil
+}
+
+// accounts fetches accounts, sorted by ID and bounded to MaxAccounts.
+func (a *RealAdapter) accounts(ctx context.Context, token string) ([]plaid.PlaidAccount, error) {
+	resp, err := a.client.GetAccounts(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+	accounts := resp.Accounts
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].AccountID < accounts[j].AccountID
+	})
+	if len(accounts) > MaxAccounts {
+		accounts = accounts[:MaxAccounts]
+	}
+	return accounts, nil
+}
+
+// balanceEvent converts a Plaid account to a canonical balance event.
+func balanceEvent(acc plaid.PlaidAccount, circleID string, now time.Time) *events.BalanceEvent {
+	event := events.NewBalanceEvent(Vendor, acc.AccountID, now, now)
+	event.AccountType = accountType(acc.Type, acc.Subtype)
+	event.MaskedNumber = acc.Mask
+	event.CurrentMinor = toMinor(acc.Balances.Current)
+	event.AvailableMinor = toMinor(acc.Balances.Available)
+	event.Currency = acc.Balances.IsoCurrencyCode
+	if event.Currency == "" {
+		event.Currency = acc.Balances.UnofficialCurrencyCode
+	}
+	if acc.Balances.Limit != nil {
+		limit := toMinor(acc.Balances.Limit)
+		event.CreditLimitMinor = &limit
+	}
+	event.Circle = identity.EntityID(circleID)
+	return event
+}
+
+// transactionEvent converts a Plaid transaction to a canonical event.
+// Plaid amounts are positive for money leaving the account.
+func transactionEvent(tx plaid.PlaidTransaction, circleID string, now time.Time) *events.TransactionEvent {
+	occurred, err := plaid.ParseDate(tx.Date)
+	if err != nil {
+		occurred = now
+	}
+
+	event := events.NewTransactionEvent(Vendor, tx.AccountID, tx.TransactionID, now, occurred)
+	amount := tx.Amount
+	event.TransactionType = "DEBIT"
+	event.TransactionKind = "PURCHASE"
+	if amount < 0 {
+		amount = -amount
+		event.TransactionType = "CREDIT"
+		event.TransactionKind = "REFUND"
+	}
+	event.TransactionStatus = "POSTED"
+	if tx.Pending {
+		event.TransactionStatus = "PENDING"
+	}
+	event.AmountMinor = toMinor(&amount)
+	event.Currency = tx.IsoCurrencyCode
+	event.MerchantName = tx.MerchantName
+	event.MerchantNameRaw = tx.Name
+	if len(tx.Category) > 0 {
+		event.MerchantCategory = tx.Category[0]
+	}
+	event.TransactionDate = occurred
+	event.Circle = identity.EntityID(circleID)
+	return event
+}
+
+// accountType maps Plaid types to the canonical account types.
+func accountType(typ, subtype string) string {
+	switch {
+	case typ == "credit":
+		return "CREDIT"
+	case subtype == "savings":
+		return "SAVINGS"
+	case typ == "depository":
+		return "CHECKING"
+	case typ == "loan":
+		return "LOAN"
+	case typ == "investment":
+		return "INVESTMENT"
+	default:
+		return "OTHER"
+	}
+}
+
+// toMinor converts an optional major-unit amount to minor units.
+func toMinor(v *float64) int64 {
+	if v == nil {
+		return 0
+	}
+	return int64(math.Round(*v * 100))
+}
+
+// Verify interface compliance.
+var _ finance_read.Adapter = (*RealAdapter)(nil)
@@ -0,0 +1,133 @@
+// Package plaid_read provides a read-only adapter for Plaid finance integration.
+// This file contains stub-client tests for the real adapter.
+package plaid_read
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	plaid "quantumlife/internal/connectors/finance/read/providers/plaid"
+	"quantumlife/pkg/clock"
+)
+
+// mockTokenMinter implements TokenMinter for testing.
+type mockTokenMinter struct {
+	provider auth.ProviderID
+	scopes   []string
+}
+
+func (m *mockTokenMinter) MintReadOnlyAccessToken(ctx context.Context, circleID string, provider auth.ProviderID, requiredScopes []string) (auth.AccessToken, error) {
+	m.provider = provider
+	m.scopes = requiredScopes
+	return auth.AccessToken{Token: "access-sandbox-token"}, nil
+}
+
+// stubClient serves a fixed number of accounts and transactions per account.
+type stubClient struct {
+	accounts     int
+	transactions int
+	startDates   []time.Time
+	counts       []int
+}
+
+func (c *stubClient) GetAccounts(ctx context.Context, accessToken string) (*plaid.AccountsGetResponse, error) {
+	current, available := 1234.56, 1000.0
+	resp := &plaid.AccountsGetResponse{}
+	for i := 0; i < c.accounts; i++ {
+		resp.Accounts = append(resp.Accounts, plaid.PlaidAccount{
+			AccountID: fmt.Sprintf("acc-%03d", i),
+			Type:      "depository",
+			Subtype:   "checking",
+			Mask:      "0000",
+			Balances: plaid.PlaidBalances{
+				Current:         &current,
+				Available:       &available,
+				IsoCurrencyCode: "USD",
+			},
+		})
+	}
+	return resp, nil
+}
+
+func (c *stubClient) GetTransactions(ctx context.Context, accessToken string, startDate, endDate time.Time, opts *plaid.TransactionsGetOptions) (*plaid.TransactionsGetResponse, error) {
+	c.startDates = append(c.startDates, startDate)
+	c.counts = append(c.counts, opts.Count)
+	resp := &plaid.TransactionsGetResponse{}
+	for i := 0; i < c.transactions; i++ {
+		resp.Transactions = append(resp.Transactions, plaid.PlaidTransaction{
+			TransactionID: fmt.Sprintf("tx-%03d", i),
+			AccountID:     opts.AccountIDs[0],
+			Amount:        12.5,
+			Date:          "2024-01-14",
+		})
+	}
+	return resp, nil
+}
+
+func TestRealAdapter_SyncIsBoundedLikeTrueLayer(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	minter := &mockTokenMinter{}
+	client := &stubClient{accounts: 30, transactions: 40}
+	adapter := NewRealAdapter(minter, client, clock.NewFixed(now), "circle-1")
+
+	out, err := adapter.Sync(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+
+	if minter.provider != auth.ProviderPlaid || len(minter.scopes) != 1 || minter.scopes[0] != "finance:read" {
+		t.Errorf("expected read-only Plaid mint, got %s %v", minter.provider, minter.scopes)
+	}
+	if out.AccountsCount != MaxAccounts || len(out.Balances) != MaxAccounts {
+		t.Errorf("expected %d accounts, got %d (%d balances)", MaxAccounts, out.AccountsCount, len(out.Balances))
+	}
+	if out.TransactionsCount != MaxAccounts*MaxTransactionsPerAccount {
+		t.Errorf("expected %d transactions, got %d", MaxAccounts*MaxTransactionsPerAccount, out.TransactionsCount)
+	}
+	if want := now.AddDate(0, 0, -SyncWindowDays); !client.startDates[0].Equal(want) {
+		t.Errorf("expected %d-day window from %v, got %v", SyncWindowDays, want, client.startDates[0])
+	}
+	if client.counts[0] != MaxTransactionsPerAccount {
+		t.Errorf("expected count=%d, got %d", MaxTransactionsPerAccount, client.counts[0])
+	}
+
+	balance := out.Balances[0]
+	if balance.Vendor != Vendor || balance.AccountID != "acc-000" {
+		t.Errorf("unexpected balance identity: %s/%s", balance.Vendor, balance.AccountID)
+	}
+	if balance.CurrentMinor != 123456 || balance.AvailableMinor != 100000 || balance.Currency != "USD" {
+		t.Errorf("unexpected balance values: %d/%d %s", balance.CurrentMinor, balance.AvailableMinor, balance.Currency)
+	}
+	if balance.AccountType != "CHECKING" || string(balance.Circle) != "circle-1" {
+		t.Errorf("unexpected balance type/circle: %s/%s", balance.AccountType, balance.Circle)
+	}
+
+	receipt := BuildSyncReceipt("circle-1", out, now, "")
+	if receipt.Provider != Vendor || !receipt.Success {
+		t.Errorf("expected successful plaid receipt, got %s success=%v", receipt.Provider, receipt.Success)
+	}
+}
+
+func TestRealAdapter_WindowIsClamped(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	client := &stubClient{accounts: 1}
+	adapter := NewRealAdapter(&mockTokenMinter{}, client, clock.NewFixed(now), "circle-1")
+
+	if _, err := adapter.Sync(context.Background(), 365); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if want := now.AddDate(0, 0, -MaxSyncWindowDays); !client.startDates[0].Equal(want) {
+		t.Errorf("expected window clamped to %d days, got start %v", MaxSyncWindowDays, client.startDates[0])
+	}
+}
+
+func TestBuildSyncReceipt_Failure(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	receipt := BuildSyncReceipt("circle-1", nil, now, "sync_error")
+	if receipt.Success || receipt.FailReason != "sync_error" || receipt.Provider != Vendor {
+		t.Errorf("unexpected failure receipt: %+v", receipt)
+	}
+}
@@ -0,0 +1,44 @@
+package interest
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+)
+
+// ErrInvalidEmail indicates the submitted text is not a usable address.
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// ParseEmail parses an RFC 5322 address and returns its bare addr-spec,
+// without any display name.
+// Beyond what net/mail accepts, it requires a non-empty local part and a
+// domain name of at least two non-empty labels, so "a@b", "a@b." and
+// domain literals such as "a@[192.0.2.1]" fail.
+func ParseEmail(raw string) (string, error) {
+	addr, err := mail.ParseAddress(raw)
+	if err != nil {
+		return "", ErrInvalidEmail
+	}
+
+	at := strings.LastIndex(addr.Address, "@")
+	if at < 0 {
+		return "", ErrInvalidEmail
+	}
+	local, domain := addr.Address[:at], addr.Address[at+1:]
+	if local == "" || domain == "" || strings.HasPrefix(domain, "[") {
+		return "", ErrInvalidEmail
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", ErrInvalidEmail
+	}
+	for _, label := range labels {
+		if label == "" {
+			return "", ErrInvalidEmail
+		}
+	}
+	// Without a name, String is the addr-spec in angle brackets, with the
+	// local part re-quoted when it needs it, e.g. <"a b"@example.com>
+	bare := (&mail.Address{Address: addr.Address}).String()
+	return strings.TrimSuffix(strings.TrimPrefix(bare, "<"), ">"), nil
+}
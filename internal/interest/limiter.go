@@ -0,0 +1,116 @@
+package interest
+
+import (
+	"sync"
+	"time"
+)
+
+// MaxLimiterKeys bounds how many clients the limiter tracks. Past it, the
+// limiter forgets buckets that have refilled, then the least recently used.
+const MaxLimiterKeys = 10000
+
+// Limiter is a token-bucket rate limiter keyed by client.
+// Each client may spend up to capacity tokens, refilled evenly over
+// interval. Refill is computed from the injected clock on each call;
+// there is no background timer.
+type Limiter struct {
+	mu       sync.Mutex
+	capacity float64
+	interval time.Duration
+	buckets  map[string]*tokenBucket
+
+	// Clock injection for determinism.
+	clock func() time.Time
+}
+
+// tokenBucket is one client's remaining tokens as of last.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter creates a limiter allowing capacity requests per interval
+// for each key. A capacity of zero or less allows every request.
+func NewLimiter(capacity int, interval time.Duration, clock func() time.Time) *Limiter {
+	return &Limiter{
+		capacity: float64(capacity),
+		interval: interval,
+		buckets:  make(map[string]*tokenBucket),
+		clock:    clock,
+	}
+}
+
+// Allow spends one token for key and reports whether one was available.
+func (l *Limiter) Allow(key string) bool {
+	if l.capacity <= 0 || l.interval <= 0 {
+		return true
+	}
+
+	now := l.clock()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= MaxLimiterKeys {
+			l.forgetFull(now)
+		}
+		if len(l.buckets) >= MaxLimiterKeys {
+			l.forgetOldest()
+		}
+		b = &tokenBucket{tokens: l.capacity, last: now}
+		l.buckets[key] = b
+	}
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refill adds the tokens earned since b.last, up to capacity.
+func (l *Limiter) refill(b *tokenBucket, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += l.capacity * float64(elapsed) / float64(l.interval)
+		if b.tokens > l.capacity {
+			b.tokens = l.capacity
+		}
+	}
+	b.last = now
+}
+
+// forgetFull drops buckets that have refilled; a new bucket starts full,
+// so forgetting them changes nothing.
+func (l *Limiter) forgetFull(now time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.capacity {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// forgetOldest drops the least recently used bucket, the lowest key on a
+// tie. That client starts over with a full bucket, which bounds memory
+// when more clients than MaxLimiterKeys are active at once.
+func (l *Limiter) forgetOldest() {
+	var oldestKey string
+	var oldest *tokenBucket
+	for key, b := range l.buckets {
+		if oldest == nil || b.last.Before(oldest.last) || (b.last.Equal(oldest.last) && key < oldestKey) {
+			oldestKey, oldest = key, b
+		}
+	}
+	if oldest != nil {
+		delete(l.buckets, oldestKey)
+	}
+}
+
+// Len returns how many clients the limiter tracks.
+func (l *Limiter) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.buckets)
+}
@@ -146,3 +146,11 @@ func hashEmail(email string) string {
 	h := sha256.Sum256([]byte(email))
 	return hex.EncodeToString(h[:])
 }
+
+// Clear removes all entries.
+func (s *Store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]Entry, 0)
+	s.hashes = make(map[string]bool)
+}
@@ -8,7 +8,10 @@
 package interruptions
 
 import (
+	"sort"
+
 	"quantumlife/pkg/domain/interrupt"
+	"quantumlife/pkg/hashutil"
 )
 
 // DedupStore tracks seen dedup keys.
@@ -76,5 +79,59 @@ func Dedup(interruptions []*interrupt.Interruption, store DedupStore) ([]*interr
 	return kept, dropped
 }
 
+// HashDedupKey returns the hash recorded for a dedup key.
+func HashDedupKey(key string) string {
+	return hashutil.HashString("interruptions.DedupKey", key)
+}
+
+// seenDedupKeys returns the sorted hashes of candidate keys the store has
+// already seen.
+func seenDedupKeys(interruptions []*interrupt.Interruption, store DedupStore) []string {
+	var seen []string
+	for _, i := range interruptions {
+		if store.HasSeen(i.DedupKey) {
+			seen = append(seen, HashDedupKey(i.DedupKey))
+		}
+	}
+	sort.Strings(seen)
+	return seen
+}
+
+// seededDeduper is an InMemoryDeduper that also treats keys whose hash was
+// recorded as seen.
+type seededDeduper struct {
+	*InMemoryDeduper
+	seenHashes map[string]bool
+}
+
+// newSeededDeduper creates a deduper seeded with recorded key hashes.
+func newSeededDeduper(hashes []string) *seededDeduper {
+	d := &seededDeduper{
+		InMemoryDeduper: NewInMemoryDeduper(),
+		seenHashes:      make(map[string]bool, len(hashes)),
+	}
+	for _, h := range hashes {
+		d.seenHashes[h] = true
+	}
+	return d
+}
+
+// HasSeen checks the recorded hashes, then keys marked since.
+func (d *seededDeduper) HasSeen(key string) bool {
+	return d.seenHashes[HashDedupKey(key)] || d.InMemoryDeduper.HasSeen(key)
+}
+
+// Clear removes recorded and marked keys.
+func (d *seededDeduper) Clear() {
+	d.seenHashes = make(map[string]bool)
+	d.InMemoryDeduper.Clear()
+}
+
+// Count returns the number of recorded and marked keys.
+func (d *seededDeduper) Count() int {
+	return len(d.seenHashes) + d.InMemoryDeduper.Count()
+}
+
 // Verify interface compliance.
 var _ DedupStore = (*InMemoryDeduper)(nil)
+var _ DedupStore = (*seededDeduper)(nil)
@@ -15,8 +15,10 @@ import (
 	"time"
 
 	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/interrupt"
 	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/policy"
 	"quantumlife/pkg/domain/view"
 )
 
@@ -82,11 +84,72 @@ func NewEngine(config Config, clk clock.Clock, dedupStore DedupStore, quotaStore
 	}
 }
 
+// SetPolicySet sets the per-circle policies. A circle with a policy uses
+// its RegretThreshold, NotifyThreshold and UrgentThreshold for levels and
+// its DailyNotifyQuota and DailyQueuedQuota, enforced independently, for
+// quota.
+func (e *Engine) SetPolicySet(ps *policy.PolicySet) {
+	e.quotaEnforcer.SetPolicySet(ps)
+}
+
+// DefaultCirclePolicy returns the policy matching what the engine does for
+// circleID without one: config notify and urgent thresholds, the queued
+// threshold as regret threshold and the circle type's notify quota. Its
+// queued quota is MaxDailyQuota, the most a policy allows.
+func (e *Engine) DefaultCirclePolicy(circleID string) policy.CirclePolicy {
+	return policy.CirclePolicy{
+		CircleID:         circleID,
+		RegretThreshold:  e.config.QueuedThreshold,
+		NotifyThreshold:  e.config.NotifyThreshold,
+		UrgentThreshold:  e.config.UrgentThreshold,
+		DailyNotifyQuota: e.quotaEnforcer.getLimit(circleTypeFromID(identity.EntityID(circleID))),
+		DailyQueuedQuota: policy.MaxDailyQuota,
+	}
+}
+
+// Fresh returns an engine with the same config and policies but empty
+// dedup and quota state, reading time from clk. Used for side-effect-free
+// evaluation such as run replay.
+func (e *Engine) Fresh(clk clock.Clock) *Engine {
+	fresh := NewEngine(e.config, clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+	fresh.quotaEnforcer.policies = e.quotaEnforcer.policies
+	return fresh
+}
+
+// Seeded returns a Fresh engine whose dedup and quota state starts from
+// state, reading time from clk. Processing the same obligations reproduces
+// the decisions of the Process call that recorded state.
+func (e *Engine) Seeded(clk clock.Clock, state StartState) *Engine {
+	seeded := e.Fresh(clk)
+	seeded.dedupStore = newSeededDeduper(state.SeenDedupKeys)
+	dayKey := clk.Now().UTC().Format("2006-01-02")
+	for circleKey, used := range state.QuotaUsage {
+		for i := 0; i < used; i++ {
+			seeded.quotaEnforcer.store.IncrementUsage(circleKey, dayKey)
+		}
+	}
+	return seeded
+}
+
+// StartState is the dedup and quota state a Process call started from,
+// limited to what that call read.
+type StartState struct {
+	// SeenDedupKeys are hashes of candidate dedup keys that were already
+	// seen. Raw keys carry source references, so only hashes are kept.
+	SeenDedupKeys []string
+
+	// QuotaUsage is the quota already used per quota key that day.
+	QuotaUsage map[string]int
+}
+
 // ProcessResult contains engine output.
 type ProcessResult struct {
 	Interruptions []*interrupt.Interruption
 	Report        *interrupt.DecisionReport
 	Hash          string
+
+	// Start is the dedup and quota state the call started from.
+	Start StartState
 }
 
 // Process transforms obligations into prioritized interruptions.
@@ -101,6 +164,10 @@ func (e *Engine) Process(dailyView *view.DailyView, obligations []*obligation.Ob
 		interruptions = append(interruptions, intr)
 	}
 	report.TotalProcessed = len(interruptions)
+	start := StartState{
+		SeenDedupKeys: seenDedupKeys(interruptions, e.dedupStore),
+		QuotaUsage:    e.quotaEnforcer.usage(interruptions, now),
+	}
 
 	// Step 2: Apply dedup
 	interruptions, dedupDropped := Dedup(interruptions, e.dedupStore)
@@ -126,6 +193,7 @@ func (e *Engine) Process(dailyView *view.DailyView, obligations []*obligation.Ob
 		Interruptions: interruptions,
 		Report:        report,
 		Hash:          hash,
+		Start:         start,
 	}
 }
 
@@ -213,25 +281,35 @@ func (e *Engine) computeRegretScore(oblig *obligation.Obligation, now time.Time)
 	return score
 }
 
-// computeLevel determines interruption level.
+// computeLevel determines interruption level. Urgent and notify thresholds
+// come from the circle's policy when it has one, else from config. The
+// policy's regret threshold is the floor under notify: below it nothing is
+// queued.
 func (e *Engine) computeLevel(regret int, oblig *obligation.Obligation, now time.Time) interrupt.Level {
 	var hoursUntilDue float64 = -1
 	if oblig.DueBy != nil {
 		hoursUntilDue = oblig.DueBy.Sub(now).Hours()
 	}
 
-	// Urgent: Regret >= 90 AND due within 24h
-	if regret >= e.config.UrgentThreshold && hoursUntilDue >= 0 && hoursUntilDue <= 24 {
+	urgentThreshold, notifyThreshold := e.config.UrgentThreshold, e.config.NotifyThreshold
+	queuedThreshold := e.config.QueuedThreshold
+	if cp := circlePolicy(e.quotaEnforcer.policies, oblig.CircleID); cp != nil {
+		urgentThreshold, notifyThreshold = cp.UrgentThreshold, cp.NotifyThreshold
+		queuedThreshold = cp.RegretThreshold
+	}
+
+	// Urgent: Regret >= urgent threshold (90 by default) AND due within 24h
+	if regret >= urgentThreshold && hoursUntilDue >= 0 && hoursUntilDue <= 24 {
 		return interrupt.LevelUrgent
 	}
 
-	// Notify: Regret >= 75 AND due within 48h
-	if regret >= e.config.NotifyThreshold && hoursUntilDue >= 0 && hoursUntilDue <= 48 {
+	// Notify: Regret >= notify threshold (75 by default) AND due within 48h
+	if regret >= notifyThreshold && hoursUntilDue >= 0 && hoursUntilDue <= 48 {
 		return interrupt.LevelNotify
 	}
 
-	// Queued: Regret >= 50
-	if regret >= e.config.QueuedThreshold {
+	// Queued: Regret >= queued threshold (50 by default)
+	if regret >= queuedThreshold {
 		return interrupt.LevelQueued
 	}
 
@@ -8,6 +8,7 @@ import (
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/interrupt"
 	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/policy"
 	"quantumlife/pkg/domain/view"
 )
 
@@ -392,3 +393,179 @@ func createTestDailyView(now time.Time) *view.DailyView {
 	builder.AddCircle("circle-finance", "Finance")
 	return builder.Build()
 }
+
+func TestEnginePerCircleQuotaIndependent(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+
+	ps := policy.EmptyPolicySet(fixedTime)
+	circleA := policy.MinimalCirclePolicy("circle-alpha")
+	circleA.DailyNotifyQuota = 1
+	circleB := policy.MinimalCirclePolicy("circle-beta")
+	circleB.DailyNotifyQuota = 2
+	ps.Circles[circleA.CircleID] = circleA
+	ps.Circles[circleB.CircleID] = circleB
+
+	engine := NewEngine(DefaultConfig(), clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+	engine.SetPolicySet(&ps)
+
+	// Same NOTIFY-level shape as TestEngineQuota: due 30-36h out, critical, action needed.
+	// Circle A gets 3 (quota 1), circle B gets 2 (quota 2).
+	var obligations []*obligation.Obligation
+	add := func(circleID identity.EntityID, eventID string, i int) {
+		dueBy := fixedTime.Add(time.Duration(30+i) * time.Hour)
+		obligations = append(obligations, obligation.NewObligation(
+			circleID,
+			eventID,
+			"email",
+			obligation.ObligationReply,
+			fixedTime,
+		).WithDueBy(dueBy, fixedTime).WithSeverity(obligation.SeverityCritical).WithScoring(0.95, 0.85))
+	}
+	add("circle-alpha", "alpha-1", 0)
+	add("circle-alpha", "alpha-2", 1)
+	add("circle-alpha", "alpha-3", 2)
+	add("circle-beta", "beta-1", 3)
+	add("circle-beta", "beta-2", 4)
+
+	result := engine.Process(createTestDailyView(fixedTime), obligations)
+
+	counts := map[identity.EntityID]map[interrupt.Level]int{}
+	for _, intr := range result.Interruptions {
+		if counts[intr.CircleID] == nil {
+			counts[intr.CircleID] = map[interrupt.Level]int{}
+		}
+		counts[intr.CircleID][intr.Level]++
+	}
+
+	if got := counts["circle-alpha"][interrupt.LevelNotify]; got != 1 {
+		t.Errorf("circle A: expected 1 notify (quota=1), got %d", got)
+	}
+	if got := counts["circle-alpha"][interrupt.LevelQueued]; got != 2 {
+		t.Errorf("circle A: expected 2 queued beyond quota, got %d", got)
+	}
+	if got := counts["circle-beta"][interrupt.LevelNotify]; got != 2 {
+		t.Errorf("circle B: expected 2 notify unaffected by circle A, got %d", got)
+	}
+	if result.Report.QuotaDowngraded != 2 {
+		t.Errorf("Expected 2 downgraded, got %d", result.Report.QuotaDowngraded)
+	}
+}
+
+func TestEngineSeededReproducesStartState(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+	obligations := createTestObligations(fixedTime)
+	dailyView := createTestDailyView(fixedTime)
+
+	// A second Process on live state sees the first call's dedup and quota.
+	live := NewEngine(DefaultConfig(), clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+	live.Process(dailyView, obligations)
+	second := live.Process(dailyView, obligations)
+	if len(second.Start.SeenDedupKeys) == 0 {
+		t.Fatal("expected the second call to record seen dedup keys")
+	}
+
+	replayed := live.Seeded(clk, second.Start).Process(dailyView, obligations)
+	if replayed.Hash != second.Hash {
+		t.Errorf("seeded hash %s != live hash %s", replayed.Hash, second.Hash)
+	}
+	if replayed.Report.DedupDropped != second.Report.DedupDropped {
+		t.Errorf("seeded dropped %d != live dropped %d", replayed.Report.DedupDropped, second.Report.DedupDropped)
+	}
+
+	// A fresh engine starts from nothing and diverges.
+	fresh := live.Fresh(clk).Process(dailyView, obligations)
+	if fresh.Report.DedupDropped == second.Report.DedupDropped {
+		t.Error("expected fresh state to dedup differently from live state")
+	}
+}
+
+func TestEnginePerCircleThresholds(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+
+	// Regret lands between the policy and config notify thresholds.
+	dueBy := fixedTime.Add(36 * time.Hour)
+	oblig := obligation.NewObligation(
+		"circle-alpha",
+		"alpha-1",
+		"email",
+		obligation.ObligationReview,
+		fixedTime,
+	).WithDueBy(dueBy, fixedTime).WithScoring(0.5, 0.8)
+
+	engine := NewEngine(DefaultConfig(), clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+	regret := engine.computeRegretScore(oblig, fixedTime)
+	if regret >= DefaultConfig().NotifyThreshold {
+		t.Fatalf("test setup: regret %d must be below the config notify threshold", regret)
+	}
+	if got := engine.computeLevel(regret, oblig, fixedTime); got == interrupt.LevelNotify {
+		t.Fatalf("expected config thresholds not to notify, got %s", got)
+	}
+
+	ps := policy.EmptyPolicySet(fixedTime)
+	cp := policy.MinimalCirclePolicy("circle-alpha")
+	cp.RegretThreshold = 0
+	cp.NotifyThreshold = regret
+	ps.Circles[cp.CircleID] = cp
+	engine.SetPolicySet(&ps)
+
+	if got := engine.computeLevel(regret, oblig, fixedTime); got != interrupt.LevelNotify {
+		t.Errorf("expected the circle's notify threshold to apply, got %s", got)
+	}
+}
+
+func TestEnginePolicyRegretThresholdAndQueuedQuota(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := clock.NewFixed(fixedTime)
+
+	// Three obligations with no due date: regret lands below notify.
+	var obligations []*obligation.Obligation
+	for _, eventID := range []string{"alpha-1", "alpha-2", "alpha-3"} {
+		obligations = append(obligations, obligation.NewObligation(
+			"circle-alpha",
+			eventID,
+			"email",
+			obligation.ObligationReview,
+			fixedTime,
+		).WithScoring(1, 0.8))
+	}
+
+	process := func(cp policy.CirclePolicy) map[interrupt.Level]int {
+		ps := policy.EmptyPolicySet(fixedTime)
+		ps.Circles[cp.CircleID] = cp
+		engine := NewEngine(DefaultConfig(), clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+		engine.SetPolicySet(&ps)
+		counts := map[interrupt.Level]int{}
+		for _, intr := range engine.Process(createTestDailyView(fixedTime), obligations).Interruptions {
+			counts[intr.Level]++
+		}
+		return counts
+	}
+
+	engine := NewEngine(DefaultConfig(), clk, NewInMemoryDeduper(), NewInMemoryQuotaStore())
+	regret := engine.computeRegretScore(obligations[0], fixedTime)
+
+	cp := policy.MinimalCirclePolicy("circle-alpha")
+	cp.RegretThreshold = regret
+	cp.NotifyThreshold = 100
+	cp.UrgentThreshold = 100
+	if got := process(cp)[interrupt.LevelQueued]; got != 3 {
+		t.Fatalf("expected 3 queued at regret threshold %d, got %d", regret, got)
+	}
+
+	// Raising the regret threshold above the score stops queueing.
+	cp.RegretThreshold = regret + 1
+	if got := process(cp)[interrupt.LevelQueued]; got != 0 {
+		t.Errorf("expected nothing queued below the regret threshold, got %d", got)
+	}
+
+	// Lowering the queued quota caps queued; the rest go to ambient.
+	cp.RegretThreshold = regret
+	cp.DailyQueuedQuota = 1
+	counts := process(cp)
+	if counts[interrupt.LevelQueued] != 1 || counts[interrupt.LevelAmbient] != 2 {
+		t.Errorf("expected 1 queued and 2 ambient with queued quota 1, got %v", counts)
+	}
+}
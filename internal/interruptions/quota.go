@@ -4,6 +4,11 @@
 // When quota is exceeded, Notify is downgraded to Queued.
 // Urgent is NEVER downgraded.
 //
+// When a policy.PolicySet is provided, each circle with a CirclePolicy uses
+// its DailyNotifyQuota and is counted independently of every other circle.
+// Queued beyond its DailyQueuedQuota is downgraded to Ambient. The engine
+// also takes that circle's regret, notify and urgent thresholds.
+//
 // CRITICAL: Deterministic. Same inputs + same clock = same decisions.
 // CRITICAL: Uses UTC day key for quota bucket.
 package interruptions
@@ -14,6 +19,7 @@ import (
 
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/interrupt"
+	"quantumlife/pkg/domain/policy"
 )
 
 // QuotaConfig defines per-circle quota limits.
@@ -79,8 +85,9 @@ func (s *InMemoryQuotaStore) Clear() {
 
 // QuotaEnforcer applies quota limits to interruptions.
 type QuotaEnforcer struct {
-	config QuotaConfig
-	store  QuotaStore
+	config   QuotaConfig
+	store    QuotaStore
+	policies *policy.PolicySet
 }
 
 // NewQuotaEnforcer creates a new quota enforcer.
@@ -91,10 +98,18 @@ func NewQuotaEnforcer(config QuotaConfig, store QuotaStore) *QuotaEnforcer {
 	}
 }
 
+// SetPolicySet sets the per-circle policies used for quota limits.
+// A nil policy set falls back to QuotaConfig by circle type.
+func (e *QuotaEnforcer) SetPolicySet(ps *policy.PolicySet) {
+	e.policies = ps
+}
+
 // Apply applies quota limits to interruptions.
 // Returns (result interruptions, downgrade count).
 // Urgent is NEVER downgraded.
 // Notify is downgraded to Queued if quota exceeded.
+// Queued is downgraded to Ambient once the circle's policy DailyQueuedQuota
+// is used up.
 func (e *QuotaEnforcer) Apply(interruptions []*interrupt.Interruption, now time.Time) ([]*interrupt.Interruption, int) {
 	dayKey := now.UTC().Format("2006-01-02")
 	downgraded := 0
@@ -103,32 +118,99 @@ func (e *QuotaEnforcer) Apply(interruptions []*interrupt.Interruption, now time.
 	copy(result, interruptions)
 
 	for i, intr := range result {
-		// Only check Notify and Urgent levels
-		if intr.Level != interrupt.LevelNotify && intr.Level != interrupt.LevelUrgent {
-			continue
-		}
+		level := intr.Level
+
+		// Check Notify and Urgent against the notify quota
+		if level == interrupt.LevelNotify || level == interrupt.LevelUrgent {
+			circleKey, limit := e.limitFor(intr.CircleID)
+			currentUsage := e.store.GetUsage(circleKey, dayKey)
 
-		circleKey := circleTypeFromID(intr.CircleID)
-		limit := e.getLimit(circleKey)
-		currentUsage := e.store.GetUsage(circleKey, dayKey)
+			if currentUsage >= limit {
+				// Quota exceeded
+				if level == interrupt.LevelNotify {
+					// Downgrade Notify to Queued
+					level = interrupt.LevelQueued
+				}
+				// Urgent is NEVER downgraded, even if over quota
+			} else {
+				// Within quota, increment usage
+				e.store.IncrementUsage(circleKey, dayKey)
+			}
+		}
 
-		if currentUsage >= limit {
-			// Quota exceeded
-			if intr.Level == interrupt.LevelNotify {
-				// Downgrade Notify to Queued
-				downgraded++
-				result[i] = downgradeToQueued(intr)
+		// Check Queued, including downgraded Notify, against the queued quota
+		if level == interrupt.LevelQueued {
+			if cp := circlePolicy(e.policies, intr.CircleID); cp != nil {
+				queuedKey := queuedQuotaKey(intr.CircleID)
+				if e.store.GetUsage(queuedKey, dayKey) >= cp.DailyQueuedQuota {
+					level = interrupt.LevelAmbient
+				} else {
+					e.store.IncrementUsage(queuedKey, dayKey)
+				}
 			}
-			// Urgent is NEVER downgraded, even if over quota
-		} else {
-			// Within quota, increment usage
-			e.store.IncrementUsage(circleKey, dayKey)
+		}
+
+		if level != intr.Level {
+			downgraded++
+			result[i] = downgradeTo(intr, level)
 		}
 	}
 
 	return result, downgraded
 }
 
+// usage returns the usage already recorded that day for each quota key
+// Apply would read for interruptions.
+func (e *QuotaEnforcer) usage(interruptions []*interrupt.Interruption, now time.Time) map[string]int {
+	dayKey := now.UTC().Format("2006-01-02")
+	result := make(map[string]int)
+	record := func(key string) {
+		if used := e.store.GetUsage(key, dayKey); used > 0 {
+			result[key] = used
+		}
+	}
+	for _, intr := range interruptions {
+		if intr.Level != interrupt.LevelNotify && intr.Level != interrupt.LevelUrgent && intr.Level != interrupt.LevelQueued {
+			continue
+		}
+		if intr.Level != interrupt.LevelQueued {
+			circleKey, _ := e.limitFor(intr.CircleID)
+			record(circleKey)
+		}
+		if intr.Level != interrupt.LevelUrgent && circlePolicy(e.policies, intr.CircleID) != nil {
+			record(queuedQuotaKey(intr.CircleID))
+		}
+	}
+	return result
+}
+
+// queuedQuotaKey returns the usage key for a circle's queued quota.
+func queuedQuotaKey(circleID identity.EntityID) string {
+	return string(circleID) + "|queued"
+}
+
+// limitFor returns the usage key and daily limit for a circle.
+// Circles with a policy are keyed by circle ID so each is enforced independently.
+func (e *QuotaEnforcer) limitFor(circleID identity.EntityID) (string, int) {
+	if cp := circlePolicy(e.policies, circleID); cp != nil {
+		return string(circleID), cp.DailyNotifyQuota
+	}
+	circleType := circleTypeFromID(circleID)
+	return circleType, e.getLimit(circleType)
+}
+
+// circlePolicy returns the policy for a circle, matched by circle ID and
+// then by circle type, or nil when ps is nil or has neither.
+func circlePolicy(ps *policy.PolicySet, circleID identity.EntityID) *policy.CirclePolicy {
+	if ps == nil {
+		return nil
+	}
+	if cp := ps.GetCircle(string(circleID)); cp != nil {
+		return cp
+	}
+	return ps.GetCircle(circleTypeFromID(circleID))
+}
+
 // getLimit returns the limit for a circle type.
 func (e *QuotaEnforcer) getLimit(circleType string) int {
 	if limit, ok := e.config.MaxNotifyUrgentPerDay[circleType]; ok {
@@ -168,8 +250,8 @@ func containsType(s, t string) bool {
 	return false
 }
 
-// downgradeToQueued creates a new interruption with Queued level.
-func downgradeToQueued(i *interrupt.Interruption) *interrupt.Interruption {
+// downgradeTo creates a new interruption with the given lower level.
+func downgradeTo(i *interrupt.Interruption, level interrupt.Level) *interrupt.Interruption {
 	return interrupt.NewInterruption(
 		i.CircleID,
 		i.Trigger,
@@ -177,7 +259,7 @@ func downgradeToQueued(i *interrupt.Interruption) *interrupt.Interruption {
 		i.ObligationID,
 		i.RegretScore,
 		i.Confidence,
-		interrupt.LevelQueued, // Downgraded level
+		level, // Downgraded level
 		i.ExpiresAt,
 		i.CreatedAt,
 		i.Summary+" (quota)",
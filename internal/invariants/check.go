@@ -0,0 +1,197 @@
+// Package invariants provides a runtime self-check of the engagement-free
+// guarantees: no background pollers, no push notifications configured, and
+// every whisper cue dismissible for the current period.
+//
+// CRITICAL: Checks are pure functions over runtime state passed in by the
+// caller. No goroutines. No time.Now(). Stdlib only.
+// CRITICAL: Reports carry ONLY abstract names, counts and route paths.
+package invariants
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Name identifies an invariant.
+type Name string
+
+const (
+	// NoBackgroundPollers means no goroutine was started by our own code.
+	NoBackgroundPollers Name = "no_background_pollers"
+
+	// NoPushNotifications means no push delivery is configured.
+	NoPushNotifications Name = "no_push_notifications"
+
+	// CuesPeriodDismissible means every whisper cue has a dismiss route.
+	CuesPeriodDismissible Name = "cues_period_dismissible"
+)
+
+// Status is the outcome of a single invariant check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+)
+
+// CueDismissal describes how a whisper cue is dismissed for the period.
+type CueDismissal struct {
+	// Kind is the cue kind.
+	Kind string
+
+	// DismissPath is the POST route that dismisses the cue. Empty if none.
+	DismissPath string
+
+	// Registered indicates DismissPath is served by the running mux.
+	Registered bool
+}
+
+// Input is the runtime state the checks are evaluated against.
+type Input struct {
+	// GoroutineDump is the full goroutine dump (runtime.Stack with all=true).
+	GoroutineDump string
+
+	// OwnPrefixes are function name prefixes that belong to this codebase,
+	// e.g. "quantumlife/" and "main.".
+	OwnPrefixes []string
+
+	// AllowedCreators are creator functions exempt from the poller check,
+	// e.g. the command layer's graceful shutdown signal listener.
+	AllowedCreators []string
+
+	// PushRegistrations is the number of recorded push device registrations.
+	PushRegistrations int
+
+	// Cues lists every whisper cue and its dismiss route.
+	Cues []CueDismissal
+}
+
+// Result is the outcome of one invariant.
+type Result struct {
+	Name   Name   `json:"name"`
+	Status Status `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// Report is the pass/fail report for all invariants.
+type Report struct {
+	Results []Result `json:"results"`
+	AllPass bool     `json:"all_pass"`
+}
+
+// Evaluate runs all invariant checks in a fixed order.
+func Evaluate(in Input) Report {
+	results := []Result{
+		checkBackgroundPollers(in),
+		checkPushNotifications(in),
+		checkCuesDismissible(in),
+	}
+
+	report := Report{Results: results, AllPass: true}
+	for _, r := range results {
+		if r.Status != StatusPass {
+			report.AllPass = false
+		}
+	}
+	return report
+}
+
+// BackgroundGoroutines returns the sorted creator functions of goroutines
+// that were started by our own code. The main goroutine, goroutines
+// started by the standard library (e.g. net/http connections) and allowed
+// creators are ignored.
+func BackgroundGoroutines(dump string, ownPrefixes, allowed []string) []string {
+	var creators []string
+	for _, line := range strings.Split(dump, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "created by ") {
+			continue
+		}
+		fn := strings.TrimPrefix(line, "created by ")
+		if i := strings.Index(fn, " in goroutine "); i >= 0 {
+			fn = fn[:i]
+		}
+		if contains(allowed, fn) {
+			continue
+		}
+		for _, prefix := range ownPrefixes {
+			if strings.HasPrefix(fn, prefix) {
+				creators = append(creators, fn)
+				break
+			}
+		}
+	}
+	sort.Strings(creators)
+	return creators
+}
+
+func checkBackgroundPollers(in Input) Result {
+	creators := BackgroundGoroutines(in.GoroutineDump, in.OwnPrefixes, in.AllowedCreators)
+	if len(creators) > 0 {
+		return Result{
+			Name:   NoBackgroundPollers,
+			Status: StatusFail,
+			Detail: "background goroutines started by: " + strings.Join(creators, ", "),
+		}
+	}
+	return Result{Name: NoBackgroundPollers, Status: StatusPass, Detail: "no background goroutines"}
+}
+
+func checkPushNotifications(in Input) Result {
+	if in.PushRegistrations > 0 {
+		return Result{
+			Name:   NoPushNotifications,
+			Status: StatusFail,
+			Detail: strconv.Itoa(in.PushRegistrations) + " push device registration(s)",
+		}
+	}
+	return Result{Name: NoPushNotifications, Status: StatusPass, Detail: "no push device registrations"}
+}
+
+func checkCuesDismissible(in Input) Result {
+	var missing []string
+	for _, cue := range in.Cues {
+		if cue.DismissPath == "" || !cue.Registered {
+			missing = append(missing, cue.Kind)
+		}
+	}
+	if len(missing) > 0 {
+		return Result{
+			Name:   CuesPeriodDismissible,
+			Status: StatusFail,
+			Detail: "no dismiss route for: " + strings.Join(missing, ", "),
+		}
+	}
+	return Result{
+		Name:   CuesPeriodDismissible,
+		Status: StatusPass,
+		Detail: strconv.Itoa(len(in.Cues)) + " cues dismissible",
+	}
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// CanonicalString returns a deterministic string representation.
+func (r Report) CanonicalString() string {
+	parts := make([]string, len(r.Results))
+	for i, res := range r.Results {
+		parts[i] = string(res.Name) + ":" + string(res.Status)
+	}
+	return "invariants|" + strings.Join(parts, "|")
+}
+
+// Hash returns the SHA256 hash of the canonical string.
+func (r Report) Hash() string {
+	h := sha256.Sum256([]byte(r.CanonicalString()))
+	return hex.EncodeToString(h[:])
+}
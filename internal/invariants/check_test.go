@@ -0,0 +1,104 @@
+package invariants
+
+import (
+	"runtime"
+	"testing"
+)
+
+var ownPrefixes = []string{"quantumlife/", "main."}
+
+const quietDump = `goroutine 1 [running]:
+main.main()
+	/src/cmd/quantumlife-web/main.go:100 +0x1d
+
+goroutine 7 [IO wait]:
+internal/poll.runtime_pollWait(0x0, 0x72)
+	/go/src/runtime/netpoll.go:345 +0x85
+created by net/http.(*Server).Serve in goroutine 1
+	/go/src/net/http/server.go:3285 +0x4b4
+`
+
+const pollerDump = quietDump + `
+goroutine 9 [sleep]:
+time.Sleep(0x3b9aca00)
+	/go/src/runtime/time.go:195 +0x125
+quantumlife/internal/sync.(*Poller).loop()
+	/src/internal/sync/poller.go:40 +0x2a
+created by quantumlife/internal/sync.(*Poller).Start in goroutine 1
+	/src/internal/sync/poller.go:30 +0x5a
+`
+
+func TestBackgroundGoroutines_IgnoresStdlib(t *testing.T) {
+	if got := BackgroundGoroutines(quietDump, ownPrefixes, nil); len(got) != 0 {
+		t.Errorf("expected no background goroutines, got %v", got)
+	}
+}
+
+func TestBackgroundGoroutines_DetectsOwnPoller(t *testing.T) {
+	got := BackgroundGoroutines(pollerDump, ownPrefixes, nil)
+	if len(got) != 1 || got[0] != "quantumlife/internal/sync.(*Poller).Start" {
+		t.Errorf("expected poller creator, got %v", got)
+	}
+}
+
+func TestBackgroundGoroutines_AllowedCreator(t *testing.T) {
+	allowed := []string{"quantumlife/internal/sync.(*Poller).Start"}
+	if got := BackgroundGoroutines(pollerDump, ownPrefixes, allowed); len(got) != 0 {
+		t.Errorf("expected allowed creator to be ignored, got %v", got)
+	}
+}
+
+func TestBackgroundGoroutines_LiveRuntime(t *testing.T) {
+	buf := make([]byte, 1<<16)
+	dump := string(buf[:runtime.Stack(buf, true)])
+	if got := BackgroundGoroutines(dump, ownPrefixes, nil); len(got) != 0 {
+		t.Errorf("expected no background goroutines in test binary, got %v", got)
+	}
+}
+
+func TestEvaluate_AllPass(t *testing.T) {
+	report := Evaluate(Input{
+		GoroutineDump: quietDump,
+		OwnPrefixes:   ownPrefixes,
+		Cues: []CueDismissal{
+			{Kind: "proof", DismissPath: "/proof/dismiss", Registered: true},
+		},
+	})
+	if !report.AllPass {
+		t.Fatalf("expected all pass, got %+v", report.Results)
+	}
+	if len(report.Results) != 3 {
+		t.Errorf("expected 3 results, got %d", len(report.Results))
+	}
+}
+
+func TestEvaluate_Failures(t *testing.T) {
+	report := Evaluate(Input{
+		GoroutineDump:     pollerDump,
+		OwnPrefixes:       ownPrefixes,
+		PushRegistrations: 2,
+		Cues: []CueDismissal{
+			{Kind: "proof", DismissPath: "/proof/dismiss", Registered: true},
+			{Kind: "trust_transfer"},
+			{Kind: "reality", DismissPath: "/reality/ack", Registered: false},
+		},
+	})
+	if report.AllPass {
+		t.Fatal("expected failures")
+	}
+	for _, r := range report.Results {
+		if r.Status != StatusFail {
+			t.Errorf("%s: expected fail, got %s (%s)", r.Name, r.Status, r.Detail)
+		}
+	}
+	if got := report.Results[2].Detail; got != "no dismiss route for: trust_transfer, reality" {
+		t.Errorf("unexpected cue detail: %q", got)
+	}
+}
+
+func TestReport_HashDeterministic(t *testing.T) {
+	in := Input{GoroutineDump: quietDump, OwnPrefixes: ownPrefixes}
+	if Evaluate(in).Hash() != Evaluate(in).Hash() {
+		t.Error("report hash not deterministic")
+	}
+}
@@ -0,0 +1,404 @@
+package loop
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/internal/commerce/extract"
+	"quantumlife/internal/interruptions"
+	"quantumlife/internal/obligations"
+	"quantumlife/pkg/domain/draft"
+	domainevents "quantumlife/pkg/domain/events"
+	"quantumlife/pkg/domain/feedback"
+	"quantumlife/pkg/domain/identity"
+)
+
+// oblIdentityRepo implements obligations.IdentityRepository for testing.
+type oblIdentityRepo struct{}
+
+func (oblIdentityRepo) GetByID(id identity.EntityID) (identity.Entity, error) { return nil, nil }
+func (oblIdentityRepo) IsHighPriority(id identity.EntityID) bool              { return false }
+
+// newMockEventEngine builds an engine over a realistic mock event set:
+// three circles with mail, invites, commerce mail and balances.
+func newMockEventEngine(now time.Time) *Engine {
+	clk := &mockClock{now: now}
+	personal := createTestCircle("Personal", now)
+	work := createTestCircle("Work", now)
+	finance := createTestCircle("Finance", now)
+
+	store := domainevents.NewInMemoryEventStore()
+	email := func(circle identity.EntityID, id, domain, subject, body string, age time.Duration) *domainevents.EmailMessageEvent {
+		e := domainevents.NewEmailMessageEvent("gmail", id, "self@example.com", now, now.Add(-age))
+		e.Circle = circle
+		e.Subject = subject
+		e.BodyPreview = body
+		e.SenderDomain = domain
+		e.From = domainevents.EmailAddress{Address: "sender@" + domain, Name: "Sender"}
+		e.Folder = "INBOX"
+		return e
+	}
+
+	urgent := email(work.ID(), "msg-100", "company.com", "URGENT: Approval needed - Q1 Budget Review", "Please approve by Friday.", 3*time.Hour)
+	urgent.IsImportant = true
+	store.Store(urgent)
+	store.Store(email(work.ID(), "msg-101", "company.com", "Action required: sign the contract", "Deadline tomorrow.", 5*time.Hour))
+	for i, vendor := range []struct{ domain, subject, body string }{
+		{"amazon.com", "Your order has shipped", "Order #123-4567890 Tracking: 1Z999AA10123456784"},
+		{"edf.co.uk", "Your invoice is ready", "Invoice for £120.00 due by 2024-02-01"},
+		{"deliveroo.co.uk", "Your order has been placed", "Order #DEL-12345 Total: £24.99"},
+		{"netflix.com", "Your subscription has been renewed", "Monthly renewal $15.99"},
+	} {
+		e := email(personal.ID(), "msg-2"+string(rune('0'+i)), vendor.domain, vendor.subject, vendor.body, time.Duration(i+1)*time.Hour)
+		e.IsTransactional = true
+		store.Store(e)
+	}
+
+	for i, circle := range []identity.EntityID{work.ID(), personal.ID(), work.ID()} {
+		invite := domainevents.NewCalendarEventEvent("google", "cal-1", "evt-00"+string(rune('1'+i)), "self@example.com", now, now)
+		invite.Circle = circle
+		invite.Title = "Review"
+		invite.StartTime = now.Add(time.Duration(4+i) * time.Hour)
+		invite.EndTime = invite.StartTime.Add(time.Hour)
+		invite.MyResponseStatus = domainevents.RSVPNeedsAction
+		invite.AttendeeCount = 3 + i
+		store.Store(invite)
+	}
+
+	balance := domainevents.NewBalanceEvent("truelayer", "acc-300", now, now)
+	balance.Circle = finance.ID()
+	balance.AccountType = "CHECKING"
+	balance.AvailableMinor = 4000
+	balance.CurrentMinor = 4500
+	balance.Currency = "GBP"
+	store.Store(balance)
+
+	return &Engine{
+		Clock: clk,
+		IdentityRepo: &mockIdentityRepo{
+			circles: []*identity.Circle{personal, work, finance},
+		},
+		EventStore:       store,
+		ObligationEngine: obligations.NewEngine(obligations.DefaultConfig(), clk, oblIdentityRepo{}),
+		InterruptionEngine: interruptions.NewEngine(interruptions.DefaultConfig(), clk,
+			interruptions.NewInMemoryDeduper(), interruptions.NewInMemoryQuotaStore()),
+		DraftStore:                  draft.NewInMemoryStore(),
+		FeedbackStore:               feedback.NewMemoryStore(),
+		EventEmitter:                &mockEventEmitter{},
+		CommerceExtractor:           extract.NewEngine(clk),
+		CommerceObligationExtractor: obligations.NewCommerceObligationExtractor(obligations.DefaultCommerceConfig()),
+	}
+}
+
+func TestEngine_runDeterministic_MockEvents(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := newMockEventEngine(now)
+
+	result, err := engine.runDeterministic(context.Background(), RunOptions{IncludeMockData: true}, 20)
+	if err != nil {
+		t.Fatalf("loop is not deterministic: %v", err)
+	}
+
+	// The mock set must actually exercise the pipeline.
+	obligationCount := 0
+	for _, c := range result.Circles {
+		obligationCount += c.ObligationCount + c.CommerceObligationCount
+	}
+	if len(result.Circles) != 3 || obligationCount == 0 {
+		t.Fatalf("expected 3 circles with obligations, got %d circles, %d obligations", len(result.Circles), obligationCount)
+	}
+
+	// A fresh engine over the same inputs hashes identically.
+	again, err := newMockEventEngine(now).runDeterministic(context.Background(), RunOptions{IncludeMockData: true}, 2)
+	if err != nil {
+		t.Fatalf("second engine not deterministic: %v", err)
+	}
+	if resultHash(result) != resultHash(again) {
+		t.Errorf("result hash differs across engines: %s != %s", resultHash(result), resultHash(again))
+	}
+}
+
+func TestCompareFields_ReportsFirstDivergentPath(t *testing.T) {
+	base := RunResult{
+		RunID: "run-1",
+		Circles: []CircleResult{
+			{CircleID: "circle-a", CircleName: "Personal"},
+			{CircleID: "circle-b", CircleName: "Work"},
+		},
+	}
+	swapped := base
+	swapped.Circles = []CircleResult{base.Circles[1], base.Circles[0]}
+
+	err := compareFields(flattenResult(base), flattenResult(swapped))
+	if err == nil {
+		t.Fatal("expected divergence for reordered circles")
+	}
+	if err.Path != "RunResult.Circles[0].CircleID" || err.Want != "circle-a" || err.Got != "circle-b" {
+		t.Errorf("unexpected divergence: %+v", err)
+	}
+	if resultHash(base) == resultHash(swapped) {
+		t.Error("expected different hashes for reordered circles")
+	}
+
+	var target *divergenceError
+	if !errors.As(error(err), &target) {
+		t.Error("expected *divergenceError")
+	}
+
+	grown := base
+	grown.Errors = []string{"late"}
+	err = compareFields(flattenResult(base), flattenResult(grown))
+	if err == nil || err.Path != "RunResult.Errors.len" {
+		t.Errorf("expected divergence at Errors.len, got %+v", err)
+	}
+
+	if err := compareFields(flattenResult(base), flattenResult(base)); err != nil {
+		t.Errorf("expected identical results to match, got %v", err)
+	}
+}
+
+func TestEngine_Evaluate_HoldsObligationsWithoutSideEffects(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := newMockEventEngine(now)
+	store := obligations.NewInMemoryStore()
+	engine.ObligationStore = store
+
+	first := engine.Evaluate(context.Background(), RunOptions{})
+	second := engine.Evaluate(context.Background(), RunOptions{})
+	if resultHash(first) != resultHash(second) {
+		t.Fatal("repeated evaluations differ")
+	}
+	for _, c := range first.Circles {
+		if len(store.CountByCategory(c.CircleID)) != 0 {
+			t.Fatal("evaluate recorded obligation counts")
+		}
+	}
+
+	all := first.ObligationsFor("")
+	held := first.HeldObligations("")
+	interruptions := 0
+	for _, c := range first.Circles {
+		interruptions += c.InterruptionCount
+	}
+	if len(all) == 0 || len(held) > len(all) || len(held) < len(all)-interruptions {
+		t.Errorf("held %d inconsistent with %d obligations and %d interruptions", len(held), len(all), interruptions)
+	}
+
+	for _, c := range first.Circles {
+		for _, obl := range first.HeldObligations(c.CircleID) {
+			if obl.CircleID != c.CircleID {
+				t.Errorf("%s: held obligation from circle %s", c.CircleName, obl.CircleID)
+			}
+		}
+	}
+}
+
+// divergenceError reports that repeated runs over identical inputs
+// produced different results.
+type divergenceError struct {
+	// Run is the index of the first run that differed from run 0.
+	Run int
+
+	// Path is the first differing field, e.g. "Circles[1].Obligations[0].ID".
+	Path string
+
+	// Want and Got are the canonical values at Path in run 0 and Run.
+	Want string
+	Got  string
+}
+
+func (e *divergenceError) Error() string {
+	return fmt.Sprintf("loop run %d diverged at %s: got %q, want %q", e.Run, e.Path, e.Got, e.Want)
+}
+
+// runDeterministic evaluates the loop n times at the same instant and
+// checks every run hashes to the same resultHash. It catches code that
+// ranges over a map without sorting before the output reaches a
+// downstream hash.
+//
+// Each run uses Evaluate, a side-effect free copy of the engine, so
+// dedup, quota and draft stores cannot make later runs legitimately differ.
+// Returns the first run's result, or a *divergenceError naming the first
+// divergent field.
+func (e *Engine) runDeterministic(ctx context.Context, opts RunOptions, n int) (RunResult, error) {
+	if n < 2 {
+		n = 2
+	}
+	if opts.AsOf.IsZero() {
+		opts.AsOf = e.Clock.Now()
+	}
+
+	var first RunResult
+	var firstFields []resultField
+	for i := 0; i < n; i++ {
+		result := e.Evaluate(ctx, opts)
+		fields := flattenResult(result)
+		if i == 0 {
+			first, firstFields = result, fields
+			continue
+		}
+		if err := compareFields(firstFields, fields); err != nil {
+			err.Run = i
+			return first, err
+		}
+	}
+	return first, nil
+}
+
+// resultHash returns a deterministic hash over every field of a run result,
+// including the order of every slice.
+func resultHash(result RunResult) string {
+	var sb strings.Builder
+	for _, f := range flattenResult(result) {
+		sb.WriteString(f.path)
+		sb.WriteString("=")
+		sb.WriteString(f.value)
+		sb.WriteString("\n")
+	}
+	hash := sha256.Sum256([]byte(sb.String()))
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+// resultField is one leaf value of a flattened run result.
+type resultField struct {
+	path  string
+	value string
+}
+
+// compareFields returns the first difference between two flattened results.
+func compareFields(want, got []resultField) *divergenceError {
+	for i := 0; i < len(want) && i < len(got); i++ {
+		if want[i].path != got[i].path {
+			return &divergenceError{Path: want[i].path, Want: "present", Got: "missing"}
+		}
+		if want[i].value != got[i].value {
+			return &divergenceError{Path: want[i].path, Want: want[i].value, Got: got[i].value}
+		}
+	}
+	switch {
+	case len(got) > len(want):
+		return &divergenceError{Path: got[len(want)].path, Want: "missing", Got: "present"}
+	case len(want) > len(got):
+		return &divergenceError{Path: want[len(got)].path, Want: "present", Got: "missing"}
+	}
+	return nil
+}
+
+// flattenResult lists every leaf of a run result in a fixed order.
+// Map keys are sorted; slice order is kept so unsorted output is visible.
+func flattenResult(result RunResult) []resultField {
+	f := &flattener{visiting: make(map[uintptr]bool)}
+	f.walk("RunResult", reflect.ValueOf(result))
+	return f.fields
+}
+
+// flattener walks a value by reflection, guarding against pointer cycles.
+type flattener struct {
+	fields   []resultField
+	visiting map[uintptr]bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (f *flattener) add(path, value string) {
+	f.fields = append(f.fields, resultField{path: path, value: value})
+}
+
+func (f *flattener) walk(path string, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		f.add(path, "<nil>")
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			f.add(path, "<nil>")
+			return
+		}
+		ptr := v.Pointer()
+		if f.visiting[ptr] {
+			f.add(path, "<cycle>")
+			return
+		}
+		f.visiting[ptr] = true
+		f.walk(path, v.Elem())
+		delete(f.visiting, ptr)
+
+	case reflect.Interface:
+		if v.IsNil() {
+			f.add(path, "<nil>")
+			return
+		}
+		f.walk(path, v.Elem())
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			// Unexported times cannot be read through reflection; they are
+			// internal to their type and covered by its exported fields.
+			if v.CanInterface() {
+				f.add(path, v.Interface().(time.Time).UTC().Format(time.RFC3339Nano))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f.walk(path+"."+v.Type().Field(i).Name, v.Field(i))
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			f.add(path, hex.EncodeToString(v.Bytes()))
+			return
+		}
+		f.add(path+".len", fmt.Sprintf("%d", v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			f.walk(fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+		}
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		names := make([]string, len(keys))
+		byName := make(map[string]reflect.Value, len(keys))
+		for i, k := range keys {
+			names[i] = leafString(k)
+			byName[names[i]] = k
+		}
+		sort.Strings(names)
+		f.add(path+".len", fmt.Sprintf("%d", len(names)))
+		for _, name := range names {
+			f.walk(fmt.Sprintf("%s[%s]", path, name), v.MapIndex(byName[name]))
+		}
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		// Not data
+
+	default:
+		f.add(path, leafString(v))
+	}
+}
+
+// leafString formats a scalar without calling Interface, so unexported
+// fields can be read.
+func leafString(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return fmt.Sprintf("%t", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fmt.Sprintf("%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%g", v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprintf("%g", v.Complex())
+	}
+	return v.Type().String()
+}
@@ -77,6 +77,9 @@ type Engine struct {
 
 	// CommerceObligationExtractor extracts obligations from commerce events (Phase 8).
 	CommerceObligationExtractor *obligations.CommerceObligationExtractor
+
+	// ObligationStore receives abstract per-circle obligation counts.
+	ObligationStore obligations.Store
 }
 
 // RunOptions configures a loop run.
@@ -89,6 +92,10 @@ type RunOptions struct {
 
 	// ExecuteApprovedDrafts executes approved calendar drafts if true.
 	ExecuteApprovedDrafts bool
+
+	// AsOf pins the run time. Zero means use the engine clock.
+	// Used by replay to re-execute a snapshot deterministically.
+	AsOf time.Time
 }
 
 // RunResult contains the result of a loop run.
@@ -127,6 +134,13 @@ type CircleResult struct {
 	InterruptionCount   int
 	DraftCount          int
 
+	// InterruptionsDeduplicated counts interruptions dropped by dedup.
+	InterruptionsDeduplicated int
+
+	// InterruptionStart is the dedup and quota state the circle's
+	// interruptions were computed from. Snapshots record it for replay.
+	InterruptionStart interruptions.StartState
+
 	// Commerce (Phase 8)
 	CommerceEvents            []*commerce.CommerceEvent
 	CommerceObligations       []*obligation.Obligation
@@ -149,9 +163,12 @@ type NeedsYouSummary struct {
 	TotalItems int
 
 	// PendingDrafts are drafts awaiting approval.
+	// Ordered by horizon (ExpiresAt, soonest first), then DraftID.
 	PendingDrafts []draft.Draft
 
 	// ActiveInterruptions are interruptions that should surface.
+	// Ordered by urgency bucket (Level, most urgent first), then horizon
+	// (ExpiresAt, soonest first), then InterruptionID. See rankNeedsYou.
 	ActiveInterruptions []*interrupt.Interruption
 
 	// Hash is the deterministic hash of the needs-you state.
@@ -164,6 +181,9 @@ type NeedsYouSummary struct {
 // Run executes one iteration of the daily loop.
 func (e *Engine) Run(ctx context.Context, opts RunOptions) RunResult {
 	now := e.Clock.Now()
+	if !opts.AsOf.IsZero() {
+		now = opts.AsOf
+	}
 	result := RunResult{
 		StartedAt: now,
 	}
@@ -197,6 +217,9 @@ func (e *Engine) Run(ctx context.Context, opts RunOptions) RunResult {
 	})
 
 	result.CompletedAt = e.Clock.Now()
+	if !opts.AsOf.IsZero() {
+		result.CompletedAt = now
+	}
 
 	// Emit completion event
 	e.emitEvent(events.Phase6DailyRunCompleted, map[string]string{
@@ -257,6 +280,17 @@ func (e *Engine) processCircle(ctx context.Context, circle CircleInfo, now time.
 		intResult := e.InterruptionEngine.Process(dailyView, result.Obligations)
 		result.Interruptions = intResult.Interruptions
 		result.InterruptionCount = len(result.Interruptions)
+		result.InterruptionsDeduplicated = intResult.Report.DedupDropped
+		result.InterruptionStart = intResult.Start
+	}
+
+	// Record abstract counts; obligations without an interruption are held
+	if e.ObligationStore != nil {
+		surfaced := make(map[string]bool, len(result.Interruptions))
+		for _, i := range result.Interruptions {
+			surfaced[i.ObligationID] = true
+		}
+		e.ObligationStore.Record(circle.ID, result.Obligations, surfaced)
 	}
 
 	// Generate drafts from obligations
@@ -503,13 +537,8 @@ func (e *Engine) computeNeedsYou(circles []CircleResult) NeedsYouSummary {
 		summary.ActiveInterruptions = append(summary.ActiveInterruptions, circle.Interruptions...)
 	}
 
-	// Sort for determinism
-	sort.Slice(summary.PendingDrafts, func(i, j int) bool {
-		return string(summary.PendingDrafts[i].DraftID) < string(summary.PendingDrafts[j].DraftID)
-	})
-	sort.Slice(summary.ActiveInterruptions, func(i, j int) bool {
-		return summary.ActiveInterruptions[i].InterruptionID < summary.ActiveInterruptions[j].InterruptionID
-	})
+	// Rank for determinism
+	rankNeedsYou(summary.PendingDrafts, summary.ActiveInterruptions)
 
 	summary.TotalItems = len(summary.PendingDrafts) + len(summary.ActiveInterruptions)
 	summary.IsQuiet = summary.TotalItems == 0
@@ -518,6 +547,44 @@ func (e *Engine) computeNeedsYou(circles []CircleResult) NeedsYouSummary {
 	return summary
 }
 
+// rankNeedsYou orders needs-you items so the same state always yields the
+// same order, whatever order circles produced them in:
+//
+//  1. urgency bucket: interrupt Level, most urgent first (drafts have none)
+//  2. horizon: ExpiresAt, soonest first; a zero horizon sorts last
+//  3. item key hash: InterruptionID or DraftID, ascending
+//
+// The item key hash is unique per item, so ties never fall back to input
+// order.
+func rankNeedsYou(drafts []draft.Draft, interrupts []*interrupt.Interruption) {
+	sort.Slice(drafts, func(i, j int) bool {
+		a, b := drafts[i], drafts[j]
+		if !a.ExpiresAt.Equal(b.ExpiresAt) {
+			return horizonBefore(a.ExpiresAt, b.ExpiresAt)
+		}
+		return a.DraftID < b.DraftID
+	})
+	sort.Slice(interrupts, func(i, j int) bool {
+		a, b := interrupts[i], interrupts[j]
+		if la, lb := interrupt.LevelOrder(a.Level), interrupt.LevelOrder(b.Level); la != lb {
+			return la > lb
+		}
+		if !a.ExpiresAt.Equal(b.ExpiresAt) {
+			return horizonBefore(a.ExpiresAt, b.ExpiresAt)
+		}
+		return a.InterruptionID < b.InterruptionID
+	})
+}
+
+// horizonBefore reports whether horizon a is sooner than b.
+// A zero horizon means none, and sorts after any real one.
+func horizonBefore(a, b time.Time) bool {
+	if a.IsZero() || b.IsZero() {
+		return !a.IsZero()
+	}
+	return a.Before(b)
+}
+
 // getCircles returns circles to process.
 func (e *Engine) getCircles(opts RunOptions) []CircleInfo {
 	if e.IdentityRepo == nil {
@@ -689,10 +756,7 @@ func (e *Engine) GetExecutionHistory() []calexec.Envelope {
 	if e.CalendarExecutor == nil {
 		return nil
 	}
-	env, _ := e.CalendarExecutor.GetEnvelope("")
-	// Return empty for now - would need to list all
-	_ = env
-	return nil
+	return e.CalendarExecutor.ListEnvelopes(calexec.ListFilter{IncludeAll: true})
 }
 
 // GetEmailExecutionHistory returns email execution history.
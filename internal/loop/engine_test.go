@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"quantumlife/internal/obligations"
 	"quantumlife/pkg/clock"
 	"quantumlife/pkg/domain/draft"
+	domainevents "quantumlife/pkg/domain/events"
 	"quantumlife/pkg/domain/feedback"
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/interrupt"
@@ -189,6 +191,159 @@ func TestEngine_Run_WithPendingDrafts(t *testing.T) {
 	}
 }
 
+func TestEngine_Replay_UnchangedSnapshotMatches(t *testing.T) {
+	asOf := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := &mockClock{now: asOf.Add(5 * time.Minute)}
+
+	draftStore := draft.NewInMemoryStore()
+	circle := createTestCircle("Personal", asOf)
+
+	engine := &Engine{
+		Clock: clk,
+		IdentityRepo: &mockIdentityRepo{
+			circles: []*identity.Circle{circle},
+		},
+		DraftStore:    draftStore,
+		FeedbackStore: feedback.NewMemoryStore(),
+		EventEmitter:  &mockEventEmitter{},
+	}
+
+	opts := RunOptions{AsOf: asOf}
+	result, original := engine.RunWithSnapshot(context.Background(), opts, "cfg")
+	if !result.StartedAt.Equal(asOf) || !result.CompletedAt.Equal(asOf) {
+		t.Fatalf("expected run pinned to AsOf, got %v..%v", result.StartedAt, result.CompletedAt)
+	}
+	if original.RunID != result.RunID {
+		t.Errorf("snapshot RunID %s != run RunID %s", original.RunID, result.RunID)
+	}
+
+	// Replay later on the wall clock - AsOf comes from the snapshot.
+	clk.now = asOf.Add(2 * time.Hour)
+	replay := engine.Replay(context.Background(), original, RunOptions{})
+	if !replay.Success {
+		t.Fatalf("expected replay to match, differences: %v", replay.Differences)
+	}
+	if replay.ReplayHash != original.ResultHash {
+		t.Errorf("replay hash %s != original %s", replay.ReplayHash, original.ResultHash)
+	}
+
+	// Draft review state is read live, so a new pending draft is detected.
+	draftStore.Put(draft.Draft{
+		DraftID:   "draft-replay",
+		DraftType: draft.DraftTypeEmailReply,
+		CircleID:  circle.ID(),
+		Status:    draft.StatusProposed,
+		CreatedAt: asOf,
+		ExpiresAt: asOf.Add(24 * time.Hour),
+	})
+	replay = engine.Replay(context.Background(), original, RunOptions{})
+	if replay.Success {
+		t.Error("expected replay mismatch after drafts changed")
+	}
+}
+
+func TestEngine_RunWithSnapshot_RecordsExecutedRun(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := newMockEventEngine(now)
+	emitter := engine.EventEmitter.(*mockEventEmitter)
+
+	// The first run marks dedup keys, so the second run drops them.
+	engine.Run(context.Background(), RunOptions{AsOf: now})
+	emitted := len(emitter.events)
+	result, snapshot := engine.RunWithSnapshot(context.Background(), RunOptions{AsOf: now}, "cfg")
+
+	if len(emitter.events) != 2*emitted {
+		t.Errorf("expected the snapshotted run to emit %d events once, got %d", emitted, len(emitter.events)-emitted)
+	}
+	if snapshot.RunID != result.RunID || snapshot.NeedsYouHash != result.NeedsYou.Hash {
+		t.Error("snapshot does not describe the executed run")
+	}
+	if snapshot.EventsIngested == 0 || snapshot.EventsIngested != len(snapshot.EventHashes) {
+		t.Fatalf("expected recorded input events, got %d count, %d hashes", snapshot.EventsIngested, len(snapshot.EventHashes))
+	}
+	if snapshot.InterruptionsDeduplicated == 0 || len(snapshot.SeenDedupHashes) == 0 {
+		t.Fatal("expected the second run to record its dedup state")
+	}
+	if len(emitter.events) == 0 || len(snapshot.EmittedEventHashes) != emitted {
+		t.Errorf("expected %d emitted event hashes, got %d", emitted, len(snapshot.EmittedEventHashes))
+	}
+
+	// Ingesting after the run does not change the replay.
+	late := domainevents.NewEmailMessageEvent("gmail", "msg-late", "self@example.com", now, now)
+	late.Circle = result.Circles[0].CircleID
+	late.Subject = "Action required: reply today"
+	late.SenderDomain = "company.com"
+	engine.EventStore.Store(late)
+
+	replay := engine.Replay(context.Background(), snapshot, RunOptions{})
+	if !replay.Success {
+		t.Fatalf("expected replay against recorded inputs to match, differences: %v", replay.Differences)
+	}
+	if len(emitter.events) != 2*emitted {
+		t.Error("replay emitted events")
+	}
+}
+
+func TestReplayEngine_Verify(t *testing.T) {
+	asOf := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	clk := &mockClock{now: asOf}
+
+	circle := createTestCircle("Personal", asOf)
+	engine := &Engine{
+		Clock: clk,
+		IdentityRepo: &mockIdentityRepo{
+			circles: []*identity.Circle{circle},
+		},
+		DraftStore:    draft.NewInMemoryStore(),
+		FeedbackStore: feedback.NewMemoryStore(),
+		EventEmitter:  &mockEventEmitter{},
+	}
+	_, original := engine.RunWithSnapshot(context.Background(), RunOptions{AsOf: asOf}, "cfg")
+
+	verifier := NewReplayEngine(engine, RunOptions{})
+	clk.now = asOf.Add(3 * time.Hour)
+	result, mismatch := verifier.Verify(original)
+	if mismatch {
+		t.Fatalf("expected stored snapshot to verify, differences: %v", result.Differences)
+	}
+
+	// A tampered snapshot no longer matches its recorded hash.
+	tampered := *original
+	tampered.NeedsYouItems++
+	result, mismatch = verifier.Verify(&tampered)
+	if !mismatch {
+		t.Fatal("expected mismatch for tampered snapshot")
+	}
+	if len(result.Differences) == 0 {
+		t.Error("expected field-level differences for tampered snapshot")
+	}
+}
+
+func TestReplayEngine_Verify_IgnoresLaterEvents(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := newMockEventEngine(now)
+	_, snapshot := engine.RunWithSnapshot(context.Background(), RunOptions{AsOf: now}, "cfg")
+
+	// New mail arrives for every circle after the run.
+	for i, c := range engine.getCircles(RunOptions{}) {
+		late := domainevents.NewEmailMessageEvent("gmail", "msg-late-"+string(rune('a'+i)), "self@example.com", now, now)
+		late.Circle = c.ID
+		late.Subject = "URGENT: Action required today"
+		late.SenderDomain = "company.com"
+		late.IsImportant = true
+		engine.EventStore.Store(late)
+	}
+	if current := engine.Evaluate(context.Background(), RunOptions{AsOf: now}); current.NeedsYou.Hash == snapshot.NeedsYouHash {
+		t.Fatal("expected the late events to change a fresh evaluation")
+	}
+
+	verifier := NewReplayEngine(engine, RunOptions{})
+	result, mismatch := verifier.Verify(snapshot)
+	if mismatch {
+		t.Fatalf("expected verify against recorded inputs to match, differences: %v", result.Differences)
+	}
+}
+
 func TestEngine_RecordFeedback(t *testing.T) {
 	fixedTime := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
 	emitter := &mockEventEmitter{}
@@ -285,3 +440,75 @@ func TestComputeNeedsYouHash_Deterministic(t *testing.T) {
 		t.Error("hash should be same regardless of input order")
 	}
 }
+
+func TestComputeNeedsYou_StableRankingWithTies(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	soon := now.Add(2 * time.Hour)
+	later := now.Add(24 * time.Hour)
+
+	// Several equal-priority items that differ only by horizon and ID
+	items := []*interrupt.Interruption{
+		{InterruptionID: "int-c", Level: interrupt.LevelQueued, RegretScore: 50, ExpiresAt: later},
+		{InterruptionID: "int-a", Level: interrupt.LevelQueued, RegretScore: 50, ExpiresAt: later},
+		{InterruptionID: "int-d", Level: interrupt.LevelQueued, RegretScore: 50, ExpiresAt: soon},
+		{InterruptionID: "int-b", Level: interrupt.LevelQueued, RegretScore: 50, ExpiresAt: soon},
+		{InterruptionID: "int-e", Level: interrupt.LevelQueued, RegretScore: 50},
+		{InterruptionID: "int-z", Level: interrupt.LevelUrgent, RegretScore: 10, ExpiresAt: later},
+	}
+	want := []string{"int-z", "int-b", "int-d", "int-a", "int-c", "int-e"}
+
+	engine := &Engine{}
+	var firstHash string
+	for run := 0; run < len(items); run++ {
+		// Rotate items across two circles so every run sees a new input order
+		rotated := append(append([]*interrupt.Interruption{}, items[run:]...), items[:run]...)
+		circles := []CircleResult{
+			{Interruptions: rotated[:3]},
+			{Interruptions: rotated[3:]},
+		}
+
+		summary := engine.computeNeedsYou(circles)
+		var got []string
+		for _, i := range summary.ActiveInterruptions {
+			got = append(got, i.InterruptionID)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("run %d: expected %d items, got %d", run, len(want), len(got))
+		}
+		for k := range want {
+			if got[k] != want[k] {
+				t.Fatalf("run %d: order = %v, want %v", run, got, want)
+			}
+		}
+
+		if run == 0 {
+			firstHash = summary.Hash
+		} else if summary.Hash != firstHash {
+			t.Errorf("run %d: hash changed: %s != %s", run, summary.Hash, firstHash)
+		}
+	}
+}
+
+func TestEngine_Run_RecordsObligationCounts(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	engine := newMockEventEngine(now)
+	store := obligations.NewInMemoryStore()
+	engine.ObligationStore = store
+
+	result := engine.Run(context.Background(), RunOptions{})
+	for _, c := range result.Circles {
+		total, held := 0, 0
+		for _, n := range store.CountByCategory(c.CircleID) {
+			total += n
+		}
+		for _, n := range store.CountHeldByCategory(c.CircleID) {
+			held += n
+		}
+		if total != c.ObligationCount {
+			t.Errorf("%s: expected %d obligations recorded, got %d", c.CircleName, c.ObligationCount, total)
+		}
+		if held > total || held < total-c.InterruptionCount {
+			t.Errorf("%s: held %d inconsistent with %d obligations and %d interruptions", c.CircleName, held, total, c.InterruptionCount)
+		}
+	}
+}
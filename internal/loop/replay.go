@@ -0,0 +1,44 @@
+package loop
+
+import (
+	"context"
+
+	"quantumlife/pkg/domain/runlog"
+)
+
+// ReplayEngine verifies that stored run snapshots are reproducible.
+//
+// Verification re-evaluates the loop at the snapshot's recorded start time
+// against the snapshot's recorded inputs: the input events it read and the
+// interruption state it started from. Events ingested after the run are not
+// visible, so the result hash must match; a mismatch means the loop is
+// nondeterministic or a recorded input is no longer available.
+type ReplayEngine struct {
+	engine *Engine
+	opts   RunOptions
+}
+
+// NewReplayEngine creates a replay engine. opts supplies the run options
+// (e.g. mock data); CircleID and AsOf are taken from each snapshot.
+func NewReplayEngine(engine *Engine, opts RunOptions) *ReplayEngine {
+	return &ReplayEngine{
+		engine: engine,
+		opts:   opts,
+	}
+}
+
+// Verify replays the snapshot and compares the replay to the stored result.
+// It returns the replay result and a mismatch flag. A snapshot whose stored
+// hash no longer matches its own fields is also reported as a mismatch.
+func (r *ReplayEngine) Verify(snapshot *runlog.RunSnapshot) (runlog.ReplayResult, bool) {
+	result := *r.engine.Replay(context.Background(), snapshot, r.opts)
+
+	if computed := snapshot.ComputeResultHash(); computed != snapshot.ResultHash {
+		result.Success = false
+		result.Differences = append([]string{
+			"stored_hash: " + snapshot.ResultHash + " vs recomputed " + computed,
+		}, result.Differences...)
+	}
+
+	return result, !result.Success
+}
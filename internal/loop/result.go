@@ -0,0 +1,40 @@
+package loop
+
+import (
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/obligation"
+)
+
+// ObligationsFor returns a circle's obligations from the run, or every
+// circle's when circleID is empty. Order follows the run.
+func (r *RunResult) ObligationsFor(circleID identity.EntityID) []*obligation.Obligation {
+	var result []*obligation.Obligation
+	for _, c := range r.Circles {
+		if circleID != "" && c.CircleID != circleID {
+			continue
+		}
+		result = append(result, c.Obligations...)
+	}
+	return result
+}
+
+// HeldObligations returns the obligations the run held: those that did not
+// become an interruption. An empty circleID means every circle.
+func (r *RunResult) HeldObligations(circleID identity.EntityID) []*obligation.Obligation {
+	var result []*obligation.Obligation
+	for _, c := range r.Circles {
+		if circleID != "" && c.CircleID != circleID {
+			continue
+		}
+		surfaced := make(map[string]bool, len(c.Interruptions))
+		for _, i := range c.Interruptions {
+			surfaced[i.ObligationID] = true
+		}
+		for _, obl := range c.Obligations {
+			if !surfaced[obl.ID] {
+				result = append(result, obl)
+			}
+		}
+	}
+	return result
+}
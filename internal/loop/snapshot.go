@@ -0,0 +1,287 @@
+package loop
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"quantumlife/internal/interruptions"
+	"quantumlife/pkg/clock"
+	domainevents "quantumlife/pkg/domain/events"
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/runlog"
+	"quantumlife/pkg/events"
+)
+
+// RunWithSnapshot executes one iteration of the loop, exactly as Run does,
+// and returns its result together with a finalized snapshot of that run.
+//
+// The snapshot records the run's inputs: the hashes of the events it read
+// and the interruption dedup and quota state it started from. Replay
+// evaluates against those recorded inputs, not the stores' current state.
+func (e *Engine) RunWithSnapshot(ctx context.Context, opts RunOptions, configHash string) (RunResult, *runlog.RunSnapshot) {
+	if opts.AsOf.IsZero() {
+		opts.AsOf = e.Clock.Now()
+	}
+
+	recorded := *e
+	inputs := &inputEventRecorder{EventStore: e.EventStore}
+	emitted := &emittedEventRecorder{next: e.EventEmitter}
+	if e.EventStore != nil {
+		recorded.EventStore = inputs
+	}
+	recorded.EventEmitter = emitted
+
+	result := recorded.Run(ctx, opts)
+	return result, snapshotFromResult(result, opts.CircleID, configHash, inputs.hashes(), emitted.hashes)
+}
+
+// Replay re-evaluates the loop at the snapshot's start time against the
+// snapshot's recorded inputs and compares the result to the original.
+//
+// Only recorded input events are visible and the interruption engine
+// starts from the recorded dedup and quota state, so events ingested since
+// the run do not change the replay. Nothing is written, executed or
+// emitted. Draft review state is read from the live draft store.
+func (e *Engine) Replay(ctx context.Context, original *runlog.RunSnapshot, opts RunOptions) *runlog.ReplayResult {
+	opts.CircleID = original.CircleID
+	opts.AsOf = original.StartTime
+	opts.ExecuteApprovedDrafts = false
+
+	pure := e.pure(opts.AsOf)
+	if e.InterruptionEngine != nil {
+		pure.InterruptionEngine = e.InterruptionEngine.Seeded(pure.Clock, interruptions.StartState{
+			SeenDedupKeys: original.SeenDedupHashes,
+			QuotaUsage:    original.QuotaUsage,
+		})
+	}
+	inputs := &inputEventRecorder{}
+	if e.EventStore != nil {
+		inputs.EventStore = newRecordedEventStore(e.EventStore, original.EventHashes)
+		pure.EventStore = inputs
+	}
+	emitted := &emittedEventRecorder{}
+	pure.EventEmitter = emitted
+
+	result := pure.Run(ctx, opts)
+	replay := snapshotFromResult(result, opts.CircleID, original.ConfigHash, inputs.hashes(), emitted.hashes)
+	return runlog.VerifyReplay(original, replay)
+}
+
+// snapshotFromResult builds a finalized snapshot of a run result.
+func snapshotFromResult(result RunResult, circleID identity.EntityID, configHash string, eventHashes, emittedHashes []string) *runlog.RunSnapshot {
+	snapshot := runlog.NewRunSnapshot(result.RunID, result.StartedAt, result.CompletedAt, circleID, configHash)
+	snapshot.EventHashes = eventHashes
+	snapshot.EventsIngested = len(eventHashes)
+	snapshot.EmittedEventHashes = emittedHashes
+	for _, circle := range result.Circles {
+		for _, i := range circle.Interruptions {
+			snapshot.InterruptionHashes = append(snapshot.InterruptionHashes, i.InterruptionID)
+		}
+		for _, d := range circle.DraftsPending {
+			snapshot.DraftHashes = append(snapshot.DraftHashes, string(d.DraftID))
+		}
+		snapshot.InterruptionsCreated += circle.InterruptionCount
+		snapshot.InterruptionsDeduplicated += circle.InterruptionsDeduplicated
+
+		// Each quota key keeps the usage its first circle started from;
+		// later circles see this run's own increments.
+		snapshot.SeenDedupHashes = append(snapshot.SeenDedupHashes, circle.InterruptionStart.SeenDedupKeys...)
+		for key, used := range circle.InterruptionStart.QuotaUsage {
+			if snapshot.QuotaUsage == nil {
+				snapshot.QuotaUsage = make(map[string]int)
+			}
+			if _, seen := snapshot.QuotaUsage[key]; !seen {
+				snapshot.QuotaUsage[key] = used
+			}
+		}
+	}
+	snapshot.NeedsYouItems = result.NeedsYou.TotalItems
+	snapshot.NeedsYouHash = result.NeedsYou.Hash
+
+	snapshot.FinalizeSnapshot()
+	return snapshot
+}
+
+// Evaluate runs the loop at opts.AsOf (zero means the engine clock)
+// without side effects: no drafts, executions, events or recorded counts,
+// and fresh dedup and quota state. Read-only pages use it so the same
+// inputs always render the same state.
+func (e *Engine) Evaluate(ctx context.Context, opts RunOptions) RunResult {
+	if opts.AsOf.IsZero() {
+		opts.AsOf = e.Clock.Now()
+	}
+	opts.ExecuteApprovedDrafts = false
+	return e.pure(opts.AsOf).Run(ctx, opts)
+}
+
+// pure returns a copy of the engine that cannot mutate stores or emit
+// events, with its clock pinned to asOf.
+func (e *Engine) pure(asOf time.Time) *Engine {
+	p := *e
+	p.Clock = clock.NewFixed(asOf)
+	p.DraftEngine = nil
+	p.EventEmitter = nil
+	p.ObligationStore = nil
+	if e.InterruptionEngine != nil {
+		p.InterruptionEngine = e.InterruptionEngine.Fresh(p.Clock)
+	}
+	return &p
+}
+
+// emittedEventRecorder captures hashes of the events a run emits and
+// forwards each event to next, if any.
+type emittedEventRecorder struct {
+	next   events.Emitter
+	hashes []string
+}
+
+// Emit records the event hash and forwards the event.
+func (r *emittedEventRecorder) Emit(event events.Event) {
+	r.hashes = append(r.hashes, runlog.HashEmittedEvent(string(event.Type), event.Metadata))
+	if r.next != nil {
+		r.next.Emit(event)
+	}
+}
+
+// inputEventRecorder records the hash of every event read through it.
+type inputEventRecorder struct {
+	domainevents.EventStore
+	seen map[string]bool
+}
+
+// GetByID reads and records an event.
+func (r *inputEventRecorder) GetByID(id string) (domainevents.CanonicalEvent, error) {
+	event, err := r.EventStore.GetByID(id)
+	if err == nil {
+		r.record(event)
+	}
+	return event, err
+}
+
+// GetByCircle reads and records a circle's events.
+func (r *inputEventRecorder) GetByCircle(circleID identity.EntityID, eventType *domainevents.EventType, limit int) ([]domainevents.CanonicalEvent, error) {
+	result, err := r.EventStore.GetByCircle(circleID, eventType, limit)
+	for _, event := range result {
+		r.record(event)
+	}
+	return result, err
+}
+
+// GetByTimeRange reads and records events in a time range.
+func (r *inputEventRecorder) GetByTimeRange(start, end time.Time, eventType *domainevents.EventType) ([]domainevents.CanonicalEvent, error) {
+	result, err := r.EventStore.GetByTimeRange(start, end, eventType)
+	for _, event := range result {
+		r.record(event)
+	}
+	return result, err
+}
+
+// record adds an event's input hash.
+func (r *inputEventRecorder) record(event domainevents.CanonicalEvent) {
+	if r.seen == nil {
+		r.seen = make(map[string]bool)
+	}
+	r.seen[runlog.HashInputEvent(event.EventID(), event.CanonicalString())] = true
+}
+
+// hashes returns the recorded input hashes, sorted.
+func (r *inputEventRecorder) hashes() []string {
+	result := make([]string, 0, len(r.seen))
+	for h := range r.seen {
+		result = append(result, h)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// endOfTime bounds time range reads that cover every event.
+var endOfTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// errRecordedInputs is returned when a replay tries to store an event.
+var errRecordedInputs = errors.New("replay inputs are read-only")
+
+// recordedEventStore is a read-only view of an event store limited to the
+// events whose input hash a snapshot recorded.
+type recordedEventStore struct {
+	inner   domainevents.EventStore
+	allowed map[string]bool
+}
+
+// newRecordedEventStore limits inner to the recorded input hashes.
+func newRecordedEventStore(inner domainevents.EventStore, hashes []string) *recordedEventStore {
+	allowed := make(map[string]bool, len(hashes))
+	for _, h := range hashes {
+		allowed[h] = true
+	}
+	return &recordedEventStore{inner: inner, allowed: allowed}
+}
+
+// recorded reports whether the snapshot recorded event as an input.
+func (s *recordedEventStore) recorded(event domainevents.CanonicalEvent) bool {
+	return s.allowed[runlog.HashInputEvent(event.EventID(), event.CanonicalString())]
+}
+
+// filter keeps the recorded events, preserving order.
+func (s *recordedEventStore) filter(in []domainevents.CanonicalEvent) []domainevents.CanonicalEvent {
+	var out []domainevents.CanonicalEvent
+	for _, event := range in {
+		if s.recorded(event) {
+			out = append(out, event)
+		}
+	}
+	return out
+}
+
+// Store refuses writes.
+func (s *recordedEventStore) Store(domainevents.CanonicalEvent) error {
+	return errRecordedInputs
+}
+
+// GetByID returns a recorded event.
+func (s *recordedEventStore) GetByID(id string) (domainevents.CanonicalEvent, error) {
+	event, err := s.inner.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.recorded(event) {
+		return nil, domainevents.ErrEventNotFound
+	}
+	return event, nil
+}
+
+// GetByCircle returns a circle's recorded events. The limit applies after
+// filtering.
+func (s *recordedEventStore) GetByCircle(circleID identity.EntityID, eventType *domainevents.EventType, limit int) ([]domainevents.CanonicalEvent, error) {
+	all, err := s.inner.GetByCircle(circleID, eventType, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := s.filter(all)
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+// GetByTimeRange returns recorded events in a time range.
+func (s *recordedEventStore) GetByTimeRange(start, end time.Time, eventType *domainevents.EventType) ([]domainevents.CanonicalEvent, error) {
+	all, err := s.inner.GetByTimeRange(start, end, eventType)
+	if err != nil {
+		return nil, err
+	}
+	return s.filter(all), nil
+}
+
+// Count returns the number of recorded inputs.
+func (s *recordedEventStore) Count() int {
+	return len(s.allowed)
+}
+
+// CountByType returns the number of recorded events of a type still in
+// the inner store.
+func (s *recordedEventStore) CountByType(eventType domainevents.EventType) int {
+	all, _ := s.inner.GetByTimeRange(time.Time{}, endOfTime, &eventType)
+	return len(s.filter(all))
+}
@@ -0,0 +1,108 @@
+package mirror
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+)
+
+// unviewedReminderLine is the only copy the reminder ever uses.
+const unviewedReminderLine = "You have a connection you haven't looked at."
+
+// UnviewedReminderInput holds the abstract state needed to decide whether
+// the "connected but unused" reminder applies.
+type UnviewedReminderInput struct {
+	// Period is the day key the reminder is scoped to (dismissal is per period).
+	Period string
+
+	// ConnectedCount is how many sources are connected (mock or real).
+	ConnectedCount int
+
+	// MirrorViewed indicates the mirror has been viewed at least once.
+	MirrorViewed bool
+
+	// Dismissed indicates the reminder was dismissed this period.
+	Dismissed bool
+}
+
+// UnviewedReminder is a pull-only line shown on /today when a source is
+// connected but the mirror has never been viewed.
+//
+// CRITICAL: Rendered only when the user visits. Never pushed, never notified.
+type UnviewedReminder struct {
+	// Period is the day key the reminder applies to.
+	Period string
+
+	// Line is the calm reminder text.
+	Line string
+
+	// Hash identifies the reminder for audit.
+	Hash string
+}
+
+// BuildUnviewedReminder returns the reminder, or nil if it does not apply.
+func BuildUnviewedReminder(input UnviewedReminderInput) *UnviewedReminder {
+	if input.ConnectedCount <= 0 || input.MirrorViewed || input.Dismissed {
+		return nil
+	}
+	h := sha256.Sum256([]byte("MIRROR_UNVIEWED_REMINDER|v1|" + input.Period + "|" + unviewedReminderLine))
+	return &UnviewedReminder{
+		Period: input.Period,
+		Line:   unviewedReminderLine,
+		Hash:   hex.EncodeToString(h[:]),
+	}
+}
+
+// ReminderStore records per-period dismissals of the unviewed reminder.
+//
+// CRITICAL: Stores only period keys. No goroutines.
+type ReminderStore struct {
+	mu        sync.Mutex
+	dismissed map[string]bool
+	order     []string
+	maxKeys   int
+}
+
+// NewReminderStore creates a bounded reminder dismissal store.
+func NewReminderStore(maxKeys int) *ReminderStore {
+	if maxKeys <= 0 {
+		maxKeys = 30
+	}
+	return &ReminderStore{
+		dismissed: make(map[string]bool),
+		maxKeys:   maxKeys,
+	}
+}
+
+// Dismiss records that the reminder was dismissed for a period.
+// Returns false if it was already dismissed.
+func (s *ReminderStore) Dismiss(period string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dismissed[period] {
+		return false
+	}
+	if len(s.order) >= s.maxKeys {
+		delete(s.dismissed, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.dismissed[period] = true
+	s.order = append(s.order, period)
+	return true
+}
+
+// IsDismissed reports whether the reminder was dismissed for a period.
+func (s *ReminderStore) IsDismissed(period string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dismissed[period]
+}
+
+// Clear removes all dismissals.
+func (s *ReminderStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dismissed = make(map[string]bool)
+	s.order = nil
+}
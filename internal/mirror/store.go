@@ -21,6 +21,7 @@ type AckStore struct {
 	mu          sync.RWMutex
 	records     []string        // Only record hashes
 	mirrorIndex map[string]bool // Index of acknowledged mirror hashes
+	viewed      bool            // Whether any mirror was ever viewed
 	maxRecords  int
 }
 
@@ -64,10 +65,21 @@ func (s *AckStore) Record(action mirror.AckAction, mirrorHash string, now time.T
 
 	// Index the mirror hash for quick lookup
 	s.mirrorIndex[mirrorHash] = true
+	if action == mirror.AckViewed {
+		s.viewed = true
+	}
 
 	return nil
 }
 
+// HasViewed reports whether any mirror was ever viewed.
+// Survives eviction of the underlying record hashes.
+func (s *AckStore) HasViewed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.viewed
+}
+
 // HasRecent checks if a mirror hash has been acknowledged.
 // Returns true if the mirror hash exists in the store.
 func (s *AckStore) HasRecent(mirrorHash string) bool {
@@ -103,3 +115,12 @@ func computeRecordHash(ack mirror.MirrorAck, tsHash string) string {
 	h := sha256.Sum256([]byte(canonical))
 	return fmt.Sprintf("%x", h)
 }
+
+// Clear removes all acknowledgement records.
+func (s *AckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]string, 0)
+	s.mirrorIndex = make(map[string]bool)
+	s.viewed = false
+}
@@ -0,0 +1,125 @@
+package mode
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"quantumlife/pkg/domain/identity"
+)
+
+// ModeChange is a one-time acknowledgment that the derived mode changed.
+//
+// CRITICAL: Contains ONLY abstract modes and a hash.
+type ModeChange struct {
+	// From is the previously seen mode.
+	From Mode
+
+	// To is the newly derived mode.
+	To Mode
+
+	// ChangeHash identifies this transition for dismissal.
+	ChangeHash string
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (c ModeChange) CanonicalString() string {
+	return "MODE_CHANGE|v1|" + string(c.From) + "|" + string(c.To)
+}
+
+// Line returns the calm acknowledgment line.
+func (c ModeChange) Line() string {
+	return "You're now in " + c.To.DisplayText() + " mode."
+}
+
+// modeAckRecord is the per-circle state, keyed by circle hash.
+type modeAckRecord struct {
+	lastSeen Mode
+	seq      int
+	pending  *ModeChange
+}
+
+// AckStore tracks the last-seen mode per circle and the pending
+// acknowledgment for the most recent change.
+//
+// CRITICAL: Circles are stored by hash only.
+// CRITICAL: In-memory, no goroutines.
+type AckStore struct {
+	mu      sync.Mutex
+	records map[string]*modeAckRecord
+}
+
+// NewAckStore creates a new mode acknowledgment store.
+func NewAckStore() *AckStore {
+	return &AckStore{records: make(map[string]*modeAckRecord)}
+}
+
+// Observe records the derived mode for a circle.
+//
+// Returns the change and true only when this call saw a transition.
+// The first observation for a circle establishes the baseline and is
+// not a change.
+func (s *AckStore) Observe(circleID identity.EntityID, m Mode) (ModeChange, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := hashCircle(circleID)
+	rec, ok := s.records[key]
+	if !ok {
+		s.records[key] = &modeAckRecord{lastSeen: m}
+		return ModeChange{}, false
+	}
+	if rec.lastSeen == m {
+		return ModeChange{}, false
+	}
+
+	rec.seq++
+	change := ModeChange{From: rec.lastSeen, To: m}
+	h := sha256.Sum256([]byte(key + "|" + change.CanonicalString() + "|" + strconv.Itoa(rec.seq)))
+	change.ChangeHash = hex.EncodeToString(h[:16])
+
+	rec.lastSeen = m
+	rec.pending = &change
+	return change, true
+}
+
+// Pending returns the undismissed acknowledgment for a circle, if any.
+func (s *AckStore) Pending(circleID identity.EntityID) *ModeChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[hashCircle(circleID)]
+	if !ok || rec.pending == nil {
+		return nil
+	}
+	change := *rec.pending
+	return &change
+}
+
+// Dismiss clears the pending acknowledgment if it matches changeHash.
+// Returns true if something was dismissed.
+func (s *AckStore) Dismiss(circleID identity.EntityID, changeHash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, ok := s.records[hashCircle(circleID)]
+	if !ok || rec.pending == nil || rec.pending.ChangeHash != changeHash {
+		return false
+	}
+	rec.pending = nil
+	return true
+}
+
+// hashCircle returns the SHA256 hash of a circle ID.
+func hashCircle(circleID identity.EntityID) string {
+	h := sha256.Sum256([]byte("MODE_ACK_CIRCLE|v1|" + string(circleID)))
+	return hex.EncodeToString(h[:])
+}
+
+// Clear removes all acknowledgement records.
+func (s *AckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]*modeAckRecord)
+}
@@ -0,0 +1,167 @@
+// Package oauth provides CalDAV connect flow handling.
+//
+// CalDAV servers (Fastmail, Nextcloud) have no OAuth. Start issues a state
+// for the credentials form and Connect stores the submitted app password
+// through the broker as an opaque secret.
+//
+// CRITICAL: Read-only scope only (calendar:read).
+// CRITICAL: No goroutines. All operations synchronous.
+// CRITICAL: Credentials flow only through auth.TokenBroker - never in receipts.
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	caldavread "quantumlife/internal/integrations/caldav_read"
+)
+
+// CalDAVScopes defines the only allowed scopes for CalDAV.
+// CRITICAL: Read-only only.
+var CalDAVScopes = []string{"calendar:read"}
+
+// CalDAVHandler handles the CalDAV credentials flow.
+type CalDAVHandler struct {
+	stateManager *StateManager
+	broker       auth.TokenBroker
+	clock        func() time.Time
+}
+
+// NewCalDAVHandler creates a new CalDAV handler.
+func NewCalDAVHandler(stateManager *StateManager, broker auth.TokenBroker, clock func() time.Time) *CalDAVHandler {
+	return &CalDAVHandler{
+		stateManager: stateManager,
+		broker:       broker,
+		clock:        clock,
+	}
+}
+
+// Start issues a state for the credentials form.
+// AuthURL is empty; the caller renders the form with State.Encode().
+func (h *CalDAVHandler) Start(circleID string) (*StartResult, error) {
+	state, err := h.stateManager.GenerateState(circleID)
+	if err != nil {
+		return nil, fmt.Errorf("generate state: %w", err)
+	}
+
+	receipt := &ConnectionReceipt{
+		CircleID:  circleID,
+		Provider:  ProviderCalDAV,
+		Product:   ProductCalendar,
+		Action:    ActionOAuthStart,
+		Success:   true,
+		At:        h.clock(),
+		StateHash: state.Hash(),
+	}
+
+	return &StartResult{
+		State:   state,
+		Receipt: receipt,
+	}, nil
+}
+
+// Connect validates the state and stores the credentials via the broker.
+// The receipt never contains the server URL, username or password.
+func (h *CalDAVHandler) Connect(ctx context.Context, stateParam string, creds caldavread.Credentials) (*CallbackResult, error) {
+	// Validate state
+	state, err := h.stateManager.ValidateState(stateParam)
+	if err != nil {
+		return nil, fmt.Errorf("validate state: %w", err)
+	}
+
+	fail := func(reason string, err error) (*CallbackResult, error) {
+		return &CallbackResult{
+			CircleID: state.CircleID,
+			Receipt: &ConnectionReceipt{
+				CircleID:   state.CircleID,
+				Provider:   ProviderCalDAV,
+				Product:    ProductCalendar,
+				Action:     ActionOAuthCallback,
+				Success:    false,
+				FailReason: reason,
+				At:         h.clock(),
+				StateHash:  state.Hash(),
+			},
+		}, err
+	}
+
+	secret, err := creds.Secret()
+	if err != nil {
+		return fail("invalid_credentials", err)
+	}
+
+	handle, err := h.broker.ExchangeCodeForCircle(ctx, state.CircleID, auth.ProviderCalDAV, secret, "")
+	if err != nil {
+		return fail("store_failed", fmt.Errorf("store credentials: %w", err))
+	}
+
+	// Verify scopes are read-only
+	if err := validateCalDAVScopes(handle.Scopes); err != nil {
+		_ = h.broker.RevokeToken(ctx, state.CircleID, auth.ProviderCalDAV)
+		return nil, fmt.Errorf("invalid scopes: %w", err)
+	}
+
+	receipt := &ConnectionReceipt{
+		CircleID:    state.CircleID,
+		Provider:    ProviderCalDAV,
+		Product:     ProductCalendar,
+		Action:      ActionOAuthCallback,
+		Success:     true,
+		At:          h.clock(),
+		StateHash:   state.Hash(),
+		TokenHandle: handle.ID,
+	}
+
+	return &CallbackResult{
+		CircleID:    state.CircleID,
+		TokenHandle: &handle,
+		Receipt:     receipt,
+	}, nil
+}
+
+// Revoke removes the stored CalDAV credentials for a circle.
+// This is idempotent. App passwords can only be revoked on the server,
+// so ProviderRevoked is always false.
+func (h *CalDAVHandler) Revoke(ctx context.Context, circleID string) (*RevokeResult, error) {
+	receipt := &RevokeReceipt{
+		CircleID:        circleID,
+		Provider:        ProviderCalDAV,
+		Product:         ProductCalendar,
+		Success:         true, // Idempotent - treat as already disconnected
+		At:              h.clock(),
+		ProviderRevoked: false,
+		LocalRemoved:    false,
+	}
+
+	hasToken, err := h.broker.HasToken(ctx, circleID, auth.ProviderCalDAV)
+	if err != nil || !hasToken {
+		return &RevokeResult{Receipt: receipt}, nil
+	}
+
+	if err := h.broker.RevokeToken(ctx, circleID, auth.ProviderCalDAV); err == nil {
+		receipt.LocalRemoved = true
+	}
+
+	return &RevokeResult{Receipt: receipt}, nil
+}
+
+// HasConnection checks if a circle has stored CalDAV credentials.
+func (h *CalDAVHandler) HasConnection(ctx context.Context, circleID string) (bool, error) {
+	return h.broker.HasToken(ctx, circleID, auth.ProviderCalDAV)
+}
+
+// validateCalDAVScopes ensures only calendar:read is present.
+func validateCalDAVScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return errors.New("no scopes granted")
+	}
+	for _, scope := range scopes {
+		if scope != "calendar:read" {
+			return fmt.Errorf("forbidden scope: %s", scope)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"quantumlife/internal/connectors/auth"
+	"quantumlife/pkg/domain/connection"
 )
 
 // GmailScopes defines the only allowed scopes for Gmail OAuth.
@@ -26,6 +27,31 @@ import (
 // Uses QuantumLife scope names which are mapped to Google scopes by the broker.
 var GmailScopes = []string{"email:read"}
 
+// MaxGmailAccounts bounds how many Gmail accounts one circle may bind.
+// Together with the per-account sync cap this bounds a single sync.
+const MaxGmailAccounts = 5
+
+// PrimaryGmailAccount is the first account slot. Its token keeps the plain
+// circle key, so connections made before multi-account support still work.
+const PrimaryGmailAccount = "gmail-1"
+
+// ErrGmailAccountLimit indicates every account slot for the circle is bound.
+var ErrGmailAccountLimit = errors.New("gmail account limit reached")
+
+// GmailAccountKey returns the broker key for one of a circle's Gmail accounts.
+// Account IDs are opaque slot names - never email addresses.
+func GmailAccountKey(circleID, accountID string) string {
+	if accountID == PrimaryGmailAccount {
+		return circleID
+	}
+	return circleID + "/" + accountID
+}
+
+// gmailAccountID returns the account ID for a 1-based slot.
+func gmailAccountID(slot int) string {
+	return fmt.Sprintf("gmail-%d", slot)
+}
+
 // GmailHandler handles Gmail OAuth flows.
 type GmailHandler struct {
 	stateManager *StateManager
@@ -70,6 +96,11 @@ func (h *GmailHandler) Start(circleID string) (*StartResult, error) {
 		return nil, fmt.Errorf("generate state: %w", err)
 	}
 
+	// PKCE: hold a code verifier until the callback
+	if err := h.stateManager.AttachPKCE(state); err != nil {
+		return nil, fmt.Errorf("attach pkce: %w", err)
+	}
+
 	// Build redirect URI
 	redirectURI := h.redirectBase + "/connect/gmail/callback"
 
@@ -83,6 +114,10 @@ func (h *GmailHandler) Start(circleID string) (*StartResult, error) {
 	if err != nil {
 		return nil, fmt.Errorf("begin oauth: %w", err)
 	}
+	authURL, err = withCodeChallenge(authURL, state.CodeChallenge)
+	if err != nil {
+		return nil, fmt.Errorf("add pkce challenge: %w", err)
+	}
 
 	// Create receipt
 	receipt := &ConnectionReceipt{
@@ -105,8 +140,10 @@ func (h *GmailHandler) Start(circleID string) (*StartResult, error) {
 // CallbackResult contains the result of handling an OAuth callback.
 type CallbackResult struct {
 	CircleID    string
+	AccountID   string // Gmail only: the account slot the token was bound to
 	TokenHandle *auth.TokenHandle
 	Receipt     *ConnectionReceipt
+	PKCE        bool // Whether a PKCE verifier was sent (never the verifier)
 }
 
 // Callback handles the OAuth callback from Google.
@@ -117,11 +154,48 @@ func (h *GmailHandler) Callback(ctx context.Context, code, stateParam string) (*
 		return nil, fmt.Errorf("validate state: %w", err)
 	}
 
+	// PKCE: the verifier held since Start must accompany the exchange
+	verifier, err := h.stateManager.TakeCodeVerifier(state)
+	if err != nil {
+		return &CallbackResult{
+			CircleID: state.CircleID,
+			Receipt: &ConnectionReceipt{
+				CircleID:   state.CircleID,
+				Provider:   ProviderGoogle,
+				Product:    ProductGmail,
+				Action:     ActionOAuthCallback,
+				Success:    false,
+				FailReason: "pkce_verifier_missing",
+				At:         h.clock(),
+				StateHash:  state.Hash(),
+			},
+		}, err
+	}
+
+	// Bind the new account to the first free slot
+	accountID, err := h.nextAccountID(ctx, state.CircleID)
+	if err != nil {
+		return &CallbackResult{
+			CircleID: state.CircleID,
+			Receipt: &ConnectionReceipt{
+				CircleID:   state.CircleID,
+				Provider:   ProviderGoogle,
+				Product:    ProductGmail,
+				Action:     ActionOAuthCallback,
+				Success:    false,
+				FailReason: "account_limit",
+				At:         h.clock(),
+				StateHash:  state.Hash(),
+			},
+		}, err
+	}
+	accountKey := GmailAccountKey(state.CircleID, accountID)
+
 	// Build redirect URI
 	redirectURI := h.redirectBase + "/connect/gmail/callback"
 
 	// Exchange code for tokens
-	handle, err := h.broker.ExchangeCodeForCircle(ctx, state.CircleID, auth.ProviderGoogle, code, redirectURI)
+	handle, err := h.broker.ExchangeCodeForCircle(auth.WithCodeVerifier(ctx, verifier), accountKey, auth.ProviderGoogle, code, redirectURI)
 	if err != nil {
 		return &CallbackResult{
 			CircleID: state.CircleID,
@@ -141,7 +215,7 @@ func (h *GmailHandler) Callback(ctx context.Context, code, stateParam string) (*
 	// Verify scopes are read-only
 	if err := validateReadOnlyScopes(handle.Scopes); err != nil {
 		// Revoke immediately if we got write scopes
-		_ = h.broker.RevokeToken(ctx, state.CircleID, auth.ProviderGoogle)
+		_ = h.broker.RevokeToken(ctx, accountKey, auth.ProviderGoogle)
 		return nil, fmt.Errorf("invalid scopes: %w", err)
 	}
 
@@ -159,11 +233,57 @@ func (h *GmailHandler) Callback(ctx context.Context, code, stateParam string) (*
 
 	return &CallbackResult{
 		CircleID:    state.CircleID,
+		AccountID:   accountID,
 		TokenHandle: &handle,
 		Receipt:     receipt,
+		PKCE:        true,
 	}, nil
 }
 
+// Accounts returns the circle's bound Gmail account IDs in slot order.
+func (h *GmailHandler) Accounts(ctx context.Context, circleID string) ([]string, error) {
+	var accounts []string
+	for slot := 1; slot <= MaxGmailAccounts; slot++ {
+		accountID := gmailAccountID(slot)
+		hasToken, err := h.broker.HasToken(ctx, GmailAccountKey(circleID, accountID), auth.ProviderGoogle)
+		if err != nil {
+			return nil, err
+		}
+		if hasToken {
+			accounts = append(accounts, accountID)
+		}
+	}
+	return accounts, nil
+}
+
+// nextAccountID returns the first unbound account slot for a circle.
+func (h *GmailHandler) nextAccountID(ctx context.Context, circleID string) (string, error) {
+	for slot := 1; slot <= MaxGmailAccounts; slot++ {
+		accountID := gmailAccountID(slot)
+		hasToken, err := h.broker.HasToken(ctx, GmailAccountKey(circleID, accountID), auth.ProviderGoogle)
+		if err != nil {
+			return "", err
+		}
+		if !hasToken {
+			return accountID, nil
+		}
+	}
+	return "", ErrGmailAccountLimit
+}
+
+// withCodeChallenge adds the PKCE S256 challenge to an authorization URL.
+func withCodeChallenge(authURL, challenge string) (string, error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("code_challenge", challenge)
+	q.Set("code_challenge_method", CodeChallengeMethod)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
 // validateReadOnlyScopes ensures only read-only scopes are present.
 func validateReadOnlyScopes(scopes []string) error {
 	for _, scope := range scopes {
@@ -182,13 +302,37 @@ type RevokeResult struct {
 	Receipt *RevokeReceipt
 }
 
-// Revoke revokes the Gmail connection for a circle.
+// Revoke revokes every Gmail account bound to a circle.
 // This is idempotent - returns success even if already disconnected.
+// ProviderRevoked is true only if Google revoked every removed account.
 func (h *GmailHandler) Revoke(ctx context.Context, circleID string) (*RevokeResult, error) {
+	receipt := &RevokeReceipt{
+		CircleID: circleID,
+		Provider: ProviderGoogle,
+		Product:  ProductGmail,
+		Success:  true,
+		At:       h.clock(),
+	}
+
+	accounts, _ := h.Accounts(ctx, circleID)
+	receipt.ProviderRevoked = len(accounts) > 0
+	for _, accountID := range accounts {
+		result, _ := h.RevokeAccount(ctx, circleID, accountID)
+		receipt.LocalRemoved = receipt.LocalRemoved || result.Receipt.LocalRemoved
+		receipt.ProviderRevoked = receipt.ProviderRevoked && result.Receipt.ProviderRevoked
+	}
+
+	return &RevokeResult{Receipt: receipt}, nil
+}
+
+// RevokeAccount revokes one Gmail account bound to a circle.
+// This is idempotent - returns success even if already disconnected.
+func (h *GmailHandler) RevokeAccount(ctx context.Context, circleID, accountID string) (*RevokeResult, error) {
 	now := h.clock()
+	accountKey := GmailAccountKey(circleID, accountID)
 
 	// Check if token exists
-	hasToken, err := h.broker.HasToken(ctx, circleID, auth.ProviderGoogle)
+	hasToken, err := h.broker.HasToken(ctx, accountKey, auth.ProviderGoogle)
 	if err != nil {
 		return &RevokeResult{
 			Receipt: &RevokeReceipt{
@@ -220,14 +364,14 @@ func (h *GmailHandler) Revoke(ctx context.Context, circleID string) (*RevokeResu
 
 	// Try to revoke with Google
 	providerRevoked := false
-	if err := h.revokeWithGoogle(ctx, circleID); err == nil {
+	if err := h.revokeWithGoogle(ctx, accountKey); err == nil {
 		providerRevoked = true
 	}
 	// Continue even if Google revoke fails - still remove local token
 
 	// Remove local token
 	localRemoved := false
-	if err := h.broker.RevokeToken(ctx, circleID, auth.ProviderGoogle); err == nil {
+	if err := h.broker.RevokeToken(ctx, accountKey, auth.ProviderGoogle); err == nil {
 		localRemoved = true
 	}
 
@@ -244,10 +388,10 @@ func (h *GmailHandler) Revoke(ctx context.Context, circleID string) (*RevokeResu
 	}, nil
 }
 
-// revokeWithGoogle calls Google's revocation endpoint.
-func (h *GmailHandler) revokeWithGoogle(ctx context.Context, circleID string) error {
+// revokeWithGoogle calls Google's revocation endpoint for one account key.
+func (h *GmailHandler) revokeWithGoogle(ctx context.Context, accountKey string) error {
 	// First mint a token to get something to revoke
-	token, err := h.broker.MintReadOnlyAccessToken(ctx, circleID, auth.ProviderGoogle, []string{"email:read"})
+	token, err := h.broker.MintReadOnlyAccessToken(ctx, accountKey, auth.ProviderGoogle, []string{"email:read"})
 	if err != nil {
 		return fmt.Errorf("mint token for revoke: %w", err)
 	}
@@ -277,9 +421,84 @@ func (h *GmailHandler) revokeWithGoogle(ctx context.Context, circleID string) er
 	return nil
 }
 
-// HasConnection checks if a circle has a Gmail connection.
+// HasConnection checks if a circle has at least one Gmail account bound.
 func (h *GmailHandler) HasConnection(ctx context.Context, circleID string) (bool, error) {
-	return h.broker.HasToken(ctx, circleID, auth.ProviderGoogle)
+	accounts, err := h.Accounts(ctx, circleID)
+	return len(accounts) > 0, err
+}
+
+// GoogleTokenInfoURL is Google's token introspection endpoint.
+const GoogleTokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+
+// HasValidToken checks whether every Gmail account bound to the circle
+// still works, returning the first status that is not valid.
+//
+// CRITICAL: Mints one access token per account and introspects it.
+// Never fetches messages.
+// An error is returned only when the check could not complete.
+func (h *GmailHandler) HasValidToken(ctx context.Context, circleID string) (connection.TokenStatus, error) {
+	accounts, err := h.Accounts(ctx, circleID)
+	if err != nil {
+		return connection.TokenUnknown, err
+	}
+	if len(accounts) == 0 {
+		return connection.TokenRevoked, nil
+	}
+	for _, accountID := range accounts {
+		status, err := h.accountTokenStatus(ctx, GmailAccountKey(circleID, accountID))
+		if err != nil || status != connection.TokenValid {
+			return status, err
+		}
+	}
+	return connection.TokenValid, nil
+}
+
+// accountTokenStatus introspects a freshly minted token for one account key.
+func (h *GmailHandler) accountTokenStatus(ctx context.Context, accountKey string) (connection.TokenStatus, error) {
+	token, err := h.broker.MintReadOnlyAccessToken(ctx, accountKey, auth.ProviderGoogle, GmailScopes)
+	if err != nil {
+		return tokenStatusFromMintError(err)
+	}
+
+	data := url.Values{}
+	data.Set("access_token", token.Token)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", GoogleTokenInfoURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return connection.TokenUnknown, fmt.Errorf("create tokeninfo request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return connection.TokenUnknown, fmt.Errorf("tokeninfo request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return connection.TokenValid, nil
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized:
+		// A freshly minted token that fails introspection was withdrawn
+		return connection.TokenRevoked, nil
+	default:
+		return connection.TokenUnknown, fmt.Errorf("tokeninfo status %d", resp.StatusCode)
+	}
+}
+
+// tokenStatusFromMintError maps a broker mint failure to a token status.
+// A missing token or a rejected refresh (invalid_grant) counts as revoked.
+func tokenStatusFromMintError(err error) (connection.TokenStatus, error) {
+	switch {
+	case errors.Is(err, auth.ErrNoToken):
+		return connection.TokenRevoked, nil
+	case errors.Is(err, auth.ErrTokenExpired):
+		return connection.TokenExpired, nil
+	case strings.Contains(err.Error(), "invalid_grant"):
+		return connection.TokenRevoked, nil
+	default:
+		return connection.TokenUnknown, err
+	}
 }
 
 // ErrNoConnection indicates no connection exists for the circle.
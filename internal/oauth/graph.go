@@ -0,0 +1,196 @@
+// Package oauth provides Outlook (Microsoft Graph) OAuth flow handling.
+//
+// Mirrors GmailHandler for Microsoft 365 / Outlook inboxes.
+//
+// CRITICAL: Read-only scopes only (Mail.Read).
+// CRITICAL: No goroutines. All operations synchronous.
+// CRITICAL: Tokens flow only through auth.TokenBroker.
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	"quantumlife/pkg/domain/connection"
+)
+
+// GraphScopes defines the only allowed scopes for Outlook OAuth.
+// CRITICAL: Read-only only. No write scopes.
+// Uses QuantumLife scope names which are mapped to Graph scopes by the broker.
+var GraphScopes = []string{"email:read"}
+
+// GraphHandler handles Outlook (Microsoft Graph) OAuth flows.
+type GraphHandler struct {
+	stateManager *StateManager
+	broker       auth.TokenBroker
+	redirectBase string
+	clock        func() time.Time
+}
+
+// NewGraphHandler creates a new Outlook OAuth handler.
+func NewGraphHandler(
+	stateManager *StateManager,
+	broker auth.TokenBroker,
+	redirectBase string,
+	clock func() time.Time,
+) *GraphHandler {
+	return &GraphHandler{
+		stateManager: stateManager,
+		broker:       broker,
+		redirectBase: strings.TrimSuffix(redirectBase, "/"),
+		clock:        clock,
+	}
+}
+
+// Start initiates the Outlook OAuth flow for a circle.
+func (h *GraphHandler) Start(circleID string) (*StartResult, error) {
+	// Generate state
+	state, err := h.stateManager.GenerateState(circleID)
+	if err != nil {
+		return nil, fmt.Errorf("generate state: %w", err)
+	}
+
+	// Get auth URL from broker
+	authURL, err := h.broker.BeginOAuth(
+		auth.ProviderMicrosoft,
+		h.redirectURI(),
+		state.Encode(),
+		GraphScopes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("begin oauth: %w", err)
+	}
+
+	receipt := &ConnectionReceipt{
+		CircleID:  circleID,
+		Provider:  ProviderMicrosoft,
+		Product:   ProductOutlook,
+		Action:    ActionOAuthStart,
+		Success:   true,
+		At:        h.clock(),
+		StateHash: state.Hash(),
+	}
+
+	return &StartResult{
+		AuthURL: authURL,
+		State:   state,
+		Receipt: receipt,
+	}, nil
+}
+
+// Callback handles the OAuth callback from Microsoft.
+func (h *GraphHandler) Callback(ctx context.Context, code, stateParam string) (*CallbackResult, error) {
+	// Validate state
+	state, err := h.stateManager.ValidateState(stateParam)
+	if err != nil {
+		return nil, fmt.Errorf("validate state: %w", err)
+	}
+
+	// Exchange code for tokens
+	handle, err := h.broker.ExchangeCodeForCircle(ctx, state.CircleID, auth.ProviderMicrosoft, code, h.redirectURI())
+	if err != nil {
+		return &CallbackResult{
+			CircleID: state.CircleID,
+			Receipt: &ConnectionReceipt{
+				CircleID:   state.CircleID,
+				Provider:   ProviderMicrosoft,
+				Product:    ProductOutlook,
+				Action:     ActionOAuthCallback,
+				Success:    false,
+				FailReason: "token_exchange_failed",
+				At:         h.clock(),
+				StateHash:  state.Hash(),
+			},
+		}, fmt.Errorf("exchange code: %w", err)
+	}
+
+	// Verify scopes are read-only
+	if err := validateGraphReadOnlyScopes(handle.Scopes); err != nil {
+		// Revoke immediately if we got write scopes
+		_ = h.broker.RevokeToken(ctx, state.CircleID, auth.ProviderMicrosoft)
+		return nil, fmt.Errorf("invalid scopes: %w", err)
+	}
+
+	receipt := &ConnectionReceipt{
+		CircleID:    state.CircleID,
+		Provider:    ProviderMicrosoft,
+		Product:     ProductOutlook,
+		Action:      ActionOAuthCallback,
+		Success:     true,
+		At:          h.clock(),
+		StateHash:   state.Hash(),
+		TokenHandle: handle.ID,
+	}
+
+	return &CallbackResult{
+		CircleID:    state.CircleID,
+		TokenHandle: &handle,
+		Receipt:     receipt,
+	}, nil
+}
+
+// validateGraphReadOnlyScopes ensures only read-only mail scopes are present.
+func validateGraphReadOnlyScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if scope != "email:read" &&
+			scope != "Mail.Read" &&
+			scope != "https://graph.microsoft.com/Mail.Read" {
+			return fmt.Errorf("forbidden scope: %s", scope)
+		}
+	}
+	return nil
+}
+
+// Revoke revokes the Outlook connection for a circle.
+// This is idempotent - returns success even if already disconnected.
+//
+// Microsoft Graph has no per-app token revocation endpoint, so only the
+// local token is removed; ProviderRevoked is always false.
+func (h *GraphHandler) Revoke(ctx context.Context, circleID string) (*RevokeResult, error) {
+	now := h.clock()
+
+	receipt := &RevokeReceipt{
+		CircleID:        circleID,
+		Provider:        ProviderMicrosoft,
+		Product:         ProductOutlook,
+		Success:         true, // Idempotent - treat as already disconnected
+		At:              now,
+		ProviderRevoked: false,
+		LocalRemoved:    false,
+	}
+
+	hasToken, err := h.broker.HasToken(ctx, circleID, auth.ProviderMicrosoft)
+	if err != nil || !hasToken {
+		return &RevokeResult{Receipt: receipt}, nil
+	}
+
+	// Remove local token
+	if err := h.broker.RevokeToken(ctx, circleID, auth.ProviderMicrosoft); err == nil {
+		receipt.LocalRemoved = true
+	}
+
+	return &RevokeResult{Receipt: receipt}, nil
+}
+
+// HasConnection checks if a circle has an Outlook connection.
+func (h *GraphHandler) HasConnection(ctx context.Context, circleID string) (bool, error) {
+	return h.broker.HasToken(ctx, circleID, auth.ProviderMicrosoft)
+}
+
+// HasValidToken checks whether the circle's Outlook credentials still work.
+// Graph has no introspection endpoint for delegated tokens, so a successful
+// refresh is the check. Never fetches messages.
+func (h *GraphHandler) HasValidToken(ctx context.Context, circleID string) (connection.TokenStatus, error) {
+	if _, err := h.broker.MintReadOnlyAccessToken(ctx, circleID, auth.ProviderMicrosoft, GraphScopes); err != nil {
+		return tokenStatusFromMintError(err)
+	}
+	return connection.TokenValid, nil
+}
+
+// redirectURI returns the OAuth callback URI for Outlook.
+func (h *GraphHandler) redirectURI() string {
+	return h.redirectBase + "/connect/outlook/callback"
+}
@@ -0,0 +1,282 @@
+// Package oauth provides Plaid Link flow handling.
+//
+// Mirrors TrueLayerHandler for US/CA banks. Plaid has no OAuth redirect of
+// its own, so Start creates a Hosted Link session and Callback collects the
+// public token once Link redirects back.
+//
+// CRITICAL: Read-only products only (transactions).
+// CRITICAL: No goroutines. All operations synchronous.
+// CRITICAL: Tokens flow only through auth.TokenBroker.
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"quantumlife/internal/connectors/auth"
+	plaid "quantumlife/internal/connectors/finance/read/providers/plaid"
+)
+
+// PlaidScopes defines the only allowed scopes for Plaid.
+// CRITICAL: Read-only only. Mapped to the "transactions" product by the broker.
+var PlaidScopes = []string{"finance:read"}
+
+// PlaidProducts defines the only Plaid products requested from Link.
+// CRITICAL: Read-only only. No payment or transfer products.
+var PlaidProducts = []string{plaid.ProductTransactions}
+
+// ErrLinkNotCompleted indicates Link returned without producing a public token.
+var ErrLinkNotCompleted = errors.New("plaid link not completed")
+
+// PlaidLinkClient is the subset of the Plaid client used by the Link flow.
+type PlaidLinkClient interface {
+	CreateLinkToken(ctx context.Context, req plaid.LinkTokenCreateRequest) (*plaid.LinkTokenCreateResponse, error)
+	GetLinkToken(ctx context.Context, linkToken string) (*plaid.LinkTokenGetResponse, error)
+}
+
+// PlaidHandler handles Plaid Link flows.
+type PlaidHandler struct {
+	stateManager *StateManager
+	broker       auth.TokenBroker
+	client       PlaidLinkClient
+	redirectBase string
+	clock        func() time.Time
+
+	// Link tokens keyed by state nonce, held until the callback.
+	mu         sync.Mutex
+	linkTokens map[string]pendingLinkToken
+}
+
+// pendingLinkToken is a link token awaiting its callback.
+type pendingLinkToken struct {
+	linkToken string
+	issuedAt  time.Time
+}
+
+// NewPlaidHandler creates a new Plaid Link handler.
+func NewPlaidHandler(
+	stateManager *StateManager,
+	broker auth.TokenBroker,
+	client PlaidLinkClient,
+	redirectBase string,
+	clock func() time.Time,
+) *PlaidHandler {
+	return &PlaidHandler{
+		stateManager: stateManager,
+		broker:       broker,
+		client:       client,
+		redirectBase: strings.TrimSuffix(redirectBase, "/"),
+		clock:        clock,
+		linkTokens:   make(map[string]pendingLinkToken),
+	}
+}
+
+// Start creates a Hosted Link session for a circle.
+// The returned AuthURL is the Plaid-hosted Link page.
+func (h *PlaidHandler) Start(ctx context.Context, circleID string) (*StartResult, error) {
+	// Validate products are read-only
+	if err := validatePlaidProducts(PlaidProducts); err != nil {
+		return nil, err
+	}
+
+	// Generate state
+	state, err := h.stateManager.GenerateState(circleID)
+	if err != nil {
+		return nil, fmt.Errorf("generate state: %w", err)
+	}
+
+	resp, err := h.client.CreateLinkToken(ctx, plaid.LinkTokenCreateRequest{
+		ClientName:   "QuantumLife",
+		Language:     "en",
+		CountryCodes: []string{"US", "CA"},
+		User:         plaid.LinkTokenUser{ClientUserID: state.Hash()},
+		Products:     PlaidProducts,
+		HostedLink: &plaid.LinkTokenHostedLink{
+			CompletionRedirectURI: h.redirectURI() + "?" + url.Values{"state": {state.Encode()}}.Encode(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create link token: %w", err)
+	}
+	if resp.HostedLinkURL == "" {
+		return nil, errors.New("create link token: no hosted link url")
+	}
+
+	h.holdLinkToken(state.Nonce, resp.LinkToken)
+
+	receipt := &ConnectionReceipt{
+		CircleID:  circleID,
+		Provider:  ProviderPlaid,
+		Product:   ProductFinance,
+		Action:    ActionOAuthStart,
+		Success:   true,
+		At:        h.clock(),
+		StateHash: state.Hash(),
+	}
+
+	return &StartResult{
+		AuthURL: resp.HostedLinkURL,
+		State:   state,
+		Receipt: receipt,
+	}, nil
+}
+
+// Callback completes the Link flow after Plaid redirects back.
+// The public token is fetched server-side and exchanged via the broker.
+func (h *PlaidHandler) Callback(ctx context.Context, stateParam string) (*CallbackResult, error) {
+	// Validate state
+	state, err := h.stateManager.ValidateState(stateParam)
+	if err != nil {
+		return nil, fmt.Errorf("validate state: %w", err)
+	}
+
+	fail := func(reason string, err error) (*CallbackResult, error) {
+		return &CallbackResult{
+			CircleID: state.CircleID,
+			Receipt: &ConnectionReceipt{
+				CircleID:   state.CircleID,
+				Provider:   ProviderPlaid,
+				Product:    ProductFinance,
+				Action:     ActionOAuthCallback,
+				Success:    false,
+				FailReason: reason,
+				At:         h.clock(),
+				StateHash:  state.Hash(),
+			},
+		}, err
+	}
+
+	linkToken, ok := h.takeLinkToken(state.Nonce)
+	if !ok {
+		return fail("link_token_missing", ErrLinkNotCompleted)
+	}
+
+	linkResp, err := h.client.GetLinkToken(ctx, linkToken)
+	if err != nil {
+		return fail("link_token_get_failed", fmt.Errorf("get link token: %w", err))
+	}
+	publicToken := linkResp.PublicToken()
+	if publicToken == "" {
+		return fail("link_not_completed", ErrLinkNotCompleted)
+	}
+
+	// Exchange public token for a stored access token
+	handle, err := h.broker.ExchangeCodeForCircle(ctx, state.CircleID, auth.ProviderPlaid, publicToken, h.redirectURI())
+	if err != nil {
+		return fail("token_exchange_failed", fmt.Errorf("exchange public token: %w", err))
+	}
+
+	// Verify scopes are read-only
+	if err := validatePlaidScopes(handle.Scopes); err != nil {
+		// Revoke immediately if we got anything beyond read
+		_ = h.broker.RevokeToken(ctx, state.CircleID, auth.ProviderPlaid)
+		return nil, fmt.Errorf("invalid scopes: %w", err)
+	}
+
+	receipt := &ConnectionReceipt{
+		CircleID:    state.CircleID,
+		Provider:    ProviderPlaid,
+		Product:     ProductFinance,
+		Action:      ActionOAuthCallback,
+		Success:     true,
+		At:          h.clock(),
+		StateHash:   state.Hash(),
+		TokenHandle: handle.ID,
+	}
+
+	return &CallbackResult{
+		CircleID:    state.CircleID,
+		TokenHandle: &handle,
+		Receipt:     receipt,
+	}, nil
+}
+
+// Revoke revokes the Plaid connection for a circle.
+// This is idempotent - returns success even if already disconnected.
+//
+// The read-only client has no /item/remove call, so only the local token
+// is removed; ProviderRevoked is always false.
+func (h *PlaidHandler) Revoke(ctx context.Context, circleID string) (*RevokeResult, error) {
+	receipt := &RevokeReceipt{
+		CircleID:        circleID,
+		Provider:        ProviderPlaid,
+		Product:         ProductFinance,
+		Success:         true, // Idempotent - treat as already disconnected
+		At:              h.clock(),
+		ProviderRevoked: false,
+		LocalRemoved:    false,
+	}
+
+	hasToken, err := h.broker.HasToken(ctx, circleID, auth.ProviderPlaid)
+	if err != nil || !hasToken {
+		return &RevokeResult{Receipt: receipt}, nil
+	}
+
+	// Remove local token
+	if err := h.broker.RevokeToken(ctx, circleID, auth.ProviderPlaid); err == nil {
+		receipt.LocalRemoved = true
+	}
+
+	return &RevokeResult{Receipt: receipt}, nil
+}
+
+// HasConnection checks if a circle has a Plaid connection.
+func (h *PlaidHandler) HasConnection(ctx context.Context, circleID string) (bool, error) {
+	return h.broker.HasToken(ctx, circleID, auth.ProviderPlaid)
+}
+
+// holdLinkToken records a link token for a state nonce.
+// Link tokens older than MaxStateAge are dropped on each call.
+func (h *PlaidHandler) holdLinkToken(nonce, linkToken string) {
+	now := h.clock()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for n, p := range h.linkTokens {
+		if now.Sub(p.issuedAt) > MaxStateAge {
+			delete(h.linkTokens, n)
+		}
+	}
+	h.linkTokens[nonce] = pendingLinkToken{linkToken: linkToken, issuedAt: now}
+}
+
+// takeLinkToken returns and forgets the link token for a state nonce.
+func (h *PlaidHandler) takeLinkToken(nonce string) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, ok := h.linkTokens[nonce]
+	delete(h.linkTokens, nonce)
+	if !ok || h.clock().Sub(p.issuedAt) > MaxStateAge {
+		return "", false
+	}
+	return p.linkToken, true
+}
+
+// validatePlaidProducts ensures only allowed, read-only products are requested.
+func validatePlaidProducts(products []string) error {
+	for _, product := range products {
+		if !plaid.IsAllowedPlaidProduct(product) || plaid.IsForbiddenPlaidProduct(product) {
+			return fmt.Errorf("forbidden product: %s", product)
+		}
+	}
+	return nil
+}
+
+// validatePlaidScopes ensures only the read-only finance scope is present.
+func validatePlaidScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if scope != "finance:read" {
+			return fmt.Errorf("forbidden scope: %s", scope)
+		}
+	}
+	return nil
+}
+
+// redirectURI returns the Link completion URI for Plaid.
+func (h *PlaidHandler) redirectURI() string {
+	return h.redirectBase + "/connect/plaid/callback"
+}
@@ -8,24 +8,32 @@
 package oauth
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // Provider identifies an OAuth provider.
 type Provider string
 
 const (
-	ProviderGoogle Provider = "google"
+	ProviderGoogle    Provider = "google"
+	ProviderMicrosoft Provider = "microsoft"
+	ProviderPlaid     Provider = "plaid"
+	ProviderCalDAV    Provider = "caldav"
 )
 
 // Product identifies what product/API we're accessing.
 type Product string
 
 const (
-	ProductGmail Product = "gmail"
+	ProductGmail    Product = "gmail"
+	ProductOutlook  Product = "outlook"
+	ProductFinance  Product = "finance"
+	ProductCalendar Product = "calendar"
 )
 
 // ConnectionReceipt records what happened during a connect/sync operation.
@@ -69,9 +77,7 @@ func (r *ConnectionReceipt) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the receipt.
 func (r *ConnectionReceipt) Hash() string {
-	data := []byte(r.CanonicalString())
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("oauth.ConnectionReceipt", r.CanonicalString())
 }
 
 // SyncReceipt records what happened during a Gmail sync.
@@ -106,9 +112,7 @@ func (r *SyncReceipt) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the receipt.
 func (r *SyncReceipt) Hash() string {
-	data := []byte(r.CanonicalString())
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("oauth.SyncReceipt", r.CanonicalString())
 }
 
 // RevokeReceipt records what happened during a revocation.
@@ -141,9 +145,42 @@ func (r *RevokeReceipt) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the receipt.
 func (r *RevokeReceipt) Hash() string {
-	data := []byte(r.CanonicalString())
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("oauth.RevokeReceipt", r.CanonicalString())
+}
+
+// DisconnectAllReceipt summarises one disconnect-all request.
+// Sources are product names (gmail, outlook, truelayer, plaid, caldav);
+// kinds are connection kinds that received a disconnect intent.
+type DisconnectAllReceipt struct {
+	CircleID        string
+	At              time.Time
+	Kinds           []string // Kinds that were connected and got an intent
+	LocalRevoked    []string // Sources whose local tokens were removed
+	ProviderRevoked []string // Sources also revoked with the provider
+}
+
+// CanonicalString returns the canonical string representation.
+// Lists are sorted so the hash does not depend on revoke order.
+func (r *DisconnectAllReceipt) CanonicalString() string {
+	return fmt.Sprintf("DISCONNECT_ALL_RECEIPT|v1|%s|%s|%s|%s|%s",
+		r.CircleID,
+		r.At.UTC().Format(time.RFC3339),
+		sortedList(r.Kinds),
+		sortedList(r.LocalRevoked),
+		sortedList(r.ProviderRevoked),
+	)
+}
+
+// Hash returns the SHA256 hash of the receipt.
+func (r *DisconnectAllReceipt) Hash() string {
+	return hashutil.HashString("oauth.DisconnectAllReceipt", r.CanonicalString())
+}
+
+// sortedList joins a copy of items in sorted order.
+func sortedList(items []string) string {
+	sorted := append([]string(nil), items...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
 }
 
 // MagnitudeBucket converts a count to a display bucket.
@@ -17,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -27,6 +28,10 @@ type State struct {
 	Nonce          string // Random nonce for uniqueness (16 bytes hex)
 	IssuedAtBucket int64  // Unix timestamp floored to 5-minute bucket
 	Signature      string // HMAC-SHA256 of canonical string
+
+	// CodeChallenge is the PKCE S256 challenge for this flow, set by
+	// AttachPKCE. Not part of the encoded state; it travels in the auth URL.
+	CodeChallenge string
 }
 
 // StateBucketDuration is the time window for state validity.
@@ -45,10 +50,27 @@ var ErrExpiredState = errors.New("expired oauth state")
 // ErrInvalidSignature indicates the state signature does not match.
 var ErrInvalidSignature = errors.New("invalid state signature")
 
+// ErrMissingCodeVerifier indicates no PKCE verifier is held for the state.
+var ErrMissingCodeVerifier = errors.New("missing pkce code verifier")
+
+// CodeChallengeMethod is the only PKCE challenge method used.
+const CodeChallengeMethod = "S256"
+
 // StateManager creates and validates OAuth states.
 type StateManager struct {
 	secretKey []byte
 	clock     func() time.Time
+
+	// PKCE verifiers keyed by state nonce.
+	// CRITICAL: Held in memory only, never logged or persisted.
+	mu        sync.Mutex
+	verifiers map[string]pkceVerifier
+}
+
+// pkceVerifier is a pending PKCE code verifier.
+type pkceVerifier struct {
+	verifier string
+	issuedAt time.Time
 }
 
 // NewStateManager creates a new StateManager with the given secret key.
@@ -57,7 +79,47 @@ func NewStateManager(secretKey []byte, clock func() time.Time) *StateManager {
 	return &StateManager{
 		secretKey: secretKey,
 		clock:     clock,
+		verifiers: make(map[string]pkceVerifier),
+	}
+}
+
+// AttachPKCE generates a PKCE (RFC 7636) code verifier for the state, holds
+// it until the callback, and sets state.CodeChallenge to its S256 challenge.
+// Verifiers older than MaxStateAge are dropped on each call.
+func (m *StateManager) AttachPKCE(state *State) error {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return fmt.Errorf("generate code verifier: %w", err)
+	}
+	verifier := base64.RawURLEncoding.EncodeToString(raw)
+	sum := sha256.Sum256([]byte(verifier))
+
+	now := m.clock()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for nonce, v := range m.verifiers {
+		if now.Sub(v.issuedAt) > MaxStateAge {
+			delete(m.verifiers, nonce)
+		}
+	}
+	m.verifiers[state.Nonce] = pkceVerifier{verifier: verifier, issuedAt: now}
+
+	state.CodeChallenge = base64.RawURLEncoding.EncodeToString(sum[:])
+	return nil
+}
+
+// TakeCodeVerifier returns and forgets the PKCE verifier held for a
+// validated state. Each verifier can be taken once.
+func (m *StateManager) TakeCodeVerifier(state *State) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.verifiers[state.Nonce]
+	delete(m.verifiers, state.Nonce)
+	if !ok || m.clock().Sub(v.issuedAt) > MaxStateAge {
+		return "", ErrMissingCodeVerifier
 	}
+	return v.verifier, nil
 }
 
 // GenerateState creates a new OAuth state for the given circle.
@@ -0,0 +1,119 @@
+package obligations
+
+import (
+	"sync"
+
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+// Store keeps abstract per-circle obligation counts from the latest loop run.
+//
+// CRITICAL: Only category counts are kept. No obligation content, IDs or
+// evidence is retained, so queries can only ever return magnitudes.
+type Store interface {
+	// Record replaces a circle's counts with the obligations from a run.
+	// Obligations whose ID is not in surfaced are counted as held.
+	Record(circleID identity.EntityID, obligs []*obligation.Obligation, surfaced map[string]bool)
+
+	// CountByCategory returns the number of obligations per category.
+	CountByCategory(circleID identity.EntityID) map[shadowllm.AbstractCategory]int
+
+	// CountHeldByCategory returns the number of held (not surfaced)
+	// obligations per category.
+	CountHeldByCategory(circleID identity.EntityID) map[shadowllm.AbstractCategory]int
+}
+
+// CategoryOf maps an obligation to an abstract category, by source type
+// first and obligation type second.
+func CategoryOf(obl *obligation.Obligation) shadowllm.AbstractCategory {
+	switch obl.SourceType {
+	case "email":
+		return shadowllm.CategoryPeople
+	case "calendar":
+		return shadowllm.CategoryTime
+	case "finance":
+		return shadowllm.CategoryMoney
+	}
+	switch obl.Type {
+	case obligation.ObligationReply, obligation.ObligationFollowup:
+		return shadowllm.CategoryPeople
+	case obligation.ObligationAttend, obligation.ObligationDecide:
+		return shadowllm.CategoryTime
+	case obligation.ObligationPay:
+		return shadowllm.CategoryMoney
+	default:
+		return shadowllm.CategoryWork
+	}
+}
+
+// categoryCounts holds one circle's counts.
+type categoryCounts struct {
+	all  map[shadowllm.AbstractCategory]int
+	held map[shadowllm.AbstractCategory]int
+}
+
+// InMemoryStore is a thread-safe in-memory Store.
+type InMemoryStore struct {
+	mu      sync.RWMutex
+	circles map[identity.EntityID]categoryCounts
+}
+
+// NewInMemoryStore creates an empty obligation store.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{
+		circles: make(map[identity.EntityID]categoryCounts),
+	}
+}
+
+// Clear removes all recorded counts.
+func (s *InMemoryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.circles = make(map[identity.EntityID]categoryCounts)
+}
+
+// Record replaces a circle's counts with the obligations from a run.
+func (s *InMemoryStore) Record(circleID identity.EntityID, obligs []*obligation.Obligation, surfaced map[string]bool) {
+	counts := categoryCounts{
+		all:  make(map[shadowllm.AbstractCategory]int),
+		held: make(map[shadowllm.AbstractCategory]int),
+	}
+	for _, obl := range obligs {
+		cat := CategoryOf(obl)
+		counts.all[cat]++
+		if !surfaced[obl.ID] {
+			counts.held[cat]++
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.circles[circleID] = counts
+}
+
+// CountByCategory returns a copy of the circle's per-category counts.
+func (s *InMemoryStore) CountByCategory(circleID identity.EntityID) map[shadowllm.AbstractCategory]int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyCounts(s.circles[circleID].all)
+}
+
+// CountHeldByCategory returns a copy of the circle's per-category held counts.
+func (s *InMemoryStore) CountHeldByCategory(circleID identity.EntityID) map[shadowllm.AbstractCategory]int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyCounts(s.circles[circleID].held)
+}
+
+func copyCounts(counts map[shadowllm.AbstractCategory]int) map[shadowllm.AbstractCategory]int {
+	result := make(map[shadowllm.AbstractCategory]int, len(counts))
+	for cat, n := range counts {
+		result[cat] = n
+	}
+	return result
+}
+
+// Verify interface compliance.
+var _ Store = (*InMemoryStore)(nil)
@@ -0,0 +1,45 @@
+package obligations
+
+import (
+	"testing"
+	"time"
+
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+func TestInMemoryStore_CountsByCategory(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := NewInMemoryStore()
+
+	reply := obligation.NewObligation("circle-1", "evt-1", "email", obligation.ObligationReply, now)
+	attend := obligation.NewObligation("circle-1", "evt-2", "calendar", obligation.ObligationAttend, now)
+	decide := obligation.NewObligation("circle-1", "evt-3", "calendar", obligation.ObligationDecide, now)
+	pay := obligation.NewObligation("circle-1", "evt-4", "commerce", obligation.ObligationPay, now)
+
+	store.Record("circle-1", []*obligation.Obligation{reply, attend, decide, pay}, map[string]bool{attend.ID: true})
+
+	all := store.CountByCategory("circle-1")
+	if all[shadowllm.CategoryPeople] != 1 || all[shadowllm.CategoryTime] != 2 || all[shadowllm.CategoryMoney] != 1 {
+		t.Errorf("unexpected counts: %v", all)
+	}
+	held := store.CountHeldByCategory("circle-1")
+	if held[shadowllm.CategoryTime] != 1 || held[shadowllm.CategoryPeople] != 1 || held[shadowllm.CategoryMoney] != 1 {
+		t.Errorf("expected surfaced obligation excluded from held, got %v", held)
+	}
+
+	// Callers get copies
+	all[shadowllm.CategoryTime] = 99
+	if store.CountByCategory("circle-1")[shadowllm.CategoryTime] != 2 {
+		t.Error("expected returned counts to be a copy")
+	}
+
+	// A later run replaces the circle's counts
+	store.Record("circle-1", nil, nil)
+	if len(store.CountByCategory("circle-1")) != 0 || len(store.CountHeldByCategory("circle-1")) != 0 {
+		t.Error("expected counts cleared by an empty run")
+	}
+	if len(store.CountByCategory("unknown")) != 0 {
+		t.Error("expected no counts for unknown circle")
+	}
+}
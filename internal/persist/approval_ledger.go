@@ -1,7 +1,10 @@
 package persist
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -25,8 +28,26 @@ type ApprovalLedger struct {
 	states       *approvalflow.ApprovalStateSet
 	tokens       *approvaltoken.TokenSet
 	tokensByHash map[string]*approvaltoken.Token // lookup by hash for revocation
+	redeemed     map[string]bool                 // token IDs whose decision is recorded
+	signer       approvaltoken.Signer            // issuer key for IssueToken and Redeem
+	now          func() time.Time                // decision timestamps for Redeem
 }
 
+// Approval token redemption errors.
+var (
+	// ErrNoTokenSigner is returned when the ledger has no issuer key,
+	// so no token can be issued or verified.
+	ErrNoTokenSigner = errors.New("approval ledger has no token signer")
+
+	// ErrUnknownApprovalState is returned for tokens whose approval
+	// state the ledger does not hold.
+	ErrUnknownApprovalState = errors.New("approval state not found")
+
+	// ErrNotApprover is returned when the token holder is not a required
+	// approver for the state.
+	ErrNotApprover = errors.New("token holder is not an approver for this state")
+)
+
 // NewApprovalLedger creates a new file-backed approval ledger.
 func NewApprovalLedger(log storelog.AppendOnlyLog) (*ApprovalLedger, error) {
 	ledger := &ApprovalLedger{
@@ -35,6 +56,7 @@ func NewApprovalLedger(log storelog.AppendOnlyLog) (*ApprovalLedger, error) {
 		states:       approvalflow.NewApprovalStateSet(),
 		tokens:       approvaltoken.NewTokenSet(),
 		tokensByHash: make(map[string]*approvaltoken.Token),
+		redeemed:     make(map[string]bool),
 	}
 
 	// Replay existing records
@@ -45,6 +67,33 @@ func NewApprovalLedger(log storelog.AppendOnlyLog) (*ApprovalLedger, error) {
 	return ledger, nil
 }
 
+// NewFileApprovalLedger opens (or creates) an approval ledger at path.
+//
+// Records are appended to a storelog.FileLog, which fsyncs every append,
+// and the file is replayed on open so issued tokens and recorded
+// decisions survive restarts.
+func NewFileApprovalLedger(path string, now func() time.Time) (*ApprovalLedger, error) {
+	log, err := storelog.NewFileLog(path)
+	if err != nil {
+		return nil, fmt.Errorf("open approval ledger: %w", err)
+	}
+	ledger, err := NewApprovalLedger(log)
+	if err != nil {
+		return nil, err
+	}
+	ledger.now = now
+	return ledger, nil
+}
+
+// WithTokenSigner sets the issuer key. Tokens are issued and signed with
+// it, and presented tokens must verify under its public key.
+func (l *ApprovalLedger) WithTokenSigner(signer approvaltoken.Signer) *ApprovalLedger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.signer = signer
+	return l
+}
+
 // replay loads approval data from the log.
 func (l *ApprovalLedger) replay() error {
 	// Load intersection policies
@@ -86,6 +135,9 @@ func (l *ApprovalLedger) replay() error {
 		if state := l.states.Get(stateID); state != nil {
 			state.RecordApproval(approval)
 		}
+		if approval.TokenID != "" {
+			l.redeemed[approval.TokenID] = true
+		}
 	}
 
 	// Load token creates
@@ -110,7 +162,7 @@ func (l *ApprovalLedger) replay() error {
 	for _, record := range revokeRecords {
 		tokenID := parseTokenRevokePayload(record.Payload)
 		if tokenID != "" {
-			l.tokens.Remove(tokenID)
+			l.tokens.Revoke(tokenID)
 			// Remove from hash lookup
 			for hash, tok := range l.tokensByHash {
 				if tok.TokenID == tokenID {
@@ -195,7 +247,11 @@ func (l *ApprovalLedger) CreateApprovalState(state *approvalflow.ApprovalState)
 func (l *ApprovalLedger) RecordApproval(stateID string, record approvalflow.ApprovalRecord) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
+	return l.recordApprovalLocked(stateID, record)
+}
 
+// recordApprovalLocked appends and applies a decision. Caller holds l.mu.
+func (l *ApprovalLedger) recordApprovalLocked(stateID string, record approvalflow.ApprovalRecord) error {
 	state := l.states.Get(stateID)
 	if state == nil {
 		return fmt.Errorf("state not found: %s", stateID)
@@ -216,6 +272,9 @@ func (l *ApprovalLedger) RecordApproval(stateID string, record approvalflow.Appr
 	}
 
 	state.RecordApproval(record)
+	if record.TokenID != "" {
+		l.redeemed[record.TokenID] = true
+	}
 	return nil
 }
 
@@ -233,6 +292,14 @@ func (l *ApprovalLedger) GetApprovalStateByTarget(targetType approvalflow.Target
 	return l.states.GetByTarget(targetType, targetID)
 }
 
+// GetApprovalStateForAction returns the state for a target bound to
+// actionHash.
+func (l *ApprovalLedger) GetApprovalStateForAction(targetType approvalflow.TargetType, targetID, actionHash string) *approvalflow.ApprovalState {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.states.GetByAction(targetType, targetID, actionHash)
+}
+
 // ListApprovalStates returns all approval states.
 func (l *ApprovalLedger) ListApprovalStates() []*approvalflow.ApprovalState {
 	l.mu.RLock()
@@ -258,7 +325,11 @@ func (l *ApprovalLedger) ListPendingForPerson(personID identity.EntityID, now ti
 func (l *ApprovalLedger) CreateToken(token *approvaltoken.Token) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
+	return l.createTokenLocked(token)
+}
 
+// createTokenLocked appends and stores a token. Caller holds l.mu.
+func (l *ApprovalLedger) createTokenLocked(token *approvaltoken.Token) error {
 	// Create log record
 	payload := formatTokenPayload(token)
 	logRecord := storelog.NewRecord(
@@ -278,6 +349,69 @@ func (l *ApprovalLedger) CreateToken(token *approvaltoken.Token) error {
 	return nil
 }
 
+// IssueToken signs and stores a token for one approver of a state.
+// The token expires with the state. Issuing the same token twice returns
+// the stored one.
+func (l *ApprovalLedger) IssueToken(stateID string, personID identity.EntityID, action approvaltoken.ActionType, now time.Time) (*approvaltoken.Token, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.signer == nil {
+		return nil, ErrNoTokenSigner
+	}
+	state := l.states.Get(stateID)
+	if state == nil {
+		return nil, ErrUnknownApprovalState
+	}
+	if !state.IsApproverRequired(personID) {
+		return nil, ErrNotApprover
+	}
+
+	token := approvaltoken.NewToken(stateID, personID, action, now.UTC().Truncate(time.Second), state.ExpiresAt.UTC().Truncate(time.Second))
+	if existing := l.tokens.Get(token.TokenID); existing != nil {
+		return existing, nil
+	}
+	if err := token.Sign(l.signer); err != nil {
+		return nil, fmt.Errorf("sign approval token: %w", err)
+	}
+	if err := l.createTokenLocked(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// VerifyToken checks a presented token before it is used: its ID and hash
+// must match its fields, it must be signed by the ledger's issuer key, its
+// state must be known and its holder must be an approver of that state.
+func (l *ApprovalLedger) VerifyToken(token *approvaltoken.Token) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, err := l.verifyTokenLocked(token)
+	return err
+}
+
+// verifyTokenLocked implements VerifyToken. Caller holds l.mu.
+func (l *ApprovalLedger) verifyTokenLocked(token *approvaltoken.Token) (*approvalflow.ApprovalState, error) {
+	if l.signer == nil {
+		return nil, ErrNoTokenSigner
+	}
+	pub, err := l.signer.GetPublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("issuer key: %w", err)
+	}
+	if err := token.Verify(pub); err != nil {
+		return nil, err
+	}
+	state := l.states.Get(token.StateID)
+	if state == nil {
+		return nil, ErrUnknownApprovalState
+	}
+	if !state.IsApproverRequired(token.PersonID) {
+		return nil, ErrNotApprover
+	}
+	return state, nil
+}
+
 // RevokeToken revokes a token.
 func (l *ApprovalLedger) RevokeToken(tokenID string, revokedAt time.Time) error {
 	l.mu.Lock()
@@ -302,11 +436,197 @@ func (l *ApprovalLedger) RevokeToken(tokenID string, revokedAt time.Time) error
 		return err
 	}
 
-	l.tokens.Remove(tokenID)
+	l.tokens.Revoke(tokenID)
 	delete(l.tokensByHash, token.Hash)
 	return nil
 }
 
+// Revoke durably revokes a token ID before it expires, whether or not the
+// token was ever stored, so a leaked link can no longer be redeemed.
+// Returns false if the ID was already revoked.
+//
+// The ID is safe to key on because Redeem recomputes it from the token
+// fields: a leaked token with an edited ID fails verification instead of
+// passing as an unrevoked one.
+func (l *ApprovalLedger) Revoke(tokenID string, revokedAt time.Time) (bool, error) {
+	if tokenID == "" {
+		return false, fmt.Errorf("missing token ID")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.tokens.IsRevoked(tokenID) {
+		return false, nil
+	}
+
+	var personID identity.EntityID
+	token := l.tokens.Get(tokenID)
+	if token != nil {
+		personID = token.PersonID
+	}
+
+	payload := formatTokenRevokePayload(tokenID, revokedAt)
+	logRecord := storelog.NewRecord(
+		storelog.RecordTypeApprovalTokenRevoke,
+		revokedAt,
+		personID,
+		payload,
+	)
+	if err := l.log.Append(logRecord); err != nil && err != storelog.ErrRecordExists {
+		return false, err
+	}
+
+	l.tokens.Revoke(tokenID)
+	if token != nil {
+		delete(l.tokensByHash, token.Hash)
+	}
+	return true, nil
+}
+
+// IsRevoked returns whether a token ID has been revoked.
+func (l *ApprovalLedger) IsRevoked(tokenID string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.tokens.IsRevoked(tokenID)
+}
+
+// Redeem verifies a presented approval token and records its decision.
+//
+// The token must pass VerifyToken. The first redemption records an
+// approved or rejected decision for the token holder; later redemptions
+// of the same token return false and change nothing. A revoked token
+// returns approvaltoken.ErrTokenRevoked.
+func (l *ApprovalLedger) Redeem(token *approvaltoken.Token) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.redeemLocked(token)
+}
+
+// redeemLocked implements Redeem. Caller holds l.mu.
+func (l *ApprovalLedger) redeemLocked(token *approvaltoken.Token) (bool, error) {
+	if _, err := l.verifyTokenLocked(token); err != nil {
+		return false, err
+	}
+	if l.tokens.IsRevoked(token.TokenID) {
+		return false, approvaltoken.ErrTokenRevoked
+	}
+	if l.redeemed[token.TokenID] {
+		return false, nil
+	}
+	if l.tokens.Get(token.TokenID) == nil {
+		if err := l.createTokenLocked(token); err != nil {
+			return false, err
+		}
+	}
+
+	decision := approvalflow.DecisionApproved
+	if token.ActionType == approvaltoken.ActionTypeReject {
+		decision = approvalflow.DecisionRejected
+	}
+	ts := token.CreatedAt
+	if l.now != nil {
+		ts = l.now()
+	}
+	record := approvalflow.ApprovalRecord{
+		PersonID:  token.PersonID,
+		Decision:  decision,
+		Timestamp: ts,
+		TokenID:   token.TokenID,
+	}
+	return true, l.recordApprovalLocked(token.StateID, record)
+}
+
+// RedeemOutcome is the result of redeeming one token in a batch.
+type RedeemOutcome string
+
+const (
+	// RedeemApplied means the token's decision was recorded.
+	RedeemApplied RedeemOutcome = "applied"
+
+	// RedeemSkippedExpired means the token or its state has expired.
+	RedeemSkippedExpired RedeemOutcome = "skipped_expired"
+
+	// RedeemSkippedDecided means the token was already redeemed, the
+	// holder already decided, or the state is no longer pending.
+	RedeemSkippedDecided RedeemOutcome = "skipped_decided"
+
+	// RedeemSkippedRevoked means the token was revoked before use.
+	RedeemSkippedRevoked RedeemOutcome = "skipped_revoked"
+
+	// RedeemRejected means the token failed verification: a forged or
+	// edited token, a foreign signature, or a holder who is not an approver.
+	RedeemRejected RedeemOutcome = "rejected"
+
+	// RedeemFailed means the ledger could not record the decision.
+	RedeemFailed RedeemOutcome = "failed"
+)
+
+// RedeemResult is the outcome for one token in a batch.
+type RedeemResult struct {
+	Token   *approvaltoken.Token
+	Outcome RedeemOutcome
+}
+
+// RedeemBatch redeems tokens in one deterministic pass, ordered by state
+// ID then token ID. Every token is verified as in Redeem; tokens that
+// fail verification are reported as rejected, and expired or
+// already-decided tokens are skipped, without failing the rest of the
+// batch. Results are returned in the same order.
+func (l *ApprovalLedger) RedeemBatch(tokens []*approvaltoken.Token, now time.Time) []RedeemResult {
+	ordered := make([]*approvaltoken.Token, len(tokens))
+	copy(ordered, tokens)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].StateID != ordered[j].StateID {
+			return ordered[i].StateID < ordered[j].StateID
+		}
+		return ordered[i].TokenID < ordered[j].TokenID
+	})
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	results := make([]RedeemResult, 0, len(ordered))
+	for _, token := range ordered {
+		results = append(results, RedeemResult{Token: token, Outcome: l.redeemOutcomeLocked(token, now)})
+	}
+	return results
+}
+
+// redeemOutcomeLocked redeems a single batch token. Caller holds l.mu.
+func (l *ApprovalLedger) redeemOutcomeLocked(token *approvaltoken.Token, now time.Time) RedeemOutcome {
+	state, err := l.verifyTokenLocked(token)
+	if err != nil {
+		return RedeemRejected
+	}
+	if l.tokens.IsRevoked(token.TokenID) {
+		return RedeemSkippedRevoked
+	}
+	if token.IsExpired(now) {
+		return RedeemSkippedExpired
+	}
+	switch state.ComputeStatus(now) {
+	case approvalflow.StatusExpired:
+		return RedeemSkippedExpired
+	case approvalflow.StatusPending:
+		if state.GetApproval(token.PersonID) != nil {
+			return RedeemSkippedDecided
+		}
+	default:
+		return RedeemSkippedDecided
+	}
+
+	first, err := l.redeemLocked(token)
+	switch {
+	case err != nil:
+		return RedeemFailed
+	case !first:
+		return RedeemSkippedDecided
+	default:
+		return RedeemApplied
+	}
+}
+
 // GetToken returns a token by ID.
 func (l *ApprovalLedger) GetToken(tokenID string) *approvaltoken.Token {
 	l.mu.RLock()
@@ -488,8 +808,13 @@ func parseApprovalStatePayload(payload string) (*approvalflow.ApprovalState, err
 		Approvals:         []approvalflow.ApprovalRecord{},
 	}
 
+	inApprovers := false
 	parts := strings.Split(payload, "|")
 	for _, part := range parts {
+		if inApprovers || strings.HasPrefix(part, "approvers:[") {
+			inApprovers = parseApproversPart(state, strings.TrimPrefix(part, "approvers:["))
+			continue
+		}
 		if strings.HasPrefix(part, "id:") {
 			state.StateID = part[3:]
 		} else if strings.HasPrefix(part, "target_type:") {
@@ -500,6 +825,8 @@ func parseApprovalStatePayload(payload string) (*approvalflow.ApprovalState, err
 			state.IntersectionID = part[13:]
 		} else if strings.HasPrefix(part, "action:") {
 			state.ActionClass = intersection.ActionClass(part[7:])
+		} else if strings.HasPrefix(part, "action_hash:") {
+			state.ActionHash = part[12:]
 		} else if strings.HasPrefix(part, "threshold:") {
 			fmt.Sscanf(part[10:], "%d", &state.Threshold)
 		} else if strings.HasPrefix(part, "max_age:") {
@@ -519,6 +846,22 @@ func parseApprovalStatePayload(payload string) (*approvalflow.ApprovalState, err
 	return state, nil
 }
 
+// parseApproversPart parses one "|"-separated piece of the approvers list
+// ("person:a", "role:owner,person:b", "role:spouse]") into state.
+// Returns whether the list continues past this piece.
+func parseApproversPart(state *approvalflow.ApprovalState, part string) bool {
+	end := strings.HasSuffix(part, "]")
+	part = strings.TrimSuffix(part, "]")
+	for _, field := range strings.Split(part, ",") {
+		if strings.HasPrefix(field, "person:") {
+			state.RequiredApprovers = append(state.RequiredApprovers, approvalflow.ApproverRef{PersonID: identity.EntityID(field[7:])})
+		} else if strings.HasPrefix(field, "role:") && len(state.RequiredApprovers) > 0 {
+			state.RequiredApprovers[len(state.RequiredApprovers)-1].Role = intersection.MemberRole(field[5:])
+		}
+	}
+	return !end
+}
+
 func formatApprovalRecordPayload(stateID string, r approvalflow.ApprovalRecord) string {
 	var b strings.Builder
 	b.WriteString("approval_record")
@@ -583,6 +926,8 @@ func formatTokenPayload(t *approvaltoken.Token) string {
 	b.WriteString(t.KeyID)
 	b.WriteString("|hash:")
 	b.WriteString(t.Hash)
+	b.WriteString("|sig:")
+	b.WriteString(base64.RawURLEncoding.EncodeToString(t.Signature))
 	return b.String()
 }
 
@@ -611,6 +956,12 @@ func parseTokenPayload(payload string) (*approvaltoken.Token, error) {
 			t.KeyID = part[4:]
 		} else if strings.HasPrefix(part, "hash:") {
 			t.Hash = part[5:]
+		} else if strings.HasPrefix(part, "sig:") {
+			sig, err := base64.RawURLEncoding.DecodeString(part[4:])
+			if err != nil {
+				return nil, fmt.Errorf("decode token signature: %w", err)
+			}
+			t.Signature = sig
 		}
 	}
 
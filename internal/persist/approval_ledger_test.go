@@ -1,6 +1,10 @@
 package persist
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -375,3 +379,295 @@ func TestApprovalLedgerDeterminism(t *testing.T) {
 
 	t.Logf("Ledger determinism verified")
 }
+
+// testTokenSigner returns a fixed issuer key for approval tokens.
+func testTokenSigner(seed byte) approvaltoken.Signer {
+	return approvaltoken.NewKeySigner(ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize)))
+}
+
+func TestFileApprovalLedgerReopenHonorsApprovals(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "approvals.log")
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now.Add(5 * time.Minute) }
+	signer := testTokenSigner(1)
+
+	ledger1, err := NewFileApprovalLedger(path, clock)
+	if err != nil {
+		t.Fatalf("open ledger: %v", err)
+	}
+	ledger1.WithTokenSigner(signer)
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-1"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 1, 60, now)
+	if err := ledger1.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+
+	token, err := ledger1.IssueToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	presented, err := approvaltoken.Decode(token.Encode())
+	if err != nil {
+		t.Fatalf("decode token: %v", err)
+	}
+	first, err := ledger1.Redeem(presented)
+	if err != nil || !first {
+		t.Fatalf("first redeem should record: first=%v err=%v", first, err)
+	}
+
+	// Reopen from the same file
+	ledger2, err := NewFileApprovalLedger(path, clock)
+	if err != nil {
+		t.Fatalf("reopen ledger: %v", err)
+	}
+	ledger2.WithTokenSigner(signer)
+
+	stored := ledger2.GetToken(token.TokenID)
+	if stored == nil {
+		t.Fatal("issued token should survive reopen")
+	}
+	if stored.Encode() != token.Encode() {
+		t.Error("reopened token should keep its signature")
+	}
+	replayed := ledger2.GetApprovalState(state.StateID)
+	if replayed == nil {
+		t.Fatal("state should survive reopen")
+	}
+	if !replayed.IsApproverRequired("person-1") {
+		t.Error("approvers should survive reopen")
+	}
+	if status := replayed.ComputeStatus(now.Add(10 * time.Minute)); status != approvalflow.StatusApproved {
+		t.Errorf("reopened state should be approved, got %s", status)
+	}
+
+	again, err := ledger2.Redeem(presented)
+	if err != nil || again {
+		t.Errorf("redeeming again after reopen should be a no-op: again=%v err=%v", again, err)
+	}
+}
+
+func TestApprovalLedgerRedeemRejectsUnverifiedTokens(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	ledger, err := NewApprovalLedger(storelog.NewInMemoryLog())
+	if err != nil {
+		t.Fatalf("create ledger: %v", err)
+	}
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-1"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 1, 60, now)
+	if err := ledger.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+
+	unsigned := approvaltoken.NewToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour))
+	if _, err := ledger.Redeem(unsigned); !errors.Is(err, ErrNoTokenSigner) {
+		t.Errorf("a ledger without a signer must refuse tokens, got %v", err)
+	}
+
+	ledger.WithTokenSigner(testTokenSigner(1))
+	issued, err := ledger.IssueToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	if _, err := ledger.IssueToken(state.StateID, "person-2", approvaltoken.ActionTypeApprove, now); !errors.Is(err, ErrNotApprover) {
+		t.Errorf("expected ErrNotApprover when issuing to a non-approver, got %v", err)
+	}
+
+	foreign := approvaltoken.NewToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour))
+	if err := foreign.Sign(testTokenSigner(2)); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	edited, _ := approvaltoken.Decode(issued.Encode())
+	edited.TokenID = "0123456789abcdef"
+	outsider := approvaltoken.NewToken(state.StateID, "person-2", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour))
+	if err := outsider.Sign(testTokenSigner(1)); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	cases := []struct {
+		name  string
+		token *approvaltoken.Token
+		want  error
+	}{
+		{"unsigned", unsigned, approvaltoken.ErrTokenUnsigned},
+		{"foreign key", foreign, approvaltoken.ErrTokenSignature},
+		{"edited ID", edited, approvaltoken.ErrTokenIDMismatch},
+		{"not an approver", outsider, ErrNotApprover},
+	}
+	for _, tc := range cases {
+		first, err := ledger.Redeem(tc.token)
+		if first || !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got first=%v err=%v", tc.name, tc.want, first, err)
+		}
+	}
+	if got := ledger.GetApprovalState(state.StateID).ComputeStatus(now); got != approvalflow.StatusPending {
+		t.Errorf("rejected tokens must not change the state, got %s", got)
+	}
+}
+func TestApprovalLedgerRedeemBatchSkipsWithoutFailing(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	ledger, err := NewApprovalLedger(storelog.NewInMemoryLog())
+	if err != nil {
+		t.Fatalf("create ledger: %v", err)
+	}
+	signer := testTokenSigner(1)
+	ledger.WithTokenSigner(signer)
+	signed := func(token *approvaltoken.Token) *approvaltoken.Token {
+		if err := token.Sign(signer); err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return token
+	}
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-1"}, {PersonID: "person-2"}}
+	open := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 2, 60, now)
+	decided := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-2", "", intersection.ActionEmailSend, approvers, 2, 60, now)
+	for _, state := range []*approvalflow.ApprovalState{open, decided} {
+		if err := ledger.CreateApprovalState(state); err != nil {
+			t.Fatalf("create state: %v", err)
+		}
+	}
+
+	// person-1 already decided draft-2
+	prior := signed(approvaltoken.NewToken(decided.StateID, "person-1", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour)))
+	if _, err := ledger.Redeem(prior); err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+
+	apply := signed(approvaltoken.NewToken(open.StateID, "person-1", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour)))
+	again := signed(approvaltoken.NewToken(decided.StateID, "person-1", approvaltoken.ActionTypeReject, now.Add(time.Minute), now.Add(time.Hour)))
+	expired := signed(approvaltoken.NewToken(open.StateID, "person-2", approvaltoken.ActionTypeApprove, now.Add(-2*time.Hour), now.Add(-time.Hour)))
+
+	results := ledger.RedeemBatch([]*approvaltoken.Token{expired, again, apply, apply}, now.Add(5*time.Minute))
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(results))
+	}
+
+	outcomes := make(map[RedeemOutcome]int)
+	for i, r := range results {
+		outcomes[r.Outcome]++
+		if i > 0 {
+			prev := results[i-1].Token
+			if prev.StateID > r.Token.StateID || (prev.StateID == r.Token.StateID && prev.TokenID > r.Token.TokenID) {
+				t.Error("results should be ordered by state ID then token ID")
+			}
+		}
+	}
+	if outcomes[RedeemApplied] != 1 || outcomes[RedeemSkippedDecided] != 2 || outcomes[RedeemSkippedExpired] != 1 {
+		t.Errorf("unexpected outcomes: %v", outcomes)
+	}
+	if ledger.GetApprovalState(open.StateID).GetApproval("person-1") == nil {
+		t.Error("applied token should record a decision")
+	}
+	if got := ledger.GetApprovalState(decided.StateID).GetApproval("person-1").Decision; got != approvalflow.DecisionApproved {
+		t.Errorf("prior decision should stand, got %s", got)
+	}
+}
+
+func TestApprovalLedgerRedeemBatchRejectsForgedTokens(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	ledger, err := NewApprovalLedger(storelog.NewInMemoryLog())
+	if err != nil {
+		t.Fatalf("create ledger: %v", err)
+	}
+	ledger.WithTokenSigner(testTokenSigner(1))
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-1"}, {PersonID: "person-2"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 2, 60, now)
+	if err := ledger.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+
+	valid, err := ledger.IssueToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now)
+	if err != nil {
+		t.Fatalf("issue token: %v", err)
+	}
+	unsigned := approvaltoken.NewToken(state.StateID, "person-2", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour))
+	forged := approvaltoken.NewToken(state.StateID, "person-2", approvaltoken.ActionTypeApprove, now.Add(time.Minute), now.Add(time.Hour))
+	if err := forged.Sign(testTokenSigner(2)); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	results := ledger.RedeemBatch([]*approvaltoken.Token{forged, valid, unsigned}, now.Add(5*time.Minute))
+	outcomes := make(map[string]RedeemOutcome)
+	for _, r := range results {
+		outcomes[string(r.Token.PersonID)+"/"+r.Token.TokenID] = r.Outcome
+	}
+	if got := outcomes["person-1/"+valid.TokenID]; got != RedeemApplied {
+		t.Errorf("valid token should apply, got %s", got)
+	}
+	for _, bad := range []*approvaltoken.Token{unsigned, forged} {
+		if got := outcomes["person-2/"+bad.TokenID]; got != RedeemRejected {
+			t.Errorf("forged token %s should be rejected, got %s", bad.TokenID, got)
+		}
+	}
+	if ledger.GetApprovalState(state.StateID).GetApproval("person-2") != nil {
+		t.Error("forged tokens must not record a decision")
+	}
+}
+
+func TestFileApprovalLedgerRevocationSurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "approvals.log")
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	signer := testTokenSigner(1)
+
+	ledger1, err := NewFileApprovalLedger(path, clock)
+	if err != nil {
+		t.Fatalf("open ledger: %v", err)
+	}
+	ledger1.WithTokenSigner(signer)
+
+	approvers := []approvalflow.ApproverRef{{PersonID: "person-1"}}
+	state := approvalflow.NewApprovalState(approvalflow.TargetTypeDraft, "draft-1", "", intersection.ActionEmailSend, approvers, 1, 60, now)
+	if err := ledger1.CreateApprovalState(state); err != nil {
+		t.Fatalf("create state: %v", err)
+	}
+
+	// A leaked token that was never redeemed can still be revoked
+	leaked := approvaltoken.NewToken(state.StateID, "person-1", approvaltoken.ActionTypeApprove, now, now.Add(time.Hour))
+	if err := leaked.Sign(signer); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	revoked, err := ledger1.Revoke(leaked.TokenID, now.Add(time.Minute))
+	if err != nil || !revoked {
+		t.Fatalf("revoke should record: revoked=%v err=%v", revoked, err)
+	}
+	again, err := ledger1.Revoke(leaked.TokenID, now.Add(2*time.Minute))
+	if err != nil || again {
+		t.Errorf("second revoke should be a no-op: again=%v err=%v", again, err)
+	}
+
+	ledger2, err := NewFileApprovalLedger(path, clock)
+	if err != nil {
+		t.Fatalf("reopen ledger: %v", err)
+	}
+	ledger2.WithTokenSigner(signer)
+	if !ledger2.IsRevoked(leaked.TokenID) {
+		t.Fatal("revocation should survive reopen")
+	}
+	if _, err := ledger2.Redeem(leaked); !errors.Is(err, approvaltoken.ErrTokenRevoked) {
+		t.Errorf("expected ErrTokenRevoked, got %v", err)
+	}
+
+	// Editing the leaked token's ID does not get around the revocation
+	edited, err := approvaltoken.Decode(leaked.Encode())
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	edited.TokenID = "fedcba9876543210"
+	if first, err := ledger2.Redeem(edited); first || !errors.Is(err, approvaltoken.ErrTokenIDMismatch) {
+		t.Errorf("edited ID should be rejected: first=%v err=%v", first, err)
+	}
+	if got := ledger2.GetApprovalState(state.StateID).ComputeStatus(now); got != approvalflow.StatusPending {
+		t.Errorf("revoked token must not approve the state, got %s", got)
+	}
+	results := ledger2.RedeemBatch([]*approvaltoken.Token{leaked}, now.Add(5*time.Minute))
+	if results[0].Outcome != RedeemSkippedRevoked {
+		t.Errorf("expected skipped_revoked in batch, got %s", results[0].Outcome)
+	}
+	if ledger2.GetToken(leaked.TokenID) != nil {
+		t.Error("revoked token should never be stored")
+	}
+}
@@ -239,3 +239,11 @@ func parseCircleBinding(payload string) (*deviceidentity.CircleBinding, error) {
 		BindingHash:   "", // Computed on demand
 	}, nil
 }
+
+// Clear removes all bindings from memory.
+func (s *CircleBindingStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bindings = make(map[string][]*deviceidentity.CircleBinding)
+	s.byFingerprint = make(map[string][]string)
+}
@@ -250,3 +250,20 @@ func (s *CircleSemanticsAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all semantics records.
+func (s *CircleSemanticsStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.SemanticsRecord, 0)
+	s.latestByCircle = make(map[string]int)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *CircleSemanticsAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.SemanticsProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
@@ -212,3 +212,11 @@ func (s *InMemoryConnectionStore) IntentCount() int {
 	defer s.mu.RUnlock()
 	return len(s.intents)
 }
+
+// Clear removes all intents. Configured providers stay recorded.
+func (s *InMemoryConnectionStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.intents = make(connection.IntentList, 0)
+	s.byHash = make(map[string]*connection.ConnectionIntent)
+}
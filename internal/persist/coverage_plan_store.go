@@ -263,3 +263,20 @@ func (s *CoverageProofAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all coverage plans.
+func (s *CoveragePlanStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.CoveragePlan, 0)
+	s.latestByCircle = make(map[string]int)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *CoverageProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.CoverageProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
@@ -297,3 +297,12 @@ func (s *DelegatedHoldingStore) EvictOldRecords(now time.Time) {
 	defer s.mu.Unlock()
 	s.evictIfNeededLocked(now)
 }
+
+// Clear removes all contracts and revocations.
+func (s *DelegatedHoldingStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.contracts = make(map[string]*storedContract)
+	s.contractList = make([]*storedContract, 0)
+	s.revocations = make(map[string]*dh.Revocation)
+}
@@ -37,8 +37,29 @@ type DeviceKeyStore struct {
 	loaded     bool
 }
 
+// Default device key location, under the user config directory.
+const (
+	DefaultDeviceKeyDirName  = "quantumlife"
+	DefaultDeviceKeyFileName = "device.key"
+)
+
+// ErrInsecureKeyPermissions is returned when the key file is readable by
+// anyone other than its owner.
+var ErrInsecureKeyPermissions = errors.New("device key file has insecure permissions")
+
+// DefaultDeviceKeyPath returns the default private key path in the user
+// config directory, which survives reboots (unlike os.TempDir).
+func DefaultDeviceKeyPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot determine config directory: %w", err)
+	}
+	return filepath.Join(dir, DefaultDeviceKeyDirName, DefaultDeviceKeyFileName), nil
+}
+
 // NewDeviceKeyStore creates a new device key store.
-// keyPath is the path to the private key file.
+// keyPath is the path to the private key file, normally DefaultDeviceKeyPath.
+// The directory is created 0700 and the key file 0600 on first use.
 func NewDeviceKeyStore(keyPath string) *DeviceKeyStore {
 	return &DeviceKeyStore{
 		keyPath: keyPath,
@@ -61,13 +82,15 @@ func (s *DeviceKeyStore) EnsureKeypair() (deviceidentity.DevicePublicKey, device
 	}
 
 	// Check if key file exists
-	if _, err := os.Stat(s.keyPath); os.IsNotExist(err) {
+	if info, err := os.Stat(s.keyPath); os.IsNotExist(err) {
 		// Create new keypair
 		if err := s.createKeypair(); err != nil {
 			return "", "", fmt.Errorf("failed to create keypair: %w", err)
 		}
 	} else if err != nil {
 		return "", "", fmt.Errorf("failed to check key file: %w", err)
+	} else if mode := info.Mode().Perm(); mode&0077 != 0 {
+		return "", "", fmt.Errorf("%w: mode %o, expected 0600", ErrInsecureKeyPermissions, mode)
 	} else {
 		// Load existing keypair
 		if err := s.loadKeypair(); err != nil {
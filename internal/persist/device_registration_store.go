@@ -391,3 +391,13 @@ func (s *DeviceRegistrationStore) Replay() error {
 	// Implementation would iterate through storelog records
 	return nil
 }
+
+// Clear removes all registration receipts.
+func (s *DeviceRegistrationStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]map[string]*devicereg.DeviceRegistrationReceipt)
+	s.activeByCircle = make(map[string]string)
+	s.periodOrder = make([]string, 0)
+	s.allRecordIDs = make([]string, 0)
+}
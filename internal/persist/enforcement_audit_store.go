@@ -298,3 +298,22 @@ func (s *EnforcementAuditAckStore) EvictOldRecords(now time.Time) {
 	defer s.mu.Unlock()
 	s.evictIfNeededLocked(now)
 }
+
+// Clear removes all audit runs.
+func (s *EnforcementAuditStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs = make(map[string]*eaStoredRun)
+	s.runList = make([]*eaStoredRun, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *EnforcementAuditAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make(map[string]*eaStoredAck)
+	s.byRunHash = make(map[string]string)
+	s.ackList = make([]*eaStoredAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
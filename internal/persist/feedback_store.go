@@ -138,30 +138,7 @@ func (s *FeedbackStore) List() []feedback.FeedbackRecord {
 
 // Stats returns feedback statistics.
 func (s *FeedbackStore) Stats() feedback.FeedbackStats {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	stats := feedback.FeedbackStats{
-		TotalRecords: len(s.records),
-	}
-
-	for _, record := range s.records {
-		switch record.TargetType {
-		case feedback.TargetInterruption:
-			stats.InterruptFeedback++
-		case feedback.TargetDraft:
-			stats.DraftFeedback++
-		}
-
-		switch record.Signal {
-		case feedback.SignalHelpful:
-			stats.HelpfulCount++
-		case feedback.SignalUnnecessary:
-			stats.UnnecessaryCount++
-		}
-	}
-
-	return stats
+	return feedback.ComputeStats(s.List())
 }
 
 // Flush ensures all records are persisted.
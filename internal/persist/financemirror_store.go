@@ -326,3 +326,14 @@ func parseFinanceMirrorAckFromPayload(payload string) (*financemirror.FinanceMir
 	// For now, we'll return an error since we need proper parsing
 	return nil, fmt.Errorf("replay parsing not implemented - acks should be stored fresh")
 }
+
+// Clear removes all receipts, acks and connection hashes from memory.
+func (s *FinanceMirrorStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.syncReceipts = make(map[string]*financemirror.FinanceSyncReceipt)
+	s.syncReceiptsByCircle = make(map[string][]string)
+	s.syncReceiptsByPeriod = make(map[string]string)
+	s.acks = make(map[string]*financemirror.FinanceMirrorAck)
+	s.connectionHashes = make(map[string]string)
+}
@@ -185,3 +185,12 @@ func (s *FirstActionStore) RecordState(
 	}
 	return s.Store(record)
 }
+
+// Clear removes all action records.
+func (s *FirstActionStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]*firstaction.ActionRecord)
+	s.byCircle = make(map[identity.EntityID][]*firstaction.ActionRecord)
+	s.byPeriod = make(map[string][]*firstaction.ActionRecord)
+}
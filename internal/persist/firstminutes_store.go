@@ -382,3 +382,11 @@ func computeFirstMinutesDismissalHash(circleID, period, summaryHash string, time
 	h := sha256.Sum256([]byte(canonical))
 	return hex.EncodeToString(h[:])
 }
+
+// Clear removes all summaries and dismissals from memory.
+func (s *FirstMinutesStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.summaries = make(map[string]*firstMinutesSummaryRecord)
+	s.dismissals = make(map[string]*firstMinutesDismissalRecord)
+}
@@ -356,3 +356,21 @@ func (s *HeldProofAckStore) EvictOldRecords(now time.Time) {
 	defer s.mu.Unlock()
 	s.evictIfNeededLocked(now)
 }
+
+// Clear removes all signals.
+func (s *HeldProofSignalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signals = make(map[string][]storedSignal)
+	s.signalList = make([]*storedSignal, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *HeldProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.viewed = make(map[string]*storedAck)
+	s.dismissed = make(map[string]*storedAck)
+	s.ackList = make([]*storedAck, 0)
+}
@@ -311,3 +311,11 @@ func (s *InterruptPolicyStore) Replay() error {
 func (s *InterruptPolicyStore) SetStorelog(log storelog.AppendOnlyLog) {
 	s.storelogRef = log
 }
+
+// Clear removes all policy records.
+func (s *InterruptPolicyStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]map[string]*interruptpolicy.InterruptPolicyRecord)
+	s.periodOrder = make([]string, 0)
+}
@@ -364,3 +364,11 @@ func (s *InterruptPreviewAckStore) Replay() error {
 func (s *InterruptPreviewAckStore) SetStorelog(log storelog.AppendOnlyLog) {
 	s.storelogRef = log
 }
+
+// Clear removes all acks.
+func (s *InterruptPreviewAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make(map[string]map[string]*interruptpreview.PreviewAck)
+	s.periodOrder = make([]string, 0)
+}
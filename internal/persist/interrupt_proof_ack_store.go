@@ -289,3 +289,11 @@ func (s *InterruptProofAckStore) Replay() error {
 func (s *InterruptProofAckStore) SetStorelog(log storelog.AppendOnlyLog) {
 	s.storelogRef = log
 }
+
+// Clear removes all acks.
+func (s *InterruptProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make(map[string]map[string]*interruptpolicy.InterruptProofAck)
+	s.periodOrder = make([]string, 0)
+}
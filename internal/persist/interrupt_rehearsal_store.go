@@ -292,3 +292,12 @@ func (s *InterruptRehearsalStore) CanDeliver(circleIDHash string, periodKey stri
 func (s *InterruptRehearsalStore) GetDailyDeliveryCount(circleIDHash string, periodKey string) int {
 	return s.GetDeliveryCountRaw(circleIDHash, periodKey)
 }
+
+// Clear removes all receipts and acks.
+func (s *InterruptRehearsalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts = make(map[string]*ir.RehearsalReceipt)
+	s.receiptList = make([]*storedReceipt, 0)
+	s.acks = make(map[string]*ir.RehearsalAck)
+}
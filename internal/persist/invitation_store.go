@@ -176,3 +176,12 @@ func (s *InvitationStore) RecordDecision(
 	}
 	return s.Store(record)
 }
+
+// Clear removes all invitation records.
+func (s *InvitationStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]*invitation.InvitationRecord)
+	s.byCircle = make(map[identity.EntityID][]*invitation.InvitationRecord)
+	s.byPeriod = make(map[string][]*invitation.InvitationRecord)
+}
@@ -210,3 +210,11 @@ func computeJourneyDismissalHash(circleID, periodKey, statusHash string, timeBuc
 	h := sha256.Sum256([]byte(canonical))
 	return hex.EncodeToString(h[:])
 }
+
+// Clear removes all dismissals from memory.
+func (s *JourneyDismissalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dismissals = make(map[string]*journeyDismissalRecord)
+	s.byHash = make(map[string]*journeyDismissalRecord)
+}
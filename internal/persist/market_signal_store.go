@@ -287,3 +287,20 @@ func (s *MarketProofAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all signals.
+func (s *MarketSignalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signals = make([]domain.MarketSignal, 0)
+	s.byCirclePeriod = make(map[string][]int)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *MarketProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.MarketProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
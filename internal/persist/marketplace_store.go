@@ -414,3 +414,28 @@ func (s *MarketplaceAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all install records.
+func (s *MarketplaceInstallStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.PackInstallRecord, 0)
+	s.latestByPack = make(map[string]int)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all removal records.
+func (s *MarketplaceRemovalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.PackRemovalRecord, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *MarketplaceAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.MarketplaceProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
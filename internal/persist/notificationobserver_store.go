@@ -369,3 +369,12 @@ func (s *NotificationObserverStore) Replay() error {
 	// Implementation would iterate through storelog records
 	return nil
 }
+
+// Clear removes all pressure signals.
+func (s *NotificationObserverStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]map[string]*notificationobserver.NotificationPressureSignal)
+	s.periodOrder = make([]string, 0)
+	s.allRecordIDs = make([]string, 0)
+}
@@ -12,6 +12,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"time"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // OAuthStateRecord represents a persisted OAuth state for replay.
@@ -147,9 +149,7 @@ func (r *GmailSyncReceiptRecord) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the record.
 func (r *GmailSyncReceiptRecord) Hash() string {
-	data := []byte(r.CanonicalString())
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("persist.GmailSyncReceiptRecord", r.CanonicalString())
 }
 
 // OAuthRevokeReceiptRecord represents a persisted revoke receipt for replay.
@@ -186,9 +186,7 @@ func (r *OAuthRevokeReceiptRecord) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the record.
 func (r *OAuthRevokeReceiptRecord) Hash() string {
-	data := []byte(r.CanonicalString())
-	hash := sha256.Sum256(data)
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("persist.OAuthRevokeReceiptRecord", r.CanonicalString())
 }
 
 // OAuthRecordStore provides in-memory storage for OAuth records.
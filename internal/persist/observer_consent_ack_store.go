@@ -119,3 +119,11 @@ func (s *ObserverConsentAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all acks.
+func (s *ObserverConsentAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.ObserverConsentAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
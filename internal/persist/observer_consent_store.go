@@ -146,3 +146,11 @@ func (s *ObserverConsentStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.receipts)
 }
+
+// Clear removes all consent receipts.
+func (s *ObserverConsentStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts = make([]domain.ObserverConsentReceipt, 0)
+	s.dedupIndex = make(map[string]bool)
+}
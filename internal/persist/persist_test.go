@@ -2,6 +2,7 @@ package persist
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"quantumlife/pkg/domain/feedback"
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/storelog"
+	"quantumlife/pkg/domain/suppress"
 	"quantumlife/pkg/primitives"
 )
 
@@ -470,3 +472,62 @@ func TestIdentityStore_Stats(t *testing.T) {
 		t.Errorf("TotalEntityCount = %d, want 5", stats.TotalEntityCount)
 	}
 }
+
+func TestSyncReceiptStore_PruneKeepsLatestPerCircle(t *testing.T) {
+	base := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	store := NewSyncReceiptStore(func() time.Time { return base })
+
+	for i := 0; i < 3; i++ {
+		store.Store(NewSyncReceipt("circle-a", "gmail", i+1, 0, base.AddDate(0, 0, i), true, ""))
+	}
+	store.Store(NewSyncReceipt("circle-b", "gmail", 1, 0, base, true, ""))
+
+	// Cutoff after the first two circle-a receipts but before the third
+	removed := store.Prune(base.AddDate(0, 0, 2))
+	if removed != 2 {
+		t.Errorf("expected 2 removed, got %d", removed)
+	}
+	if store.Count() != 2 || len(store.GetByCircle("circle-a")) != 1 {
+		t.Errorf("expected one receipt per circle, got count=%d", store.Count())
+	}
+	if store.GetLatestByCircle("circle-b") == nil {
+		t.Error("latest receipt for circle-b must be kept")
+	}
+}
+
+func TestSuppressStore_PatternRuleReplay(t *testing.T) {
+	log := storelog.NewInMemoryLog()
+	store, err := NewSuppressStore(log)
+	if err != nil {
+		t.Fatalf("NewSuppressStore failed: %v", err)
+	}
+
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	rule, err := suppress.NewPatternRule("work", suppress.ScopeVendor, "^dom_(a1|b2)", now, nil, "a|b", suppress.SourceManual)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	if err := store.AddRule(rule); err != nil {
+		t.Fatalf("AddRule failed: %v", err)
+	}
+
+	bad := suppress.SuppressionRule{RuleID: "sr_bad", CircleID: "work", Scope: suppress.ScopeVendor, Pattern: "(", CreatedAt: now}
+	if err := store.AddRule(bad); !errors.Is(err, suppress.ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern, got %v", err)
+	}
+
+	replayed, err := NewSuppressStore(log)
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	rules := replayed.ListRules("work")
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 replayed rule, got %d", len(rules))
+	}
+	if rules[0].Pattern != rule.Pattern || rules[0].Reason != "a|b" {
+		t.Errorf("Pattern or reason not preserved: %q %q", rules[0].Pattern, rules[0].Reason)
+	}
+	if replayed.FindMatch(now, "work", suppress.ScopeVendor, "dom_b2") == nil {
+		t.Error("Expected replayed pattern rule to match")
+	}
+}
@@ -100,11 +100,31 @@ func (s *PolicyStore) Put(ps *policy.PolicySet) error {
 	return nil
 }
 
+// ErrPolicyHashMismatch means the policy set changed since the caller read it.
+var ErrPolicyHashMismatch = errors.New("policy set changed since it was read")
+
 // UpdateCircle updates a single circle's policy.
 func (s *PolicyStore) UpdateCircle(circleID string, mutator func(policy.CirclePolicy) policy.CirclePolicy, now time.Time) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	return s.updateCircleLocked(circleID, mutator, now)
+}
+
+// UpdateCircleIfHash updates a single circle's policy only if the current
+// policy set hash is expectedHash, so concurrent edits never clobber each
+// other. Returns ErrPolicyHashMismatch otherwise.
+func (s *PolicyStore) UpdateCircleIfHash(circleID, expectedHash string, mutator func(policy.CirclePolicy) policy.CirclePolicy, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.policy == nil || s.policy.Hash != expectedHash {
+		return ErrPolicyHashMismatch
+	}
+	return s.updateCircleLocked(circleID, mutator, now)
+}
 
+// updateCircleLocked applies a circle update. Caller must hold s.mu.
+func (s *PolicyStore) updateCircleLocked(circleID string, mutator func(policy.CirclePolicy) policy.CirclePolicy, now time.Time) error {
 	if s.policy == nil {
 		return errors.New("no policy set exists")
 	}
@@ -166,3 +166,11 @@ func (s *ProofHubAckStore) evictOldEntriesLocked() {
 		s.entries = s.entries[1:]
 	}
 }
+
+// Clear removes all acks.
+func (s *ProofHubAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]ProofHubAckEntry, 0)
+	s.dedupIndex = make(map[string]bool)
+}
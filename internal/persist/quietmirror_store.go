@@ -197,3 +197,19 @@ func (s *QuietMirrorDismissalStore) GetDismissalHash(circleID identity.EntityID,
 	hash, exists := s.dismissals[key]
 	return hash, exists
 }
+
+// Clear removes all summaries.
+func (s *QuietMirrorStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.summaries = make(map[string]*quietmirror.QuietMirrorSummary)
+	s.byCircle = make(map[identity.EntityID][]*quietmirror.QuietMirrorSummary)
+	s.byPeriod = make(map[string][]*quietmirror.QuietMirrorSummary)
+}
+
+// Clear removes all dismissals.
+func (s *QuietMirrorDismissalStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dismissals = make(map[string]string)
+}
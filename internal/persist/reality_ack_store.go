@@ -217,3 +217,10 @@ func splitPipe(s string, n int) []string {
 
 	return result
 }
+
+// Clear removes all acks from memory.
+func (s *RealityAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make(map[string]*reality.RealityAck)
+}
@@ -441,3 +441,13 @@ func (s *RulePackStore) ReplayAckRecord(record *storelog.LogRecord) error {
 
 	return nil
 }
+
+// Clear removes all packs and acks.
+func (s *RulePackStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.packs = make(map[string]*rulepack.RulePack)
+	s.packsByPeriod = make(map[string][]string)
+	s.acks = make(map[string]*rulepack.PackAck)
+	s.acksByPack = make(map[string][]string)
+}
@@ -301,3 +301,13 @@ func (s *ShadowCalibrationStore) CalibrationToStorelogRecord(record *shadowdiff.
 		Payload:   string(data),
 	}
 }
+
+// Clear removes all diffs and calibrations.
+func (s *ShadowCalibrationStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.diffs = make(map[string]*shadowdiff.DiffResult)
+	s.calibrations = make(map[string]*shadowdiff.CalibrationRecord)
+	s.diffsByPeriod = make(map[string][]string)
+	s.votesByDiff = make(map[string]shadowdiff.CalibrationVote)
+}
@@ -480,3 +480,14 @@ func (s *ShadowGateStore) ReplayIntentRecord(record *storelog.LogRecord) error {
 
 	return nil
 }
+
+// Clear removes all candidates and promotion intents.
+func (s *ShadowGateStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.candidates = make(map[string]*shadowgate.Candidate)
+	s.candidatesByPeriod = make(map[string][]string)
+	s.intents = make(map[string]*shadowgate.PromotionIntent)
+	s.intentsByPeriod = make(map[string][]string)
+	s.intentsByCandidate = make(map[string][]string)
+}
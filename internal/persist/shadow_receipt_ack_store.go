@@ -303,3 +303,11 @@ func hashVote(vote *domainshadowview.ShadowReceiptVote) string {
 	h.Write([]byte(vote.CanonicalString()))
 	return hex.EncodeToString(h.Sum(nil)[:16])
 }
+
+// Clear removes all acks and votes from memory.
+func (s *ShadowReceiptAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make(map[string]*shadowReceiptAck)
+	s.votes = make(map[string]*domainshadowview.ShadowReceiptVote)
+}
@@ -11,6 +11,10 @@
 package persist
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"sync"
 	"time"
 
@@ -146,6 +150,36 @@ func (s *ShadowReceiptStore) Count() int {
 	return len(s.receipts)
 }
 
+// Prune removes in-memory receipts created before olderThan and returns
+// how many were removed.
+//
+// CRITICAL: The latest receipt per circle is always kept, so proof pages
+// never go empty.
+// CRITICAL: The backing log, if any, is not modified.
+func (s *ShadowReceiptStore) Prune(olderThan time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest := make(map[identity.EntityID]*shadowllm.ShadowReceipt)
+	for _, receipt := range s.receipts {
+		cur := latest[receipt.CircleID]
+		if cur == nil || receipt.CreatedAt.After(cur.CreatedAt) ||
+			(receipt.CreatedAt.Equal(cur.CreatedAt) && receipt.ReceiptID > cur.ReceiptID) {
+			latest[receipt.CircleID] = receipt
+		}
+	}
+
+	removed := 0
+	for id, receipt := range s.receipts {
+		if !receipt.CreatedAt.Before(olderThan) || latest[receipt.CircleID] == receipt {
+			continue
+		}
+		delete(s.receipts, id)
+		removed++
+	}
+	return removed
+}
+
 // Stats returns summary statistics about stored receipts.
 func (s *ShadowReceiptStore) Stats() ShadowReceiptStats {
 	s.mu.RLock()
@@ -194,3 +228,175 @@ func (s *ShadowReceiptStore) VerifyHash(receiptID string, expectedHash string) b
 	}
 	return receipt.Hash() == expectedHash
 }
+
+// shadowReceiptRecord is the JSON structure for exported receipts.
+//
+// CRITICAL: Hashes, buckets and provenance only - never raw content.
+type shadowReceiptRecord struct {
+	ReceiptID       string                   `json:"receipt_id"`
+	ReceiptHash     string                   `json:"receipt_hash"`
+	CircleID        string                   `json:"circle_id"`
+	WindowBucket    string                   `json:"window_bucket"`
+	InputDigestHash string                   `json:"input_digest_hash"`
+	ModelSpec       string                   `json:"model_spec"`
+	CreatedAt       string                   `json:"created_at"`
+	Suggestions     []shadowSuggestionRecord `json:"suggestions"`
+	Provenance      shadowProvenanceRecord   `json:"provenance"`
+	WhyGeneric      string                   `json:"why_generic"`
+}
+
+// shadowSuggestionRecord is the JSON structure for an exported suggestion.
+type shadowSuggestionRecord struct {
+	Category       string `json:"category"`
+	Horizon        string `json:"horizon"`
+	Magnitude      string `json:"magnitude"`
+	Confidence     string `json:"confidence"`
+	SuggestionType string `json:"suggestion_type"`
+	ItemKeyHash    string `json:"item_key_hash"`
+}
+
+// shadowProvenanceRecord is the JSON structure for exported provenance.
+type shadowProvenanceRecord struct {
+	ProviderKind          string `json:"provider_kind"`
+	ModelOrDeployment     string `json:"model_or_deployment"`
+	RequestPolicyHash     string `json:"request_policy_hash"`
+	PromptTemplateVersion string `json:"prompt_template_version"`
+	LatencyBucket         string `json:"latency_bucket"`
+	Status                string `json:"status"`
+	ErrorBucket           string `json:"error_bucket"`
+}
+
+// ErrReceiptHashMismatch is returned when an imported receipt does not
+// hash to its recorded receipt hash.
+var ErrReceiptHashMismatch = errors.New("imported receipt hash mismatch")
+
+// ExportJSONL returns every receipt for a circle as JSON Lines, oldest first.
+//
+// CRITICAL: Each line carries hashes, buckets, provenance and timestamps only.
+func (s *ShadowReceiptStore) ExportJSONL(circleID identity.EntityID) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, receipt := range s.ListForCircle(circleID) {
+		data, err := json.Marshal(toShadowReceiptRecord(receipt))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportJSONL appends receipts from JSON Lines produced by ExportJSONL and
+// returns how many lines were read.
+//
+// CRITICAL: Each receipt must validate and hash to its recorded receipt hash.
+func (s *ShadowReceiptStore) ImportJSONL(data []byte) (int, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	count := 0
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var rec shadowReceiptRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return count, err
+		}
+		receipt, err := fromShadowReceiptRecord(&rec)
+		if err != nil {
+			return count, err
+		}
+		if receipt.Hash() != rec.ReceiptHash {
+			return count, ErrReceiptHashMismatch
+		}
+		if err := s.Append(receipt); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, scanner.Err()
+}
+
+// toShadowReceiptRecord converts a receipt to its export record.
+func toShadowReceiptRecord(r *shadowllm.ShadowReceipt) shadowReceiptRecord {
+	suggestions := make([]shadowSuggestionRecord, 0, len(r.Suggestions))
+	for _, sug := range r.Suggestions {
+		suggestions = append(suggestions, shadowSuggestionRecord{
+			Category:       string(sug.Category),
+			Horizon:        string(sug.Horizon),
+			Magnitude:      string(sug.Magnitude),
+			Confidence:     string(sug.Confidence),
+			SuggestionType: string(sug.SuggestionType),
+			ItemKeyHash:    sug.ItemKeyHash,
+		})
+	}
+
+	return shadowReceiptRecord{
+		ReceiptID:       r.ReceiptID,
+		ReceiptHash:     r.Hash(),
+		CircleID:        string(r.CircleID),
+		WindowBucket:    r.WindowBucket,
+		InputDigestHash: r.InputDigestHash,
+		ModelSpec:       r.ModelSpec,
+		CreatedAt:       r.CreatedAt.UTC().Format(time.RFC3339Nano),
+		Suggestions:     suggestions,
+		Provenance: shadowProvenanceRecord{
+			ProviderKind:          string(r.Provenance.ProviderKind),
+			ModelOrDeployment:     r.Provenance.ModelOrDeployment,
+			RequestPolicyHash:     r.Provenance.RequestPolicyHash,
+			PromptTemplateVersion: r.Provenance.PromptTemplateVersion,
+			LatencyBucket:         string(r.Provenance.LatencyBucket),
+			Status:                string(r.Provenance.Status),
+			ErrorBucket:           r.Provenance.ErrorBucket,
+		},
+		WhyGeneric: r.WhyGeneric,
+	}
+}
+
+// fromShadowReceiptRecord rebuilds a receipt from its export record.
+func fromShadowReceiptRecord(rec *shadowReceiptRecord) (*shadowllm.ShadowReceipt, error) {
+	createdAt, err := time.Parse(time.RFC3339Nano, rec.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var suggestions []shadowllm.ShadowSuggestion
+	for _, sug := range rec.Suggestions {
+		suggestions = append(suggestions, shadowllm.ShadowSuggestion{
+			Category:       shadowllm.AbstractCategory(sug.Category),
+			Horizon:        shadowllm.Horizon(sug.Horizon),
+			Magnitude:      shadowllm.MagnitudeBucket(sug.Magnitude),
+			Confidence:     shadowllm.ConfidenceBucket(sug.Confidence),
+			SuggestionType: shadowllm.SuggestionType(sug.SuggestionType),
+			ItemKeyHash:    sug.ItemKeyHash,
+		})
+	}
+
+	return &shadowllm.ShadowReceipt{
+		ReceiptID:       rec.ReceiptID,
+		CircleID:        identity.EntityID(rec.CircleID),
+		WindowBucket:    rec.WindowBucket,
+		InputDigestHash: rec.InputDigestHash,
+		ModelSpec:       rec.ModelSpec,
+		CreatedAt:       createdAt,
+		Suggestions:     suggestions,
+		Provenance: shadowllm.Provenance{
+			ProviderKind:          shadowllm.ProviderKind(rec.Provenance.ProviderKind),
+			ModelOrDeployment:     rec.Provenance.ModelOrDeployment,
+			RequestPolicyHash:     rec.Provenance.RequestPolicyHash,
+			PromptTemplateVersion: rec.Provenance.PromptTemplateVersion,
+			LatencyBucket:         shadowllm.LatencyBucket(rec.Provenance.LatencyBucket),
+			Status:                shadowllm.ReceiptStatus(rec.Provenance.Status),
+			ErrorBucket:           rec.Provenance.ErrorBucket,
+		},
+		WhyGeneric: rec.WhyGeneric,
+	}, nil
+}
+
+// Clear removes all receipts from memory.
+func (s *ShadowReceiptStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts = make(map[string]*shadowllm.ShadowReceipt)
+}
@@ -544,3 +544,27 @@ func (s *SignedClaimProofAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all claim records.
+func (s *SignedClaimStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.SignedClaimRecord, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all manifest records.
+func (s *SignedManifestStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.SignedManifestRecord, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *SignedClaimProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.SignedClaimProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
@@ -57,7 +57,7 @@ func (s *SuppressStore) replay() error {
 	// Add non-removed rules
 	for _, record := range addRecords {
 		rule, err := parseSuppressionAddPayload(record.Payload)
-		if err != nil {
+		if err != nil || rule.Compile() != nil {
 			continue // Skip corrupted records
 		}
 		if !removed[rule.RuleID] {
@@ -92,10 +92,15 @@ func (s *SuppressStore) ListActive(at time.Time) []suppress.SuppressionRule {
 }
 
 // AddRule adds a new suppression rule.
+// Pattern rules are validated before anything is logged.
 func (s *SuppressStore) AddRule(rule suppress.SuppressionRule) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if err := rule.Compile(); err != nil {
+		return err
+	}
+
 	// Create log record
 	payload := formatSuppressionAddPayload(rule)
 	logRecord := storelog.NewRecord(
@@ -110,8 +115,7 @@ func (s *SuppressStore) AddRule(rule suppress.SuppressionRule) error {
 		return err
 	}
 
-	s.set.AddRule(rule)
-	return nil
+	return s.set.AddRule(rule)
 }
 
 // RemoveRule removes a suppression rule by ID.
@@ -225,6 +229,10 @@ func formatSuppressionAddPayload(r suppress.SuppressionRule) string {
 	b.WriteString(escapePayload(r.Reason))
 	b.WriteString("|source:")
 	b.WriteString(string(r.Source))
+	if r.IsPattern() {
+		b.WriteString("|pattern:")
+		b.WriteString(escapePayload(r.Pattern))
+	}
 	return b.String()
 }
 
@@ -232,7 +240,8 @@ func formatSuppressionAddPayload(r suppress.SuppressionRule) string {
 func parseSuppressionAddPayload(payload string) (suppress.SuppressionRule, error) {
 	var r suppress.SuppressionRule
 
-	parts := strings.Split(payload, "|")
+	// Patterns commonly contain escaped pipes (alternation)
+	parts := splitEscapedPipes(payload)
 	for _, part := range parts {
 		if strings.HasPrefix(part, "rule_id:") {
 			r.RuleID = part[8:]
@@ -255,12 +264,35 @@ func parseSuppressionAddPayload(payload string) (suppress.SuppressionRule, error
 			r.Reason = unescapePayload(part[7:])
 		} else if strings.HasPrefix(part, "source:") {
 			r.Source = suppress.Source(part[7:])
+		} else if strings.HasPrefix(part, "pattern:") {
+			r.Pattern = unescapePayload(part[8:])
 		}
 	}
 
 	return r, nil
 }
 
+// splitEscapedPipes splits on "|" not preceded by an escape.
+// Escape sequences are kept so unescapePayload can reverse them.
+func splitEscapedPipes(payload string) []string {
+	var parts []string
+	var cur strings.Builder
+	for i := 0; i < len(payload); i++ {
+		switch c := payload[i]; {
+		case c == '\\' && i+1 < len(payload):
+			cur.WriteByte(c)
+			cur.WriteByte(payload[i+1])
+			i++
+		case c == '|':
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	return append(parts, cur.String())
+}
+
 // formatSuppressionRemPayload creates a canonical payload for a rule removal.
 func formatSuppressionRemPayload(ruleID string, removedAt time.Time) string {
 	var b strings.Builder
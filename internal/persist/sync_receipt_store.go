@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/hashutil"
 )
 
 // MagnitudeBucket represents an abstract count bucket.
@@ -103,6 +104,11 @@ type SyncReceipt struct {
 	// Contains generic reason, never raw error messages with PII.
 	FailReason string
 
+	// FilterDescriptor abstractly describes the source filter applied
+	// (e.g., "default", "exclude_only"). Never raw label or folder names.
+	// Empty when the provider applies no filter.
+	FilterDescriptor string
+
 	// Hash is the deterministic hash of this receipt.
 	Hash string
 }
@@ -140,6 +146,13 @@ func NewSyncReceipt(
 	return r
 }
 
+// WithFilter records the abstract filter descriptor and recomputes the hash.
+func (r *SyncReceipt) WithFilter(descriptor string) *SyncReceipt {
+	r.FilterDescriptor = descriptor
+	r.Hash = r.computeHash()
+	return r
+}
+
 // computeReceiptID generates a deterministic receipt ID.
 func computeReceiptID(circleID identity.EntityID, provider string, magnitude MagnitudeBucket, timeBucket time.Time) string {
 	canonical := fmt.Sprintf("SYNC_RECEIPT_ID|v1|%s|%s|%s|%d",
@@ -157,8 +170,11 @@ func (r *SyncReceipt) computeHash() string {
 	canonical := fmt.Sprintf("SYNC_RECEIPT|v1|%s|%s|%s|%s|%d|%s|%s",
 		r.ReceiptID, r.CircleID, r.Provider, r.MagnitudeBucket,
 		r.TimeBucket.Unix(), successStr, r.FailReason)
-	h := sha256.Sum256([]byte(canonical))
-	return fmt.Sprintf("%x", h)
+	// Appended only when set so unfiltered receipt hashes are unchanged
+	if r.FilterDescriptor != "" {
+		canonical += "|filter:" + r.FilterDescriptor
+	}
+	return hashutil.HashString("persist.SyncReceipt", canonical)
 }
 
 // Validate checks the receipt is valid.
@@ -248,6 +264,38 @@ func (s *SyncReceiptStore) GetLatestByCircle(circleID identity.EntityID) *SyncRe
 	return latest
 }
 
+// Prune removes receipts with a time bucket before olderThan and returns
+// how many were removed.
+//
+// CRITICAL: The latest receipt per circle is always kept.
+func (s *SyncReceiptStore) Prune(olderThan time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for circleID, receipts := range s.byCircle {
+		// Same choice as GetLatestByCircle: first receipt with the latest bucket
+		var latest *SyncReceipt
+		for _, r := range receipts {
+			if latest == nil || r.TimeBucket.After(latest.TimeBucket) {
+				latest = r
+			}
+		}
+
+		kept := make([]*SyncReceipt, 0, len(receipts))
+		for _, r := range receipts {
+			if r == latest || !r.TimeBucket.Before(olderThan) {
+				kept = append(kept, r)
+				continue
+			}
+			delete(s.receipts, r.ReceiptID)
+			removed++
+		}
+		s.byCircle[circleID] = kept
+	}
+	return removed
+}
+
 // Count returns the total number of receipts.
 func (s *SyncReceiptStore) Count() int {
 	s.mu.RLock()
@@ -317,3 +365,11 @@ func (s *QuietCheckStatus) computeHash() string {
 func (s *QuietCheckStatus) IsQuiet() bool {
 	return s.ObligationsHeld && !s.AutoSurface
 }
+
+// Clear removes all receipts.
+func (s *SyncReceiptStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts = make(map[string]*SyncReceipt)
+	s.byCircle = make(map[identity.EntityID][]*SyncReceipt)
+}
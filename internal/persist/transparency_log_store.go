@@ -156,3 +156,11 @@ func (s *TransparencyLogStore) AppendBatch(entries []domain.TransparencyLogEntry
 	}
 	return newCount, nil
 }
+
+// Clear removes all log entries.
+func (s *TransparencyLogStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]domain.TransparencyLogEntry, 0)
+	s.dedupIndex = make(map[string]bool)
+}
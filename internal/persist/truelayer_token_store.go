@@ -216,3 +216,10 @@ func computeTokenHash(circleID, token string) string {
 	h := sha256.Sum256([]byte(input))
 	return hex.EncodeToString(h[:16]) // First 16 bytes for brevity
 }
+
+// Clear removes all tokens.
+func (s *TrueLayerTokenStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]*TrueLayerTokenEntry)
+}
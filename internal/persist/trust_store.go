@@ -395,3 +395,12 @@ func (s *TrustStore) ReplayDismissalRecord(record *storelog.LogRecord) error {
 
 	return nil
 }
+
+// Clear removes all summaries and dismissals.
+func (s *TrustStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.summaries = make(map[string]*trust.TrustSummary)
+	s.summariesByPeriod = make(map[string]string)
+	s.dismissals = make(map[string]*trust.TrustDismissal)
+}
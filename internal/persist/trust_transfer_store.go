@@ -350,3 +350,23 @@ func (s *TrustTransferRevocationStore) EvictOldRecords(now time.Time) {
 	defer s.mu.Unlock()
 	s.evictIfNeededLocked(now)
 }
+
+// Clear removes all contracts.
+func (s *TrustTransferContractStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.contracts = make(map[string]*ttStoredContract)
+	s.byFromCircle = make(map[string][]string)
+	s.contractList = make([]*ttStoredContract, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all revocations.
+func (s *TrustTransferRevocationStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revocations = make(map[string]*ttStoredRevocation)
+	s.byContract = make(map[string]string)
+	s.revocationList = make([]*ttStoredRevocation, 0)
+	s.dedupIndex = make(map[string]bool)
+}
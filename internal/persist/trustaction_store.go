@@ -17,11 +17,14 @@ import (
 	"encoding/json"
 	"errors"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"quantumlife/pkg/domain/storelog"
 	"quantumlife/pkg/domain/trustaction"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // Record types are defined in pkg/domain/storelog/log.go:
@@ -446,24 +449,29 @@ func (s *TrustActionStore) replayStateUpdateRecord(record *storelog.LogRecord) e
 // parseUndoBucketFromCanonical parses an undo bucket from its canonical string.
 // Format: v1|undo_bucket|BucketStartRFC3339|BucketDurationMinutes
 func parseUndoBucketFromCanonical(canonical string) trustaction.UndoBucket {
-	// Simple parsing - in production would be more robust
 	var bucket trustaction.UndoBucket
-	bucket.BucketDurationMinutes = 15 // Default
-
-	// Find the timestamp between second and third pipe
-	pipeCount := 0
-	start := 0
-	for i, c := range canonical {
-		if c == '|' {
-			pipeCount++
-			if pipeCount == 2 {
-				start = i + 1
-			} else if pipeCount == 3 {
-				bucket.BucketStartRFC3339 = canonical[start:i]
-				break
-			}
+	bucket.BucketDurationMinutes = undowindow.DefaultMinutes
+
+	parts := strings.Split(canonical, "|")
+	if len(parts) > 2 {
+		bucket.BucketStartRFC3339 = parts[2]
+	}
+	if len(parts) > 3 {
+		if minutes, err := strconv.Atoi(parts[3]); err == nil && minutes > 0 {
+			bucket.BucketDurationMinutes = minutes
 		}
 	}
 
 	return bucket
 }
+
+// Clear removes all receipts from memory.
+func (s *TrustActionStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts = make(map[string]*trustaction.TrustActionReceipt)
+	s.receiptsByCircle = make(map[string][]string)
+	s.receiptsByPeriod = make(map[string]string)
+	s.draftToReceipt = make(map[string]string)
+	s.receiptToDraft = make(map[string]string)
+}
@@ -20,6 +20,7 @@ import (
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/storelog"
 	"quantumlife/pkg/domain/undoableexec"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // undoRecordPayload is the JSON structure for persisting undo records.
@@ -33,6 +34,7 @@ type undoRecordPayload struct {
 	BeforeStatus    string `json:"before_status"`
 	AfterStatus     string `json:"after_status"`
 	UndoUntilBucket string `json:"undo_until_bucket"`
+	UndoWindowMins  int    `json:"undo_window_minutes,omitempty"`
 	State           string `json:"state"`
 	ExecutedBucket  string `json:"executed_at_bucket"`
 }
@@ -225,6 +227,7 @@ func (s *UndoableExecStore) RecordToStorelogRecord(record *undoableexec.UndoReco
 		BeforeStatus:    string(record.BeforeStatus),
 		AfterStatus:     string(record.AfterStatus),
 		UndoUntilBucket: record.UndoAvailableUntilBucket.BucketStartRFC3339,
+		UndoWindowMins:  record.UndoAvailableUntilBucket.BucketDurationMinutes,
 		State:           string(record.State),
 		ExecutedBucket:  record.ExecutedAtBucket.BucketStartRFC3339,
 	}
@@ -269,6 +272,12 @@ func (s *UndoableExecStore) ReplayRecordFromStorelog(logRecord *storelog.LogReco
 		return err
 	}
 
+	// Records written before the undo window was configurable carry none.
+	undoWindowMins := payload.UndoWindowMins
+	if undoWindowMins <= 0 {
+		undoWindowMins = undowindow.DefaultMinutes
+	}
+
 	record := &undoableexec.UndoRecord{
 		ID:           payload.ID,
 		PeriodKey:    payload.PeriodKey,
@@ -280,7 +289,7 @@ func (s *UndoableExecStore) ReplayRecordFromStorelog(logRecord *storelog.LogReco
 		AfterStatus:  undoableexec.ResponseStatus(payload.AfterStatus),
 		UndoAvailableUntilBucket: undoableexec.UndoWindow{
 			BucketStartRFC3339:    payload.UndoUntilBucket,
-			BucketDurationMinutes: 15,
+			BucketDurationMinutes: undoWindowMins,
 		},
 		State: undoableexec.UndoState(payload.State),
 		ExecutedAtBucket: undoableexec.UndoWindow{
@@ -331,3 +340,13 @@ func (s *UndoableExecStore) ExpireOldRecords() {
 		}
 	}
 }
+
+// Clear removes all undo records and acks.
+func (s *UndoableExecStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]*undoableexec.UndoRecord)
+	s.acks = make([]*undoableexec.UndoAck, 0)
+	s.byCircle = make(map[identity.EntityID][]*undoableexec.UndoRecord)
+	s.byPeriod = make(map[string][]*undoableexec.UndoRecord)
+}
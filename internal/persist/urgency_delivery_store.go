@@ -185,3 +185,11 @@ func (s *UrgencyDeliveryStore) ListAll() []domain.UrgencyDeliveryReceipt {
 	}
 	return results
 }
+
+// Clear removes all delivery entries.
+func (s *UrgencyDeliveryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]UrgencyDeliveryEntry, 0)
+	s.dedupIndex = make(map[string]bool)
+}
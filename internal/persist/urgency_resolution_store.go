@@ -264,3 +264,19 @@ func (s *UrgencyAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.entries)
 }
+
+// Clear removes all resolutions.
+func (s *UrgencyResolutionStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]UrgencyResolutionEntry, 0)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *UrgencyAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make([]UrgencyAckEntry, 0)
+	s.dedupIndex = make(map[string]bool)
+}
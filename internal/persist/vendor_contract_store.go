@@ -347,3 +347,20 @@ func (s *VendorProofAckStore) Count() int {
 	defer s.mu.RUnlock()
 	return len(s.acks)
 }
+
+// Clear removes all contract records.
+func (s *VendorContractStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]domain.VendorContractRecord, 0)
+	s.byVendorPeriod = make(map[string]int)
+	s.dedupIndex = make(map[string]bool)
+}
+
+// Clear removes all acks.
+func (s *VendorProofAckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acks = make([]domain.VendorProofAck, 0)
+	s.dedupIndex = make(map[string]bool)
+}
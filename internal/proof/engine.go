@@ -20,16 +20,19 @@ func (e *Engine) BuildProof(in ProofInput) ProofSummary {
 			Statement:  "",
 			WhyLine:    "",
 			Hash:       computeEmptyHash(),
+			PeriodKey:  in.PeriodKey,
 		}
 	}
 
 	// Compute total suppressed and collect active categories
 	total := 0
 	var activeCategories []Category
+	categoryMagnitudes := make(map[Category]Magnitude)
 	for cat, count := range in.SuppressedByCategory {
 		if count > 0 {
 			total += count
 			activeCategories = append(activeCategories, cat)
+			categoryMagnitudes[cat] = bucketMagnitude(count)
 		}
 	}
 
@@ -45,11 +48,19 @@ func (e *Engine) BuildProof(in ProofInput) ProofSummary {
 	// Select why line based on magnitude
 	whyLine := selectWhyLine(magnitude)
 
+	// Bucket interruptions prevented by suppression this period
+	prevented := bucketMagnitude(in.SuppressedInPeriod)
+
 	proof := ProofSummary{
-		Magnitude:  magnitude,
-		Categories: activeCategories,
-		Statement:  statement,
-		WhyLine:    whyLine,
+		Magnitude:     magnitude,
+		Categories:    activeCategories,
+		Statement:     statement,
+		WhyLine:       whyLine,
+		Prevented:     prevented,
+		PreventedLine: selectPreventedLine(prevented),
+
+		PeriodKey:          in.PeriodKey,
+		CategoryMagnitudes: categoryMagnitudes,
 	}
 	proof.Hash = proof.ComputeHash()
 
@@ -83,6 +94,18 @@ func selectStatement(mag Magnitude) string {
 	}
 }
 
+// selectPreventedLine returns the calm line for prevented interruptions.
+func selectPreventedLine(mag Magnitude) string {
+	switch mag {
+	case MagnitudeAFew:
+		return "A few interruptions prevented."
+	case MagnitudeSeveral:
+		return "Several interruptions prevented."
+	default:
+		return ""
+	}
+}
+
 // selectWhyLine returns the reassurance line for magnitude.
 func selectWhyLine(mag Magnitude) string {
 	switch mag {
@@ -0,0 +1,52 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/csv"
+	"sort"
+)
+
+// LedgerCSVHeader is the fixed column order of the ledger export.
+var LedgerCSVHeader = []string{"period", "category", "magnitude_bucket", "proof_hash"}
+
+// ExportLedgerCSV renders summaries as a CSV restraint ledger.
+// One row per active category; a summary with no categories yields a
+// single row with an empty category so the period is still recorded.
+//
+// CRITICAL: Buckets and hashes only - never raw counts.
+// Deterministic: rows are ordered by period, then category, then hash.
+func (e *Engine) ExportLedgerCSV(summaries []ProofSummary) ([]byte, error) {
+	var rows [][]string
+	for _, s := range summaries {
+		if len(s.Categories) == 0 {
+			rows = append(rows, []string{s.PeriodKey, "", string(s.Magnitude), s.Hash})
+			continue
+		}
+		for _, cat := range s.Categories {
+			mag, ok := s.CategoryMagnitudes[cat]
+			if !ok {
+				mag = s.Magnitude
+			}
+			rows = append(rows, []string{s.PeriodKey, string(cat), string(mag), s.Hash})
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		for col := range rows[i] {
+			if rows[i][col] != rows[j][col] {
+				return rows[i][col] < rows[j][col]
+			}
+		}
+		return false
+	})
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(LedgerCSVHeader); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
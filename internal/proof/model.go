@@ -14,6 +14,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // Category represents abstract life domains.
@@ -45,6 +47,17 @@ type ProofSummary struct {
 	Statement  string     // calm, abstract copy
 	WhyLine    string     // optional short reassurance
 	Hash       string     // SHA256 of canonical string
+
+	// Prevented buckets interruptions suppressed this period.
+	Prevented     Magnitude
+	PreventedLine string // calm, abstract copy; empty when nothing
+
+	// PeriodKey labels the ledger period for exports (e.g., "2024-W03").
+	// Not part of the hash.
+	PeriodKey string
+
+	// CategoryMagnitudes buckets each active category. Not part of the hash.
+	CategoryMagnitudes map[Category]Magnitude
 }
 
 // ProofInput provides the data needed to compute proof.
@@ -60,26 +73,38 @@ type ProofInput struct {
 	// Period is the time window (e.g., "week").
 	// No dates are ever shown - just the abstract period.
 	Period string
+
+	// SuppressedInPeriod is the count of interruptions the suppression
+	// rules kept away this period. Bucketed; never shown.
+	SuppressedInPeriod int
+
+	// PeriodKey is the abstract period bucket (e.g., "2024-W03").
+	// Only used to label ledger exports.
+	PeriodKey string
 }
 
 // CanonicalString returns the deterministic string representation
 // used for hashing. Format: PROOF|v1|<magnitude>|<cat1,cat2,...>|<statement>
+// followed by |prevented:<magnitude> when interruptions were prevented.
 func (p ProofSummary) CanonicalString() string {
 	cats := make([]string, len(p.Categories))
 	for i, c := range p.Categories {
 		cats[i] = string(c)
 	}
-	return fmt.Sprintf("PROOF|v1|%s|%s|%s",
+	s := fmt.Sprintf("PROOF|v1|%s|%s|%s",
 		p.Magnitude,
 		strings.Join(cats, ","),
 		p.Statement,
 	)
+	if p.Prevented != "" && p.Prevented != MagnitudeNothing {
+		s += "|prevented:" + string(p.Prevented)
+	}
+	return s
 }
 
 // ComputeHash calculates SHA256 hash of the canonical string.
 func (p ProofSummary) ComputeHash() string {
-	h := sha256.Sum256([]byte(p.CanonicalString()))
-	return fmt.Sprintf("%x", h)
+	return hashutil.HashString("proof.ProofSummary", p.CanonicalString())
 }
 
 // categoryOrder defines deterministic sort order for categories.
@@ -0,0 +1,133 @@
+package proof
+
+import (
+	"fmt"
+	"strings"
+
+	"quantumlife/internal/obligations"
+	"quantumlife/pkg/domain/heldproof"
+	"quantumlife/pkg/domain/interrupt"
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/suppress"
+)
+
+// AllCategories returns all proof categories in canonical order.
+func AllCategories() []Category {
+	return []Category{CategoryHome, CategoryMoney, CategoryPeople, CategoryTime, CategoryWork}
+}
+
+// ParseCategories parses category names into a category set.
+// Duplicates are ignored. Unknown categories are rejected.
+func ParseCategories(values []string) ([]Category, error) {
+	var cats []Category
+	for _, v := range values {
+		cat := Category(strings.TrimSpace(v))
+		if _, ok := categoryOrder[cat]; !ok {
+			return nil, fmt.Errorf("unknown proof category: %q", cat)
+		}
+		if !containsCategory(cats, cat) {
+			cats = append(cats, cat)
+		}
+	}
+	return SortCategories(cats), nil
+}
+
+// CategoryForTrigger maps an interruption trigger to a proof category.
+func CategoryForTrigger(t interrupt.Trigger) (Category, bool) {
+	switch t {
+	case interrupt.TriggerFinanceLowBalance, interrupt.TriggerFinanceLargeTxn, interrupt.TriggerFinancePending,
+		interrupt.TriggerCommerceInvoiceDue, interrupt.TriggerCommerceRefundPending, interrupt.TriggerCommerceSubscriptionRenewed:
+		return CategoryMoney, true
+	case interrupt.TriggerCommerceShipmentPending:
+		return CategoryHome, true
+	case interrupt.TriggerCalendarInvitePending, interrupt.TriggerCalendarConflict, interrupt.TriggerCalendarUpcoming,
+		interrupt.TriggerObligationDueSoon:
+		return CategoryTime, true
+	case interrupt.TriggerEmailActionNeeded:
+		return CategoryWork, true
+	default:
+		return "", false
+	}
+}
+
+// CategoryForSuppression maps a suppression rule to a proof category.
+// Circle-wide and item-key rules carry no category and are skipped.
+func CategoryForSuppression(rule suppress.SuppressionRule) (Category, bool) {
+	switch rule.Scope {
+	case suppress.ScopeTrigger:
+		return CategoryForTrigger(interrupt.Trigger(rule.Key))
+	case suppress.ScopeVendor:
+		return CategoryMoney, true
+	case suppress.ScopePerson:
+		return CategoryPeople, true
+	default:
+		return "", false
+	}
+}
+
+// CategoryForHeldSignal maps a held proof signal to a proof category.
+func CategoryForHeldSignal(sig heldproof.HeldProofSignal) (Category, bool) {
+	switch sig.CircleType {
+	case heldproof.CircleTypeHuman:
+		return CategoryPeople, true
+	case heldproof.CircleTypeInstitution:
+		return CategoryMoney, true
+	default:
+		return "", false
+	}
+}
+
+// CategoryForObligation maps a held obligation to a proof category.
+func CategoryForObligation(obl *obligation.Obligation) (Category, bool) {
+	cat := Category(obligations.CategoryOf(obl))
+	_, ok := categoryOrder[cat]
+	return cat, ok
+}
+
+// SuppressionSources are the stored restraint records for one circle.
+type SuppressionSources struct {
+	// Rules are the active suppression rules for the circle.
+	Rules []suppress.SuppressionRule
+
+	// HeldSignals are the held proof signals for the period.
+	HeldSignals []heldproof.HeldProofSignal
+
+	// HeldObligations are the obligations the latest loop run held
+	// instead of interrupting.
+	HeldObligations []*obligation.Obligation
+}
+
+// SuppressedByCategory counts stored suppressions per category.
+// Only the given categories are kept; empty means all categories.
+// Counts are for bucketing only - never exposed.
+func SuppressedByCategory(src SuppressionSources, categories []Category) map[Category]int {
+	if len(categories) == 0 {
+		categories = AllCategories()
+	}
+
+	counts := make(map[Category]int)
+	add := func(cat Category, ok bool) {
+		if ok && containsCategory(categories, cat) {
+			counts[cat]++
+		}
+	}
+	for _, rule := range src.Rules {
+		add(CategoryForSuppression(rule))
+	}
+	for _, sig := range src.HeldSignals {
+		add(CategoryForHeldSignal(sig))
+	}
+	for _, obl := range src.HeldObligations {
+		add(CategoryForObligation(obl))
+	}
+	return counts
+}
+
+func containsCategory(cats []Category, cat Category) bool {
+	for _, c := range cats {
+		if c == cat {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,14 @@ package proof
 import (
 	"crypto/sha256"
 	"fmt"
+	"sync"
 	"time"
 )
 
 // AckStore is an append-only bounded store for acknowledgement records.
 // Only hashes are stored, never raw content.
 type AckStore struct {
+	mu         sync.RWMutex
 	records    []string        // Only record hashes
 	proofIndex map[string]bool // Index of acknowledged proof hashes
 	maxRecords int
@@ -30,6 +32,9 @@ func NewAckStore(maxRecords int) *AckStore {
 // Only the hash of the record is stored, never raw content.
 // The now parameter is injected for determinism (no time.Now()).
 func (s *AckStore) Record(action AckAction, proofHash string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	// Hash the timestamp (never store raw)
 	tsHash := hashTimestamp(now)
 
@@ -58,10 +63,13 @@ func (s *AckStore) Record(action AckAction, proofHash string, now time.Time) err
 // HasRecent checks if a proof hash has been acknowledged.
 // Returns true if the proof hash exists in the store.
 func (s *AckStore) HasRecent(proofHash string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.proofIndex[proofHash]
 }
 
 // evictOldest removes the oldest record to maintain bounds.
+// Must be called with lock held.
 // Note: We cannot remove from proofIndex without tracking which
 // proof hashes are associated with which records. For simplicity,
 // we keep proofIndex entries (they accumulate up to maxRecords unique hashes).
@@ -73,9 +81,19 @@ func (s *AckStore) evictOldest() {
 
 // Len returns the current number of stored record hashes.
 func (s *AckStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return len(s.records)
 }
 
+// Clear removes all acknowledgement records.
+func (s *AckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]string, 0)
+	s.proofIndex = make(map[string]bool)
+}
+
 // hashTimestamp creates a SHA256 hash of the timestamp.
 // We never store raw timestamps.
 func hashTimestamp(t time.Time) string {
@@ -13,6 +13,7 @@
 package reality
 
 import (
+	"strings"
 	"time"
 
 	"quantumlife/pkg/domain/reality"
@@ -48,6 +49,9 @@ func (e *Engine) BuildPage(inputs *reality.RealityInputs) *reality.RealityPage {
 	// Select calm line based on state
 	page.CalmLine = e.selectCalmLine(inputs)
 
+	// Summarize source coverage from real connection state
+	page.CoverageLine = CoverageLine(inputs.ConnectedKinds, inputs.CommerceObserved)
+
 	// Compute status hash
 	page.StatusHash = page.ComputeStatusHash()
 
@@ -215,6 +219,27 @@ func (e *Engine) ShouldShowRealityCue(
 	return cue.Available
 }
 
+// CoverageLine summarizes covered source kinds in one abstract line.
+// Example: "email and finance connected; commerce observed".
+// Returns "No sources connected yet." when nothing is covered.
+func CoverageLine(connectedKinds []string, commerceObserved bool) string {
+	var parts []string
+	switch n := len(connectedKinds); n {
+	case 0:
+	case 1:
+		parts = append(parts, connectedKinds[0]+" connected")
+	default:
+		parts = append(parts, strings.Join(connectedKinds[:n-1], ", ")+" and "+connectedKinds[n-1]+" connected")
+	}
+	if commerceObserved {
+		parts = append(parts, "commerce observed")
+	}
+	if len(parts) == 0 {
+		return "No sources connected yet."
+	}
+	return strings.Join(parts, "; ")
+}
+
 // boolToYesNo converts a bool to "yes" or "no" string.
 func boolToYesNo(b bool) string {
 	if b {
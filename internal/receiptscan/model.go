@@ -21,6 +21,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // ReceiptCategory represents an abstract commerce category.
@@ -136,8 +138,7 @@ func (s *ReceiptSignal) CanonicalString() string {
 
 // ComputeHash computes a deterministic hash of the signal.
 func (s *ReceiptSignal) ComputeHash() string {
-	h := sha256.Sum256([]byte(s.CanonicalString()))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("receiptscan.ReceiptSignal", s.CanonicalString())[:32]
 }
 
 // Validate checks if the signal is valid.
@@ -225,8 +226,7 @@ func (r *ReceiptScanResult) CanonicalString() string {
 
 // ComputeHash computes a deterministic hash of the result.
 func (r *ReceiptScanResult) ComputeHash() string {
-	h := sha256.Sum256([]byte(r.CanonicalString()))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("receiptscan.ReceiptScanResult", r.CanonicalString())[:32]
 }
 
 // Validate checks if the result is valid.
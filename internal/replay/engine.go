@@ -9,6 +9,7 @@
 // - No raw identifiers in bundles
 // - Deterministic: same storelog + same clock bucket => same bundle hash
 // - Bounded retention: default 30 days
+// - Imported bundles must carry a valid signature from a trusted device key
 //
 // Reference: docs/ADR/ADR-0061-phase30A-identity-and-replay.md
 package replay
@@ -26,8 +27,10 @@ import (
 
 // Engine manages replay bundle operations.
 type Engine struct {
-	clock func() time.Time
-	log   storelog.AppendOnlyLog
+	clock   func() time.Time
+	log     storelog.AppendOnlyLog
+	signer  BundleSigner
+	trusted map[deviceidentity.DevicePublicKey]bool
 }
 
 // NewEngine creates a new replay engine.
@@ -41,6 +44,61 @@ func NewEngine(
 	}
 }
 
+// BundleSigner signs exported bundles with the device key.
+// CRITICAL: Implementations must never expose the private key.
+type BundleSigner interface {
+	GetPublicKey() (deviceidentity.DevicePublicKey, error)
+	Sign(message []byte) (deviceidentity.Signature, error)
+}
+
+// WithBundleSigner configures the device signer used by BuildBundle.
+// The signer's own key is always trusted on import.
+func (e *Engine) WithBundleSigner(signer BundleSigner) *Engine {
+	e.signer = signer
+	return e
+}
+
+// WithTrustedKeys adds public keys whose signed bundles may be imported.
+func (e *Engine) WithTrustedKeys(keys ...deviceidentity.DevicePublicKey) *Engine {
+	if e.trusted == nil {
+		e.trusted = make(map[deviceidentity.DevicePublicKey]bool)
+	}
+	for _, key := range keys {
+		e.trusted[key] = true
+	}
+	return e
+}
+
+// isTrusted reports whether bundles signed by key may be imported.
+func (e *Engine) isTrusted(key deviceidentity.DevicePublicKey) bool {
+	if e.trusted[key] {
+		return true
+	}
+	if e.signer == nil {
+		return false
+	}
+	own, err := e.signer.GetPublicKey()
+	return err == nil && own == key
+}
+
+// Verify checks a bundle's embedded signature against its content hash
+// and requires the signing key to be trusted.
+// Returns one of the replay.Err* bundle signature errors when unverified.
+func (e *Engine) Verify(bundle *replay.ReplayBundle) (replay.VerifyResult, error) {
+	var result replay.VerifyResult
+	if bundle.Signature != nil {
+		result.Fingerprint = bundle.Signature.PublicKey.Fingerprint()
+	}
+	if err := replay.VerifyBundleSignature(bundle); err != nil {
+		return result, err
+	}
+	if !e.isTrusted(bundle.Signature.PublicKey) {
+		return result, replay.ErrUntrustedBundleKey
+	}
+	result.Verified = true
+	return result, nil
+}
+
 // BuildBundle builds a replay bundle for export.
 // Only includes safe record types with hash-only data.
 func (e *Engine) BuildBundle(circleID string, retentionDays int) (*replay.ExportResult, error) {
@@ -135,13 +193,47 @@ func (e *Engine) BuildBundle(circleID string, retentionDays int) (*replay.Export
 	// Compute bundle hash
 	bundle.Header.BundleHash = bundle.ComputeBundleHash()
 
+	// Sign with the device key so other devices can verify the source
+	if e.signer != nil {
+		pub, err := e.signer.GetPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get public key: %w", err)
+		}
+		sig, err := e.signer.Sign([]byte(bundle.Header.BundleHash))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign bundle: %w", err)
+		}
+		bundle.Signature = &replay.BundleSignature{
+			BundleHash: bundle.Header.BundleHash,
+			PublicKey:  pub,
+			Signature:  sig,
+		}
+	}
+
 	return &replay.ExportResult{
 		Success:    true,
 		Bundle:     bundle,
-		BundleText: bundle.CanonicalString(),
+		BundleText: bundle.SignedText(),
 	}, nil
 }
 
+// Export builds a signed bundle and compresses it for download.
+// The signature covers the uncompressed canonical text.
+func (e *Engine) Export(circleID string, retentionDays int) (*replay.ExportResult, error) {
+	result, err := e.BuildBundle(circleID, retentionDays)
+	if err != nil || !result.Success {
+		return result, err
+	}
+
+	compressed, err := replay.CompressBundleText(result.BundleText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress bundle: %w", err)
+	}
+	result.Compressed = compressed
+	result.Compression = replay.ComputeCompressionBucket(len(result.BundleText), len(compressed))
+	return result, nil
+}
+
 // ValidateBundle validates a bundle before import.
 // Checks format, hash integrity, and forbidden patterns.
 func (e *Engine) ValidateBundle(bundleText string) (*replay.ReplayBundle, error) {
@@ -177,6 +269,12 @@ func (e *Engine) ValidateBundle(bundleText string) (*replay.ReplayBundle, error)
 	return bundle, nil
 }
 
+// Diff compares two bundles by record hash. The result is abstract and
+// independent of record order in either bundle.
+func (e *Engine) Diff(from, to *replay.ReplayBundle) replay.BundleDiff {
+	return replay.DiffBundles(from, to)
+}
+
 // ImportBundle imports a replay bundle.
 // Validates the bundle and applies records that don't already exist.
 func (e *Engine) ImportBundle(bundleText string, circleID string) (*replay.ImportResult, error) {
@@ -198,6 +296,14 @@ func (e *Engine) ImportBundle(bundleText string, circleID string) (*replay.Impor
 		}, nil
 	}
 
+	// Verify the bundle came from a trusted device, unaltered
+	if _, err := e.Verify(bundle); err != nil {
+		return &replay.ImportResult{
+			Success: false,
+			Error:   fmt.Sprintf("bundle not verified: %v", err),
+		}, nil
+	}
+
 	// Apply records
 	added := 0
 	exists := 0
@@ -222,6 +328,18 @@ func (e *Engine) ImportBundle(bundleText string, circleID string) (*replay.Impor
 	return replay.NewImportResult(added, exists), nil
 }
 
+// Import imports a compressed or legacy uncompressed bundle.
+func (e *Engine) Import(data []byte, circleID string) (*replay.ImportResult, error) {
+	text, err := replay.DecodeBundleBytes(data)
+	if err != nil {
+		return &replay.ImportResult{
+			Success: false,
+			Error:   fmt.Sprintf("decode failed: %v", err),
+		}, nil
+	}
+	return e.ImportBundle(text, circleID)
+}
+
 // GetRecordCount returns total record count in storelog.
 func (e *Engine) GetRecordCount() int {
 	return e.log.Count()
@@ -7,7 +7,7 @@
 //   - No policy mutation
 //   - No routing hooks
 //   - Pure function, clock-injected
-//   - stdlib only, no goroutines, no I/O
+//   - stdlib only, no goroutines, no I/O outside an injected NoveltyScorer
 //
 // Reference: docs/ADR/ADR-0045-phase19-4-shadow-diff-calibration.md
 package shadowdiff
@@ -32,7 +32,8 @@ import (
 //
 // CRITICAL: This engine is observation-only. It does NOT affect behavior.
 type Engine struct {
-	clock clock.Clock
+	clock  clock.Clock
+	scorer NoveltyScorer
 }
 
 // NewEngine creates a new diff engine with the given clock.
@@ -42,6 +43,14 @@ func NewEngine(clk clock.Clock) *Engine {
 	}
 }
 
+// WithNoveltyScorer sets an optional scorer used to pair canon-only and
+// shadow-only signals that describe the same observation. A nil scorer
+// keeps exact key comparison.
+func (e *Engine) WithNoveltyScorer(scorer NoveltyScorer) *Engine {
+	e.scorer = scorer
+	return e
+}
+
 // =============================================================================
 // Canon Result (Input)
 // =============================================================================
@@ -121,8 +130,13 @@ type DiffSummary struct {
 
 // Compute computes the diff between canon results and shadow receipt.
 //
+// When a NoveltyScorer is set, canon-only and shadow-only signals that
+// score at or above DefaultMatchThreshold are reported as one match with
+// an abstract similarity bucket. Without one, keys are compared exactly.
+//
 // CRITICAL: This is a pure function with clock injection.
-// No side effects. No I/O. No behavior modification.
+// No side effects. No behavior modification. Any I/O happens only
+// inside an injected scorer.
 func (e *Engine) Compute(input DiffInput) (*DiffOutput, error) {
 	now := e.clock.Now()
 	periodBucket := now.UTC().Format("2006-01-02")
@@ -170,21 +184,55 @@ func (e *Engine) Compute(input DiffInput) (*DiffOutput, error) {
 	}
 	sort.Strings(sortedKeys)
 
+	// Pair spurious novelty by similarity when a scorer is configured
+	var shadowToCanon map[string]string
+	var pairScores map[string]float64
+	if e.scorer != nil {
+		var canonOnly, shadowOnly []string
+		for _, k := range sortedKeys {
+			switch {
+			case canonByKey[k] != nil && shadowByKey[k] == nil:
+				canonOnly = append(canonOnly, k)
+			case canonByKey[k] == nil && shadowByKey[k] != nil:
+				shadowOnly = append(shadowOnly, k)
+			}
+		}
+		shadowToCanon, pairScores = pairByScore(e.scorer, canonOnly, shadowOnly, canonByKey, shadowByKey)
+	}
+	canonToShadow := make(map[string]string, len(shadowToCanon))
+	for sk, ck := range shadowToCanon {
+		canonToShadow[ck] = sk
+	}
+
 	// Compute diffs
 	results := make([]shadowdiff.DiffResult, 0, len(sortedKeys))
 	summary := DiffSummary{}
 
 	for _, key := range sortedKeys {
+		// Paired shadow keys are reported under their canon key
+		if _, paired := shadowToCanon[key]; paired {
+			continue
+		}
+
 		canonSig := canonByKey[key]
 		shadowSig := shadowByKey[key]
 
+		var similarity shadowdiff.SimilarityBucket
+		if sk, paired := canonToShadow[key]; paired {
+			shadowSig = shadowByKey[sk]
+			similarity = SimilarityBucketFromScore(pairScores[sk])
+		}
+
 		hasCanon := canonSig != nil
 		hasShadow := shadowSig != nil
 
 		novelty := ComputeNovelty(hasCanon, hasShadow)
 		var agreement shadowdiff.AgreementKind
 
-		if novelty == shadowdiff.NoveltyNone {
+		if similarity != "" {
+			// Similar enough to be the same observation
+			agreement = shadowdiff.AgreementMatch
+		} else if novelty == shadowdiff.NoveltyNone {
 			agreement = ComputeAgreement(canonSig, shadowSig)
 		}
 
@@ -207,6 +255,7 @@ func (e *Engine) Compute(input DiffInput) (*DiffOutput, error) {
 			ShadowSignal: shadowSig,
 			Agreement:    agreement,
 			NoveltyType:  novelty,
+			Similarity:   similarity,
 			PeriodBucket: periodBucket,
 			CreatedAt:    now,
 		}
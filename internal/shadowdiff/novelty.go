@@ -0,0 +1,171 @@
+// Package shadowdiff provides the diff engine for comparing canon vs shadow.
+//
+// Phase 19.4: Shadow Diff + Calibration (Truth Harness)
+//
+// CRITICAL: Novelty scoring only reclassifies diffs. It does NOT affect behavior.
+// CRITICAL: Only abstract bucket descriptors are ever embedded - never content.
+//
+// Reference: docs/ADR/ADR-0045-phase19-4-shadow-diff-calibration.md
+package shadowdiff
+
+import (
+	"math"
+
+	"quantumlife/pkg/domain/shadowdiff"
+)
+
+// DefaultMatchThreshold is the minimum similarity at which a canon-only and
+// a shadow-only signal are treated as the same observation.
+const DefaultMatchThreshold = 0.9
+
+// NoveltyScorer scores how similar a canon signal and a shadow signal are.
+//
+// Implementations return a similarity in [0, 1]. An error makes the engine
+// fall back to exact key comparison for the whole diff.
+type NoveltyScorer interface {
+	Similarity(canon *shadowdiff.CanonSignal, shadow *shadowdiff.ShadowSignal) (float64, error)
+}
+
+// Embedder returns an embedding vector for an abstract descriptor.
+//
+// CRITICAL: Descriptors are built from abstract buckets only.
+type Embedder interface {
+	Embed(descriptor string) ([]float64, error)
+}
+
+// EmbeddingScorer scores similarity as the cosine similarity of embedded
+// signal descriptors.
+//
+// CRITICAL: Vectors are cached in memory for one scorer only - never persisted.
+type EmbeddingScorer struct {
+	embedder Embedder
+	cache    map[string][]float64
+}
+
+// NewEmbeddingScorer creates a scorer backed by the given embedder.
+func NewEmbeddingScorer(embedder Embedder) *EmbeddingScorer {
+	return &EmbeddingScorer{
+		embedder: embedder,
+		cache:    make(map[string][]float64),
+	}
+}
+
+// Similarity returns the cosine similarity between the two signal descriptors,
+// clamped to [0, 1].
+func (s *EmbeddingScorer) Similarity(canon *shadowdiff.CanonSignal, shadow *shadowdiff.ShadowSignal) (float64, error) {
+	a, err := s.vector(CanonDescriptor(canon))
+	if err != nil {
+		return 0, err
+	}
+	b, err := s.vector(ShadowDescriptor(shadow))
+	if err != nil {
+		return 0, err
+	}
+	sim := CosineSimilarity(a, b)
+	if sim < 0 {
+		sim = 0
+	}
+	return sim, nil
+}
+
+// vector returns the cached embedding for a descriptor, embedding it once.
+func (s *EmbeddingScorer) vector(descriptor string) ([]float64, error) {
+	if v, ok := s.cache[descriptor]; ok {
+		return v, nil
+	}
+	v, err := s.embedder.Embed(descriptor)
+	if err != nil {
+		return nil, err
+	}
+	s.cache[descriptor] = v
+	return v, nil
+}
+
+// CanonDescriptor renders a canon signal as an abstract descriptor.
+// Item key hashes and circle IDs are deliberately excluded.
+func CanonDescriptor(sig *shadowdiff.CanonSignal) string {
+	decision := "none"
+	switch {
+	case sig.SurfaceDecision:
+		decision = "surface"
+	case sig.HoldDecision:
+		decision = "hold"
+	}
+	return string(sig.Key.Category) + " " + string(sig.Horizon) + " " + string(sig.Magnitude) + " " + decision
+}
+
+// ShadowDescriptor renders a shadow signal as an abstract descriptor.
+// Item key hashes and circle IDs are deliberately excluded.
+func ShadowDescriptor(sig *shadowdiff.ShadowSignal) string {
+	return string(sig.Key.Category) + " " + string(sig.Horizon) + " " + string(sig.Magnitude) + " " + string(sig.SuggestionType)
+}
+
+// CosineSimilarity returns the cosine similarity of two vectors.
+// Mismatched or zero-length vectors score 0.
+func CosineSimilarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// SimilarityBucketFromScore maps a similarity score to an abstract bucket.
+func SimilarityBucketFromScore(score float64) shadowdiff.SimilarityBucket {
+	switch {
+	case score >= DefaultMatchThreshold:
+		return shadowdiff.SimilarityHigh
+	case score >= 0.75:
+		return shadowdiff.SimilarityMedium
+	default:
+		return shadowdiff.SimilarityLow
+	}
+}
+
+// pairByScore pairs each shadow-only key with its most similar canon-only key
+// at or above the match threshold. Keys are visited in sorted order and ties
+// keep the earlier canon key, so pairing is deterministic.
+//
+// Returns nil pairs if the scorer fails, forcing exact comparison.
+func pairByScore(
+	scorer NoveltyScorer,
+	canonOnly, shadowOnly []string,
+	canonByKey map[string]*shadowdiff.CanonSignal,
+	shadowByKey map[string]*shadowdiff.ShadowSignal,
+) (shadowToCanon map[string]string, scores map[string]float64) {
+	shadowToCanon = make(map[string]string)
+	scores = make(map[string]float64)
+	used := make(map[string]bool)
+
+	for _, sk := range shadowOnly {
+		bestKey := ""
+		bestScore := 0.0
+		for _, ck := range canonOnly {
+			if used[ck] {
+				continue
+			}
+			score, err := scorer.Similarity(canonByKey[ck], shadowByKey[sk])
+			if err != nil {
+				return nil, nil
+			}
+			if score >= DefaultMatchThreshold && score > bestScore {
+				bestKey, bestScore = ck, score
+			}
+		}
+		if bestKey != "" {
+			used[bestKey] = true
+			shadowToCanon[sk] = bestKey
+			scores[sk] = bestScore
+		}
+	}
+
+	return shadowToCanon, scores
+}
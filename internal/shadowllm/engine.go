@@ -174,6 +174,29 @@ func (e *Engine) Run(input RunInput) (*RunOutput, error) {
 	}, nil
 }
 
+// RunBatch performs shadow-mode analysis for several circles in one
+// synchronous call, in input order.
+//
+// Inputs whose digest saw no triggers are skipped and produce no output.
+// The first engine error stops the batch; outputs computed so far are
+// returned alongside it.
+//
+// CRITICAL: Sequential only. No goroutines.
+func (e *Engine) RunBatch(inputs []RunInput) ([]RunOutput, error) {
+	outputs := make([]RunOutput, 0, len(inputs))
+	for _, input := range inputs {
+		if !input.Digest.TriggersSeen {
+			continue
+		}
+		output, err := e.Run(input)
+		if err != nil {
+			return outputs, err
+		}
+		outputs = append(outputs, *output)
+	}
+	return outputs, nil
+}
+
 // deriveSeedFromHash derives a deterministic seed from a hash string.
 func deriveSeedFromHash(hash string) int64 {
 	if len(hash) < 16 {
@@ -0,0 +1,56 @@
+package shadowllm
+
+import (
+	"testing"
+	"time"
+
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+func TestEngine_RunBatchSkipsCirclesWithoutTriggers(t *testing.T) {
+	clk := clock.NewFixed(time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))
+	model := &flakyModel{}
+	engine := NewEngine(clk, model)
+
+	inputs := []RunInput{
+		{CircleID: "circle-a", Digest: shadowllm.ShadowInputDigest{CircleID: "circle-a", TriggersSeen: true}},
+		{CircleID: "circle-b", Digest: shadowllm.ShadowInputDigest{CircleID: "circle-b"}},
+		{CircleID: "circle-c", Digest: shadowllm.ShadowInputDigest{CircleID: "circle-c", TriggersSeen: true}},
+	}
+
+	outputs, err := engine.RunBatch(inputs)
+	if err != nil {
+		t.Fatalf("RunBatch failed: %v", err)
+	}
+	if len(outputs) != 2 || model.calls != 2 {
+		t.Fatalf("expected 2 receipts from 2 calls, got %d / %d", len(outputs), model.calls)
+	}
+	if outputs[0].Receipt.CircleID != "circle-a" || outputs[1].Receipt.CircleID != "circle-c" {
+		t.Errorf("expected receipts in input order, got %s, %s", outputs[0].Receipt.CircleID, outputs[1].Receipt.CircleID)
+	}
+	for _, out := range outputs {
+		if out.Status != RunStatusSuccess {
+			t.Errorf("expected success, got %s", out.Status)
+		}
+	}
+}
+
+func TestEngine_RunBatchStopsOnError(t *testing.T) {
+	clk := clock.NewFixed(time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC))
+	engine := NewEngine(clk, &flakyModel{})
+
+	inputs := []RunInput{
+		{CircleID: "circle-a", Digest: shadowllm.ShadowInputDigest{TriggersSeen: true}},
+		{CircleID: "", Digest: shadowllm.ShadowInputDigest{TriggersSeen: true}},
+		{CircleID: "circle-c", Digest: shadowllm.ShadowInputDigest{TriggersSeen: true}},
+	}
+
+	outputs, err := engine.RunBatch(inputs)
+	if err != shadowllm.ErrMissingCircleID {
+		t.Fatalf("expected ErrMissingCircleID, got %v", err)
+	}
+	if len(outputs) != 1 {
+		t.Errorf("expected outputs computed before the error, got %d", len(outputs))
+	}
+}
@@ -0,0 +1,302 @@
+// Package anthropic provides an Anthropic Claude provider for shadow LLM.
+//
+// CRITICAL INVARIANTS:
+//   - Makes HTTP calls to the Anthropic Messages API (Run only)
+//   - Observe never makes network calls
+//   - NO retries - single request only
+//   - Must honor context deadline
+//   - Never logs API keys or response content
+//   - Input is ALWAYS privacy-guarded abstract data
+//   - Returns abstract error buckets only
+//   - Stdlib net/http only
+package anthropic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"quantumlife/internal/shadowllm/privacy"
+	"quantumlife/internal/shadowllm/prompt"
+	"quantumlife/internal/shadowllm/validate"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+const (
+	// DefaultBaseURL is the Anthropic API base URL.
+	DefaultBaseURL = "https://api.anthropic.com"
+
+	// APIVersion is the Anthropic API version header value.
+	APIVersion = "2023-06-01"
+)
+
+// Provider implements the Anthropic Claude shadow LLM provider.
+//
+// CRITICAL: This provider makes REAL network calls.
+// CRITICAL: Must be explicitly enabled via config + consent.
+type Provider struct {
+	baseURL   string
+	model     string
+	apiKey    string
+	client    *http.Client
+	validator *validate.Validator
+}
+
+// Config contains Anthropic provider configuration.
+type Config struct {
+	// APIKey is the API key (from environment variable).
+	APIKey string
+
+	// Model is the Claude model name.
+	Model string
+
+	// BaseURL overrides the API base URL (optional, for testing).
+	BaseURL string
+}
+
+// NewProvider creates a new Anthropic provider.
+//
+// CRITICAL: APIKey should come from environment variable.
+func NewProvider(cfg Config) (*Provider, error) {
+	if cfg.APIKey == "" {
+		return nil, &ProviderError{Code: "missing_api_key", Message: "API key is required"}
+	}
+	if cfg.Model == "" {
+		return nil, &ProviderError{Code: "missing_model", Message: "model is required"}
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return &Provider{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		model:     cfg.Model,
+		apiKey:    cfg.APIKey,
+		client:    &http.Client{},
+		validator: validate.NewValidator(),
+	}, nil
+}
+
+// NewProviderFromEnv creates a provider using environment variables.
+//
+// Environment variables:
+//   - ANTHROPIC_API_KEY
+//   - ANTHROPIC_MODEL
+func NewProviderFromEnv() (*Provider, error) {
+	return NewProvider(Config{
+		APIKey: os.Getenv("ANTHROPIC_API_KEY"),
+		Model:  os.Getenv("ANTHROPIC_MODEL"),
+	})
+}
+
+// IsConfigured returns true if the provider can be configured from environment.
+func IsConfigured() bool {
+	return os.Getenv("ANTHROPIC_API_KEY") != "" &&
+		os.Getenv("ANTHROPIC_MODEL") != ""
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return "anthropic"
+}
+
+// ProviderKind returns the provider kind.
+func (p *Provider) ProviderKind() shadowllm.ProviderKind {
+	return shadowllm.ProviderKindAnthropic
+}
+
+// Model returns the model name.
+func (p *Provider) Model() string {
+	return p.model
+}
+
+// Observe implements shadowllm.ShadowModel.
+//
+// CRITICAL: No network calls. Real output comes from Run, which takes
+// privacy-guarded input; Observe returns an empty run with provenance only.
+func (p *Provider) Observe(ctx shadowllm.ShadowContext) (shadowllm.ShadowRun, error) {
+	runID := "anthropic-" + ctx.InputsHash
+	if len(ctx.InputsHash) > 16 {
+		runID = "anthropic-" + ctx.InputsHash[:16]
+	}
+
+	run := shadowllm.ShadowRun{
+		RunID:      runID,
+		CircleID:   ctx.CircleID,
+		InputsHash: ctx.InputsHash,
+		ModelSpec:  p.Name(),
+		Seed:       ctx.Seed,
+		Signals:    nil, // Suggestions come from Run, not legacy signals
+	}
+	if ctx.Clock != nil {
+		run.CreatedAt = ctx.Clock()
+	}
+	return run, nil
+}
+
+// RunResult contains the result of a shadow run.
+type RunResult struct {
+	// Output is the validated model output.
+	Output *validate.ValidatedOutput
+
+	// ResponseStatus is an abstract status bucket.
+	ResponseStatus string
+
+	// ErrorBucket contains an error category if failed.
+	ErrorBucket string
+
+	// Raw response is intentionally NOT included to prevent content leakage.
+}
+
+// Run executes a shadow analysis request.
+//
+// CRITICAL: Makes a single HTTP request - NO retries.
+// CRITICAL: Must honor context deadline.
+// CRITICAL: Never logs response content.
+func (p *Provider) Run(ctx context.Context, input *privacy.ShadowInput) (*RunResult, error) {
+	result := &RunResult{
+		ResponseStatus: "unknown",
+	}
+
+	// Validate input for privacy compliance
+	guard := privacy.NewGuard()
+	if err := guard.ValidateInput(input); err != nil {
+		result.ErrorBucket = "privacy_guard_blocked"
+		result.ResponseStatus = "blocked"
+		return result, &ProviderError{Code: "privacy_blocked", Message: "input blocked by privacy guard"}
+	}
+
+	// Build request
+	systemPrompt, userPrompt := prompt.RenderPrompt(input)
+	reqBody := MessagesRequest{
+		Model:  p.model,
+		System: systemPrompt,
+		Messages: []Message{
+			{Role: "user", Content: userPrompt},
+		},
+		MaxTokens:   256,
+		Temperature: 0.3, // Low temperature for more deterministic output
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		result.ErrorBucket = "marshal_error"
+		result.ResponseStatus = "error"
+		return result, &ProviderError{Code: "marshal_error", Message: "failed to marshal request"}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.baseURL+"/v1/messages", bytes.NewReader(bodyBytes))
+	if err != nil {
+		result.ErrorBucket = "request_error"
+		result.ResponseStatus = "error"
+		return result, &ProviderError{Code: "request_error", Message: "failed to create request"}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", p.apiKey)
+	req.Header.Set("anthropic-version", APIVersion)
+
+	// Execute request (single attempt - NO retries)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			result.ErrorBucket = "timeout"
+			result.ResponseStatus = "timeout"
+			return result, shadowllm.ErrProviderTimeout
+		}
+		result.ErrorBucket = "network_error"
+		result.ResponseStatus = "error"
+		return result, &ProviderError{Code: "network_error", Message: "request failed"}
+	}
+	defer resp.Body.Close()
+
+	// Read response (limit size to prevent memory issues)
+	respBytes, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024)) // 64KB max
+	if err != nil {
+		result.ErrorBucket = "read_error"
+		result.ResponseStatus = "error"
+		return result, &ProviderError{Code: "read_error", Message: "failed to read response"}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		result.ResponseStatus = statusBucket(resp.StatusCode)
+		result.ErrorBucket = "http_" + result.ResponseStatus
+		return result, &ProviderError{Code: result.ErrorBucket, Message: "non-200 status"}
+	}
+
+	result.ResponseStatus = "success"
+
+	var msgResp MessagesResponse
+	if err := json.Unmarshal(respBytes, &msgResp); err != nil {
+		result.ErrorBucket = "parse_error"
+		return result, &ProviderError{Code: "parse_error", Message: "failed to parse response"}
+	}
+
+	// Concatenate text blocks
+	var content strings.Builder
+	for _, block := range msgResp.Content {
+		if block.Type == "text" {
+			content.WriteString(block.Text)
+		}
+	}
+	if content.Len() == 0 {
+		result.ErrorBucket = "empty_response"
+		return result, &ProviderError{Code: "empty_response", Message: "no text in response"}
+	}
+
+	// Validate and parse content
+	result.Output = p.validator.ParseAndValidate(content.String())
+	if !result.Output.IsValid {
+		result.ErrorBucket = "validation_failed"
+	}
+
+	return result, nil
+}
+
+// statusBucket converts HTTP status code to abstract bucket.
+func statusBucket(code int) string {
+	switch {
+	case code >= 200 && code < 300:
+		return "success"
+	case code == 400:
+		return "bad_request"
+	case code == 401:
+		return "unauthorized"
+	case code == 403:
+		return "forbidden"
+	case code == 404:
+		return "not_found"
+	case code == 429:
+		return "rate_limited"
+	case code >= 500:
+		return "server_error"
+	default:
+		return "unknown_error"
+	}
+}
+
+// ProviderError represents an Anthropic provider error.
+//
+// CRITICAL: Message is abstract - never contains API response details.
+type ProviderError struct {
+	Code    string
+	Message string
+}
+
+func (e *ProviderError) Error() string {
+	return "anthropic: " + e.Code + ": " + e.Message
+}
+
+// ErrorCode returns the abstract error code.
+func (e *ProviderError) ErrorCode() string {
+	return e.Code
+}
+
+// Verify interface compliance.
+var _ shadowllm.ShadowModel = (*Provider)(nil)
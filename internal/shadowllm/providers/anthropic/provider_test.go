@@ -0,0 +1,114 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"quantumlife/internal/shadowllm/privacy"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+func testInput() *privacy.ShadowInput {
+	return &privacy.ShadowInput{
+		CircleID:          "test-circle",
+		TimeBucket:        "2024-01-15",
+		StateSnapshotHash: "abc123def456",
+		InputDigestHash:   "fed987cba654",
+	}
+}
+
+func TestProvider_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/messages" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("x-api-key") != "test-key" {
+			t.Error("expected x-api-key header")
+		}
+		if r.Header.Get("anthropic-version") != APIVersion {
+			t.Errorf("expected anthropic-version %s, got %s", APIVersion, r.Header.Get("anthropic-version"))
+		}
+
+		var req MessagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		if req.Model != "test-model" || req.System == "" || len(req.Messages) != 1 {
+			t.Errorf("unexpected request body: %+v", req)
+		}
+
+		json.NewEncoder(w).Encode(MessagesResponse{
+			Content: []ContentBlock{{
+				Type: "text",
+				Text: `{"confidence_bucket":"high","horizon_bucket":"soon","magnitude_bucket":"a_few",` +
+					`"category":"money","why_generic":"There are a few items that may need attention.",` +
+					`"suggested_action_class":"surface"}`,
+			}},
+		})
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(Config{APIKey: "test-key", Model: "test-model", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewProvider failed: %v", err)
+	}
+
+	result, err := provider.Run(context.Background(), testInput())
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if result.ResponseStatus != "success" || !result.Output.IsValid {
+		t.Errorf("expected valid success, got %s / %s", result.ResponseStatus, result.Output.ValidationError)
+	}
+	if result.Output.Confidence != shadowllm.ConfidenceHigh {
+		t.Errorf("expected high confidence, got %s", result.Output.Confidence)
+	}
+}
+
+func TestProvider_ErrorsNeverLeakKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid x-api-key secret-key", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	provider, _ := NewProvider(Config{APIKey: "secret-key", Model: "test-model", BaseURL: server.URL})
+
+	result, err := provider.Run(context.Background(), testInput())
+	if err == nil {
+		t.Fatal("expected error for 401")
+	}
+	if result.ErrorBucket != "http_unauthorized" {
+		t.Errorf("expected http_unauthorized, got %s", result.ErrorBucket)
+	}
+	if strings.Contains(err.Error(), "secret-key") {
+		t.Error("error must not contain the API key")
+	}
+}
+
+func TestNewProviderFromEnv(t *testing.T) {
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	t.Setenv("ANTHROPIC_MODEL", "")
+	if IsConfigured() {
+		t.Error("expected not configured without env vars")
+	}
+	if _, err := NewProviderFromEnv(); err == nil {
+		t.Error("expected error without env vars")
+	}
+
+	t.Setenv("ANTHROPIC_API_KEY", "test-key")
+	t.Setenv("ANTHROPIC_MODEL", "test-model")
+	if !IsConfigured() {
+		t.Error("expected configured with env vars")
+	}
+	provider, err := NewProviderFromEnv()
+	if err != nil {
+		t.Fatalf("NewProviderFromEnv failed: %v", err)
+	}
+	if provider.Model() != "test-model" || provider.ProviderKind() != shadowllm.ProviderKindAnthropic {
+		t.Errorf("unexpected provider: %s / %s", provider.Model(), provider.ProviderKind())
+	}
+}
@@ -0,0 +1,35 @@
+// Package anthropic provides an Anthropic Claude provider for shadow LLM.
+//
+// Wire types for the Anthropic Messages API.
+//
+// CRITICAL INVARIANTS:
+//   - Stdlib only.
+//   - No goroutines. No time.Now().
+package anthropic
+
+// MessagesRequest is the Anthropic Messages API request structure.
+type MessagesRequest struct {
+	Model       string    `json:"model"`
+	System      string    `json:"system,omitempty"`
+	Messages    []Message `json:"messages"`
+	MaxTokens   int       `json:"max_tokens"`
+	Temperature float64   `json:"temperature,omitempty"`
+}
+
+// Message is a single message in the conversation.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// MessagesResponse is the Anthropic Messages API response structure.
+type MessagesResponse struct {
+	Content    []ContentBlock `json:"content"`
+	StopReason string         `json:"stop_reason"`
+}
+
+// ContentBlock is a single block of response content.
+type ContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
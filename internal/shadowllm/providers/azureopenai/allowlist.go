@@ -0,0 +1,47 @@
+package azureopenai
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// CodeEndpointNotAllowed is the error code when the endpoint host is not
+// on the configured allowlist.
+const CodeEndpointNotAllowed = "endpoint_not_allowed"
+
+// EndpointAllowed returns true if the endpoint's host is on the allowlist.
+// An empty allowlist allows any endpoint.
+//
+// Entries are hostnames (e.g. "your-resource.openai.azure.com"), matched
+// case-insensitively. Only https endpoints can match a non-empty allowlist.
+func EndpointAllowed(endpoint string, allowedHosts []string) bool {
+	if len(allowedHosts) == 0 {
+		return true
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range allowedHosts {
+		if host != "" && host == strings.ToLower(strings.TrimSpace(allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEndpointNotAllowed returns true if err was caused by an endpoint
+// rejected by the allowlist.
+func IsEndpointNotAllowed(err error) bool {
+	var pe *ProviderError
+	if errors.As(err, &pe) {
+		return pe.Code == CodeEndpointNotAllowed
+	}
+	var ce *ChatError
+	if errors.As(err, &ce) {
+		return ce.Code == CodeEndpointNotAllowed
+	}
+	return false
+}
@@ -61,6 +61,10 @@ type ChatConfig struct {
 
 	// MaxSuggestions limits suggestions per run.
 	MaxSuggestions int
+
+	// AllowedHosts restricts the endpoint to approved hosts (optional).
+	// Empty allows any endpoint.
+	AllowedHosts []string
 }
 
 // NewChatProvider creates a new Azure OpenAI chat provider.
@@ -76,6 +80,10 @@ func NewChatProvider(cfg ChatConfig) (*ChatProvider, error) {
 	if cfg.APIKey == "" {
 		return nil, &ChatError{Code: "missing_api_key", Message: "API key is required"}
 	}
+	// CRITICAL: Never include the endpoint in the error
+	if !EndpointAllowed(cfg.Endpoint, cfg.AllowedHosts) {
+		return nil, &ChatError{Code: CodeEndpointNotAllowed, Message: "endpoint host is not allowed"}
+	}
 
 	apiVersion := cfg.APIVersion
 	if apiVersion == "" {
@@ -109,7 +117,9 @@ func NewChatProvider(cfg ChatConfig) (*ChatProvider, error) {
 //   - AZURE_OPENAI_API_KEY
 //   - AZURE_OPENAI_API_VERSION (optional)
 //   - SHADOW_MAX_SUGGESTIONS (optional)
-func NewChatProviderFromEnv() (*ChatProvider, error) {
+//
+// If allowedHosts is non-empty, endpoints on other hosts are refused.
+func NewChatProviderFromEnv(allowedHosts ...string) (*ChatProvider, error) {
 	deployment := os.Getenv("AZURE_OPENAI_CHAT_DEPLOYMENT")
 	if deployment == "" {
 		deployment = os.Getenv("AZURE_OPENAI_DEPLOYMENT")
@@ -128,6 +138,7 @@ func NewChatProviderFromEnv() (*ChatProvider, error) {
 		APIKey:         os.Getenv("AZURE_OPENAI_API_KEY"),
 		APIVersion:     os.Getenv("AZURE_OPENAI_API_VERSION"),
 		MaxSuggestions: maxSuggestions,
+		AllowedHosts:   allowedHosts,
 	})
 }
 
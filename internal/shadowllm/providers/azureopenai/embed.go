@@ -7,8 +7,8 @@
 //   - NO retries - single request only
 //   - Must honor context deadline
 //   - Never logs API keys or response content
-//   - Input is ALWAYS a safe constant - never user data
-//   - Output is hash of vector only - never raw embeddings
+//   - Input is ALWAYS a safe constant or an abstract bucket descriptor - never user data
+//   - Healthcheck output is hash of vector only - never raw embeddings
 //   - Stdlib net/http only
 //
 // Reference: docs/ADR/ADR-0049-phase19-3b-go-real-azure-and-embeddings.md
@@ -150,15 +150,65 @@ func (p *EmbedProvider) Healthcheck(ctx context.Context) (*EmbedHealthResult, er
 		LatencyBucket: "na", // Provider does not measure latency - see note above
 	}
 
-	// Build request with safe constant input
-	reqBody := embedRequest{
+	// Single embeddings call with safe constant input
+	vector, bucket, err := p.embed(ctx, embedRequest{
 		Input: EmbedHealthcheckInput, // CRITICAL: Safe constant only
+	})
+	if err != nil {
+		result.ErrorBucket = bucket
+		if bucket == "timeout" {
+			result.LatencyBucket = "timeout"
+		}
+		return result, err
+	}
+
+	// Hash the vector (NEVER store raw embeddings)
+	vectorHash := hashEmbedding(vector)
+	result.VectorHash = vectorHash
+	result.Status = config.EmbedStatusOK
+
+	return result, nil
+}
+
+// Embed returns the embedding vector for an abstract descriptor.
+//
+// Phase 19.4: Used by the shadow diff novelty scorer.
+//
+// CRITICAL: Descriptor must be lowercase abstract bucket tokens only
+// (letters, digits, underscores, spaces). Anything else is rejected
+// before any network call.
+// CRITICAL: The vector is returned to the caller only - never logged or stored.
+// CRITICAL: Single request - NO retries.
+func (p *EmbedProvider) Embed(ctx context.Context, descriptor string) ([]float64, error) {
+	if !IsAbstractDescriptor(descriptor) {
+		return nil, &EmbedError{Code: "descriptor_rejected", Message: "descriptor is not abstract"}
+	}
+	vector, _, err := p.embed(ctx, embedRequest{Input: descriptor})
+	return vector, err
+}
+
+// IsAbstractDescriptor reports whether s contains only abstract bucket
+// tokens: lowercase letters, digits, underscores and single spaces.
+func IsAbstractDescriptor(s string) bool {
+	if s == "" || len(s) > 128 {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == ' ':
+		default:
+			return false
+		}
 	}
+	return true
+}
 
+// embed performs a single embeddings request and returns the vector with
+// an abstract error bucket on failure.
+func (p *EmbedProvider) embed(ctx context.Context, reqBody embedRequest) ([]float64, string, error) {
 	bodyBytes, err := json.Marshal(reqBody)
 	if err != nil {
-		result.ErrorBucket = "marshal_error"
-		return result, &EmbedError{Code: "marshal_error", Message: "failed to marshal request"}
+		return nil, "marshal_error", &EmbedError{Code: "marshal_error", Message: "failed to marshal request"}
 	}
 
 	// Build URL
@@ -167,8 +217,7 @@ func (p *EmbedProvider) Healthcheck(ctx context.Context) (*EmbedHealthResult, er
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(bodyBytes))
 	if err != nil {
-		result.ErrorBucket = "request_error"
-		return result, &EmbedError{Code: "request_error", Message: "failed to create request"}
+		return nil, "request_error", &EmbedError{Code: "request_error", Message: "failed to create request"}
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -179,12 +228,9 @@ func (p *EmbedProvider) Healthcheck(ctx context.Context) (*EmbedHealthResult, er
 	if err != nil {
 		// Check for context deadline exceeded
 		if ctx.Err() == context.DeadlineExceeded {
-			result.ErrorBucket = "timeout"
-			result.LatencyBucket = "timeout"
-			return result, &EmbedError{Code: "timeout", Message: "request timed out"}
+			return nil, "timeout", &EmbedError{Code: "timeout", Message: "request timed out"}
 		}
-		result.ErrorBucket = "network_error"
-		return result, &EmbedError{Code: "network_error", Message: "request failed"}
+		return nil, "network_error", &EmbedError{Code: "network_error", Message: "request failed"}
 	}
 	defer resp.Body.Close()
 
@@ -192,35 +238,26 @@ func (p *EmbedProvider) Healthcheck(ctx context.Context) (*EmbedHealthResult, er
 	bodyReader := io.LimitReader(resp.Body, 256*1024) // 256KB max for embeddings
 	respBytes, err := io.ReadAll(bodyReader)
 	if err != nil {
-		result.ErrorBucket = "read_error"
-		return result, &EmbedError{Code: "read_error", Message: "failed to read response"}
+		return nil, "read_error", &EmbedError{Code: "read_error", Message: "failed to read response"}
 	}
 
 	// Check status code
 	if resp.StatusCode != http.StatusOK {
-		result.ErrorBucket = "http_" + embedStatusBucket(resp.StatusCode)
-		return result, &EmbedError{Code: result.ErrorBucket, Message: "non-200 status"}
+		bucket := "http_" + embedStatusBucket(resp.StatusCode)
+		return nil, bucket, &EmbedError{Code: bucket, Message: "non-200 status"}
 	}
 
 	// Parse response
 	var embedResp embedResponse
 	if err := json.Unmarshal(respBytes, &embedResp); err != nil {
-		result.ErrorBucket = "parse_error"
-		return result, &EmbedError{Code: "parse_error", Message: "failed to parse response"}
+		return nil, "parse_error", &EmbedError{Code: "parse_error", Message: "failed to parse response"}
 	}
 
-	// Extract vector and compute hash
 	if len(embedResp.Data) == 0 || len(embedResp.Data[0].Embedding) == 0 {
-		result.ErrorBucket = "empty_response"
-		return result, &EmbedError{Code: "empty_response", Message: "no embeddings in response"}
+		return nil, "empty_response", &EmbedError{Code: "empty_response", Message: "no embeddings in response"}
 	}
 
-	// Hash the vector (NEVER store raw embeddings)
-	vectorHash := hashEmbedding(embedResp.Data[0].Embedding)
-	result.VectorHash = vectorHash
-	result.Status = config.EmbedStatusOK
-
-	return result, nil
+	return embedResp.Data[0].Embedding, "", nil
 }
 
 // hashEmbedding creates a SHA256 hash of the embedding vector.
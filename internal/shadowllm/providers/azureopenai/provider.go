@@ -55,6 +55,10 @@ type Config struct {
 
 	// APIKey is the API key (from environment variable).
 	APIKey string
+
+	// AllowedHosts restricts the endpoint to approved hosts (optional).
+	// Empty allows any endpoint.
+	AllowedHosts []string
 }
 
 // NewProvider creates a new Azure OpenAI provider.
@@ -71,6 +75,10 @@ func NewProvider(cfg Config) (*Provider, error) {
 	if cfg.APIKey == "" {
 		return nil, &ProviderError{Code: "missing_api_key", Message: "API key is required"}
 	}
+	// CRITICAL: Never include the endpoint in the error
+	if !EndpointAllowed(cfg.Endpoint, cfg.AllowedHosts) {
+		return nil, &ProviderError{Code: CodeEndpointNotAllowed, Message: "endpoint host is not allowed"}
+	}
 
 	apiVersion := cfg.APIVersion
 	if apiVersion == "" {
@@ -94,12 +102,15 @@ func NewProvider(cfg Config) (*Provider, error) {
 //   - AZURE_OPENAI_DEPLOYMENT
 //   - AZURE_OPENAI_API_KEY
 //   - AZURE_OPENAI_API_VERSION (optional)
-func NewProviderFromEnv() (*Provider, error) {
+//
+// If allowedHosts is non-empty, endpoints on other hosts are refused.
+func NewProviderFromEnv(allowedHosts ...string) (*Provider, error) {
 	return NewProvider(Config{
-		Endpoint:   os.Getenv("AZURE_OPENAI_ENDPOINT"),
-		Deployment: os.Getenv("AZURE_OPENAI_DEPLOYMENT"),
-		APIKey:     os.Getenv("AZURE_OPENAI_API_KEY"),
-		APIVersion: os.Getenv("AZURE_OPENAI_API_VERSION"),
+		Endpoint:     os.Getenv("AZURE_OPENAI_ENDPOINT"),
+		Deployment:   os.Getenv("AZURE_OPENAI_DEPLOYMENT"),
+		APIKey:       os.Getenv("AZURE_OPENAI_API_KEY"),
+		APIVersion:   os.Getenv("AZURE_OPENAI_API_VERSION"),
+		AllowedHosts: allowedHosts,
 	})
 }
 
@@ -266,6 +277,11 @@ func (e *ProviderError) Error() string {
 	return "azure_openai: " + e.Code + ": " + e.Message
 }
 
+// ErrorCode returns the abstract error code.
+func (e *ProviderError) ErrorCode() string {
+	return e.Code
+}
+
 // IsConfigured returns true if the provider can be configured from environment.
 func IsConfigured() bool {
 	return os.Getenv("AZURE_OPENAI_ENDPOINT") != "" &&
@@ -0,0 +1,138 @@
+package shadowllm
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/events"
+)
+
+const (
+	// DefaultMaxRetries is the retry ceiling after the first attempt.
+	DefaultMaxRetries = 2
+
+	// DefaultRetryBackoff is the wait before the first retry.
+	// Each further retry doubles it.
+	DefaultRetryBackoff = 500 * time.Millisecond
+)
+
+// Abstract error buckets reported on retry events.
+const (
+	ErrorBucketTimeout     = "timeout"
+	ErrorBucketRateLimited = "rate_limited"
+	ErrorBucketServerError = "server_error"
+	ErrorBucketNetwork     = "network_error"
+	ErrorBucketTerminal    = "terminal"
+)
+
+// codedError is implemented by provider errors carrying an abstract code.
+type codedError interface {
+	error
+	ErrorCode() string
+}
+
+// RetryModel decorates a ShadowModel with bounded retries for transient
+// provider failures.
+//
+// CRITICAL: At most DefaultMaxRetries retries; terminal errors are never retried.
+// CRITICAL: Waits go through the injected clock. No goroutines.
+// CRITICAL: Events carry an abstract error bucket only, never the raw error.
+type RetryModel struct {
+	inner      shadowllm.ShadowModel
+	clock      clock.Clock
+	emitter    events.Emitter
+	maxRetries int
+	backoff    time.Duration
+}
+
+// NewRetryModel wraps inner with the default retry policy.
+// emitter may be nil.
+func NewRetryModel(inner shadowllm.ShadowModel, clk clock.Clock, emitter events.Emitter) *RetryModel {
+	return &RetryModel{
+		inner:      inner,
+		clock:      clk,
+		emitter:    emitter,
+		maxRetries: DefaultMaxRetries,
+		backoff:    DefaultRetryBackoff,
+	}
+}
+
+// Name returns the wrapped model name.
+func (m *RetryModel) Name() string {
+	return m.inner.Name()
+}
+
+// ProviderKind returns the wrapped provider kind.
+func (m *RetryModel) ProviderKind() shadowllm.ProviderKind {
+	return m.inner.ProviderKind()
+}
+
+// Observe calls the wrapped model, retrying retryable failures with
+// exponential backoff. The last result is returned when retries run out.
+func (m *RetryModel) Observe(ctx shadowllm.ShadowContext) (shadowllm.ShadowRun, error) {
+	wait := m.backoff
+	for attempt := 1; ; attempt++ {
+		run, err := m.inner.Observe(ctx)
+		if err == nil {
+			return run, nil
+		}
+
+		bucket, retryable := ClassifyProviderError(err)
+		willRetry := retryable && attempt <= m.maxRetries
+		m.emit(attempt, bucket, willRetry)
+		if !willRetry {
+			return run, err
+		}
+
+		clock.Sleep(m.clock, wait)
+		wait *= 2
+	}
+}
+
+// emit records one failed attempt.
+func (m *RetryModel) emit(attempt int, bucket string, willRetry bool) {
+	if m.emitter == nil {
+		return
+	}
+	m.emitter.Emit(events.Event{
+		Type:      events.Phase19_3ProviderRetry,
+		Timestamp: m.clock.Now(),
+		Metadata: map[string]string{
+			"provider":     m.inner.Name(),
+			"attempt":      strconv.Itoa(attempt),
+			"error_bucket": bucket,
+			"will_retry":   strconv.FormatBool(willRetry),
+		},
+	})
+}
+
+// ClassifyProviderError maps a provider error to an abstract bucket and
+// reports whether it is worth retrying. Timeouts, rate limits, server
+// errors and network failures are transient; everything else is terminal.
+func ClassifyProviderError(err error) (bucket string, retryable bool) {
+	if errors.Is(err, shadowllm.ErrProviderTimeout) {
+		return ErrorBucketTimeout, true
+	}
+
+	var coded codedError
+	if errors.As(err, &coded) {
+		switch coded.ErrorCode() {
+		case "timeout":
+			return ErrorBucketTimeout, true
+		case "http_rate_limited":
+			return ErrorBucketRateLimited, true
+		case "http_server_error":
+			return ErrorBucketServerError, true
+		case "network_error":
+			return ErrorBucketNetwork, true
+		}
+	}
+
+	return ErrorBucketTerminal, false
+}
+
+// Verify interface compliance.
+var _ shadowllm.ShadowModel = (*RetryModel)(nil)
@@ -0,0 +1,132 @@
+package shadowllm
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/events"
+)
+
+// sleepClock is a fixed clock that records sleeps instead of blocking.
+type sleepClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *sleepClock) Now() time.Time { return c.now }
+
+func (c *sleepClock) Sleep(d time.Duration) {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+}
+
+// codeErr mimics a provider error carrying an abstract code.
+type codeErr struct{ code string }
+
+func (e *codeErr) Error() string     { return "provider: " + e.code + ": secret detail" }
+func (e *codeErr) ErrorCode() string { return e.code }
+
+// flakyModel fails with the queued errors, then succeeds.
+type flakyModel struct {
+	errs  []error
+	calls int
+}
+
+func (m *flakyModel) Name() string                         { return "flaky" }
+func (m *flakyModel) ProviderKind() shadowllm.ProviderKind { return shadowllm.ProviderKindAzureOpenAI }
+
+func (m *flakyModel) Observe(ctx shadowllm.ShadowContext) (shadowllm.ShadowRun, error) {
+	m.calls++
+	if m.calls <= len(m.errs) {
+		return shadowllm.ShadowRun{}, m.errs[m.calls-1]
+	}
+	return shadowllm.ShadowRun{RunID: "ok", CircleID: ctx.CircleID}, nil
+}
+
+func TestRetryModel_RetriesTransientErrors(t *testing.T) {
+	clk := &sleepClock{now: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)}
+	buf := events.NewBuffer(0)
+	inner := &flakyModel{errs: []error{&codeErr{"http_rate_limited"}, shadowllm.ErrProviderTimeout}}
+
+	run, err := NewRetryModel(inner, clk, buf).Observe(shadowllm.ShadowContext{CircleID: "circle-1"})
+	if err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if run.RunID != "ok" || inner.calls != 3 {
+		t.Errorf("expected third attempt to succeed, calls=%d", inner.calls)
+	}
+
+	want := []time.Duration{DefaultRetryBackoff, 2 * DefaultRetryBackoff}
+	if len(clk.sleeps) != 2 || clk.sleeps[0] != want[0] || clk.sleeps[1] != want[1] {
+		t.Errorf("expected backoff %v, got %v", want, clk.sleeps)
+	}
+
+	evts := buf.Events()
+	if len(evts) != 2 {
+		t.Fatalf("expected 2 retry events, got %d", len(evts))
+	}
+	if evts[0].Metadata["error_bucket"] != ErrorBucketRateLimited || evts[1].Metadata["error_bucket"] != ErrorBucketTimeout {
+		t.Errorf("unexpected buckets: %v / %v", evts[0].Metadata, evts[1].Metadata)
+	}
+	for _, e := range evts {
+		for _, v := range e.Metadata {
+			if strings.Contains(v, "secret") {
+				t.Error("retry event must not carry the raw error")
+			}
+		}
+	}
+}
+
+func TestRetryModel_StopsAtMaxRetries(t *testing.T) {
+	clk := &sleepClock{now: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)}
+	transient := &codeErr{"http_server_error"}
+	inner := &flakyModel{errs: []error{transient, transient, transient, transient}}
+
+	_, err := NewRetryModel(inner, clk, nil).Observe(shadowllm.ShadowContext{CircleID: "circle-1"})
+	if err != transient {
+		t.Errorf("expected last error returned, got %v", err)
+	}
+	if inner.calls != 1+DefaultMaxRetries {
+		t.Errorf("expected %d attempts, got %d", 1+DefaultMaxRetries, inner.calls)
+	}
+}
+
+func TestRetryModel_TerminalErrorNotRetried(t *testing.T) {
+	clk := &sleepClock{now: time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)}
+	buf := events.NewBuffer(0)
+	inner := &flakyModel{errs: []error{&codeErr{"http_unauthorized"}}}
+
+	if _, err := NewRetryModel(inner, clk, buf).Observe(shadowllm.ShadowContext{}); err == nil {
+		t.Fatal("expected terminal error")
+	}
+	if inner.calls != 1 || len(clk.sleeps) != 0 {
+		t.Errorf("terminal error must not be retried: calls=%d sleeps=%v", inner.calls, clk.sleeps)
+	}
+	if evts := buf.Events(); len(evts) != 1 || evts[0].Metadata["will_retry"] != "false" {
+		t.Errorf("expected one non-retry event, got %v", evts)
+	}
+}
+
+func TestClassifyProviderError(t *testing.T) {
+	tests := []struct {
+		err       error
+		bucket    string
+		retryable bool
+	}{
+		{shadowllm.ErrProviderTimeout, ErrorBucketTimeout, true},
+		{&codeErr{"http_rate_limited"}, ErrorBucketRateLimited, true},
+		{&codeErr{"http_server_error"}, ErrorBucketServerError, true},
+		{&codeErr{"network_error"}, ErrorBucketNetwork, true},
+		{&codeErr{"http_bad_request"}, ErrorBucketTerminal, false},
+		{errors.New("something else"), ErrorBucketTerminal, false},
+	}
+	for _, tt := range tests {
+		bucket, retryable := ClassifyProviderError(tt.err)
+		if bucket != tt.bucket || retryable != tt.retryable {
+			t.Errorf("%v: got (%s, %v), want (%s, %v)", tt.err, bucket, retryable, tt.bucket, tt.retryable)
+		}
+	}
+}
@@ -160,3 +160,11 @@ func hashTimestamp(t time.Time) string {
 	h.Write([]byte(t.UTC().Format(time.RFC3339Nano)))
 	return hex.EncodeToString(h.Sum(nil)[:16])
 }
+
+// Clear removes all acknowledgement records.
+func (s *AckStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]AckRecord, 0, s.maxRecords)
+	s.receiptIndex = make(map[string]int)
+}
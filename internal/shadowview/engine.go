@@ -53,6 +53,9 @@ type BuildPageInput struct {
 
 	// CalibrationVote is the usefulness vote (if recorded).
 	CalibrationVote string
+
+	// Milestone is the first real suggestion milestone (may be nil).
+	Milestone *MilestoneRecord
 }
 
 // BuildPage creates the shadow receipt page view.
@@ -67,6 +70,9 @@ func (e *Engine) BuildPage(input BuildPageInput) ShadowReceiptPage {
 	// Source section
 	page.Source = e.buildSourceSection(input.HasGmailConnection)
 
+	// Milestone section
+	page.Milestone = e.buildMilestoneSection(input.Milestone)
+
 	if input.Receipt == nil {
 		// No receipt - return minimal page
 		page.Observation = ObservationSection{
@@ -98,6 +104,18 @@ func (e *Engine) BuildPage(input BuildPageInput) ShadowReceiptPage {
 	return page
 }
 
+// buildMilestoneSection creates the milestone section.
+func (e *Engine) buildMilestoneSection(record *MilestoneRecord) MilestoneSection {
+	if record == nil {
+		return MilestoneSection{}
+	}
+	return MilestoneSection{
+		HasMilestone: true,
+		Statement:    "A real model offered its first quiet observation. Nothing was done with it.",
+		ReceiptHash:  record.ReceiptHash,
+	}
+}
+
 // buildSourceSection creates the source section.
 func (e *Engine) buildSourceSection(hasGmail bool) SourceSection {
 	if hasGmail {
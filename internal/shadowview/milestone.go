@@ -0,0 +1,95 @@
+package shadowview
+
+import (
+	"sync"
+	"time"
+
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+// MilestoneRecord is the one-time "first real suggestion" milestone receipt.
+//
+// CRITICAL: Contains ONLY hashes and abstract buckets - never raw content.
+type MilestoneRecord struct {
+	// ReceiptHash is the SHA256 hash of the receipt that reached the milestone.
+	ReceiptHash string
+
+	// ProviderKind is the real provider that produced the suggestion.
+	ProviderKind shadowllm.ProviderKind
+
+	// TSHash is the SHA256 hash of the timestamp (never raw).
+	TSHash string
+
+	// PeriodBucket is the day bucket (YYYY-MM-DD).
+	PeriodBucket string
+}
+
+// MilestoneStore records the first suggestion produced by a real provider.
+//
+// CRITICAL: Records at most once. Subsequent suggestions never re-trigger it.
+// CRITICAL: In-memory, hash-only storage.
+type MilestoneStore struct {
+	mu     sync.RWMutex
+	record *MilestoneRecord
+}
+
+// NewMilestoneStore creates a new milestone store.
+func NewMilestoneStore() *MilestoneStore {
+	return &MilestoneStore{}
+}
+
+// IsRealSuggestion returns true if the receipt carries a suggestion from a
+// real (non-stub) provider that completed successfully.
+func IsRealSuggestion(receipt *shadowllm.ShadowReceipt) bool {
+	if receipt == nil || len(receipt.Suggestions) == 0 {
+		return false
+	}
+	switch receipt.Provenance.ProviderKind {
+	case shadowllm.ProviderKindNone, shadowllm.ProviderKindStub, "":
+		return false
+	}
+	return receipt.Provenance.Status == shadowllm.ReceiptStatusSuccess
+}
+
+// Observe records the milestone if the receipt is the first real suggestion.
+//
+// Returns the record and true only when the milestone was recorded by this
+// call. Returns false for stub receipts and once the milestone exists.
+func (s *MilestoneStore) Observe(receipt *shadowllm.ShadowReceipt, periodBucket string, now time.Time) (MilestoneRecord, bool) {
+	if !IsRealSuggestion(receipt) {
+		return MilestoneRecord{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.record != nil {
+		return MilestoneRecord{}, false
+	}
+
+	s.record = &MilestoneRecord{
+		ReceiptHash:  receipt.Hash(),
+		ProviderKind: receipt.Provenance.ProviderKind,
+		TSHash:       hashTimestamp(now),
+		PeriodBucket: periodBucket,
+	}
+	return *s.record, true
+}
+
+// Get returns the milestone record if it has been reached.
+func (s *MilestoneStore) Get() (MilestoneRecord, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.record == nil {
+		return MilestoneRecord{}, false
+	}
+	return *s.record, true
+}
+
+// Clear forgets the recorded milestone.
+func (s *MilestoneStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.record = nil
+}
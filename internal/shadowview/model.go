@@ -41,6 +41,9 @@ type ShadowReceiptPage struct {
 	// TrustAnchor section
 	TrustAnchor TrustAnchorSection
 
+	// Milestone section (first real suggestion acknowledgment)
+	Milestone MilestoneSection
+
 	// ReceiptHash is the full receipt hash for dismissal tracking.
 	ReceiptHash string
 }
@@ -111,6 +114,20 @@ type CalibrationSection struct {
 	Statement string
 }
 
+// MilestoneSection acknowledges the first real suggestion.
+//
+// CRITICAL: Shown once the milestone exists - a single calm line, no action.
+type MilestoneSection struct {
+	// HasMilestone indicates if the first real suggestion has been recorded.
+	HasMilestone bool
+
+	// Statement is the calm acknowledgment.
+	Statement string
+
+	// ReceiptHash is the hash of the milestone receipt.
+	ReceiptHash string
+}
+
 // TrustAnchorSection provides the proof hash.
 type TrustAnchorSection struct {
 	// PeriodLabel is the abstract time period (e.g., "today").
@@ -4,21 +4,60 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 )
 
 // Engine provides deterministic surface cue and page generation.
 // Same inputs + same clock = same output, always.
 type Engine struct {
-	clock func() time.Time
+	clock              func() time.Time
+	promotionThreshold int
+}
+
+// EngineOption configures the Engine.
+type EngineOption func(*Engine)
+
+// WithPromotionThreshold sets the minimum promotion score a held category
+// needs before it is offered as a surface item. Non-positive values keep
+// DefaultPromotionThreshold; values above MaxPromotionThreshold are clamped.
+func WithPromotionThreshold(threshold int) EngineOption {
+	return func(e *Engine) {
+		if threshold <= 0 {
+			return
+		}
+		if threshold > MaxPromotionThreshold {
+			threshold = MaxPromotionThreshold
+		}
+		e.promotionThreshold = threshold
+	}
 }
 
 // NewEngine creates a new surface engine with injected clock.
-func NewEngine(clock func() time.Time) *Engine {
+func NewEngine(clock func() time.Time, opts ...EngineOption) *Engine {
 	if clock == nil {
 		clock = time.Now
 	}
-	return &Engine{clock: clock}
+	e := &Engine{clock: clock, promotionThreshold: DefaultPromotionThreshold}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// PromotionThreshold returns the configured promotion threshold.
+func (e *Engine) PromotionThreshold() int {
+	return e.promotionThreshold
+}
+
+// promotable reports whether a held category has aged or grown enough
+// to be offered as a surface item.
+func (e *Engine) promotable(cat Category, input SurfaceInput) bool {
+	mag, ok := input.HeldCategories[cat]
+	if !ok {
+		return false
+	}
+	return PromotionScore(mag, input.HeldAging[cat]) >= e.promotionThreshold
 }
 
 // cueTexts are deterministic texts for the subtle cue.
@@ -78,17 +117,12 @@ func (e *Engine) BuildCue(input SurfaceInput) SurfaceCue {
 	now := e.clock()
 
 	// Rule: Cue is available when:
-	// 1. There exists ≥1 held category with magnitude a_few or several
+	// 1. There exists ≥1 held category whose promotion score meets the threshold
 	// 2. AND user preference is "quiet" (if show_all, they'll see it elsewhere)
 	available := false
 
 	if input.UserPreference == "quiet" {
-		for _, mag := range input.HeldCategories {
-			if mag == MagnitudeAFew || mag == MagnitudeSeveral {
-				available = true
-				break
-			}
-		}
+		_, available = e.selectCategory(input)
 	}
 
 	cue := SurfaceCue{
@@ -116,10 +150,8 @@ func (e *Engine) BuildCue(input SurfaceInput) SurfaceCue {
 func (e *Engine) selectCategory(input SurfaceInput) (Category, bool) {
 	// Priority order: money > time > work > people > home
 	for _, cat := range CategoryPriority {
-		if mag, ok := input.HeldCategories[cat]; ok {
-			if mag == MagnitudeAFew || mag == MagnitudeSeveral {
-				return cat, true
-			}
+		if e.promotable(cat, input) {
+			return cat, true
 		}
 	}
 	return "", false
@@ -166,23 +198,7 @@ func (e *Engine) BuildSurfacePage(input SurfaceInput, showExplain bool) SurfaceP
 	}
 
 	// Build the surfaced item
-	magnitude := input.HeldCategories[cat]
-	horizon := e.determineHorizon(cat, input)
-	reason := reasonSummaries[cat]
-	explain := explainLines[cat]
-
-	// Compute item key hash (deterministic, based on category + input hash)
-	itemKeyCanonical := fmt.Sprintf("item|cat:%s|input:%s", cat, input.Hash())
-	itemKeyHash := computeHash(itemKeyCanonical)
-
-	item := SurfaceItem{
-		Category:      cat,
-		Magnitude:     magnitude,
-		Horizon:       horizon,
-		ReasonSummary: reason,
-		Explain:       explain,
-		ItemKeyHash:   itemKeyHash,
-	}
+	item := e.buildItem(cat, input)
 
 	page := SurfacePage{
 		Title:       "Something you could look at",
@@ -209,6 +225,75 @@ func (e *Engine) BuildSurfacePage(input SurfaceInput, showExplain bool) SurfaceP
 	return page
 }
 
+// buildItem builds the abstract surface item for a category.
+func (e *Engine) buildItem(cat Category, input SurfaceInput) SurfaceItem {
+	// Compute item key hash (deterministic, based on category + input hash)
+	itemKeyCanonical := fmt.Sprintf("item|cat:%s|input:%s", cat, input.Hash())
+
+	return SurfaceItem{
+		Category:      cat,
+		Magnitude:     input.HeldCategories[cat],
+		Horizon:       e.determineHorizon(cat, input),
+		ReasonSummary: reasonSummaries[cat],
+		Explain:       explainLines[cat],
+		ItemKeyHash:   computeHash(itemKeyCanonical),
+	}
+}
+
+// BuildSurfaceList generates the show_all page with up to max items.
+// Items follow CategoryPriority, so the first item is always the one
+// BuildSurfacePage would show and the order is stable for the same state.
+// Non-positive max means MaxSurfaceListItems.
+//
+// CRITICAL: Returns no items unless the user preference is show_all,
+// so the quiet default is unchanged.
+func (e *Engine) BuildSurfaceList(input SurfaceInput, max int) SurfaceListPage {
+	now := e.clock()
+
+	if max <= 0 || max > MaxSurfaceListItems {
+		max = MaxSurfaceListItems
+	}
+
+	var items []SurfaceItem
+	if input.UserPreference == "show_all" {
+		for _, cat := range CategoryPriority {
+			if len(items) == max {
+				break
+			}
+			if e.promotable(cat, input) {
+				items = append(items, e.buildItem(cat, input))
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		return SurfaceListPage{
+			Title:       "Nothing to show",
+			Subtitle:    "Everything is being handled quietly.",
+			GeneratedAt: now,
+			Hash:        computeHash(fmt.Sprintf("list|empty|%d", now.Unix())),
+		}
+	}
+
+	page := SurfaceListPage{
+		Title:       "Everything you could look at",
+		Subtitle:    "You asked to see it all. None of it needs you.",
+		Items:       items,
+		GeneratedAt: now,
+	}
+
+	// Compute page hash
+	var b strings.Builder
+	fmt.Fprintf(&b, "list|title:%s|sub:%s", page.Title, page.Subtitle)
+	for _, item := range items {
+		fmt.Fprintf(&b, "|cat:%s|mag:%s|hor:%s", item.Category, item.Magnitude, item.Horizon)
+	}
+	fmt.Fprintf(&b, "|ts:%d", now.Unix())
+	page.Hash = computeHash(b.String())
+
+	return page
+}
+
 // HasSurfaceableContent checks if there's anything that could be surfaced.
 func (e *Engine) HasSurfaceableContent(input SurfaceInput) bool {
 	_, found := e.selectCategory(input)
@@ -0,0 +1,32 @@
+package surface
+
+import (
+	"time"
+
+	"quantumlife/internal/obligations"
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+// InputFromHeld builds a surface input from the obligations a loop run
+// held rather than interrupted for (see loop.RunResult.HeldObligations).
+// Counts are bucketed immediately; only categories and magnitudes leave
+// this function.
+func InputFromHeld(held []*obligation.Obligation, pref string, now time.Time) SurfaceInput {
+	input := SurfaceInput{
+		HeldCategories: make(map[Category]MagnitudeBucket),
+		UserPreference: pref,
+		Now:            now,
+	}
+
+	counts := make(map[Category]int)
+	for _, obl := range held {
+		counts[Category(obligations.CategoryOf(obl))]++
+	}
+	for cat, n := range counts {
+		input.HeldCategories[cat] = MagnitudeBucket(shadowllm.MagnitudeFromCount(n))
+	}
+	input.SuppressedFinance = counts[CategoryMoney] > 0
+	input.SuppressedWork = counts[CategoryWork] > 0
+	return input
+}
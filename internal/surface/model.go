@@ -86,6 +86,46 @@ const (
 	MagnitudeSeveral MagnitudeBucket = "several"
 )
 
+// AgingBucket represents how long a category has been held (no durations).
+type AgingBucket string
+
+const (
+	AgingFresh     AgingBucket = "fresh"
+	AgingLingering AgingBucket = "lingering"
+	AgingStale     AgingBucket = "stale"
+)
+
+// Promotion thresholds bound the score a held category must reach
+// before it may be offered as a surface item.
+const (
+	// DefaultPromotionThreshold surfaces any category held at a_few or more.
+	DefaultPromotionThreshold = 1
+
+	// MaxPromotionThreshold requires several items held and stale.
+	MaxPromotionThreshold = 4
+)
+
+// PromotionScore combines magnitude and aging into a held-to-surface score.
+// A category held at nothing always scores 0 and is never promoted.
+func PromotionScore(mag MagnitudeBucket, aging AgingBucket) int {
+	score := 0
+	switch mag {
+	case MagnitudeAFew:
+		score = 1
+	case MagnitudeSeveral:
+		score = 2
+	default:
+		return 0
+	}
+	switch aging {
+	case AgingLingering:
+		score++
+	case AgingStale:
+		score += 2
+	}
+	return score
+}
+
 // SurfaceCue represents the subtle availability indicator shown on /today.
 // This is intentionally minimal - just a hint that something exists.
 type SurfaceCue struct {
@@ -157,6 +197,29 @@ type SurfacePage struct {
 	GeneratedAt time.Time
 }
 
+// MaxSurfaceListItems caps the show_all list at one item per category.
+const MaxSurfaceListItems = 5
+
+// SurfaceListPage represents the show_all surface page: every promotable
+// category as an abstract item, ranked deterministically.
+// Only built for users who have opted into show_all.
+type SurfaceListPage struct {
+	// Title is the page title.
+	Title string
+
+	// Subtitle is a calming subtitle.
+	Subtitle string
+
+	// Items are the surfaced items, highest priority first.
+	Items []SurfaceItem
+
+	// Hash is SHA256 of the canonical page for audit.
+	Hash string
+
+	// GeneratedAt is when this page was computed.
+	GeneratedAt time.Time
+}
+
 // Action represents user actions on surfaced items.
 type Action string
 
@@ -199,6 +262,10 @@ type SurfaceInput struct {
 	// SuppressedWork indicates work items are suppressed.
 	SuppressedWork bool
 
+	// HeldAging maps category to how long it has been held.
+	// Missing categories are treated as fresh.
+	HeldAging map[Category]AgingBucket
+
 	// Now is the current time (injected).
 	Now time.Time
 }
@@ -214,6 +281,9 @@ func (i SurfaceInput) Hash() string {
 		i.SuppressedWork,
 		i.Now.Unix(),
 	)
+	if len(i.HeldAging) > 0 {
+		canonical += fmt.Sprintf("|aging:%v", i.HeldAging)
+	}
 	h := sha256.Sum256([]byte(canonical))
 	return hex.EncodeToString(h[:])
 }
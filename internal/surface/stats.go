@@ -0,0 +1,122 @@
+package surface
+
+import (
+	"fmt"
+
+	"quantumlife/pkg/domain/shadowllm"
+	domaintrust "quantumlife/pkg/domain/trust"
+)
+
+// RatioBucket is an abstract share of surfaced items (no percentages).
+type RatioBucket string
+
+const (
+	RatioNone RatioBucket = "none" // nothing
+	RatioSome RatioBucket = "some" // less than half
+	RatioMost RatioBucket = "most" // half or more, but not all
+	RatioAll  RatioBucket = "all"  // every one
+)
+
+// RatioFromCounts buckets part out of whole. Counts are never exposed.
+func RatioFromCounts(part, whole int) RatioBucket {
+	switch {
+	case whole <= 0 || part <= 0:
+		return RatioNone
+	case part >= whole:
+		return RatioAll
+	case part*2 >= whole:
+		return RatioMost
+	default:
+		return RatioSome
+	}
+}
+
+// ActionStats is an abstract view of what the user did with surfaced
+// items in one period. Buckets only - raw counts never leave Stats.
+type ActionStats struct {
+	// Period is the ISO week key (e.g., "2024-W03").
+	Period string
+
+	// Surfaced buckets how many items were shown.
+	Surfaced MagnitudeBucket
+
+	// Held is the share of surfaced items the user chose to hold.
+	Held RatioBucket
+
+	// PreferShowAll is the share of surfaced items after which the user
+	// asked to see everything.
+	PreferShowAll RatioBucket
+
+	// Hash is SHA256 of the canonical stats for audit.
+	Hash string
+}
+
+// HasEvidence reports whether anything was surfaced in the period.
+func (s ActionStats) HasEvidence() bool {
+	return s.Surfaced != "" && s.Surfaced != MagnitudeNothing
+}
+
+// heldLines is calm proof copy by held ratio.
+var heldLines = map[RatioBucket]string{
+	RatioNone: "You looked at what we offered, and let it be.",
+	RatioSome: "Some of what we offered, you held for later.",
+	RatioMost: "Most of what we offered, you held for later.",
+	RatioAll:  "Everything we offered, you held for later.",
+}
+
+// HeldLine returns the calm line for the held ratio. Empty without evidence.
+func (s ActionStats) HeldLine() string {
+	if !s.HasEvidence() {
+		return ""
+	}
+	return heldLines[s.Held]
+}
+
+// PreferLine returns the calm line for the show_all ratio.
+// Empty without evidence or when the user never asked to see everything.
+func (s ActionStats) PreferLine() string {
+	if !s.HasEvidence() || s.PreferShowAll == RatioNone {
+		return ""
+	}
+	return "Sometimes you asked to see everything, so we showed more."
+}
+
+// Stats returns the abstract action stats for the ISO week period
+// (e.g., "2024-W03"). Surfaced items are views of a real item; views of
+// an empty surface page are not counted.
+func (s *ActionStore) Stats(period string) ActionStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var surfaced, held, preferShowAll int
+	for _, r := range s.records {
+		if domaintrust.WeekKey(r.RecordedAt) != period {
+			continue
+		}
+		switch r.Action {
+		case ActionViewed:
+			if r.ItemKeyHash != "" {
+				surfaced++
+			}
+		case ActionHeld:
+			held++
+		case ActionPreferShowAll:
+			preferShowAll++
+		}
+	}
+
+	stats := ActionStats{
+		Period:        period,
+		Surfaced:      MagnitudeBucket(shadowllm.MagnitudeFromCount(surfaced)),
+		Held:          RatioFromCounts(held, surfaced),
+		PreferShowAll: RatioFromCounts(preferShowAll, surfaced),
+	}
+	stats.Hash = computeHash(fmt.Sprintf(
+		"surface_stats|period:%s|surfaced:%s|held:%s|prefer:%s",
+		stats.Period,
+		stats.Surfaced,
+		stats.Held,
+		stats.PreferShowAll,
+	))
+	return stats
+}
@@ -0,0 +1,57 @@
+package todayquietly
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// QuietAffirmationLine is the single calm line shown when nothing applied.
+const QuietAffirmationLine = "Quiet, as intended."
+
+// QuietAffirmationInput captures what applied on /today for a period.
+// Flags only - no identifiers, no counts.
+type QuietAffirmationInput struct {
+	// Period is the day bucket (YYYY-MM-DD).
+	Period string
+
+	// CueShown indicates a whisper cue was selected.
+	CueShown bool
+
+	// ItemSurfaced indicates an item surfaced.
+	ItemSurfaced bool
+
+	// CueDismissed indicates a cue would have applied but was dismissed
+	// or acknowledged this period.
+	CueDismissed bool
+}
+
+// QuietAffirmation explicitly confirms that nothing surfaced this period.
+// It is distinct from an empty page: it only exists when silence was the outcome.
+type QuietAffirmation struct {
+	// Period is the day bucket (YYYY-MM-DD).
+	Period string
+
+	// Line is the calm affirmation copy.
+	Line string
+
+	// Hash is the SHA256 hash of the canonical string.
+	Hash string
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (a QuietAffirmation) CanonicalString() string {
+	return "QUIET_AFFIRMATION|v1|" + a.Period + "|" + a.Line
+}
+
+// BuildQuietAffirmation returns the affirmation only when truly nothing
+// applied: no cue selected, no item surfaced, and nothing dismissed.
+// Returns nil otherwise.
+func BuildQuietAffirmation(in QuietAffirmationInput) *QuietAffirmation {
+	if in.CueShown || in.ItemSurfaced || in.CueDismissed {
+		return nil
+	}
+	a := QuietAffirmation{Period: in.Period, Line: QuietAffirmationLine}
+	h := sha256.Sum256([]byte(a.CanonicalString()))
+	a.Hash = hex.EncodeToString(h[:])
+	return &a
+}
@@ -0,0 +1,65 @@
+package todayquietly
+
+import (
+	"strings"
+
+	"quantumlife/internal/loop"
+	"quantumlife/internal/obligations"
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/obligation"
+	"quantumlife/pkg/domain/shadowllm"
+)
+
+// InputFromLoop derives projection signals from a loop run.
+// Only presence flags and the circle count are taken; no obligation
+// content reaches the page. An empty circleID considers every circle.
+// The same run always yields the same input.
+func InputFromLoop(result *loop.RunResult, circleID identity.EntityID) ProjectionInput {
+	var input ProjectionInput
+	if result == nil {
+		return input
+	}
+	input.CircleCount = len(result.Circles)
+
+	for _, c := range result.Circles {
+		if circleID != "" && c.CircleID != circleID {
+			continue
+		}
+		if len(c.Obligations) == 0 {
+			continue
+		}
+
+		name := strings.ToLower(c.CircleName)
+		switch {
+		case strings.Contains(name, "work"):
+			input.HasWorkObligations = true
+		case strings.Contains(name, "family"), strings.Contains(name, "personal"), strings.Contains(name, "home"):
+			input.HasFamilyObligations = true
+		}
+
+		for _, obl := range c.Obligations {
+			switch obligations.CategoryOf(obl) {
+			case shadowllm.CategoryMoney:
+				input.HasFinanceObligations = true
+			case shadowllm.CategoryTime:
+				input.HasCalendarCommitments = true
+			}
+			if obl.Type == obligation.ObligationReply || obl.Type == obligation.ObligationFollowup {
+				input.HasOpenConversations = true
+			}
+			if isImportantNotTimeSensitive(obl) {
+				input.HasImportantNotTimeSensitive = true
+			}
+		}
+	}
+	return input
+}
+
+// isImportantNotTimeSensitive reports a high-severity obligation with no
+// deadline inside the next day.
+func isImportantNotTimeSensitive(obl *obligation.Obligation) bool {
+	if obl.Horizon != obligation.Horizon7d && obl.Horizon != obligation.HorizonSomeday {
+		return false
+	}
+	return obl.Severity == obligation.SeverityHigh || obl.Severity == obligation.SeverityCritical
+}
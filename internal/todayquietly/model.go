@@ -106,6 +106,10 @@ type PreferenceRecord struct {
 
 	// Source identifies where this preference came from.
 	Source string
+
+	// TimeBucket is the day the preference was recorded (e.g., "2024-01-15").
+	// History exposes only this, never RecordedAt.
+	TimeBucket string
 }
 
 // ProjectionInput contains the signals used to generate the page.
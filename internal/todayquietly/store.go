@@ -3,6 +3,7 @@ package todayquietly
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,6 +11,15 @@ import (
 	"time"
 )
 
+// MaxPreferenceHistory bounds how many past preferences History returns.
+const MaxPreferenceHistory = 10
+
+// SourceRevert is the source of a preference restored from history.
+const SourceRevert = "revert"
+
+// ErrPreferenceNotFound is returned when reverting to an unknown record.
+var ErrPreferenceNotFound = errors.New("preference record not found")
+
 // PreferenceStore provides append-only storage for preference records.
 type PreferenceStore struct {
 	mu      sync.RWMutex
@@ -79,6 +89,7 @@ func (s *PreferenceStore) Record(mode, source string) (bool, error) {
 		RecordedAt: now,
 		Hash:       hash,
 		Source:     source,
+		TimeBucket: TimeBucketFor(now),
 	}
 
 	s.records = append(s.records, record)
@@ -121,6 +132,47 @@ func (s *PreferenceStore) LatestPreference() string {
 	return s.records[len(s.records)-1].Mode
 }
 
+// History returns up to MaxPreferenceHistory records, newest first.
+// RecordedAt is left zero; only the day TimeBucket is exposed.
+func (s *PreferenceStore) History() []PreferenceRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]PreferenceRecord, 0, MaxPreferenceHistory)
+	for i := len(s.records) - 1; i >= 0 && len(result) < MaxPreferenceHistory; i-- {
+		r := s.records[i]
+		result = append(result, PreferenceRecord{
+			Mode:       r.Mode,
+			Hash:       r.Hash,
+			Source:     r.Source,
+			TimeBucket: r.TimeBucket,
+		})
+	}
+	return result
+}
+
+// Revert restores the preference recorded under hash by appending a new
+// record with the same mode. Earlier records are never modified.
+// Returns the restored mode.
+func (s *PreferenceStore) Revert(hash string) (string, error) {
+	s.mu.RLock()
+	mode := ""
+	for _, r := range s.records {
+		if r.Hash == hash {
+			mode = r.Mode
+			break
+		}
+	}
+	s.mu.RUnlock()
+
+	if mode == "" {
+		return "", ErrPreferenceNotFound
+	}
+	if _, err := s.Record(mode, SourceRevert); err != nil {
+		return "", err
+	}
+	return mode, nil
+}
+
 // appendToFile appends a record to the file.
 func (s *PreferenceStore) appendToFile(record PreferenceRecord) error {
 	// Ensure directory exists
@@ -151,6 +203,11 @@ func computeCanonicalString(mode, source string, t time.Time) string {
 	return fmt.Sprintf("%s|%s|%s", mode, source, t.Format(time.RFC3339))
 }
 
+// TimeBucketFor returns the day bucket for a preference time.
+func TimeBucketFor(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
 // computeHash computes SHA256 of a string.
 func computeHash(s string) string {
 	h := sha256.Sum256([]byte(s))
@@ -168,3 +225,11 @@ func ConfirmationMessage(mode string) string {
 		return "Preference recorded."
 	}
 }
+
+// Clear removes all preference records.
+func (s *PreferenceStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make([]PreferenceRecord, 0)
+	s.hashes = make(map[string]bool)
+}
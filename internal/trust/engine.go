@@ -20,7 +20,12 @@
 package trust
 
 import (
+	"sort"
+
 	"quantumlife/pkg/clock"
+	"quantumlife/pkg/domain/config"
+	"quantumlife/pkg/domain/deviceidentity"
+	"quantumlife/pkg/domain/replay"
 	"quantumlife/pkg/domain/shadowllm"
 	"quantumlife/pkg/domain/trust"
 )
@@ -45,6 +50,64 @@ type RestraintSource interface {
 	GetShadowRejectionCount(periodKey string, period trust.TrustPeriod) int
 }
 
+// =============================================================================
+// Config
+// =============================================================================
+
+const (
+	// DefaultMaxShown is how many summaries are shown at once by default.
+	DefaultMaxShown = 3
+
+	// MaxShownLimit is the hard ceiling on a configured MaxShown.
+	MaxShownLimit = 10
+)
+
+// Config holds the display policy for trust summaries.
+type Config struct {
+	// MeaningfulMinMagnitude is the smallest bucket treated as evidence.
+	// a_few by default; several gives an even quieter experience.
+	MeaningfulMinMagnitude shadowllm.MagnitudeBucket
+
+	// MaxShown caps how many summaries are shown at once.
+	MaxShown int
+}
+
+// DefaultConfig returns the default display policy.
+func DefaultConfig() Config {
+	return Config{
+		MeaningfulMinMagnitude: shadowllm.MagnitudeAFew,
+		MaxShown:               DefaultMaxShown,
+	}
+}
+
+// ConfigFromMultiCircle returns the configured display policy.
+// A nil config or unset fields give the defaults.
+func ConfigFromMultiCircle(cfg *config.MultiCircleConfig) Config {
+	if cfg == nil {
+		return DefaultConfig()
+	}
+	return Config{
+		MeaningfulMinMagnitude: shadowllm.MagnitudeBucket(cfg.TrustMeaningfulMin),
+		MaxShown:               cfg.TrustMaxShown,
+	}.normalized()
+}
+
+// normalized replaces unset or out-of-range fields with defaults.
+// "nothing" is never a valid minimum - silence is not evidence.
+func (c Config) normalized() Config {
+	def := DefaultConfig()
+	if !c.MeaningfulMinMagnitude.Validate() || c.MeaningfulMinMagnitude == shadowllm.MagnitudeNothing {
+		c.MeaningfulMinMagnitude = def.MeaningfulMinMagnitude
+	}
+	if c.MaxShown <= 0 {
+		c.MaxShown = def.MaxShown
+	}
+	if c.MaxShown > MaxShownLimit {
+		c.MaxShown = MaxShownLimit
+	}
+	return c
+}
+
 // =============================================================================
 // Engine
 // =============================================================================
@@ -58,11 +121,62 @@ type RestraintSource interface {
 //   - Deterministic: same inputs + clock => same outputs
 type Engine struct {
 	clk clock.Clock
+	cfg Config
+
+	// Optional: sources for signed statements
+	statementSource SummarySource
+	signer          StatementSigner
 }
 
 // NewEngine creates a new Trust Accrual Engine.
-func NewEngine(clk clock.Clock) *Engine {
-	return &Engine{clk: clk}
+func NewEngine(clk clock.Clock, cfg Config) *Engine {
+	return &Engine{clk: clk, cfg: cfg.normalized()}
+}
+
+// Config returns the engine's display thresholds.
+func (e *Engine) Config() Config {
+	return e.cfg
+}
+
+// IsMeaningful returns true if the summary reaches the configured
+// minimum magnitude.
+func (e *Engine) IsMeaningful(summary *trust.TrustSummary) bool {
+	return summary.IsMeaningfulAt(e.cfg.MeaningfulMinMagnitude)
+}
+
+// Visible filters summaries to the meaningful ones, capped at MaxShown.
+// Input order is preserved (callers pass most recent first).
+func (e *Engine) Visible(summaries []trust.TrustSummary) []trust.TrustSummary {
+	var result []trust.TrustSummary
+	for i := range summaries {
+		if len(result) >= e.cfg.MaxShown {
+			break
+		}
+		if e.IsMeaningful(&summaries[i]) {
+			result = append(result, summaries[i])
+		}
+	}
+	return result
+}
+
+// SummarySource looks up the stored summary for a period.
+type SummarySource interface {
+	GetSummaryByPeriod(periodKey string) (*trust.TrustSummary, bool)
+}
+
+// StatementSigner signs statements with the device key.
+// CRITICAL: Implementations must never expose the private key.
+type StatementSigner interface {
+	GetPublicKey() (deviceidentity.DevicePublicKey, error)
+	Sign(message []byte) (deviceidentity.Signature, error)
+}
+
+// WithStatementSigner configures the summary source and device signer
+// used by BuildStatement.
+func (e *Engine) WithStatementSigner(source SummarySource, signer StatementSigner) *Engine {
+	e.statementSource = source
+	e.signer = signer
+	return e
 }
 
 // ComputeInput contains inputs for computing a trust summary.
@@ -168,6 +282,111 @@ func (e *Engine) Compute(input ComputeInput) (*ComputeOutput, error) {
 	return output, nil
 }
 
+// =============================================================================
+// Aggregation
+// =============================================================================
+
+// Aggregate rolls summaries from every circle into one abstract
+// statement per period.
+//
+// CRITICAL:
+//   - Buckets combine with saturating arithmetic - never raw counts
+//   - Invalid and non-meaningful summaries are ignored
+//   - Deterministic regardless of summary order
+func (e *Engine) Aggregate(summaries []trust.TrustSummary) trust.AggregateSummary {
+	type periodID struct {
+		period    trust.TrustPeriod
+		periodKey string
+	}
+	byPeriod := make(map[periodID]*trust.AggregateStatement)
+
+	for i := range summaries {
+		s := &summaries[i]
+		if s.Validate() != nil || !e.IsMeaningful(s) {
+			continue
+		}
+		id := periodID{period: s.Period, periodKey: s.PeriodKey}
+		stmt, ok := byPeriod[id]
+		if !ok {
+			stmt = &trust.AggregateStatement{
+				Period:          s.Period,
+				PeriodKey:       s.PeriodKey,
+				SignalKind:      trust.SignalNothingRequired,
+				MagnitudeBucket: shadowllm.MagnitudeNothing,
+			}
+			byPeriod[id] = stmt
+		}
+		if s.SignalKind.SignalPriority() > stmt.SignalKind.SignalPriority() {
+			stmt.SignalKind = s.SignalKind
+		}
+		stmt.MagnitudeBucket = trust.CombineMagnitudes(stmt.MagnitudeBucket, s.MagnitudeBucket)
+	}
+
+	result := trust.AggregateSummary{
+		Statements: make([]trust.AggregateStatement, 0, len(byPeriod)),
+	}
+	for _, stmt := range byPeriod {
+		result.Statements = append(result.Statements, *stmt)
+	}
+
+	// Most recent period key first; period breaks ties
+	sort.Slice(result.Statements, func(i, j int) bool {
+		a, b := result.Statements[i], result.Statements[j]
+		if a.PeriodKey != b.PeriodKey {
+			return a.PeriodKey > b.PeriodKey
+		}
+		return a.Period < b.Period
+	})
+
+	result.AggregateHash = result.ComputeHash()
+	return result
+}
+
+// =============================================================================
+// Signed Statements
+// =============================================================================
+
+// BuildStatement builds a device-signed statement for the previous
+// complete period. A period with no summary yields a quiet statement.
+//
+// CRITICAL:
+//   - Only the period, buckets and hashes - no raw activity
+//   - Signed over the statement hash with the device key
+func (e *Engine) BuildStatement(circleID string, period trust.TrustPeriod) (trust.SignedStatement, error) {
+	if !period.Validate() {
+		return trust.SignedStatement{}, trust.ErrInvalidPeriod
+	}
+	if e.statementSource == nil || e.signer == nil {
+		return trust.SignedStatement{}, trust.ErrNoStatementSigner
+	}
+
+	st := trust.SignedStatement{
+		CircleIDHash:    replay.HashString(circleID),
+		Period:          period,
+		PeriodKey:       e.PreviousPeriodKey(period),
+		SignalKind:      trust.SignalNothingRequired,
+		MagnitudeBucket: shadowllm.MagnitudeNothing,
+	}
+	if summary, ok := e.statementSource.GetSummaryByPeriod(st.PeriodKey); ok && summary.Period == period {
+		st.SignalKind = summary.SignalKind
+		st.MagnitudeBucket = summary.MagnitudeBucket
+	}
+	st.StatementHash = st.ComputeHash()
+
+	pub, err := e.signer.GetPublicKey()
+	if err != nil {
+		return trust.SignedStatement{}, err
+	}
+	sig, err := e.signer.Sign([]byte(st.StatementHash))
+	if err != nil {
+		return trust.SignedStatement{}, err
+	}
+	st.PublicKey = pub
+	st.Signature = sig
+
+	return st, nil
+}
+
 // =============================================================================
 // Period Key Helpers
 // =============================================================================
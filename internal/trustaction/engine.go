@@ -6,7 +6,7 @@
 // CRITICAL INVARIANTS:
 //   - Only calendar_respond action allowed
 //   - Single execution per period (day)
-//   - 15-minute undo window (bucketed)
+//   - Bounded undo window (bucketed, 15 minutes by default)
 //   - Delegates to Phase 5 calendar execution boundary (no new execution paths)
 //   - No goroutines
 //   - No retries
@@ -24,12 +24,14 @@ import (
 	"time"
 
 	calexec "quantumlife/internal/calendar/execution"
+	"quantumlife/internal/execexecutor"
 	"quantumlife/internal/persist"
 	"quantumlife/internal/reality"
 	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/identity"
 	realitydomain "quantumlife/pkg/domain/reality"
 	"quantumlife/pkg/domain/trustaction"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // Engine orchestrates trust-confirming actions.
@@ -44,6 +46,8 @@ type Engine struct {
 	realityAckStore  *persist.RealityAckStore
 	trustActionStore *persist.TrustActionStore
 	realityEngine    *reality.Engine
+	undoConfig       undowindow.Config
+	dryRun           func() bool
 }
 
 // EngineConfig contains configuration for the engine.
@@ -55,6 +59,15 @@ type EngineConfig struct {
 	RealityAckStore  *persist.RealityAckStore
 	TrustActionStore *persist.TrustActionStore
 	RealityEngine    *reality.Engine
+
+	// Undo is the undo policy, usually undowindow.FromMultiCircle.
+	// The zero value uses the defaults.
+	Undo undowindow.Config
+
+	// DryRun reports whether dry-run mode is on, normally the draft
+	// executor's DryRun. While it is, Execute and Undo write nothing.
+	// Optional: nil never dry-runs.
+	DryRun func() bool
 }
 
 // NewEngine creates a new trust action engine.
@@ -67,6 +80,8 @@ func NewEngine(config EngineConfig) *Engine {
 		realityAckStore:  config.RealityAckStore,
 		trustActionStore: config.TrustActionStore,
 		realityEngine:    config.RealityEngine,
+		undoConfig:       config.Undo,
+		dryRun:           config.DryRun,
 	}
 }
 
@@ -262,7 +277,7 @@ func (e *Engine) Execute(ctx context.Context, circleID identity.EntityID, draftI
 	receipt := &trustaction.TrustActionReceipt{
 		ActionKind:   trustaction.ActionKindCalendarRespond,
 		State:        trustaction.StateExecuted,
-		UndoBucket:   trustaction.NewUndoBucket(now),
+		UndoBucket:   trustaction.NewUndoBucketWithConfig(e.undoConfig, now),
 		Period:       period,
 		CircleID:     string(circleID),
 		DraftIDHash:  trustaction.HashString(draftID),
@@ -283,7 +298,14 @@ func (e *Engine) Execute(ctx context.Context, circleID identity.EntityID, draftI
 }
 
 // executeCalendarDraft executes a calendar draft via the Phase 5 boundary.
+// In dry-run mode it refuses the write.
 func (e *Engine) executeCalendarDraft(ctx context.Context, d draft.Draft) calexec.ExecuteResult {
+	if e.dryRun != nil && e.dryRun() {
+		return calexec.ExecuteResult{
+			Success: false,
+			Error:   execexecutor.DryRunBlockedReason,
+		}
+	}
 	if e.calendarExecutor == nil {
 		return calexec.ExecuteResult{
 			Success: false,
@@ -3,13 +3,16 @@
 // Phase 25: First Undoable Execution (Opt-In, Single-Shot)
 //
 // This engine orchestrates the first real external write that is undoable.
-// Phase 25 ONLY supports calendar_respond because:
-//   - Email send is not truly undoable
-//   - Finance is not undoable
-//   - Calendar RSVP can be reversed by applying previous response
+// It supports:
+//   - calendar_respond: RSVP is reversed by applying previous response
+//   - email_scheduled_send: the provider holds the reply for the undo
+//     window, and undo cancels it before dispatch
+//
+// A sent email is not undoable, so email is only offered when the
+// provider can delay a send. Finance is not undoable.
 //
 // CRITICAL INVARIANTS:
-//   - Only calendar execution boundary is called
+//   - Only the calendar and email execution boundaries are called
 //   - Single-shot per period (max one execution)
 //   - Undo window is bounded (bucketed time)
 //   - Undo is a first-class flow, not "best effort"
@@ -29,18 +32,24 @@ import (
 	"time"
 
 	calexec "quantumlife/internal/calendar/execution"
+	emailexec "quantumlife/internal/email/execution"
+	"quantumlife/internal/execexecutor"
 	"quantumlife/internal/persist"
 	"quantumlife/pkg/domain/draft"
 	"quantumlife/pkg/domain/identity"
 	"quantumlife/pkg/domain/undoableexec"
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // Engine orchestrates undoable execution.
 type Engine struct {
 	clock            func() time.Time
 	calendarExecutor *calexec.Executor
+	emailExecutor    *emailexec.Executor
 	draftStore       draft.Store
 	undoStore        *persist.UndoableExecStore
+	undoConfig       undowindow.Config
+	dryRun           func() bool
 }
 
 // EngineConfig contains configuration for the engine.
@@ -49,6 +58,19 @@ type EngineConfig struct {
 	CalendarExecutor *calexec.Executor
 	DraftStore       draft.Store
 	UndoStore        *persist.UndoableExecStore
+
+	// EmailExecutor enables undoable email sends. Optional: without it,
+	// or for providers that cannot schedule sends, email is not offered.
+	EmailExecutor *emailexec.Executor
+
+	// Undo is the undo policy, usually undowindow.FromMultiCircle.
+	// The zero value uses the defaults.
+	Undo undowindow.Config
+
+	// DryRun reports whether dry-run mode is on, normally the draft
+	// executor's DryRun. While it is, RunOnce and Undo write nothing.
+	// Optional: nil never dry-runs.
+	DryRun func() bool
 }
 
 // NewEngine creates a new undoable execution engine.
@@ -56,8 +78,11 @@ func NewEngine(config EngineConfig) *Engine {
 	return &Engine{
 		clock:            config.Clock,
 		calendarExecutor: config.CalendarExecutor,
+		emailExecutor:    config.EmailExecutor,
 		draftStore:       config.DraftStore,
 		undoStore:        config.UndoStore,
+		undoConfig:       config.Undo,
+		dryRun:           config.DryRun,
 	}
 }
 
@@ -79,12 +104,14 @@ func (e *Engine) EligibleAction(ctx context.Context, circleID identity.EntityID)
 		}
 	}
 
-	// Find approved calendar response drafts
+	// Find approved calendar response drafts, plus email replies whose
+	// provider can hold the send for the undo window
 	candidates := e.findApprovedCalendarDrafts(circleID)
+	candidates = append(candidates, e.findSchedulableEmailDrafts(circleID)...)
 	if len(candidates) == 0 {
 		return &undoableexec.ActionEligibility{
 			Eligible:  false,
-			Reason:    "no approved calendar drafts",
+			Reason:    "no approved undoable drafts",
 			PeriodKey: periodKey,
 			CircleID:  string(circleID),
 		}
@@ -97,12 +124,20 @@ func (e *Engine) EligibleAction(ctx context.Context, circleID identity.EntityID)
 		Eligible:   true,
 		Reason:     "eligible",
 		DraftID:    string(selected.DraftID),
-		ActionKind: undoableexec.ActionKindCalendarRespond,
+		ActionKind: actionKindForDraft(selected),
 		PeriodKey:  periodKey,
 		CircleID:   string(circleID),
 	}
 }
 
+// actionKindForDraft returns the undoable action kind for a candidate draft.
+func actionKindForDraft(d draft.Draft) undoableexec.UndoableActionKind {
+	if d.DraftType == draft.DraftTypeEmailReply {
+		return undoableexec.ActionKindEmailScheduledSend
+	}
+	return undoableexec.ActionKindCalendarRespond
+}
+
 // findApprovedCalendarDrafts finds all approved calendar response drafts.
 func (e *Engine) findApprovedCalendarDrafts(circleID identity.EntityID) []draft.Draft {
 	if e.draftStore == nil {
@@ -118,6 +153,31 @@ func (e *Engine) findApprovedCalendarDrafts(circleID identity.EntityID) []draft.
 	return e.draftStore.List(filter)
 }
 
+// findSchedulableEmailDrafts finds approved email reply drafts whose
+// provider can schedule a send.
+//
+// CRITICAL: A provider that cannot delay a send is never offered -
+// without a hold window there is nothing for undo to cancel.
+func (e *Engine) findSchedulableEmailDrafts(circleID identity.EntityID) []draft.Draft {
+	if e.draftStore == nil || e.emailExecutor == nil {
+		return nil
+	}
+
+	filter := draft.ListFilter{
+		CircleID:  circleID,
+		Status:    draft.StatusApproved,
+		DraftType: draft.DraftTypeEmailReply,
+	}
+	var result []draft.Draft
+	for _, d := range e.draftStore.List(filter) {
+		content, ok := d.EmailContent()
+		if ok && e.emailExecutor.CanSchedule(content.ProviderHint) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
 // selectLowestHash deterministically selects the draft with lowest hash.
 func (e *Engine) selectLowestHash(candidates []draft.Draft) draft.Draft {
 	if len(candidates) == 0 {
@@ -143,6 +203,9 @@ type RunOnceResult struct {
 	// ExecResult contains execution details.
 	ExecResult *calexec.ExecuteResult
 
+	// EmailEnvelope is the scheduled email envelope (email sends only).
+	EmailEnvelope *emailexec.Envelope
+
 	// Error contains error details if failed.
 	Error string
 }
@@ -180,6 +243,10 @@ func (e *Engine) RunOnce(ctx context.Context, circleID identity.EntityID, draftI
 		}
 	}
 
+	if eligibility.ActionKind == undoableexec.ActionKindEmailScheduledSend {
+		return e.runEmailOnce(ctx, d, periodKey, now)
+	}
+
 	// Get calendar content
 	calContent, ok := d.CalendarContent()
 	if !ok {
@@ -205,7 +272,8 @@ func (e *Engine) RunOnce(ctx context.Context, circleID identity.EntityID, draftI
 	}
 
 	// Create undo record
-	undoRecord := undoableexec.NewUndoRecord(
+	undoRecord := undoableexec.NewUndoRecordWithConfig(
+		e.undoConfig,
 		periodKey,
 		string(circleID),
 		undoableexec.ActionKindCalendarRespond,
@@ -228,8 +296,90 @@ func (e *Engine) RunOnce(ctx context.Context, circleID identity.EntityID, draftI
 	}
 }
 
+// runEmailOnce schedules an email reply via the email boundary, held
+// until the undo window closes.
+func (e *Engine) runEmailOnce(ctx context.Context, d draft.Draft, periodKey string, now time.Time) *RunOnceResult {
+	if e.dryRunning() {
+		return &RunOnceResult{
+			Success: false,
+			Error:   execexecutor.DryRunBlockedReason,
+		}
+	}
+	if e.emailExecutor == nil {
+		return &RunOnceResult{
+			Success: false,
+			Error:   "no email executor",
+		}
+	}
+
+	// Use empty policy/view snapshots for Phase 25 (simplified)
+	traceID := fmt.Sprintf("phase25-undoable-%s", d.DraftID)
+	envelope, err := emailexec.NewEnvelopeFromDraft(d, "phase25-undoable", "phase25-undoable", now, traceID, now)
+	if err != nil {
+		return &RunOnceResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+
+	// The provider holds the reply until the undo window closes, so an
+	// undo inside the window always lands before dispatch.
+	undoRecord := undoableexec.NewUndoRecordWithConfig(
+		e.undoConfig,
+		periodKey,
+		string(d.CircleID),
+		undoableexec.ActionKindEmailScheduledSend,
+		string(d.DraftID),
+		envelope.EnvelopeID,
+		undoableexec.StatusUnsent,
+		undoableexec.StatusScheduled,
+		now,
+	)
+	sendAt := undoRecord.UndoAvailableUntilBucket.Deadline()
+
+	// CRITICAL: This is the ONLY external write path
+	result, err := e.emailExecutor.ExecuteScheduled(ctx, *envelope, sendAt)
+	if err != nil {
+		return &RunOnceResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+	if result.Status != emailexec.EnvelopeStatusScheduled {
+		reason := "email send not scheduled"
+		if result.ExecutionResult != nil && result.ExecutionResult.BlockedReason != "" {
+			reason = result.ExecutionResult.BlockedReason
+		} else if result.ExecutionResult != nil && result.ExecutionResult.Error != "" {
+			reason = result.ExecutionResult.Error
+		}
+		return &RunOnceResult{
+			Success:       false,
+			EmailEnvelope: result,
+			Error:         reason,
+		}
+	}
+
+	// Store undo record
+	if e.undoStore != nil {
+		_ = e.undoStore.AppendRecord(undoRecord)
+	}
+
+	return &RunOnceResult{
+		Success:       true,
+		UndoRecord:    undoRecord,
+		EmailEnvelope: result,
+	}
+}
+
 // executeCalendarDraft executes a calendar draft via the calendar boundary.
+// In dry-run mode it refuses the write.
 func (e *Engine) executeCalendarDraft(ctx context.Context, d draft.Draft) calexec.ExecuteResult {
+	if e.dryRunning() {
+		return calexec.ExecuteResult{
+			Success: false,
+			Error:   execexecutor.DryRunBlockedReason,
+		}
+	}
 	if e.calendarExecutor == nil {
 		return calexec.ExecuteResult{
 			Success: false,
@@ -252,6 +402,11 @@ func (e *Engine) executeCalendarDraft(ctx context.Context, d draft.Draft) calexe
 	return e.calendarExecutor.ExecuteFromDraft(ctx, d, policySnapshot, viewSnapshot, traceID)
 }
 
+// dryRunning reports whether dry-run mode is on.
+func (e *Engine) dryRunning() bool {
+	return e.dryRun != nil && e.dryRun()
+}
+
 // UndoResult contains the result of an undo operation.
 type UndoResult struct {
 	// Success indicates the undo succeeded.
@@ -263,6 +418,9 @@ type UndoResult struct {
 	// ExecResult contains execution details.
 	ExecResult *calexec.ExecuteResult
 
+	// EmailEnvelope is the cancelled email envelope (email sends only).
+	EmailEnvelope *emailexec.Envelope
+
 	// Error contains error details if failed.
 	Error string
 }
@@ -297,6 +455,10 @@ func (e *Engine) Undo(ctx context.Context, undoRecordID string) *UndoResult {
 		}
 	}
 
+	if record.ActionKind == undoableexec.ActionKindEmailScheduledSend {
+		return e.undoEmail(ctx, record, now)
+	}
+
 	// Get the original draft to build reversal
 	originalDraft, found := e.draftStore.Get(draft.DraftID(record.DraftID))
 	if !found {
@@ -330,6 +492,41 @@ func (e *Engine) Undo(ctx context.Context, undoRecordID string) *UndoResult {
 	}
 }
 
+// undoEmail cancels a scheduled email send before the provider
+// dispatches it.
+func (e *Engine) undoEmail(ctx context.Context, record *undoableexec.UndoRecord, now time.Time) *UndoResult {
+	if e.dryRunning() {
+		return &UndoResult{
+			Success: false,
+			Error:   execexecutor.DryRunBlockedReason,
+		}
+	}
+	if e.emailExecutor == nil {
+		return &UndoResult{
+			Success: false,
+			Error:   "no email executor",
+		}
+	}
+
+	envelope, err := e.emailExecutor.CancelScheduled(ctx, record.EnvelopeID)
+	if err != nil {
+		return &UndoResult{
+			Success: false,
+			Error:   err.Error(),
+		}
+	}
+
+	// Create and store ack
+	ack := undoableexec.NewUndoAck(record.ID, undoableexec.StateUndone, now, "scheduled send cancelled")
+	_ = e.undoStore.AppendAck(ack)
+
+	return &UndoResult{
+		Success:       true,
+		Ack:           ack,
+		EmailEnvelope: envelope,
+	}
+}
+
 // buildReversalDraft creates a draft to reverse the original action.
 func (e *Engine) buildReversalDraft(original draft.Draft, targetStatus undoableexec.ResponseStatus) draft.Draft {
 	calContent, _ := original.CalendarContent()
@@ -64,6 +64,25 @@ func (f FuncClock) Now() time.Time {
 	return f()
 }
 
+// Sleeper is implemented by clocks that can block for a duration.
+// Code that must wait calls Sleep with its injected Clock, so tests can
+// use a clock that records waits instead of blocking.
+type Sleeper interface {
+	Sleep(d time.Duration)
+}
+
+// Sleep blocks for d using the system timer.
+func (RealClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// Sleep waits for d if c implements Sleeper; other clocks return at once.
+func Sleep(c Clock, d time.Duration) {
+	if s, ok := c.(Sleeper); ok {
+		s.Sleep(d)
+	}
+}
+
 // NewReal returns a Clock that uses the real system time.
 // ONLY use at application entry points (cmd/*).
 func NewReal() Clock {
@@ -87,4 +106,6 @@ var (
 	_ Clock = RealClock{}
 	_ Clock = FixedClock{}
 	_ Clock = FuncClock(nil)
+
+	_ Sleeper = RealClock{}
 )
@@ -120,6 +120,10 @@ type ApprovalState struct {
 	// ActionClass is the type of action being approved.
 	ActionClass intersection.ActionClass
 
+	// ActionHash binds the approval to one exact action, such as one
+	// version of a draft's execution intent. Empty when unbound.
+	ActionHash string
+
 	// RequiredApprovers are the approvers needed.
 	RequiredApprovers []ApproverRef
 
@@ -191,11 +195,22 @@ func NewApprovalState(
 	return s
 }
 
+// BindAction ties the state to one exact action and recomputes its ID,
+// so approvals for one version of a target never cover another.
+func (s *ApprovalState) BindAction(actionHash string) {
+	s.ActionHash = actionHash
+	s.StateID = s.computeStateID()
+	s.ComputeHash()
+}
+
 // computeStateID generates a deterministic ID for the state.
 func (s *ApprovalState) computeStateID() string {
 	input := fmt.Sprintf("approval_state|%s|%s|%s|%s|%s",
 		s.TargetType, s.TargetID, s.IntersectionID, s.ActionClass,
 		s.CreatedAt.UTC().Format(time.RFC3339))
+	if s.ActionHash != "" {
+		input += "|" + s.ActionHash
+	}
 	hash := sha256.Sum256([]byte(input))
 	return hex.EncodeToString(hash[:])[:16]
 }
@@ -234,7 +249,16 @@ func (s *ApprovalState) ComputeStatus(now time.Time) Status {
 		}
 	}
 
-	// Count valid approvals
+	// Check threshold
+	if s.ApprovedCount(now) >= s.Threshold {
+		return StatusApproved
+	}
+
+	return StatusPending
+}
+
+// ApprovedCount returns the number of approvals still fresh at now.
+func (s *ApprovalState) ApprovedCount(now time.Time) int {
 	approvedCount := 0
 	for _, approval := range s.Approvals {
 		if approval.Decision == DecisionApproved {
@@ -245,13 +269,7 @@ func (s *ApprovalState) ComputeStatus(now time.Time) Status {
 			}
 		}
 	}
-
-	// Check threshold
-	if approvedCount >= s.Threshold {
-		return StatusApproved
-	}
-
-	return StatusPending
+	return approvedCount
 }
 
 // IsApproverRequired checks if a person is a required approver.
@@ -315,6 +333,10 @@ func (s *ApprovalState) CanonicalString() string {
 	sb.WriteString(s.IntersectionID)
 	sb.WriteString("|action:")
 	sb.WriteString(string(s.ActionClass))
+	if s.ActionHash != "" {
+		sb.WriteString("|action_hash:")
+		sb.WriteString(s.ActionHash)
+	}
 	sb.WriteString("|threshold:")
 	sb.WriteString(fmt.Sprintf("%d", s.Threshold))
 	sb.WriteString("|max_age:")
@@ -423,6 +445,22 @@ func (s *ApprovalStateSet) GetByTarget(targetType TargetType, targetID string) *
 	return nil
 }
 
+// GetByAction returns the newest state for a target bound to actionHash,
+// ties broken by state ID.
+func (s *ApprovalStateSet) GetByAction(targetType TargetType, targetID, actionHash string) *ApprovalState {
+	var newest *ApprovalState
+	for _, state := range s.States {
+		if state.TargetType != targetType || state.TargetID != targetID || state.ActionHash != actionHash {
+			continue
+		}
+		if newest == nil || state.CreatedAt.After(newest.CreatedAt) ||
+			(state.CreatedAt.Equal(newest.CreatedAt) && state.StateID > newest.StateID) {
+			newest = state
+		}
+	}
+	return newest
+}
+
 // List returns all states in deterministic order.
 func (s *ApprovalStateSet) List() []*ApprovalState {
 	ids := make([]string, 0, len(s.States))
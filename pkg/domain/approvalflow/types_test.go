@@ -619,3 +619,30 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestBindActionSeparatesStates(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	approvers := []ApproverRef{{PersonID: "person-satish", Role: intersection.RoleOwner}}
+
+	newState := func(actionHash string) *ApprovalState {
+		s := NewApprovalState(TargetTypeDraft, "draft-123", "", intersection.ActionEmailSend, approvers, 1, 60, now)
+		s.BindAction(actionHash)
+		return s
+	}
+	a := newState("hash-a")
+	b := newState("hash-b")
+
+	if a.StateID == b.StateID || a.Hash == b.Hash {
+		t.Error("states bound to different actions must have different IDs and hashes")
+	}
+
+	set := NewApprovalStateSet()
+	set.Add(a)
+	set.Add(b)
+	if got := set.GetByAction(TargetTypeDraft, "draft-123", "hash-a"); got == nil || got.StateID != a.StateID {
+		t.Errorf("expected the state bound to hash-a, got %+v", got)
+	}
+	if got := set.GetByAction(TargetTypeDraft, "draft-123", "hash-c"); got != nil {
+		t.Errorf("expected no state for an unbound action, got %s", got.StateID)
+	}
+}
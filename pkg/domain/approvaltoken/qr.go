@@ -0,0 +1,27 @@
+// Package approvaltoken defines signed approval tokens for link-based approvals.
+// This file renders approval links as QR codes for approving from another device.
+package approvaltoken
+
+import (
+	"strings"
+
+	"quantumlife/pkg/qrcode"
+)
+
+// QRScale is the number of pixels per QR module in rendered PNGs.
+const QRScale = 6
+
+// URL returns the approval link that presents this token at baseURL.
+func (t *Token) URL(baseURL string) string {
+	return strings.TrimRight(baseURL, "/") + "/approve?t=" + t.Encode()
+}
+
+// QRCodePNG renders the approval link as a PNG QR code.
+// The same token and base URL always produce the same bytes.
+func (t *Token) QRCodePNG(baseURL string) ([]byte, error) {
+	code, err := qrcode.Encode([]byte(t.URL(baseURL)))
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(QRScale)
+}
@@ -0,0 +1,112 @@
+// Package approvaltoken defines signed approval tokens for link-based approvals.
+// This file signs tokens and verifies presented tokens against the issuer key.
+package approvaltoken
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+
+	"quantumlife/pkg/domain/deviceidentity"
+)
+
+// SignatureAlgorithmEd25519 is the only signature algorithm tokens accept.
+const SignatureAlgorithmEd25519 = "Ed25519"
+
+// Token verification errors.
+var (
+	// ErrTokenUnsigned is returned for tokens that carry no signature.
+	ErrTokenUnsigned = errors.New("approval token is not signed")
+
+	// ErrTokenIDMismatch is returned when a token's ID does not match
+	// the ID recomputed from its fields.
+	ErrTokenIDMismatch = errors.New("approval token ID does not match its contents")
+
+	// ErrTokenSignature is returned when the signature does not verify
+	// under the issuer key.
+	ErrTokenSignature = errors.New("approval token signature is invalid")
+)
+
+// Signer signs token bytes with the issuer's Ed25519 key.
+// Implemented by persist.DeviceKeyStore and KeySigner.
+type Signer interface {
+	Sign(message []byte) (deviceidentity.Signature, error)
+	GetPublicKey() (deviceidentity.DevicePublicKey, error)
+}
+
+// KeySigner is a Signer over an in-memory Ed25519 key, for issuers whose
+// tokens do not need to outlive the process.
+type KeySigner struct {
+	private ed25519.PrivateKey
+}
+
+// NewKeySigner returns a signer for the given private key.
+func NewKeySigner(private ed25519.PrivateKey) *KeySigner {
+	return &KeySigner{private: private}
+}
+
+// Sign signs message and returns the hex signature.
+func (s *KeySigner) Sign(message []byte) (deviceidentity.Signature, error) {
+	return deviceidentity.Signature(hex.EncodeToString(ed25519.Sign(s.private, message))), nil
+}
+
+// GetPublicKey returns the hex public key.
+func (s *KeySigner) GetPublicKey() (deviceidentity.DevicePublicKey, error) {
+	pub := s.private.Public().(ed25519.PublicKey)
+	return deviceidentity.DevicePublicKey(hex.EncodeToString(pub)), nil
+}
+
+// KeyIDFor returns the key ID tokens carry for an issuer public key.
+func KeyIDFor(pub deviceidentity.DevicePublicKey) string {
+	return pub.Fingerprint().Short()
+}
+
+// Sign recomputes the token ID and hash from the token fields and signs
+// the canonical string with signer.
+func (t *Token) Sign(signer Signer) error {
+	pub, err := signer.GetPublicKey()
+	if err != nil {
+		return err
+	}
+	t.TokenID = t.computeTokenID()
+	t.Hash = t.computeHash()
+	sig, err := signer.Sign(t.SignableBytes())
+	if err != nil {
+		return err
+	}
+	sigBytes, err := sig.ToBytes()
+	if err != nil {
+		return err
+	}
+	t.SetSignature(SignatureAlgorithmEd25519, KeyIDFor(pub), sigBytes)
+	return nil
+}
+
+// Verify checks a presented token against the issuer public key.
+//
+// The token ID and hash are recomputed from the canonical fields, so a
+// token whose ID was edited is rejected rather than treated as new.
+// Unsigned tokens, other algorithms and other keys are rejected.
+func (t *Token) Verify(pub deviceidentity.DevicePublicKey) error {
+	if err := t.IsValid(); err != nil {
+		return err
+	}
+	if t.TokenID != t.computeTokenID() {
+		return ErrTokenIDMismatch
+	}
+	t.Hash = t.computeHash()
+	if !t.IsSigned() {
+		return ErrTokenUnsigned
+	}
+	if t.SignatureAlgorithm != SignatureAlgorithmEd25519 || t.KeyID != KeyIDFor(pub) {
+		return ErrTokenSignature
+	}
+	pubBytes, err := pub.ToBytes()
+	if err != nil || len(pubBytes) != ed25519.PublicKeySize {
+		return ErrTokenSignature
+	}
+	if !ed25519.Verify(pubBytes, t.SignableBytes(), t.Signature) {
+		return ErrTokenSignature
+	}
+	return nil
+}
@@ -263,11 +263,18 @@ func Decode(encoded string) (*Token, error) {
 	return t, nil
 }
 
+// ErrTokenRevoked is returned when a revoked token is presented.
+var ErrTokenRevoked = errors.New("approval token revoked")
+
 // TokenSet holds multiple tokens for batch operations.
 type TokenSet struct {
 	// Tokens maps token ID to token.
 	Tokens map[string]*Token
 
+	// Revoked holds revoked token IDs. A revoked ID stays revoked even if
+	// the token was never stored, so a leaked token cannot be redeemed.
+	Revoked map[string]bool
+
 	// Version is incremented on each update.
 	Version int
 
@@ -279,6 +286,7 @@ type TokenSet struct {
 func NewTokenSet() *TokenSet {
 	s := &TokenSet{
 		Tokens:  make(map[string]*Token),
+		Revoked: make(map[string]bool),
 		Version: 1,
 	}
 	s.ComputeHash()
@@ -345,6 +353,27 @@ func (s *TokenSet) Remove(tokenID string) bool {
 	return false
 }
 
+// Revoke removes a token and marks its ID revoked.
+// Returns false if the ID was already revoked.
+func (s *TokenSet) Revoke(tokenID string) bool {
+	if s.Revoked[tokenID] {
+		return false
+	}
+	if s.Revoked == nil {
+		s.Revoked = make(map[string]bool)
+	}
+	s.Revoked[tokenID] = true
+	delete(s.Tokens, tokenID)
+	s.Version++
+	s.ComputeHash()
+	return true
+}
+
+// IsRevoked returns whether a token ID has been revoked.
+func (s *TokenSet) IsRevoked(tokenID string) bool {
+	return s.Revoked[tokenID]
+}
+
 // PruneExpired removes all expired tokens.
 func (s *TokenSet) PruneExpired(now time.Time) int {
 	pruned := 0
@@ -377,6 +406,17 @@ func (s *TokenSet) CanonicalString() string {
 	}
 	sb.WriteString("]")
 
+	if len(s.Revoked) > 0 {
+		revoked := make([]string, 0, len(s.Revoked))
+		for id := range s.Revoked {
+			revoked = append(revoked, id)
+		}
+		bubbleSort(revoked)
+		sb.WriteString("|revoked:[")
+		sb.WriteString(strings.Join(revoked, ","))
+		sb.WriteString("]")
+	}
+
 	return sb.String()
 }
 
@@ -1,6 +1,9 @@
 package approvaltoken
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
 	"testing"
 	"time"
 
@@ -131,6 +134,39 @@ func TestTokenSignature(t *testing.T) {
 	}
 }
 
+func TestTokenSignVerify(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	issuer := NewKeySigner(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)))
+	pub, _ := issuer.GetPublicKey()
+
+	token := NewToken("state-1", "person-satish", ActionTypeApprove, now, now.Add(time.Hour))
+	if err := token.Verify(pub); !errors.Is(err, ErrTokenUnsigned) {
+		t.Errorf("expected ErrTokenUnsigned, got %v", err)
+	}
+	if err := token.Sign(issuer); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	decoded, err := Decode(token.Encode())
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if err := decoded.Verify(pub); err != nil {
+		t.Errorf("signed token should verify after a roundtrip: %v", err)
+	}
+
+	decoded.PersonID = "person-other"
+	if err := decoded.Verify(pub); !errors.Is(err, ErrTokenIDMismatch) {
+		t.Errorf("expected ErrTokenIDMismatch for edited fields, got %v", err)
+	}
+
+	other := NewKeySigner(ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize)))
+	otherPub, _ := other.GetPublicKey()
+	if err := token.Verify(otherPub); !errors.Is(err, ErrTokenSignature) {
+		t.Errorf("expected ErrTokenSignature under another key, got %v", err)
+	}
+}
+
 func TestTokenEncodeDecodeRoundtrip(t *testing.T) {
 	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 	expires := now.Add(60 * time.Minute)
@@ -383,3 +419,34 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestTokenQRCodeDeterministic(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	token := NewToken("state-123", "person-satish", ActionTypeApprove, now, now.Add(time.Hour))
+	token.SignatureAlgorithm = "Ed25519"
+	token.KeyID = "key-1"
+	token.Signature = []byte("signature-bytes")
+
+	url := token.URL("http://localhost:8080/")
+	if url != "http://localhost:8080/approve?t="+token.Encode() {
+		t.Errorf("unexpected approval URL: %s", url)
+	}
+
+	p1, err := token.QRCodePNG("http://localhost:8080")
+	if err != nil {
+		t.Fatalf("QRCodePNG failed: %v", err)
+	}
+	p2, _ := token.QRCodePNG("http://localhost:8080")
+	if string(p1) != string(p2) {
+		t.Error("same token should render identical PNG bytes")
+	}
+	if !contains(string(p1[:8]), "PNG") {
+		t.Error("expected PNG signature")
+	}
+
+	other := NewToken("state-123", "person-satish", ActionTypeReject, now, now.Add(time.Hour))
+	p3, _ := other.QRCodePNG("http://localhost:8080")
+	if string(p1) == string(p3) {
+		t.Error("different tokens should render different PNGs")
+	}
+}
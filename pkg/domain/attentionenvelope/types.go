@@ -28,6 +28,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // Storage constraints
@@ -454,8 +456,7 @@ func (r *EnvelopeReceipt) ComputeStatusHash() string {
 		r.EnvelopeHash,
 		r.Action,
 	)
-	h := sha256.Sum256([]byte(content))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("attentionenvelope.EnvelopeReceipt", content)[:32]
 }
 
 // Validate checks if the receipt is valid.
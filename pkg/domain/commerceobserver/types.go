@@ -198,6 +198,8 @@ const (
 	SourceGmailReceipt SourceKind = "gmail_receipt"
 	// SourceFinanceTrueLayer indicates observation from TrueLayer transaction sync (Phase 31.2).
 	SourceFinanceTrueLayer SourceKind = "finance_truelayer"
+	// SourceOutlookReceipt indicates observation from Outlook receipt classification.
+	SourceOutlookReceipt SourceKind = "outlook_receipt"
 )
 
 // AllSourceKinds returns all source kinds in deterministic order.
@@ -205,13 +207,14 @@ func AllSourceKinds() []SourceKind {
 	return []SourceKind{
 		SourceGmailReceipt,
 		SourceFinanceTrueLayer,
+		SourceOutlookReceipt,
 	}
 }
 
 // Validate checks if the source kind is valid.
 func (s SourceKind) Validate() error {
 	switch s {
-	case SourceGmailReceipt, SourceFinanceTrueLayer:
+	case SourceGmailReceipt, SourceFinanceTrueLayer, SourceOutlookReceipt:
 		return nil
 	default:
 		return fmt.Errorf("invalid source kind: %s", s)
@@ -221,7 +224,7 @@ func (s SourceKind) Validate() error {
 // DisplayText returns human-readable text for the source.
 func (s SourceKind) DisplayText() string {
 	switch s {
-	case SourceGmailReceipt:
+	case SourceGmailReceipt, SourceOutlookReceipt:
 		return "Email receipt"
 	case SourceFinanceTrueLayer:
 		return "Bank transaction"
@@ -235,7 +238,7 @@ func (s SourceKind) DisplayText() string {
 // CRITICAL: Contains NO raw data, NO identifiable info.
 // Only: source, category bucket, frequency bucket, stability bucket, period, evidence hash.
 type CommerceObservation struct {
-	// Source indicates where this observation originated (gmail_receipt, finance_truelayer).
+	// Source indicates where this observation originated (gmail_receipt, finance_truelayer, outlook_receipt).
 	Source SourceKind
 
 	// Category is the abstract category bucket.
@@ -0,0 +1,287 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/execintent"
+	"quantumlife/pkg/domain/policy"
+)
+
+// DefaultSourcePath is the SourcePath of a config built from code defaults
+// rather than read from a file.
+const DefaultSourcePath = "(default)"
+
+// EffectiveSource says where an effective setting came from.
+type EffectiveSource string
+
+const (
+	SourceFile    EffectiveSource = "file"    // the config file
+	SourceDefault EffectiveSource = "default" // a code default
+	SourceEnv     EffectiveSource = "env"     // an environment variable override
+)
+
+// Masked values stand in for secrets. A secret's value is never shown,
+// only whether it is present.
+const (
+	MaskedSet   = "(set)"
+	MaskedUnset = "(unset)"
+)
+
+// EffectiveSetting is one setting as it is actually in effect.
+type EffectiveSetting struct {
+	// Key is the config path, e.g. "shadow.provider_kind".
+	Key string
+
+	// Value is the effective value. Secrets hold MaskedSet or MaskedUnset.
+	Value string
+
+	// Source is where Value came from.
+	Source EffectiveSource
+
+	// EnvVar names the environment variable when Source is SourceEnv.
+	EnvVar string
+
+	// Secret marks a masked value.
+	Secret bool
+}
+
+// EffectiveView is the merged, redacted config: file values, code defaults
+// and environment overrides, in a fixed order.
+// CRITICAL: Never holds API keys, endpoints or other secrets.
+type EffectiveView struct {
+	// ConfigHash is the file config's hash (MultiCircleConfig.Hash).
+	ConfigHash string
+
+	// SourcePath is the config file path, or DefaultSourcePath.
+	SourcePath string
+
+	// Settings are in canonical order.
+	Settings []EffectiveSetting
+}
+
+// CanonicalString returns a deterministic, pipe-delimited representation.
+func (v *EffectiveView) CanonicalString() string {
+	var b strings.Builder
+	b.WriteString("EFFECTIVE_CONFIG|v1|config_hash:" + v.ConfigHash + "\n")
+	for _, s := range v.Settings {
+		b.WriteString(s.Key + "|" + s.Value + "|" + string(s.Source))
+		if s.EnvVar != "" {
+			b.WriteString(":" + s.EnvVar)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Hash returns the SHA256 of the canonical view.
+func (v *EffectiveView) Hash() string {
+	h := sha256.Sum256([]byte(v.CanonicalString()))
+	return hex.EncodeToString(h[:])
+}
+
+// FromEnv returns the settings overridden by environment variables.
+func (v *EffectiveView) FromEnv() []EffectiveSetting {
+	var out []EffectiveSetting
+	for _, s := range v.Settings {
+		if s.Source == SourceEnv {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// EnvLookup reads an environment variable, like os.LookupEnv.
+// Domain code never reads the environment itself.
+type EnvLookup func(key string) (string, bool)
+
+// EffectiveView merges the config with code defaults and the environment
+// overrides the server applies (QL_SHADOW_*, AZURE_OPENAI_*,
+// SHADOW_MAX_SUGGESTIONS). A nil env means no overrides.
+// CRITICAL: The API key and the endpoint are masked.
+func (c *MultiCircleConfig) EffectiveView(env EnvLookup) EffectiveView {
+	if env == nil {
+		env = func(string) (string, bool) { return "", false }
+	}
+	getenv := func(key string) string {
+		v, _ := env(key)
+		return v
+	}
+
+	var settings []EffectiveSetting
+	add := func(key, value string, source EffectiveSource) {
+		settings = append(settings, EffectiveSetting{Key: key, Value: value, Source: source})
+	}
+	addEnv := func(key, value, envVar string) {
+		settings = append(settings, EffectiveSetting{Key: key, Value: value, Source: SourceEnv, EnvVar: envVar})
+	}
+	// pick reports a file value when set, otherwise the default.
+	pick := func(key, value, def string) {
+		if value != "" && value != def {
+			add(key, value, SourceFile)
+			return
+		}
+		add(key, def, SourceDefault)
+	}
+
+	circleSource := SourceFile
+	if c.SourcePath == DefaultSourcePath {
+		circleSource = SourceDefault
+	}
+	for _, id := range c.CircleIDs() {
+		circle := c.Circles[id]
+		prefix := "circle:" + string(id)
+		add(prefix+".name", circle.Name, circleSource)
+		for i, e := range circle.EmailIntegrations {
+			add(fmt.Sprintf("%s.email[%d]", prefix, i), e.Provider+":"+e.Identifier+":"+strings.Join(e.Scopes, ","), circleSource)
+		}
+		for i, cal := range circle.CalendarIntegrations {
+			add(fmt.Sprintf("%s.calendar[%d]", prefix, i), cal.Provider+":"+cal.CalendarID+":"+strings.Join(cal.Scopes, ","), circleSource)
+		}
+		for i, f := range circle.FinanceIntegrations {
+			add(fmt.Sprintf("%s.finance[%d]", prefix, i), f.Provider+":"+f.Identifier+":"+strings.Join(f.Scopes, ","), circleSource)
+		}
+		pick(prefix+".email_sync_max_messages", positive(circle.EmailSyncMaxMessages), "adapter default")
+		pick(prefix+".email_sync_window_days", positive(circle.EmailSyncWindowDays), "sync lookback")
+		if circle.EmailSyncLabels != nil {
+			add(prefix+".email_sync_labels", strings.Join(circle.EmailSyncLabels, ","), circleSource)
+		}
+		if circle.QuietHours != "" {
+			add(prefix+".quiet_hours", circle.QuietHours, circleSource)
+			pick(prefix+".quiet_hours_timezone", circle.QuietHoursTimezone, "display timezone")
+		}
+	}
+
+	pick("routing.work_domains", strings.Join(c.Routing.WorkDomains, ","), "none")
+	pick("routing.personal_domains", strings.Join(c.Routing.PersonalDomains, ","), "none")
+	pick("routing.vip_senders", strings.Join(c.Routing.VIPSenders, ","), "none")
+	pick("routing.family_members", strings.Join(c.Routing.FamilyMembers, ","), "none")
+
+	if refusals := refusalNames(c.Refusals); refusals != refusalNames(policy.DefaultRefusals()) {
+		if refusals == "" {
+			refusals = "none"
+		}
+		add("policy.refusals", refusals, SourceFile)
+	} else {
+		add("policy.refusals", refusals, SourceDefault)
+	}
+
+	for _, kind := range connection.AllKinds() {
+		key := "sync." + string(kind) + "_lookback_days"
+		value := itoa(c.Sync.EffectiveLookbackDays(kind))
+		if _, ok := c.Sync.LookbackDays[kind]; ok {
+			add(key, value, SourceFile)
+		} else {
+			add(key, value, SourceDefault)
+		}
+	}
+
+	thresholds := c.Approvals.Thresholds()
+	for _, action := range execintent.AllActionClasses() {
+		key := "approvals." + string(action)
+		value := itoa(thresholds.Required(action))
+		if _, ok := c.Approvals.Required[action]; ok {
+			add(key, value, SourceFile)
+		} else {
+			add(key, value, SourceDefault)
+		}
+	}
+
+	if c.DefaultCircleID != "" && c.Circles[c.DefaultCircleID] != nil {
+		add("defaults.circle", string(c.DefaultCircleID), SourceFile)
+	} else {
+		add("defaults.circle", string(c.DefaultCircle()), SourceDefault)
+	}
+	pick("proof.categories", strings.Join(c.ProofCategories, ","), "all")
+	pick("surface.promotion_threshold", positive(c.SurfacePromotionThreshold), "engine default")
+	pick("display.timezone", c.DisplayTimezone, "UTC")
+	pick("magnitude.a_few_max", positive(c.MagnitudeAFewMax), "3")
+	pick("trust.meaningful_min", c.TrustMeaningfulMin, "engine default")
+	pick("trust.max_shown", positive(c.TrustMaxShown), "engine default")
+	pick("undo.window_minutes", positive(c.UndoWindowMinutes), "15")
+
+	// Shadow: QL_SHADOW_* override the file, as in the server.
+	def := DefaultShadowConfig()
+	shadow := c.Shadow
+	pick("shadow.mode", shadow.Mode, def.Mode)
+	pick("shadow.model", shadow.ModelName, def.ModelName)
+	if v := getenv("QL_SHADOW_PROVIDER_KIND"); v != "" {
+		addEnv("shadow.provider_kind", v, "QL_SHADOW_PROVIDER_KIND")
+	} else if shadow.ProviderKind == "none" {
+		add("shadow.provider_kind", def.ProviderKind, SourceFile)
+	} else {
+		pick("shadow.provider_kind", shadow.ProviderKind, def.ProviderKind)
+	}
+	if getenv("QL_SHADOW_REAL_ALLOWED") == "true" && !shadow.RealAllowed {
+		addEnv("shadow.real_allowed", "true", "QL_SHADOW_REAL_ALLOWED")
+	} else {
+		pick("shadow.real_allowed", strconv.FormatBool(shadow.RealAllowed), "false")
+	}
+	if n, err := strconv.Atoi(getenv("SHADOW_MAX_SUGGESTIONS")); err == nil && n > 0 {
+		addEnv("shadow.max_suggestions", strconv.Itoa(n), "SHADOW_MAX_SUGGESTIONS")
+	} else {
+		pick("shadow.max_suggestions", strconv.Itoa(shadow.GetMaxSuggestions()), strconv.Itoa(DefaultMaxSuggestions))
+	}
+
+	// Azure: file values win; AZURE_OPENAI_* fill what the file leaves empty.
+	azure := shadow.AzureOpenAI
+	fileOrEnv := func(key, value, def string, envVars ...string) {
+		if value != "" && value != def {
+			add(key, value, SourceFile)
+			return
+		}
+		for _, name := range envVars {
+			if v := getenv(name); v != "" {
+				addEnv(key, v, name)
+				return
+			}
+		}
+		add(key, def, SourceDefault)
+	}
+	endpoint := EffectiveSetting{Key: "shadow.azure_endpoint", Value: MaskedUnset, Source: SourceDefault, Secret: true}
+	if azure.Endpoint != "" {
+		endpoint.Value, endpoint.Source = MaskedSet, SourceFile
+	} else if getenv("AZURE_OPENAI_ENDPOINT") != "" {
+		endpoint.Value, endpoint.Source, endpoint.EnvVar = MaskedSet, SourceEnv, "AZURE_OPENAI_ENDPOINT"
+	}
+	settings = append(settings, endpoint)
+	fileOrEnv("shadow.azure_chat_deployment", azure.GetChatDeployment(), "none", "AZURE_OPENAI_CHAT_DEPLOYMENT", "AZURE_OPENAI_DEPLOYMENT")
+	fileOrEnv("shadow.azure_embed_deployment", azure.EmbedDeployment, "none", "AZURE_OPENAI_EMBED_DEPLOYMENT")
+	fileOrEnv("shadow.azure_api_version", azure.APIVersion, DefaultAzureOpenAIAPIVersion, "AZURE_OPENAI_API_VERSION")
+	pick("shadow.azure_key_env_name", azure.APIKeyEnvName, DefaultAzureAPIKeyEnvName)
+	keyEnv := azure.GetAPIKeyEnvName()
+	apiKey := EffectiveSetting{Key: "shadow.azure_api_key", Value: MaskedUnset, Source: SourceDefault, Secret: true}
+	if getenv(keyEnv) != "" {
+		apiKey.Value, apiKey.Source, apiKey.EnvVar = MaskedSet, SourceEnv, keyEnv
+	}
+	settings = append(settings, apiKey)
+	pick("shadow.azure_endpoint_allowlist", strings.Join(azure.EndpointAllowlist, ","), "any")
+
+	return EffectiveView{
+		ConfigHash: c.Hash(),
+		SourcePath: c.SourcePath,
+		Settings:   settings,
+	}
+}
+
+// positive formats n, or returns "" when n is unset (zero or less).
+func positive(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// refusalNames lists refusal classes in canonical order.
+func refusalNames(r policy.Refusals) string {
+	ordered := r.Ordered()
+	names := make([]string, len(ordered))
+	for i, class := range ordered {
+		names[i] = string(class)
+	}
+	return strings.Join(names, ",")
+}
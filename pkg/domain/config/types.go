@@ -17,6 +17,29 @@
 //	personal_domains = gmail.com, outlook.com
 //	vip_senders = alice@work.com, bob@work.com
 //
+//	[policy]
+//	refusals = never_send, never_pay, never_share
+//
+//	[sync]
+//	email_lookback_days = 7
+//	finance_lookback_days = 30
+//
+//	[approvals]
+//	email_send = 1
+//	finance_payment = 2
+//
+//	[defaults]
+//	circle = personal
+//
+//	[proof]
+//	categories = money, time, work, people, home
+//
+//	[surface]
+//	promotion_threshold = 1
+//
+//	[display]
+//	timezone = UTC
+//
 // Example:
 //
 //	[circle:work]
@@ -40,10 +63,14 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/domain/execintent"
 	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/policy"
 )
 
 // CircleConfig defines a single circle's configuration.
@@ -62,6 +89,26 @@ type CircleConfig struct {
 
 	// FinanceIntegrations lists finance integration configurations.
 	FinanceIntegrations []FinanceIntegration
+
+	// EmailSyncMaxMessages is the configured manual-sync message cap.
+	// Zero means the default. Clamped by the sync adapter's hard ceiling.
+	EmailSyncMaxMessages int
+
+	// EmailSyncWindowDays is the configured manual-sync window in days.
+	// Zero means the [sync] email lookback. Clamped by the hard ceiling.
+	EmailSyncWindowDays int
+
+	// EmailSyncLabels is the manual-sync Gmail label filter ("-ID" excludes).
+	// Nil means the default (skip Promotions and Social); empty means none.
+	EmailSyncLabels []string
+
+	// QuietHours is a daily "HH:MM-HH:MM" window during which nothing is
+	// surfaced for this circle. May wrap midnight. Empty means none.
+	QuietHours string
+
+	// QuietHoursTimezone is the IANA zone QuietHours is read in.
+	// Empty means the display timezone.
+	QuietHoursTimezone string
 }
 
 // EmailIntegration defines an email integration.
@@ -126,6 +173,48 @@ type MultiCircleConfig struct {
 	// Shadow contains shadow-mode configuration (Phase 19).
 	Shadow ShadowConfig
 
+	// Refusals is the declared set of action classes the system refuses.
+	// Rendered verbatim on /proof/refusals.
+	Refusals policy.Refusals
+
+	// Sync contains per-kind sync configuration.
+	Sync SyncConfig
+
+	// Approvals contains per-action-class approval thresholds.
+	Approvals ApprovalsConfig
+
+	// DefaultCircleID is the configured default circle (may be empty).
+	// Use DefaultCircle() to resolve it.
+	DefaultCircleID identity.EntityID
+
+	// ProofCategories limits the categories counted by the quiet proof.
+	// Empty means all categories.
+	ProofCategories []string
+
+	// SurfacePromotionThreshold is the minimum held-to-surface score
+	// before a surface item is offered. Zero means the engine default.
+	SurfacePromotionThreshold int
+
+	// DisplayTimezone is the IANA zone for human-readable times in pages.
+	// Empty means UTC. Never affects period keys.
+	DisplayTimezone string
+
+	// MagnitudeAFewMax is the largest count bucketed as "a few".
+	// Zero means the default (3).
+	MagnitudeAFewMax int
+
+	// TrustMeaningfulMin is the smallest magnitude bucket shown as trust
+	// evidence ("a_few" or "several"). Empty means the engine default.
+	TrustMeaningfulMin string
+
+	// TrustMaxShown caps how many trust summaries are shown at once.
+	// Zero means the engine default.
+	TrustMaxShown int
+
+	// UndoWindowMinutes is how long undo stays open after an undoable
+	// execution or trust action. Zero means the default (15).
+	UndoWindowMinutes int
+
 	// LoadedAt is when the config was loaded.
 	LoadedAt time.Time
 
@@ -157,7 +246,7 @@ type ShadowConfig struct {
 
 	// Phase 19.3: Provider configuration
 	// ProviderKind identifies the provider type.
-	// Valid values: "none", "stub" (default), "azure_openai", "local_slm"
+	// Valid values: "none", "stub" (default), "azure_openai", "anthropic", "local_slm"
 	ProviderKind string
 
 	// RealAllowed indicates if real (non-stub) providers are permitted.
@@ -209,6 +298,11 @@ type AzureOpenAIConfig struct {
 	// Default: "AZURE_OPENAI_API_KEY"
 	// CRITICAL: Never store actual keys in config files.
 	APIKeyEnvName string
+
+	// EndpointAllowlist restricts the endpoint to approved hosts.
+	// Example: ["your-resource.openai.azure.com"]
+	// Empty allows any endpoint.
+	EndpointAllowlist []string
 }
 
 // GetChatDeployment returns the effective chat deployment name.
@@ -239,7 +333,8 @@ func (c *AzureOpenAIConfig) CanonicalString() string {
 		"|chat:" + c.GetChatDeployment() +
 		"|embed:" + c.EmbedDeployment +
 		"|api_version:" + c.APIVersion +
-		"|key_env:" + c.GetAPIKeyEnvName()
+		"|key_env:" + c.GetAPIKeyEnvName() +
+		"|allow:" + strings.Join(c.EndpointAllowlist, ",")
 }
 
 // DefaultAzureOpenAIAPIVersion is the default Azure OpenAI API version.
@@ -367,6 +462,122 @@ func (c *ShadowConfig) GetMaxSuggestions() int {
 	return c.MaxSuggestions
 }
 
+// DefaultSyncLookbackDays is the default sync lookback for every connection kind.
+const DefaultSyncLookbackDays = 7
+
+// maxSyncLookbackDays caps the configurable lookback per connection kind.
+// CRITICAL: Config can never widen a sync beyond these bounds.
+var maxSyncLookbackDays = map[connection.ConnectionKind]int{
+	connection.KindEmail:    30,
+	connection.KindCalendar: 30,
+	connection.KindFinance:  90,
+}
+
+// MaxSyncLookbackDays returns the hard lookback cap for a connection kind.
+// Unknown kinds are capped at the default lookback.
+func MaxSyncLookbackDays(kind connection.ConnectionKind) int {
+	if max, ok := maxSyncLookbackDays[kind]; ok {
+		return max
+	}
+	return DefaultSyncLookbackDays
+}
+
+// SyncConfig contains per-kind sync configuration.
+//
+// Each sync handler consults the effective lookback for its kind.
+// Unset kinds use DefaultSyncLookbackDays; configured values are clamped
+// to MaxSyncLookbackDays for the kind.
+type SyncConfig struct {
+	// LookbackDays maps connection kind to configured lookback in days.
+	LookbackDays map[connection.ConnectionKind]int
+}
+
+// EffectiveLookbackDays returns the clamped lookback in days for a kind.
+func (c *SyncConfig) EffectiveLookbackDays(kind connection.ConnectionKind) int {
+	days, ok := c.LookbackDays[kind]
+	if !ok || days <= 0 {
+		days = DefaultSyncLookbackDays
+	}
+	if max := MaxSyncLookbackDays(kind); days > max {
+		days = max
+	}
+	return days
+}
+
+// EffectiveLookback returns the clamped lookback duration for a kind.
+func (c *SyncConfig) EffectiveLookback(kind connection.ConnectionKind) time.Duration {
+	return time.Duration(c.EffectiveLookbackDays(kind)) * 24 * time.Hour
+}
+
+// LookbackBucket returns a lookback window as an abstract magnitude
+// relative to DefaultSyncLookbackDays, for event metadata. It is the one
+// bucket scheme for sync windows of every connection kind.
+func LookbackBucket(days int) string {
+	switch {
+	case days <= DefaultSyncLookbackDays:
+		return "a_few"
+	case days <= 2*DefaultSyncLookbackDays:
+		return "several"
+	default:
+		return "many"
+	}
+}
+
+// CanonicalString returns a deterministic string representation.
+func (c *SyncConfig) CanonicalString() string {
+	var b strings.Builder
+	b.WriteString("sync")
+	for _, kind := range connection.AllKinds() {
+		b.WriteString("|")
+		b.WriteString(string(kind))
+		b.WriteString("_lookback:")
+		b.WriteString(itoa(c.EffectiveLookbackDays(kind)))
+	}
+	return b.String()
+}
+
+// ApprovalsConfig contains per-action-class approval thresholds and the
+// people who may approve drafts.
+//
+// Unset classes use execintent.DefaultApprovalThresholds.
+type ApprovalsConfig struct {
+	// Required maps an action class to the approvals a draft needs
+	// before it executes (0 = the user's confirm is enough).
+	Required map[execintent.ActionClass]int
+
+	// Approvers are the person IDs issued approval tokens for drafts.
+	// Empty means the circle owner alone approves.
+	Approvers []identity.EntityID
+}
+
+// Thresholds returns the defaults with the configured values applied.
+func (c *ApprovalsConfig) Thresholds() execintent.ApprovalThresholds {
+	return execintent.DefaultApprovalThresholds().WithOverrides(c.Required)
+}
+
+// CanonicalString returns a deterministic string representation.
+func (c *ApprovalsConfig) CanonicalString() string {
+	var b strings.Builder
+	b.WriteString("approvals")
+	thresholds := c.Thresholds()
+	for _, action := range execintent.AllActionClasses() {
+		b.WriteString("|")
+		b.WriteString(string(action))
+		b.WriteString(":")
+		b.WriteString(itoa(thresholds.Required(action)))
+	}
+	if len(c.Approvers) > 0 {
+		b.WriteString("|approvers:")
+		for i, id := range c.Approvers {
+			if i > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString(string(id))
+		}
+	}
+	return b.String()
+}
+
 // CircleIDs returns circle IDs in deterministic sorted order.
 func (c *MultiCircleConfig) CircleIDs() []identity.EntityID {
 	ids := make([]identity.EntityID, 0, len(c.Circles))
@@ -379,6 +590,34 @@ func (c *MultiCircleConfig) CircleIDs() []identity.EntityID {
 	return ids
 }
 
+// DisplayLocation returns the location for human-readable times.
+// Falls back to UTC when unset or unknown.
+func (c *MultiCircleConfig) DisplayLocation() *time.Location {
+	if c.DisplayTimezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(c.DisplayTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// DefaultCircle returns the configured default circle if it exists,
+// otherwise the first circle in sorted order. Empty if no circles exist.
+func (c *MultiCircleConfig) DefaultCircle() identity.EntityID {
+	if c.DefaultCircleID != "" {
+		if _, ok := c.Circles[c.DefaultCircleID]; ok {
+			return c.DefaultCircleID
+		}
+	}
+	ids := c.CircleIDs()
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
 // GetCircle returns a circle config by ID.
 func (c *MultiCircleConfig) GetCircle(id identity.EntityID) *CircleConfig {
 	return c.Circles[id]
@@ -431,6 +670,23 @@ func (c *MultiCircleConfig) CanonicalString() string {
 			b.WriteString(f)
 		}
 
+		if circle.EmailSyncMaxMessages > 0 || circle.EmailSyncWindowDays > 0 {
+			b.WriteString("|email_sync:")
+			b.WriteString(strconv.Itoa(circle.EmailSyncMaxMessages))
+			b.WriteString(",")
+			b.WriteString(strconv.Itoa(circle.EmailSyncWindowDays))
+		}
+		if circle.EmailSyncLabels != nil {
+			b.WriteString("|email_sync_labels:")
+			b.WriteString(strings.Join(circle.EmailSyncLabels, ","))
+		}
+		if circle.QuietHours != "" {
+			b.WriteString("|quiet_hours:")
+			b.WriteString(circle.QuietHours)
+			b.WriteString(",")
+			b.WriteString(circle.QuietHoursTimezone)
+		}
+
 		b.WriteString("\n")
 	}
 
@@ -474,6 +730,37 @@ func (c *MultiCircleConfig) CanonicalString() string {
 	}
 	// Note: Azure config excluded from canonical string as it contains runtime env vars
 
+	b.WriteString("\npolicy|")
+	b.WriteString(c.Refusals.CanonicalString())
+	b.WriteString("\n")
+	b.WriteString(c.Sync.CanonicalString())
+	if len(c.Approvals.Required) > 0 {
+		b.WriteString("\n")
+		b.WriteString(c.Approvals.CanonicalString())
+	}
+	b.WriteString("\ndefaults|circle:")
+	b.WriteString(string(c.DefaultCircle()))
+	b.WriteString("\nproof|categories:")
+	b.WriteString(strings.Join(c.ProofCategories, ","))
+	b.WriteString("\nsurface|promotion_threshold:")
+	b.WriteString(strconv.Itoa(c.SurfacePromotionThreshold))
+	b.WriteString("\ndisplay|timezone:")
+	b.WriteString(c.DisplayTimezone)
+	if c.MagnitudeAFewMax > 0 {
+		b.WriteString("\nmagnitude|a_few_max:")
+		b.WriteString(strconv.Itoa(c.MagnitudeAFewMax))
+	}
+	if c.TrustMeaningfulMin != "" || c.TrustMaxShown > 0 {
+		b.WriteString("\ntrust|meaningful_min:")
+		b.WriteString(c.TrustMeaningfulMin)
+		b.WriteString("|max_shown:")
+		b.WriteString(strconv.Itoa(c.TrustMaxShown))
+	}
+	if c.UndoWindowMinutes > 0 {
+		b.WriteString("\nundo|window_minutes:")
+		b.WriteString(strconv.Itoa(c.UndoWindowMinutes))
+	}
+
 	return b.String()
 }
 
@@ -493,6 +780,12 @@ func (c *MultiCircleConfig) Validate() error {
 		return &ConfigError{Field: "circles", Message: "at least one circle required"}
 	}
 
+	if c.DefaultCircleID != "" {
+		if _, ok := c.Circles[c.DefaultCircleID]; !ok {
+			return &ConfigError{Field: "defaults.circle", Message: "default circle not configured: " + string(c.DefaultCircleID)}
+		}
+	}
+
 	for id, circle := range c.Circles {
 		if string(id) == "" {
 			return &ConfigError{Field: "circle.id", Message: "circle ID cannot be empty"}
@@ -0,0 +1,85 @@
+package connection
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// AccessClass is the abstract access class a connection was authorized for.
+// Never contains provider scopes.
+type AccessClass string
+
+const (
+	AccessRead  AccessClass = "read"
+	AccessWrite AccessClass = "write"
+)
+
+// AccessClassFor returns the access class authorized for a connection kind.
+// All connection kinds are read-only by design.
+func AccessClassFor(kind ConnectionKind) AccessClass {
+	return AccessRead
+}
+
+// ConsentEntry is one abstract connect or revoke record.
+//
+// CRITICAL: Contains ONLY abstract buckets and hashes. No tokens, no scopes.
+type ConsentEntry struct {
+	// Kind is the connection kind.
+	Kind ConnectionKind `json:"kind"`
+
+	// Action is connect or disconnect.
+	Action IntentAction `json:"action"`
+
+	// Access is the abstract access class authorized.
+	Access AccessClass `json:"access"`
+
+	// PeriodBucket is the day bucket (YYYY-MM-DD) of the action.
+	PeriodBucket string `json:"period_bucket"`
+
+	// ReceiptHash is the SHA256 hash of the recorded intent.
+	ReceiptHash string `json:"receipt_hash"`
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (e ConsentEntry) CanonicalString() string {
+	return "CONSENT_ENTRY|v1|" + string(e.Kind) + "|" + string(e.Action) + "|" +
+		string(e.Access) + "|" + e.PeriodBucket + "|" + e.ReceiptHash
+}
+
+// ConsentHistory is the consolidated consent history across connection kinds.
+type ConsentHistory struct {
+	// Entries are grouped by kind (AllKinds order), then chronological.
+	Entries []ConsentEntry `json:"entries"`
+
+	// HistoryHash is the SHA256 hash of all entries.
+	HistoryHash string `json:"history_hash"`
+}
+
+// BuildConsentHistory assembles the consent history from real connect and
+// disconnect intents. Mock intents are not consent and are skipped.
+func BuildConsentHistory(intents IntentList) ConsentHistory {
+	history := ConsentHistory{Entries: []ConsentEntry{}}
+	for _, kind := range AllKinds() {
+		for _, intent := range intents.ByKind(kind) {
+			if intent.Mode != ModeReal {
+				continue
+			}
+			history.Entries = append(history.Entries, ConsentEntry{
+				Kind:         intent.Kind,
+				Action:       intent.Action,
+				Access:       AccessClassFor(intent.Kind),
+				PeriodBucket: intent.At.UTC().Format("2006-01-02"),
+				ReceiptHash:  intent.Hash(),
+			})
+		}
+	}
+
+	parts := make([]string, len(history.Entries))
+	for i, e := range history.Entries {
+		parts[i] = e.CanonicalString()
+	}
+	h := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	history.HistoryHash = hex.EncodeToString(h[:])
+	return history
+}
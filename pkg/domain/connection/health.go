@@ -0,0 +1,143 @@
+package connection
+
+import "time"
+
+// Health is the abstract health indicator for a connection.
+// Never exposes raw metrics (timestamps, counts, error text).
+type Health string
+
+const (
+	HealthHealthy  Health = "healthy"
+	HealthStale    Health = "stale"
+	HealthDegraded Health = "degraded"
+)
+
+// String returns the string representation of the health.
+func (h Health) String() string {
+	return string(h)
+}
+
+// DisplayText returns human-readable text for the health.
+func (h Health) DisplayText() string {
+	switch h {
+	case HealthHealthy:
+		return "Healthy"
+	case HealthStale:
+		return "Quiet for a while"
+	case HealthDegraded:
+		return "Needs a look"
+	default:
+		return "Unknown"
+	}
+}
+
+// RecencyBucket is the abstract age of the last sync.
+type RecencyBucket string
+
+const (
+	RecencyNever  RecencyBucket = "never"
+	RecencyRecent RecencyBucket = "recent"
+	RecencyStale  RecencyBucket = "stale"
+)
+
+// StaleAfter is how long after the last sync a connection becomes stale.
+const StaleAfter = 24 * time.Hour
+
+// RecencyFor buckets the last sync time relative to now.
+// A zero lastSync means the connection has never synced.
+func RecencyFor(lastSync, now time.Time) RecencyBucket {
+	if lastSync.IsZero() {
+		return RecencyNever
+	}
+	if now.Sub(lastSync) > StaleAfter {
+		return RecencyStale
+	}
+	return RecencyRecent
+}
+
+// ExpiryBucket is the abstract time left before stored credentials lapse.
+type ExpiryBucket string
+
+const (
+	ExpirySoon  ExpiryBucket = "soon"
+	ExpiryLater ExpiryBucket = "later"
+)
+
+// ExpiringSoonWithin is how close to expiry credentials count as expiring soon.
+const ExpiringSoonWithin = 48 * time.Hour
+
+// ExpiryFor buckets the time remaining before credentials lapse.
+func ExpiryFor(remaining time.Duration) ExpiryBucket {
+	if remaining <= ExpiringSoonWithin {
+		return ExpirySoon
+	}
+	return ExpiryLater
+}
+
+// HealthInput is the abstract input for health derivation.
+type HealthInput struct {
+	// Recency is the bucketed age of the last sync receipt.
+	Recency RecencyBucket
+
+	// LastSyncSuccess indicates the last sync receipt succeeded.
+	LastSyncSuccess bool
+
+	// Live indicates the liveness check passed (credentials present).
+	Live bool
+}
+
+// DeriveHealth combines liveness, last-sync success and recency.
+//
+// Rules (first match wins):
+// - not live → degraded
+// - never synced → stale
+// - last sync failed → degraded
+// - last sync older than StaleAfter → stale
+// - otherwise → healthy
+func DeriveHealth(in HealthInput) Health {
+	switch {
+	case !in.Live:
+		return HealthDegraded
+	case in.Recency == RecencyNever:
+		return HealthStale
+	case !in.LastSyncSuccess:
+		return HealthDegraded
+	case in.Recency == RecencyStale:
+		return HealthStale
+	default:
+		return HealthHealthy
+	}
+}
+
+// TokenStatus is the abstract state of a source's stored credentials.
+// Never exposes token values, scopes or provider error text.
+type TokenStatus string
+
+const (
+	TokenValid   TokenStatus = "valid"
+	TokenExpired TokenStatus = "expired"
+	TokenRevoked TokenStatus = "revoked"
+
+	// TokenUnknown means the check itself could not complete
+	// (for example, the provider was unreachable).
+	TokenUnknown TokenStatus = "unknown"
+)
+
+// String returns the string representation of the token status.
+func (t TokenStatus) String() string {
+	return string(t)
+}
+
+// DisplayText returns human-readable text for the token status.
+func (t TokenStatus) DisplayText() string {
+	switch t {
+	case TokenValid:
+		return "Access is current"
+	case TokenExpired:
+		return "Access has expired"
+	case TokenRevoked:
+		return "Access was withdrawn"
+	default:
+		return "Could not check"
+	}
+}
@@ -0,0 +1,125 @@
+// Package cuereason describes why a whisper cue won the single-whisper
+// selection on /today.
+//
+// CRITICAL: Reasons carry ONLY abstract inputs (buckets, flags, hashes).
+// CRITICAL: No goroutines. No time.Now(). Stdlib only.
+// CRITICAL: Reasons are diagnostic only. They never influence selection.
+package cuereason
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CueKind identifies a whisper cue participating in the single-whisper rule.
+type CueKind string
+
+const (
+	KindSurface       CueKind = "surface"
+	KindProof         CueKind = "proof"
+	KindFirstMinutes  CueKind = "first_minutes"
+	KindReality       CueKind = "reality"
+	KindShadowReceipt CueKind = "shadow_receipt"
+	KindTrustAction   CueKind = "trust_action"
+	KindTrustTransfer CueKind = "trust_transfer"
+	KindUnviewed      CueKind = "unviewed_connection"
+)
+
+// PriorityOrder returns cue kinds from highest to lowest priority.
+// The single whisper rule on /today shows the first available cue in this
+// order.
+func PriorityOrder() []CueKind {
+	return []CueKind{
+		KindSurface,
+		KindProof,
+		KindFirstMinutes,
+		KindReality,
+		KindShadowReceipt,
+		KindTrustAction,
+		KindTrustTransfer,
+		KindUnviewed,
+	}
+}
+
+// PriorityRank returns the 1-based priority rank, or 0 if unknown.
+func (k CueKind) PriorityRank() int {
+	for i, kind := range PriorityOrder() {
+		if kind == k {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Candidate is a cue considered by the single-whisper selection.
+type Candidate struct {
+	// Kind is the cue kind.
+	Kind CueKind
+
+	// Available indicates the cue was available to show.
+	Available bool
+
+	// Inputs are the abstract inputs that made the cue available.
+	Inputs map[string]string
+}
+
+// CueReason is the machine-readable reason a cue won the selection.
+type CueReason struct {
+	// Kind is the chosen cue kind.
+	Kind CueKind
+
+	// PriorityRank is the 1-based rank in the whisper priority order.
+	PriorityRank int
+
+	// Inputs are "key=value" pairs, sorted by key.
+	Inputs []string
+}
+
+// Select returns the reason for the highest-priority available candidate,
+// or nil if no candidate is available.
+func Select(candidates []Candidate) *CueReason {
+	var chosen *Candidate
+	for i := range candidates {
+		c := &candidates[i]
+		if !c.Available || c.Kind.PriorityRank() == 0 {
+			continue
+		}
+		if chosen == nil || c.Kind.PriorityRank() < chosen.Kind.PriorityRank() {
+			chosen = c
+		}
+	}
+	if chosen == nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(chosen.Inputs))
+	for k := range chosen.Inputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	inputs := make([]string, len(keys))
+	for i, k := range keys {
+		inputs[i] = k + "=" + chosen.Inputs[k]
+	}
+
+	return &CueReason{
+		Kind:         chosen.Kind,
+		PriorityRank: chosen.Kind.PriorityRank(),
+		Inputs:       inputs,
+	}
+}
+
+// CanonicalString returns a deterministic string representation.
+func (r *CueReason) CanonicalString() string {
+	return "cue_reason|" + string(r.Kind) + "|" + strconv.Itoa(r.PriorityRank) + "|" + strings.Join(r.Inputs, ",")
+}
+
+// Hash returns the SHA256 hash of the canonical string.
+func (r *CueReason) Hash() string {
+	h := sha256.Sum256([]byte(r.CanonicalString()))
+	return hex.EncodeToString(h[:])
+}
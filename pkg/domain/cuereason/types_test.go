@@ -0,0 +1,42 @@
+package cuereason
+
+import "testing"
+
+func TestSelect_ChosenCueCarriesReason(t *testing.T) {
+	candidates := []Candidate{
+		{Kind: KindTrustAction, Available: true, Inputs: map[string]string{"should_show": "true"}},
+		{Kind: KindProof, Available: true, Inputs: map[string]string{"magnitude": "a_few", "has_recent_ack": "false"}},
+		{Kind: KindSurface, Available: false},
+	}
+
+	reason := Select(candidates)
+	if reason == nil {
+		t.Fatal("Expected chosen cue to carry a reason")
+	}
+	if reason.Kind != KindProof {
+		t.Errorf("Expected proof cue to win, got %s", reason.Kind)
+	}
+	if reason.PriorityRank != 2 {
+		t.Errorf("Expected priority rank 2, got %d", reason.PriorityRank)
+	}
+	want := []string{"has_recent_ack=false", "magnitude=a_few"}
+	if len(reason.Inputs) != len(want) {
+		t.Fatalf("Expected inputs %v, got %v", want, reason.Inputs)
+	}
+	for i := range want {
+		if reason.Inputs[i] != want[i] {
+			t.Errorf("Input %d: expected %s, got %s", i, want[i], reason.Inputs[i])
+		}
+	}
+
+	again := Select(candidates)
+	if again.Hash() != reason.Hash() {
+		t.Error("Reason hash should be deterministic")
+	}
+}
+
+func TestSelect_NoAvailableCue(t *testing.T) {
+	if reason := Select([]Candidate{{Kind: KindSurface}}); reason != nil {
+		t.Errorf("Expected no reason, got %+v", reason)
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // DevicePlatform identifies the device operating system.
@@ -203,8 +205,7 @@ func (r *DeviceRegistrationReceipt) CanonicalString() string {
 
 // ComputeStatusHash computes the deterministic status hash.
 func (r *DeviceRegistrationReceipt) ComputeStatusHash() string {
-	hash := sha256.Sum256([]byte(r.CanonicalString()))
-	return hex.EncodeToString(hash[:16])
+	return hashutil.HashString("devicereg.DeviceRegistrationReceipt", r.CanonicalString())[:32]
 }
 
 // ComputeReceiptID computes the unique receipt ID.
@@ -57,14 +57,16 @@ type Store interface {
 
 // InMemoryStore implements Store with in-memory storage.
 type InMemoryStore struct {
-	mu     sync.RWMutex
-	drafts map[DraftID]Draft
+	mu       sync.RWMutex
+	drafts   map[DraftID]Draft
+	versions map[DraftID]*VersionHistory
 }
 
 // NewInMemoryStore creates a new in-memory draft store.
 func NewInMemoryStore() *InMemoryStore {
 	return &InMemoryStore{
-		drafts: make(map[DraftID]Draft),
+		drafts:   make(map[DraftID]Draft),
+		versions: make(map[DraftID]*VersionHistory),
 	}
 }
 
@@ -78,6 +80,13 @@ func (s *InMemoryStore) Put(d Draft) error {
 		d.DeterministicHash = d.Hash()
 	}
 
+	// A replaced draft starts a fresh history.
+	if history, ok := s.versions[d.DraftID]; ok {
+		if current, _ := history.Current(); current.ContentHash != ContentHash(d.Content) {
+			delete(s.versions, d.DraftID)
+		}
+	}
+
 	s.drafts[d.DraftID] = d
 	return nil
 }
@@ -209,6 +218,7 @@ func (s *InMemoryStore) Delete(id DraftID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.drafts, id)
+	delete(s.versions, id)
 }
 
 // Clear removes all drafts.
@@ -216,7 +226,121 @@ func (s *InMemoryStore) Clear() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.drafts = make(map[DraftID]Draft)
+	s.versions = make(map[DraftID]*VersionHistory)
+}
+
+// PutVersion records content as the draft's next version and selects it.
+func (s *InMemoryStore) PutVersion(id DraftID, content DraftContent, source VersionSource, hints []RegenerateHint, at time.Time) (DraftVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.drafts[id]
+	if !ok {
+		return DraftVersion{}, ErrDraftNotFound
+	}
+	if d.Status != StatusProposed {
+		return DraftVersion{}, ErrDraftNotEditable
+	}
+
+	history := s.historyLocked(d)
+	current, _ := history.Current()
+	hash := ContentHash(content)
+	if hash == current.ContentHash {
+		return DraftVersion{}, ErrVersionUnchanged
+	}
+
+	v := DraftVersion{
+		Number:      len(history.Versions) + 1,
+		Content:     content,
+		ContentHash: hash,
+		Source:      source,
+		Hints:       append([]RegenerateHint(nil), hints...),
+		BasedOn:     current.Number,
+		CreatedAt:   at,
+	}
+	history.Versions = append(history.Versions, v)
+	history.Selected = v.Number
+
+	d.Content = content
+	d.DeterministicHash = d.Hash()
+	s.drafts[id] = d
+	return v, nil
+}
+
+// SelectVersion makes an existing version the draft's content.
+func (s *InMemoryStore) SelectVersion(id DraftID, number int) (DraftVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.drafts[id]
+	if !ok {
+		return DraftVersion{}, ErrDraftNotFound
+	}
+	if d.Status != StatusProposed {
+		return DraftVersion{}, ErrDraftNotEditable
+	}
+
+	history := s.historyLocked(d)
+	v, ok := history.Get(number)
+	if !ok {
+		return DraftVersion{}, ErrVersionNotFound
+	}
+	if number == history.Selected {
+		return DraftVersion{}, ErrVersionUnchanged
+	}
+	history.Selected = number
+
+	d.Content = v.Content
+	d.DeterministicHash = d.Hash()
+	s.drafts[id] = d
+	return v, nil
+}
+
+// History returns the draft's versions.
+func (s *InMemoryStore) History(id DraftID) (VersionHistory, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	d, ok := s.drafts[id]
+	if !ok {
+		return VersionHistory{}, false
+	}
+	if history, ok := s.versions[id]; ok {
+		out := *history
+		out.Versions = append([]DraftVersion(nil), history.Versions...)
+		return out, true
+	}
+	return generatedHistory(d), true
+}
+
+// historyLocked returns the draft's history, recording the generated
+// content as version 1 on first use. Caller holds the write lock.
+func (s *InMemoryStore) historyLocked(d Draft) *VersionHistory {
+	if history, ok := s.versions[d.DraftID]; ok {
+		return history
+	}
+	history := generatedHistory(d)
+	s.versions[d.DraftID] = &history
+	return &history
+}
+
+// generatedHistory is the history of a draft that was never edited.
+func generatedHistory(d Draft) VersionHistory {
+	return VersionHistory{
+		DraftID: d.DraftID,
+		Versions: []DraftVersion{{
+			Number:      1,
+			Content:     d.Content,
+			ContentHash: ContentHash(d.Content),
+			Source:      VersionGenerated,
+			CreatedAt:   d.CreatedAt,
+		}},
+		Selected: 1,
+	}
 }
 
 // Verify interface compliance.
-var _ Store = (*InMemoryStore)(nil)
+var (
+	_ Store        = (*InMemoryStore)(nil)
+	_ VersionStore = (*InMemoryStore)(nil)
+)
@@ -407,3 +407,95 @@ func TestInMemoryStore_Clear(t *testing.T) {
 		t.Errorf("Expected count 0 after clear, got %d", store.Count())
 	}
 }
+
+func TestInMemoryStore_Versions(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	original := EmailDraftContent{To: "alice@example.com", Subject: "Test", Body: "Hello"}
+	_ = store.Put(Draft{DraftID: "d1", Status: StatusProposed, CreatedAt: now, ExpiresAt: now.Add(24 * time.Hour), Content: original})
+	before, _ := store.Get("d1")
+
+	history, ok := store.History("d1")
+	if !ok || len(history.Versions) != 1 || history.Selected != 1 {
+		t.Fatalf("Expected one generated version, got %+v", history)
+	}
+
+	edited, _ := WithBody(original, "Hello there")
+	v, err := store.PutVersion("d1", edited, VersionEdited, nil, now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("PutVersion failed: %v", err)
+	}
+	if v.Number != 2 || v.BasedOn != 1 {
+		t.Errorf("Expected version 2 based on 1, got %d based on %d", v.Number, v.BasedOn)
+	}
+
+	d, _ := store.Get("d1")
+	if body, _ := ContentBody(d.Content); body != "Hello there" {
+		t.Errorf("Draft content should be the selected version, got %q", body)
+	}
+	if d.DeterministicHash == before.DeterministicHash {
+		t.Errorf("Draft hash should follow its content")
+	}
+
+	if _, err := store.PutVersion("d1", edited, VersionEdited, nil, now); err != ErrVersionUnchanged {
+		t.Errorf("Expected ErrVersionUnchanged, got %v", err)
+	}
+
+	// Revert to the generated version; no version is removed.
+	if _, err := store.SelectVersion("d1", 1); err != nil {
+		t.Fatalf("SelectVersion failed: %v", err)
+	}
+	d, _ = store.Get("d1")
+	if body, _ := ContentBody(d.Content); body != "Hello" {
+		t.Errorf("Expected reverted body, got %q", body)
+	}
+	if d.DeterministicHash != before.DeterministicHash {
+		t.Errorf("Reverted draft should hash as before")
+	}
+	history, _ = store.History("d1")
+	if len(history.Versions) != 2 || history.Selected != 1 {
+		t.Errorf("Expected 2 versions with 1 selected, got %d with %d", len(history.Versions), history.Selected)
+	}
+
+	if _, err := store.SelectVersion("d1", 3); err != ErrVersionNotFound {
+		t.Errorf("Expected ErrVersionNotFound, got %v", err)
+	}
+}
+
+func TestInMemoryStore_VersionsOnlyWhileProposed(t *testing.T) {
+	store := NewInMemoryStore()
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	content := EmailDraftContent{To: "alice@example.com", Body: "Hello"}
+	_ = store.Put(Draft{DraftID: "d1", Status: StatusProposed, CreatedAt: now, ExpiresAt: now.Add(24 * time.Hour), Content: content})
+	_ = store.UpdateStatus("d1", StatusApproved, "ok", "user", now)
+
+	edited, _ := WithBody(content, "Changed")
+	if _, err := store.PutVersion("d1", edited, VersionEdited, nil, now); err != ErrDraftNotEditable {
+		t.Errorf("Expected ErrDraftNotEditable, got %v", err)
+	}
+	if _, err := store.PutVersion("missing", edited, VersionEdited, nil, now); err != ErrDraftNotFound {
+		t.Errorf("Expected ErrDraftNotFound, got %v", err)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	diff := DiffLines("a\nb\nc", "a\nx\nc\nd")
+
+	want := []DiffLine{
+		{DiffSame, "a"},
+		{DiffRemoved, "b"},
+		{DiffAdded, "x"},
+		{DiffSame, "c"},
+		{DiffAdded, "d"},
+	}
+	if len(diff) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %+v", len(want), len(diff), diff)
+	}
+	for i := range want {
+		if diff[i] != want[i] {
+			t.Errorf("Line %d: expected %+v, got %+v", i, want[i], diff[i])
+		}
+	}
+}
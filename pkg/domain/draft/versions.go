@@ -0,0 +1,235 @@
+// Package draft - draft versions: edits, regenerations and reverts.
+package draft
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Draft version errors.
+var (
+	ErrDraftNotFound       = errors.New("draft not found")
+	ErrDraftNotEditable    = errors.New("only proposed drafts can be edited")
+	ErrVersionNotFound     = errors.New("draft version not found")
+	ErrVersionUnchanged    = errors.New("draft version unchanged")
+	ErrBodyNotEditable     = errors.New("draft content has no editable body")
+	ErrVersionsUnsupported = errors.New("draft store does not keep versions")
+)
+
+// VersionSource says how a draft version was produced.
+type VersionSource string
+
+const (
+	VersionGenerated   VersionSource = "generated"   // the generator's original draft
+	VersionEdited      VersionSource = "edited"      // the user edited the body
+	VersionRegenerated VersionSource = "regenerated" // regenerated with hints
+)
+
+// RegenerateHint asks for a change of tone or length when regenerating.
+type RegenerateHint string
+
+const (
+	HintShorter RegenerateHint = "shorter"
+	HintWarmer  RegenerateHint = "warmer"
+	HintFormal  RegenerateHint = "formal"
+)
+
+// AllRegenerateHints returns the hints in display order.
+func AllRegenerateHints() []RegenerateHint {
+	return []RegenerateHint{HintShorter, HintWarmer, HintFormal}
+}
+
+// Valid returns true if the hint is known.
+func (h RegenerateHint) Valid() bool {
+	for _, known := range AllRegenerateHints() {
+		if h == known {
+			return true
+		}
+	}
+	return false
+}
+
+// DraftVersion is one immutable version of a draft's content.
+type DraftVersion struct {
+	// Number counts from 1 (the generated draft).
+	Number int
+
+	// Content is the version's full content.
+	Content DraftContent
+
+	// ContentHash is the SHA256 of Content's canonical string.
+	ContentHash string
+
+	// Source says how the version was produced.
+	Source VersionSource
+
+	// Hints are the regeneration hints (VersionRegenerated only).
+	Hints []RegenerateHint
+
+	// BasedOn is the version this one was derived from (0 for version 1).
+	BasedOn int
+
+	// CreatedAt is when the version was recorded.
+	CreatedAt time.Time
+}
+
+// VersionHistory is a draft's versions and which one is selected.
+type VersionHistory struct {
+	DraftID DraftID
+
+	// Versions are oldest first. Versions are never removed.
+	Versions []DraftVersion
+
+	// Selected is the Number of the version in the draft's Content.
+	Selected int
+}
+
+// Get returns version n.
+func (h VersionHistory) Get(n int) (DraftVersion, bool) {
+	if n < 1 || n > len(h.Versions) {
+		return DraftVersion{}, false
+	}
+	return h.Versions[n-1], true
+}
+
+// Current returns the selected version.
+func (h VersionHistory) Current() (DraftVersion, bool) {
+	return h.Get(h.Selected)
+}
+
+// VersionStore is implemented by draft stores that keep versions.
+// The draft's Content always holds the selected version, so anything that
+// reads the draft (approval, execution) uses it without knowing about versions.
+type VersionStore interface {
+	// PutVersion records content as the draft's next version and selects it.
+	// The generated content is recorded as version 1 on first use.
+	PutVersion(id DraftID, content DraftContent, source VersionSource, hints []RegenerateHint, at time.Time) (DraftVersion, error)
+
+	// SelectVersion makes an existing version the draft's content.
+	SelectVersion(id DraftID, number int) (DraftVersion, error)
+
+	// History returns the draft's versions. A draft that was never edited
+	// has a single generated version.
+	History(id DraftID) (VersionHistory, bool)
+}
+
+// ContentHash returns the SHA256 of the content's canonical string.
+func ContentHash(c DraftContent) string {
+	if c == nil {
+		return ""
+	}
+	h := sha256.Sum256([]byte(c.CanonicalString()))
+	return hex.EncodeToString(h[:])
+}
+
+// ContentBody returns the free-text body of the content: the email body,
+// or the message sent with a calendar response.
+func ContentBody(c DraftContent) (string, bool) {
+	switch content := c.(type) {
+	case EmailDraftContent:
+		return content.Body, true
+	case CalendarDraftContent:
+		return content.Message, true
+	case ShipmentFollowUpContent:
+		return content.Body, true
+	case RefundFollowUpContent:
+		return content.Body, true
+	case InvoiceReminderContent:
+		return content.Body, true
+	case SubscriptionReviewContent:
+		return content.Body, true
+	}
+	return "", false
+}
+
+// WithBody returns a copy of the content with its body replaced.
+// Content without a free-text body (e.g. payments) is not editable.
+func WithBody(c DraftContent, body string) (DraftContent, bool) {
+	switch content := c.(type) {
+	case EmailDraftContent:
+		content.Body = body
+		return content, true
+	case CalendarDraftContent:
+		content.Message = body
+		return content, true
+	case ShipmentFollowUpContent:
+		content.Body = body
+		return content, true
+	case RefundFollowUpContent:
+		content.Body = body
+		return content, true
+	case InvoiceReminderContent:
+		content.Body = body
+		return content, true
+	case SubscriptionReviewContent:
+		content.Body = body
+		return content, true
+	}
+	return c, false
+}
+
+// DiffOp is the kind of a diff line.
+type DiffOp string
+
+const (
+	DiffSame    DiffOp = "same"
+	DiffRemoved DiffOp = "removed"
+	DiffAdded   DiffOp = "added"
+)
+
+// DiffLine is one line of a line diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// DiffLines returns a line diff from a to b (longest common subsequence).
+// Deterministic: removals come before additions at each change.
+func DiffLines(a, b string) []DiffLine {
+	left := strings.Split(a, "\n")
+	right := strings.Split(b, "\n")
+
+	// lcs[i][j] is the LCS length of left[i:] and right[j:].
+	lcs := make([][]int, len(left)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(right)+1)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		for j := len(right) - 1; j >= 0; j-- {
+			if left[i] == right[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out []DiffLine
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		switch {
+		case left[i] == right[j]:
+			out = append(out, DiffLine{Op: DiffSame, Text: left[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, DiffLine{Op: DiffRemoved, Text: left[i]})
+			i++
+		default:
+			out = append(out, DiffLine{Op: DiffAdded, Text: right[j]})
+			j++
+		}
+	}
+	for ; i < len(left); i++ {
+		out = append(out, DiffLine{Op: DiffRemoved, Text: left[i]})
+	}
+	for ; j < len(right); j++ {
+		out = append(out, DiffLine{Op: DiffAdded, Text: right[j]})
+	}
+	return out
+}
@@ -20,17 +20,26 @@ type InMemoryEventStore struct {
 	mu     sync.RWMutex
 	events map[string]CanonicalEvent
 
-	// Indexes
-	byCircle map[identity.EntityID][]string // circle ID -> event IDs
-	byType   map[EventType][]string         // event type -> event IDs
+	// Indexes. The circle indexes are kept newest first (ties in insertion
+	// order) so reads need no sort.
+	byCircle     map[identity.EntityID][]string // circle ID -> event IDs
+	byType       map[EventType][]string         // event type -> event IDs
+	byCircleType map[circleTypeKey][]string     // circle ID + type -> event IDs
+}
+
+// circleTypeKey keys the per-circle, per-type index.
+type circleTypeKey struct {
+	circleID  identity.EntityID
+	eventType EventType
 }
 
 // NewInMemoryEventStore creates a new in-memory event store.
 func NewInMemoryEventStore() *InMemoryEventStore {
 	return &InMemoryEventStore{
-		events:   make(map[string]CanonicalEvent),
-		byCircle: make(map[identity.EntityID][]string),
-		byType:   make(map[EventType][]string),
+		events:       make(map[string]CanonicalEvent),
+		byCircle:     make(map[identity.EntityID][]string),
+		byType:       make(map[EventType][]string),
+		byCircleType: make(map[circleTypeKey][]string),
 	}
 }
 
@@ -47,16 +56,31 @@ func (s *InMemoryEventStore) Store(event CanonicalEvent) error {
 
 	// Update indexes
 	circleID := event.CircleID()
+	eventType := event.EventType()
 	if circleID != "" {
-		s.byCircle[circleID] = append(s.byCircle[circleID], id)
+		s.byCircle[circleID] = s.insertNewestFirst(s.byCircle[circleID], id)
+		key := circleTypeKey{circleID: circleID, eventType: eventType}
+		s.byCircleType[key] = s.insertNewestFirst(s.byCircleType[key], id)
 	}
 
-	eventType := event.EventType()
 	s.byType[eventType] = append(s.byType[eventType], id)
 
 	return nil
 }
 
+// insertNewestFirst inserts id after every event that occurred at or after
+// it, keeping ids ordered newest first with ties in insertion order.
+func (s *InMemoryEventStore) insertNewestFirst(ids []string, id string) []string {
+	occurred := s.events[id].OccurredAt()
+	i := sort.Search(len(ids), func(i int) bool {
+		return s.events[ids[i]].OccurredAt().Before(occurred)
+	})
+	ids = append(ids, "")
+	copy(ids[i+1:], ids[i:])
+	ids[i] = id
+	return ids
+}
+
 func (s *InMemoryEventStore) GetByID(id string) (CanonicalEvent, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -68,32 +92,32 @@ func (s *InMemoryEventStore) GetByID(id string) (CanonicalEvent, error) {
 	return event, nil
 }
 
+// GetByCircle returns a circle's events, newest first. With an event type
+// it reads the circle+type index, so the cost is O(matching events), or
+// O(limit) with a limit, rather than O(all events). Events that occurred at
+// the same instant keep insertion order.
 func (s *InMemoryEventStore) GetByCircle(circleID identity.EntityID, eventType *EventType, limit int) ([]CanonicalEvent, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	eventIDs := s.byCircle[circleID]
+	var eventIDs []string
+	if eventType != nil {
+		eventIDs = s.byCircleType[circleTypeKey{circleID: circleID, eventType: *eventType}]
+	} else {
+		eventIDs = s.byCircle[circleID]
+	}
 	if len(eventIDs) == 0 {
 		return nil, nil
 	}
 
-	var result []CanonicalEvent
-	for _, id := range eventIDs {
-		event := s.events[id]
-		if eventType != nil && event.EventType() != *eventType {
-			continue
-		}
-		result = append(result, event)
+	// Apply limit; the index is already newest first
+	if limit > 0 && len(eventIDs) > limit {
+		eventIDs = eventIDs[:limit]
 	}
 
-	// Sort by occurred time (newest first)
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].OccurredAt().After(result[j].OccurredAt())
-	})
-
-	// Apply limit
-	if limit > 0 && len(result) > limit {
-		result = result[:limit]
+	result := make([]CanonicalEvent, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		result = append(result, s.events[id])
 	}
 
 	return result, nil
@@ -150,3 +174,13 @@ func (s *InMemoryEventStore) GetRecentByCircle(circleID identity.EntityID, count
 
 // Verify interface compliance.
 var _ EventStore = (*InMemoryEventStore)(nil)
+
+// Clear removes all events.
+func (s *InMemoryEventStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = make(map[string]CanonicalEvent)
+	s.byCircle = make(map[identity.EntityID][]string)
+	s.byType = make(map[EventType][]string)
+	s.byCircleType = make(map[circleTypeKey][]string)
+}
@@ -0,0 +1,137 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"quantumlife/pkg/domain/identity"
+)
+
+var benchCircles = []identity.EntityID{"circle-personal", "circle-work", "circle-finance"}
+
+// populateStore stores n events spread round-robin across circles and types.
+func populateStore(n int, now time.Time) *InMemoryEventStore {
+	store := NewInMemoryEventStore()
+	for i := 0; i < n; i++ {
+		circle := benchCircles[i%len(benchCircles)]
+		at := now.Add(-time.Duration(i%500) * time.Minute)
+		var event CanonicalEvent
+		if i%2 == 0 {
+			e := NewEmailMessageEvent("gmail", fmt.Sprintf("msg-%05d", i), "self@example.com", now, at)
+			e.Circle = circle
+			event = e
+		} else {
+			e := NewCalendarEventEvent("google", "cal-1", fmt.Sprintf("evt-%05d", i), "self@example.com", now, at)
+			e.Circle = circle
+			event = e
+		}
+		store.Store(event)
+	}
+	return store
+}
+
+// scanByCircle is the linear scan GetByCircle replaces: every stored event
+// is visited to find one circle's events of one type.
+func scanByCircle(s *InMemoryEventStore, circleID identity.EntityID, eventType EventType) []CanonicalEvent {
+	var result []CanonicalEvent
+	for _, event := range s.events {
+		if event.CircleID() == circleID && event.EventType() == eventType {
+			result = append(result, event)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].OccurredAt().After(result[j].OccurredAt())
+	})
+	return result
+}
+
+func TestGetByCircle_TypeIndexMatchesScan(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := populateStore(3000, now)
+
+	emailType := EventTypeEmailMessage
+	for _, circle := range benchCircles {
+		got, err := store.GetByCircle(circle, &emailType, 0)
+		if err != nil {
+			t.Fatalf("GetByCircle failed: %v", err)
+		}
+		want := scanByCircle(store, circle, emailType)
+		if len(got) != len(want) || len(got) != 500 {
+			t.Fatalf("%s: expected 500 emails, got %d (scan %d)", circle, len(got), len(want))
+		}
+		for _, e := range got {
+			if e.CircleID() != circle || e.EventType() != emailType {
+				t.Fatalf("%s: unexpected event %s", circle, e.EventID())
+			}
+		}
+
+		all, _ := store.GetByCircle(circle, nil, 0)
+		if len(all) != 1000 {
+			t.Errorf("%s: expected 1000 events untyped, got %d", circle, len(all))
+		}
+	}
+
+	if _, err := store.GetByID(store.byCircle[benchCircles[0]][0]); err != nil {
+		t.Errorf("GetByID failed: %v", err)
+	}
+}
+
+func TestGetByCircle_TiesKeepInsertionOrder(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := NewInMemoryEventStore()
+	var ids []string
+	for i := 0; i < 50; i++ {
+		e := NewEmailMessageEvent("gmail", fmt.Sprintf("msg-%02d", i), "self@example.com", now, now)
+		e.Circle = "circle-1"
+		store.Store(e)
+		ids = append(ids, e.EventID())
+	}
+
+	emailType := EventTypeEmailMessage
+	got, _ := store.GetByCircle("circle-1", &emailType, 0)
+	for i, e := range got {
+		if e.EventID() != ids[i] {
+			t.Fatalf("position %d: expected insertion order %s, got %s", i, ids[i], e.EventID())
+		}
+	}
+}
+
+func TestGetByCircle_NewestFirstWithLimit(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	store := NewInMemoryEventStore()
+	for _, offset := range []int{3, 9, 1, 7, 5} {
+		e := NewCalendarEventEvent("google", "cal-1", fmt.Sprintf("evt-%d", offset), "self@example.com", now, now.Add(time.Duration(offset)*time.Hour))
+		e.Circle = "circle-1"
+		store.Store(e)
+	}
+
+	calType := EventTypeCalendarEvent
+	got, _ := store.GetByCircle("circle-1", &calType, 3)
+	if len(got) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(got))
+	}
+	for i, want := range []int{9, 7, 5} {
+		if !got[i].OccurredAt().Equal(now.Add(time.Duration(want) * time.Hour)) {
+			t.Errorf("position %d: expected +%dh, got %v", i, want, got[i].OccurredAt())
+		}
+	}
+}
+
+func BenchmarkGetByCircle_Indexed(b *testing.B) {
+	store := populateStore(10000, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	emailType := EventTypeEmailMessage
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.GetByCircle(benchCircles[i%len(benchCircles)], &emailType, 0)
+	}
+}
+
+func BenchmarkGetByCircle_Scan(b *testing.B) {
+	store := populateStore(10000, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scanByCircle(store, benchCircles[i%len(benchCircles)], EventTypeEmailMessage)
+	}
+}
@@ -0,0 +1,81 @@
+package execintent
+
+import (
+	"quantumlife/pkg/domain/draft"
+)
+
+// AllActionClasses returns every action class in canonical order.
+func AllActionClasses() []ActionClass {
+	return []ActionClass{ActionEmailSend, ActionCalendarRespond, ActionFinancePayment}
+}
+
+// Valid returns true if the action class is known.
+func (a ActionClass) Valid() bool {
+	for _, known := range AllActionClasses() {
+		if a == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionClassForDraftType returns the action a draft of the given type
+// executes as. Commerce follow-ups are email sends.
+func ActionClassForDraftType(t draft.DraftType) (ActionClass, bool) {
+	switch t {
+	case draft.DraftTypeEmailReply,
+		draft.DraftTypeShipmentFollowUp,
+		draft.DraftTypeRefundFollowUp,
+		draft.DraftTypeInvoiceReminder,
+		draft.DraftTypeSubscriptionReview:
+		return ActionEmailSend, true
+	case draft.DraftTypeCalendarResponse:
+		return ActionCalendarRespond, true
+	case draft.DraftTypePayment:
+		return ActionFinancePayment, true
+	}
+	return "", false
+}
+
+// DefaultRequiredApprovals applies to action classes without a threshold.
+// Conservative: an unknown action never runs on a single confirm.
+const DefaultRequiredApprovals = 2
+
+// MaxRequiredApprovals caps a configured threshold.
+const MaxRequiredApprovals = 10
+
+// ApprovalThresholds maps action classes to the approvals a draft needs
+// through the approval-token flow before it executes.
+// 0 means the user's own confirm is enough.
+type ApprovalThresholds map[ActionClass]int
+
+// DefaultApprovalThresholds returns the conservative defaults: calendar
+// responses execute on confirm, email sends need one approval and
+// payments need two.
+func DefaultApprovalThresholds() ApprovalThresholds {
+	return ApprovalThresholds{
+		ActionEmailSend:       1,
+		ActionCalendarRespond: 0,
+		ActionFinancePayment:  2,
+	}
+}
+
+// Required returns the approvals needed for an action class.
+func (t ApprovalThresholds) Required(action ActionClass) int {
+	if n, ok := t[action]; ok && n >= 0 {
+		return n
+	}
+	return DefaultRequiredApprovals
+}
+
+// WithOverrides returns a copy of t with overrides applied.
+func (t ApprovalThresholds) WithOverrides(overrides map[ActionClass]int) ApprovalThresholds {
+	out := make(ApprovalThresholds, len(t)+len(overrides))
+	for k, v := range t {
+		out[k] = v
+	}
+	for k, v := range overrides {
+		out[k] = v
+	}
+	return out
+}
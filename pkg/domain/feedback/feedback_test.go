@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/policy"
 )
 
 func TestComputeFeedbackID_Deterministic(t *testing.T) {
@@ -252,4 +253,50 @@ func TestMemoryStore_Stats(t *testing.T) {
 	if stats.UnnecessaryCount != 1 {
 		t.Errorf("UnnecessaryCount = %d, want 1", stats.UnnecessaryCount)
 	}
+	if stats.InterruptUnnecessary != 1 {
+		t.Errorf("InterruptUnnecessary = %d, want 1", stats.InterruptUnnecessary)
+	}
+}
+
+func TestSuggestThresholdAdjustments(t *testing.T) {
+	current := policy.CirclePolicy{CircleID: "work", RegretThreshold: 30, NotifyThreshold: 50, UrgentThreshold: 75}
+
+	tests := []struct {
+		name     string
+		stats    FeedbackStats
+		current  policy.CirclePolicy
+		proposed int // 0 means no suggestion
+	}{
+		{"no feedback", FeedbackStats{}, current, 0},
+		{"too few unwanted", FeedbackStats{InterruptFeedback: 2, InterruptUnnecessary: 2}, current, 0},
+		{"mostly helpful", FeedbackStats{InterruptFeedback: 6, InterruptUnnecessary: 3}, current, 0},
+		{"repeatedly unwanted", FeedbackStats{InterruptFeedback: 4, InterruptUnnecessary: 3}, current, 60},
+		{"capped at urgent", FeedbackStats{InterruptFeedback: 3, InterruptUnnecessary: 3}, policy.CirclePolicy{CircleID: "work", NotifyThreshold: 70, UrgentThreshold: 75}, 75},
+		{"already at urgent", FeedbackStats{InterruptFeedback: 3, InterruptUnnecessary: 3}, policy.CirclePolicy{CircleID: "work", NotifyThreshold: 75, UrgentThreshold: 75}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SuggestThresholdAdjustments(tt.stats, tt.current)
+			if tt.proposed == 0 {
+				if len(got) != 0 {
+					t.Fatalf("expected no suggestion, got %+v", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("expected one suggestion, got %+v", got)
+			}
+			s := got[0]
+			if s.CircleID != "work" || s.Field != FieldNotifyThreshold || s.Current != tt.current.NotifyThreshold || s.Proposed != tt.proposed {
+				t.Errorf("got %+v, want notify_threshold %d -> %d", s, tt.current.NotifyThreshold, tt.proposed)
+			}
+
+			// The proposal must pass the policy edit path's validation.
+			accepted := tt.current
+			accepted.NotifyThreshold = s.Proposed
+			if err := accepted.Validate(); err != nil {
+				t.Errorf("accepted suggestion is not a valid policy: %v", err)
+			}
+		})
+	}
 }
@@ -35,6 +35,9 @@ type FeedbackStats struct {
 	DraftFeedback     int
 	HelpfulCount      int
 	UnnecessaryCount  int
+
+	// InterruptUnnecessary counts interruptions marked unnecessary.
+	InterruptUnnecessary int
 }
 
 // MemoryStore is an in-memory feedback store.
@@ -50,6 +53,13 @@ func NewMemoryStore() *MemoryStore {
 	}
 }
 
+// Clear removes all feedback records.
+func (s *MemoryStore) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = make(map[string]FeedbackRecord)
+}
+
 // Put stores a feedback record.
 func (s *MemoryStore) Put(record FeedbackRecord) error {
 	if err := record.Validate(); err != nil {
@@ -123,14 +133,16 @@ func (s *MemoryStore) List() []FeedbackRecord {
 
 // Stats returns feedback statistics.
 func (s *MemoryStore) Stats() FeedbackStats {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	return ComputeStats(s.List())
+}
 
+// ComputeStats computes statistics over feedback records.
+func ComputeStats(records []FeedbackRecord) FeedbackStats {
 	stats := FeedbackStats{
-		TotalRecords: len(s.records),
+		TotalRecords: len(records),
 	}
 
-	for _, record := range s.records {
+	for _, record := range records {
 		switch record.TargetType {
 		case TargetInterruption:
 			stats.InterruptFeedback++
@@ -144,6 +156,10 @@ func (s *MemoryStore) Stats() FeedbackStats {
 		case SignalUnnecessary:
 			stats.UnnecessaryCount++
 		}
+
+		if record.TargetType == TargetInterruption && record.Signal == SignalUnnecessary {
+			stats.InterruptUnnecessary++
+		}
 	}
 
 	return stats
@@ -0,0 +1,75 @@
+package feedback
+
+import (
+	"quantumlife/pkg/domain/policy"
+)
+
+// MinUnwantedForSuggestion is how many interruptions must be marked
+// unnecessary before a threshold change is suggested.
+const MinUnwantedForSuggestion = 3
+
+// NotifyThresholdStep is how far a suggestion raises the notify threshold.
+const NotifyThresholdStep = 10
+
+// SuggestionField names the policy field a suggestion changes, as the
+// policy edit form names it. Only fields the interruption engine reads are
+// suggested, so an accepted suggestion changes what surfaces.
+type SuggestionField string
+
+const (
+	// FieldNotifyThreshold is the circle's notify threshold, which the
+	// interruption engine applies when leveling that circle's items.
+	FieldNotifyThreshold SuggestionField = "notify_threshold"
+)
+
+// Suggestion proposes a policy change drawn from feedback.
+//
+// CRITICAL: A suggestion is never applied automatically. The user accepts
+// it through the policy edit path, or ignores it.
+type Suggestion struct {
+	// CircleID is the circle whose policy would change.
+	CircleID string
+
+	// Field is the policy field to change.
+	Field SuggestionField
+
+	// Current is the field's value now; Proposed is the suggested value.
+	Current  int
+	Proposed int
+
+	// Unwanted of Total interruption feedback records were unnecessary.
+	Unwanted int
+	Total    int
+}
+
+// SuggestThresholdAdjustments proposes raising the notify threshold when
+// the user keeps marking surfaced interruptions as unnecessary: at least
+// MinUnwantedForSuggestion of them, and at least two thirds of all
+// interruption feedback in stats. The proposal is NotifyThresholdStep
+// higher, capped at the urgent threshold so the policy stays valid.
+//
+// stats should cover only feedback given since the policy last changed,
+// so an accepted suggestion is not proposed again on the same feedback.
+func SuggestThresholdAdjustments(stats FeedbackStats, current policy.CirclePolicy) []Suggestion {
+	unwanted := stats.InterruptUnnecessary
+	if unwanted < MinUnwantedForSuggestion || unwanted*3 < stats.InterruptFeedback*2 {
+		return nil
+	}
+
+	proposed := current.NotifyThreshold + NotifyThresholdStep
+	if proposed > current.UrgentThreshold {
+		proposed = current.UrgentThreshold
+	}
+	if proposed <= current.NotifyThreshold {
+		return nil
+	}
+
+	return []Suggestion{{
+		CircleID: current.CircleID,
+		Field:    FieldNotifyThreshold,
+		Current:  current.NotifyThreshold,
+		Proposed: proposed,
+		Unwanted: unwanted,
+		Total:    stats.InterruptFeedback,
+	}}
+}
@@ -3,6 +3,7 @@
 // CRITICAL: Feedback is captured to improve future assistance.
 // CRITICAL: All feedback IDs are deterministic via SHA256 hashing.
 // CRITICAL: No ML/training in this phase - signals are stored for future use.
+// CRITICAL: Feedback only ever suggests policy changes; it never applies them.
 //
 // Reference: docs/ADR/ADR-0023-phase6-quiet-loop-web.md
 package feedback
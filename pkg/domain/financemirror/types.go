@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"sort"
 	"time"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // MagnitudeBucket represents an abstract count bucket.
@@ -254,8 +256,7 @@ func (r *FinanceSyncReceipt) computeStatusHash() string {
 		r.ReceiptID, r.CircleID, r.Provider, r.PeriodBucket,
 		r.AccountsMagnitude, r.TransactionsMagnitude,
 		r.EvidenceHash, successStr, r.FailReason)
-	h := sha256.Sum256([]byte(canonical))
-	return hex.EncodeToString(h[:])
+	return hashutil.HashString("financemirror.FinanceSyncReceipt", canonical)
 }
 
 // CanonicalString returns the canonical string representation.
@@ -12,6 +12,8 @@ import (
 	"encoding/hex"
 	"sort"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // FirstMinutesPeriod represents a day bucket (YYYY-MM-DD format).
@@ -117,12 +119,20 @@ func (s *FirstMinutesSummary) CanonicalString() string {
 	return b.String()
 }
 
+// statusHashDomain is the hashutil domain tag of summary status hashes.
+const statusHashDomain = "firstminutes.FirstMinutesSummary"
+
 // ComputeStatusHash computes the deterministic 128-bit hash of the summary.
 // Returns 32 hex characters.
 func (s *FirstMinutesSummary) ComputeStatusHash() string {
 	canonical := s.CanonicalString()
-	h := sha256.Sum256([]byte(canonical))
-	return hex.EncodeToString(h[:16]) // 128 bits = 16 bytes = 32 hex chars
+	return hashutil.HashString(statusHashDomain, canonical)[:32] // 128 bits = 16 bytes = 32 hex chars
+}
+
+// MatchesStatusHash reports whether hash is this summary's status hash.
+// Dismissals persisted before hashutil v1 carry the legacy hash.
+func (s *FirstMinutesSummary) MatchesStatusHash(hash string) bool {
+	return len(hash) == 32 && hashutil.Matches(statusHashDomain, s.CanonicalString(), hash)
 }
 
 // FirstMinutesDismissal tracks when a summary was dismissed.
@@ -21,6 +21,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -429,8 +431,7 @@ func (r *DeliveryReceipt) ComputeStatusHash() string {
 		r.DedupedCount,
 		r.PeriodKey,
 	)
-	h := sha256.Sum256([]byte(content))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("interruptdelivery.DeliveryReceipt", content)[:32]
 }
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -627,8 +629,7 @@ func (r *RehearsalReceipt) CanonicalString() string {
 
 // ComputeStatusHash computes the status hash for this receipt.
 func (r *RehearsalReceipt) ComputeStatusHash() string {
-	h := sha256.Sum256([]byte(r.CanonicalString()))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("interruptrehearsal.RehearsalReceipt", r.CanonicalString())[:32]
 }
 
 // ComputeAttemptIDHash computes a deterministic attempt ID.
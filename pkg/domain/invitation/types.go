@@ -25,6 +25,8 @@ import (
 	"encoding/hex"
 	"sort"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // InvitationKind represents the type of invitation offered.
@@ -201,8 +203,7 @@ func (s *InvitationSummary) CanonicalString() string {
 
 // Hash returns the SHA-256 hash of the canonical string.
 func (s *InvitationSummary) Hash() string {
-	hash := sha256.Sum256([]byte(s.CanonicalString()))
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("invitation.InvitationSummary", s.CanonicalString())
 }
 
 // InvitationRecord represents a persisted invitation decision.
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"quantumlife/pkg/domain/connection"
+	"quantumlife/pkg/hashutil"
 )
 
 // MagnitudeBucket represents abstract magnitude (never raw counts).
@@ -184,8 +185,7 @@ func (s *MirrorSourceSummary) CanonicalString() string {
 
 // Hash returns the SHA256 hash of the canonical string.
 func (s *MirrorSourceSummary) Hash() string {
-	h := sha256.Sum256([]byte(s.CanonicalString()))
-	return hex.EncodeToString(h[:])
+	return hashutil.HashString("mirror.MirrorSourceSummary", s.CanonicalString())
 }
 
 // MirrorOutcome represents the abstract outcome of mirror reflection.
@@ -0,0 +1,61 @@
+package policy
+
+// MaxDailyQuota caps a circle's daily notify and queued quotas.
+const MaxDailyQuota = 100
+
+// ThresholdBucket is an abstract band for a 0-100 regret threshold.
+type ThresholdBucket string
+
+const (
+	ThresholdLow    ThresholdBucket = "low"    // 0-33
+	ThresholdMedium ThresholdBucket = "medium" // 34-66
+	ThresholdHigh   ThresholdBucket = "high"   // 67-100
+)
+
+// ThresholdBucketOf buckets a regret threshold.
+func ThresholdBucketOf(threshold int) ThresholdBucket {
+	switch {
+	case threshold <= 33:
+		return ThresholdLow
+	case threshold <= 66:
+		return ThresholdMedium
+	default:
+		return ThresholdHigh
+	}
+}
+
+// QuotaBucket is an abstract band for a daily quota.
+type QuotaBucket string
+
+const (
+	QuotaNone    QuotaBucket = "none"    // 0
+	QuotaFew     QuotaBucket = "few"     // 1-5
+	QuotaSeveral QuotaBucket = "several" // 6-20
+	QuotaMany    QuotaBucket = "many"    // more than 20
+)
+
+// QuotaBucketOf buckets a daily quota.
+func QuotaBucketOf(quota int) QuotaBucket {
+	switch {
+	case quota <= 0:
+		return QuotaNone
+	case quota <= 5:
+		return QuotaFew
+	case quota <= 20:
+		return QuotaSeveral
+	default:
+		return QuotaMany
+	}
+}
+
+// BucketMetadata returns the policy's thresholds and quotas as abstract
+// buckets, keyed with prefix (e.g. "before_"), for event metadata.
+func (c CirclePolicy) BucketMetadata(prefix string) map[string]string {
+	return map[string]string{
+		prefix + "regret":       string(ThresholdBucketOf(c.RegretThreshold)),
+		prefix + "notify":       string(ThresholdBucketOf(c.NotifyThreshold)),
+		prefix + "urgent":       string(ThresholdBucketOf(c.UrgentThreshold)),
+		prefix + "daily_notify": string(QuotaBucketOf(c.DailyNotifyQuota)),
+		prefix + "daily_queued": string(QuotaBucketOf(c.DailyQueuedQuota)),
+	}
+}
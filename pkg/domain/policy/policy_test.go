@@ -131,6 +131,18 @@ func TestCirclePolicyValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "quota above max",
+			policy: CirclePolicy{
+				CircleID:         "work",
+				RegretThreshold:  40,
+				NotifyThreshold:  60,
+				UrgentThreshold:  80,
+				DailyNotifyQuota: MaxDailyQuota + 1,
+				DailyQueuedQuota: 20,
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid hours start",
 			policy: CirclePolicy{
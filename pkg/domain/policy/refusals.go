@@ -0,0 +1,128 @@
+package policy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// RefusalClass is an abstract class of action the system refuses by design.
+//
+// Refusals are declared, not inferred. The /proof/refusals page renders
+// exactly the configured set so the copy can never drift from behavior.
+type RefusalClass string
+
+const (
+	// RefuseSend means the system never sends messages on its own.
+	RefuseSend RefusalClass = "never_send"
+
+	// RefusePay means the system never moves money on its own.
+	RefusePay RefusalClass = "never_pay"
+
+	// RefuseShare means the system never shares data with third parties.
+	RefuseShare RefusalClass = "never_share"
+)
+
+// AllRefusalClasses returns all refusal classes in canonical order.
+func AllRefusalClasses() []RefusalClass {
+	return []RefusalClass{RefuseSend, RefusePay, RefuseShare}
+}
+
+// Validate checks that the refusal class is known.
+func (c RefusalClass) Validate() error {
+	for _, known := range AllRefusalClasses() {
+		if c == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown refusal class: %q", c)
+}
+
+// Statement returns the calm, abstract statement for the refusal class.
+func (c RefusalClass) Statement() string {
+	switch c {
+	case RefuseSend:
+		return "We never send anything on your behalf."
+	case RefusePay:
+		return "We never pay or move money."
+	case RefuseShare:
+		return "We never share what we see."
+	default:
+		return ""
+	}
+}
+
+// Refusals is the declared set of action classes the system refuses.
+type Refusals struct {
+	// Classes is the set of refused action classes.
+	Classes []RefusalClass
+}
+
+// DefaultRefusals returns the full set of refusals.
+func DefaultRefusals() Refusals {
+	return Refusals{Classes: AllRefusalClasses()}
+}
+
+// ParseRefusals parses refusal class names into a Refusals set.
+// Duplicates are ignored. Unknown classes are rejected.
+func ParseRefusals(values []string) (Refusals, error) {
+	var r Refusals
+	for _, v := range values {
+		class := RefusalClass(strings.TrimSpace(v))
+		if err := class.Validate(); err != nil {
+			return Refusals{}, err
+		}
+		if !r.Contains(class) {
+			r.Classes = append(r.Classes, class)
+		}
+	}
+	return r, nil
+}
+
+// Contains returns true if the class is declared.
+func (r Refusals) Contains(class RefusalClass) bool {
+	for _, c := range r.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// Ordered returns the declared classes in canonical order.
+func (r Refusals) Ordered() []RefusalClass {
+	ordered := make([]RefusalClass, 0, len(r.Classes))
+	for _, c := range AllRefusalClasses() {
+		if r.Contains(c) {
+			ordered = append(ordered, c)
+		}
+	}
+	return ordered
+}
+
+// Statements returns the statements for the declared classes in canonical order.
+func (r Refusals) Statements() []string {
+	ordered := r.Ordered()
+	statements := make([]string, 0, len(ordered))
+	for _, c := range ordered {
+		statements = append(statements, c.Statement())
+	}
+	return statements
+}
+
+// CanonicalString returns a deterministic string representation.
+func (r Refusals) CanonicalString() string {
+	ordered := r.Ordered()
+	parts := make([]string, len(ordered))
+	for i, c := range ordered {
+		parts[i] = string(c)
+	}
+	return "refusals|" + strings.Join(parts, ",")
+}
+
+// ComputeHash returns the SHA256 hash of the canonical string.
+func (r Refusals) ComputeHash() string {
+	hash := sha256.Sum256([]byte(r.CanonicalString()))
+	return hex.EncodeToString(hash[:])
+}
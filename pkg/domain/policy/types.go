@@ -74,7 +74,7 @@ type CirclePolicy struct {
 	CircleID string
 
 	// RegretThreshold is the baseline gating (0-100).
-	// Interruptions below this score are queued.
+	// Interruptions below this score are neither notified nor queued.
 	RegretThreshold int
 
 	// NotifyThreshold is the minimum regret to Notify (0-100).
@@ -142,12 +142,12 @@ func (c CirclePolicy) Validate() error {
 			c.NotifyThreshold, c.RegretThreshold)
 	}
 
-	// Quotas must be non-negative
-	if c.DailyNotifyQuota < 0 {
-		return fmt.Errorf("daily_notify_quota must be >= 0, got %d", c.DailyNotifyQuota)
+	// Quotas must be 0-MaxDailyQuota
+	if c.DailyNotifyQuota < 0 || c.DailyNotifyQuota > MaxDailyQuota {
+		return fmt.Errorf("daily_notify_quota must be 0-%d, got %d", MaxDailyQuota, c.DailyNotifyQuota)
 	}
-	if c.DailyQueuedQuota < 0 {
-		return fmt.Errorf("daily_queued_quota must be >= 0, got %d", c.DailyQueuedQuota)
+	if c.DailyQueuedQuota < 0 || c.DailyQueuedQuota > MaxDailyQuota {
+		return fmt.Errorf("daily_queued_quota must be 0-%d, got %d", MaxDailyQuota, c.DailyQueuedQuota)
 	}
 
 	// Validate hours if present
@@ -19,6 +19,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -418,8 +420,7 @@ func (p *PushDeliveryReceiptPage) CanonicalString() string {
 // ComputeStatusHash computes a deterministic hash of the page state.
 func (p *PushDeliveryReceiptPage) ComputeStatusHash() string {
 	canonical := p.CanonicalString()
-	h := sha256.Sum256([]byte(canonical))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("pushtransport.PushDeliveryReceiptPage", canonical)[:32]
 }
 
 // DefaultPushProofPage returns a default proof page.
@@ -23,6 +23,8 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"sort"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // MirrorMagnitude represents the abstract magnitude of observed activity.
@@ -155,8 +157,7 @@ type QuietMirrorSummary struct {
 // Uses pipe-delimited canonical string format.
 func (s *QuietMirrorSummary) Hash() string {
 	canonical := s.CanonicalString()
-	hash := sha256.Sum256([]byte(canonical))
-	return hex.EncodeToString(hash[:])
+	return hashutil.HashString("quietmirror.QuietMirrorSummary", canonical)
 }
 
 // CanonicalString returns a pipe-delimited canonical representation.
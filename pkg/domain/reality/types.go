@@ -68,6 +68,10 @@ type RealityPage struct {
 	// CalmLine is the single calm summary line.
 	CalmLine string
 
+	// CoverageLine summarizes which source kinds are covered
+	// (e.g., "email and finance connected; commerce observed").
+	CoverageLine string
+
 	// StatusHash is the deterministic hash of the page (32 hex chars).
 	StatusHash string
 
@@ -95,6 +99,8 @@ func (p *RealityPage) CanonicalString() string {
 	b.WriteString("|")
 	b.WriteString(p.CalmLine)
 	b.WriteString("|")
+	b.WriteString(p.CoverageLine)
+	b.WriteString("|")
 	b.WriteString(p.BackPath)
 
 	return b.String()
@@ -171,6 +177,13 @@ type RealityInputs struct {
 	// GmailConnected indicates if Gmail is connected (yes/no).
 	GmailConnected bool
 
+	// ConnectedKinds lists connected source kinds in canonical order
+	// (email, calendar, finance). Kinds only - never accounts.
+	ConnectedKinds []string
+
+	// CommerceObserved indicates commerce observations exist for the circle.
+	CommerceObserved bool
+
 	// SyncBucket indicates last sync recency (never/recent/stale/unknown).
 	SyncBucket SyncBucket
 
@@ -216,6 +229,10 @@ func (i *RealityInputs) CanonicalString() string {
 	b.WriteString(i.NowBucket)
 	b.WriteString("|gmail:")
 	b.WriteString(boolToYesNo(i.GmailConnected))
+	b.WriteString("|kinds:")
+	b.WriteString(strings.Join(i.ConnectedKinds, ","))
+	b.WriteString("|commerce:")
+	b.WriteString(boolToYesNo(i.CommerceObserved))
 	b.WriteString("|sync:")
 	b.WriteString(string(i.SyncBucket))
 	b.WriteString("|sync_mag:")
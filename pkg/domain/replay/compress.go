@@ -0,0 +1,116 @@
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// Compressed bundle format: CompressedMagic, one version byte, then the
+// gzip stream of SignedText. Hashes and signatures always cover the
+// uncompressed canonical text, so compression never changes them.
+const (
+	// CompressedMagic prefixes every compressed bundle.
+	CompressedMagic = "QLRZ"
+
+	// CompressedVersion is the current compressed container version.
+	CompressedVersion byte = 1
+
+	// CompressedFileExt is the file extension for compressed bundles.
+	CompressedFileExt = ".qlreplay.gz"
+
+	// MaxBundleBytes bounds a decompressed bundle.
+	MaxBundleBytes = 16 << 20
+)
+
+// IsCompressedBundle reports whether data starts with the compressed magic.
+func IsCompressedBundle(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(CompressedMagic))
+}
+
+// CompressBundleText wraps bundle text in the compressed container.
+// The gzip header carries no name or timestamp, so output is deterministic.
+func CompressBundleText(text string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(CompressedMagic)
+	buf.WriteByte(CompressedVersion)
+
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write([]byte(text)); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeBundleBytes returns the bundle text from either a compressed
+// container or a legacy uncompressed bundle.
+func DecodeBundleBytes(data []byte) (string, error) {
+	if !IsCompressedBundle(data) {
+		return string(data), nil
+	}
+
+	rest := data[len(CompressedMagic):]
+	if len(rest) == 0 {
+		return "", ErrMalformedCompressedBundle
+	}
+	if rest[0] != CompressedVersion {
+		return "", fmt.Errorf("%w: %d", ErrUnsupportedCompressedVersion, rest[0])
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(rest[1:]))
+	if err != nil {
+		return "", ErrMalformedCompressedBundle
+	}
+	defer zr.Close()
+
+	text, err := io.ReadAll(io.LimitReader(zr, MaxBundleBytes+1))
+	if err != nil {
+		return "", ErrMalformedCompressedBundle
+	}
+	if len(text) > MaxBundleBytes {
+		return "", ErrCompressedBundleTooLarge
+	}
+	return string(text), nil
+}
+
+// CompressionBucket is an abstract measure of how much compression saved.
+type CompressionBucket string
+
+const (
+	CompressionNone        CompressionBucket = "none"        // < 10% saved
+	CompressionSlight      CompressionBucket = "slight"      // 10-49% saved
+	CompressionSubstantial CompressionBucket = "substantial" // 50-79% saved
+	CompressionHigh        CompressionBucket = "high"        // >= 80% saved
+)
+
+// ComputeCompressionBucket buckets the space saved by compression.
+func ComputeCompressionBucket(rawBytes, compressedBytes int) CompressionBucket {
+	if rawBytes <= 0 || compressedBytes >= rawBytes {
+		return CompressionNone
+	}
+	saved := (rawBytes - compressedBytes) * 100 / rawBytes
+	switch {
+	case saved < 10:
+		return CompressionNone
+	case saved < 50:
+		return CompressionSlight
+	case saved < 80:
+		return CompressionSubstantial
+	default:
+		return CompressionHigh
+	}
+}
+
+// Compressed bundle errors.
+const (
+	ErrMalformedCompressedBundle    replayError = "compressed bundle is malformed"
+	ErrUnsupportedCompressedVersion replayError = "unsupported compressed bundle version"
+	ErrCompressedBundleTooLarge     replayError = "compressed bundle is too large"
+)
@@ -0,0 +1,130 @@
+package replay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+)
+
+// RecordChange is a record present in both bundles under the same hash
+// whose abstract fields differ.
+type RecordChange struct {
+	RecordHash string
+	Before     CanonicalRecordLine
+	After      CanonicalRecordLine
+}
+
+// BundleDiff is the abstract difference between two replay bundles,
+// keyed by record hash. Every list is sorted by period bucket, then
+// record hash, so the diff does not depend on record order.
+type BundleDiff struct {
+	// Added records appear only in the second bundle.
+	Added []CanonicalRecordLine
+
+	// Removed records appear only in the first bundle.
+	Removed []CanonicalRecordLine
+
+	// Changed records share a hash but differ in type, period or payload hash.
+	Changed []RecordChange
+
+	// Unchanged counts records identical in both bundles.
+	Unchanged int
+
+	// FromHash and ToHash are the compared bundles' content hashes.
+	FromHash string
+	ToHash   string
+}
+
+// IsEmpty reports whether the bundles hold the same records.
+func (d *BundleDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// CanonicalString returns a deterministic, pipe-delimited representation.
+func (d *BundleDiff) CanonicalString() string {
+	var sb strings.Builder
+	sb.WriteString("v1|bundle_diff|" + d.FromHash + "|" + d.ToHash + "\n")
+	for _, r := range d.Added {
+		sb.WriteString("+|" + r.CanonicalString() + "\n")
+	}
+	for _, r := range d.Removed {
+		sb.WriteString("-|" + r.CanonicalString() + "\n")
+	}
+	for _, c := range d.Changed {
+		sb.WriteString("~|" + c.Before.CanonicalString() + "|" + c.After.CanonicalString() + "\n")
+	}
+	return sb.String()
+}
+
+// Hash returns the SHA256 of the canonical diff.
+func (d *BundleDiff) Hash() string {
+	hash := sha256.Sum256([]byte(d.CanonicalString()))
+	return hex.EncodeToString(hash[:16])
+}
+
+// DiffBundles compares two bundles record by record, keyed by record hash.
+// If a bundle repeats a hash, the line with the smallest canonical form is
+// used, so the result never depends on record order.
+func DiffBundles(from, to *ReplayBundle) BundleDiff {
+	before := indexRecords(from.Records)
+	after := indexRecords(to.Records)
+
+	diff := BundleDiff{
+		FromHash: from.ComputeBundleHash(),
+		ToHash:   to.ComputeBundleHash(),
+	}
+	for hash, a := range after {
+		b, ok := before[hash]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, a)
+		case b != a:
+			diff.Changed = append(diff.Changed, RecordChange{RecordHash: hash, Before: b, After: a})
+		default:
+			diff.Unchanged++
+		}
+	}
+	for hash, b := range before {
+		if _, ok := after[hash]; !ok {
+			diff.Removed = append(diff.Removed, b)
+		}
+	}
+
+	sortRecordLines(diff.Added)
+	sortRecordLines(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		return recordLineLess(diff.Changed[i].After, diff.Changed[j].After)
+	})
+	return diff
+}
+
+// indexRecords maps record hash to its line.
+func indexRecords(records []CanonicalRecordLine) map[string]CanonicalRecordLine {
+	index := make(map[string]CanonicalRecordLine, len(records))
+	for _, r := range records {
+		if existing, ok := index[r.RecordHash]; ok && existing.CanonicalString() <= r.CanonicalString() {
+			continue
+		}
+		index[r.RecordHash] = r
+	}
+	return index
+}
+
+// recordLineLess orders lines by period bucket, then record hash,
+// matching the canonical bundle order.
+func recordLineLess(a, b CanonicalRecordLine) bool {
+	if a.PeriodBucket != b.PeriodBucket {
+		return a.PeriodBucket < b.PeriodBucket
+	}
+	if a.RecordHash != b.RecordHash {
+		return a.RecordHash < b.RecordHash
+	}
+	return a.CanonicalString() < b.CanonicalString()
+}
+
+func sortRecordLines(lines []CanonicalRecordLine) {
+	sort.Slice(lines, func(i, j int) bool {
+		return recordLineLess(lines[i], lines[j])
+	})
+}
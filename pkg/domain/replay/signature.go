@@ -0,0 +1,106 @@
+package replay
+
+import (
+	"crypto/ed25519"
+	"strings"
+
+	"quantumlife/pkg/domain/deviceidentity"
+)
+
+// SignatureSeparator starts the signature trailer after the record lines.
+const SignatureSeparator = "==="
+
+// BundleSignature binds a bundle hash to the exporting device key.
+// The device signs the bundle hash; the hash covers header and records.
+type BundleSignature struct {
+	// BundleHash is the hash the device signed.
+	BundleHash string
+
+	// PublicKey is the exporting device's public key.
+	PublicKey deviceidentity.DevicePublicKey
+
+	// Signature is the Ed25519 signature over BundleHash.
+	Signature deviceidentity.Signature
+}
+
+// SignatureLines returns the trailer appended after the separator.
+func (s *BundleSignature) SignatureLines() string {
+	return "bundle_hash: " + s.BundleHash + "\n" +
+		"public_key: " + string(s.PublicKey) + "\n" +
+		"signature: " + string(s.Signature) + "\n"
+}
+
+// parseBundleSignature parses the trailer produced by SignatureLines.
+func parseBundleSignature(lines []string) (*BundleSignature, error) {
+	fields := make(map[string]string)
+	for _, line := range lines {
+		if k, v, ok := strings.Cut(line, ": "); ok {
+			fields[k] = strings.TrimSpace(v)
+		}
+	}
+	sig := &BundleSignature{
+		BundleHash: fields["bundle_hash"],
+		PublicKey:  deviceidentity.DevicePublicKey(fields["public_key"]),
+		Signature:  deviceidentity.Signature(fields["signature"]),
+	}
+	if sig.BundleHash == "" || sig.PublicKey == "" || sig.Signature == "" {
+		return nil, ErrMalformedBundleSignature
+	}
+	return sig, nil
+}
+
+// SignedText returns the canonical bundle followed by its signature
+// trailer. Unsigned bundles return CanonicalString unchanged.
+func (b *ReplayBundle) SignedText() string {
+	if b.Signature == nil {
+		return b.CanonicalString()
+	}
+	return b.CanonicalString() + SignatureSeparator + "\n" + b.Signature.SignatureLines()
+}
+
+// VerifyBundleSignature checks the signed hash matches the bundle content
+// and the signature verifies under the embedded public key.
+// Whether that key is trusted is for the caller to decide.
+func VerifyBundleSignature(b *ReplayBundle) error {
+	if b.Signature == nil {
+		return ErrBundleUnsigned
+	}
+	if b.Signature.BundleHash != b.ComputeBundleHash() {
+		return ErrBundleHashMismatch
+	}
+	if err := b.Signature.PublicKey.Validate(); err != nil {
+		return ErrInvalidBundleSignature
+	}
+	if err := b.Signature.Signature.Validate(); err != nil {
+		return ErrInvalidBundleSignature
+	}
+	pub, _ := b.Signature.PublicKey.ToBytes()
+	sig, _ := b.Signature.Signature.ToBytes()
+	if !ed25519.Verify(pub, []byte(b.Signature.BundleHash), sig) {
+		return ErrInvalidBundleSignature
+	}
+	return nil
+}
+
+// VerifyResult is the outcome of verifying a bundle's signature.
+type VerifyResult struct {
+	// Verified is true when the signature is valid and the key trusted.
+	Verified bool
+
+	// Fingerprint identifies the signing key, when one was embedded.
+	Fingerprint deviceidentity.Fingerprint
+}
+
+// replayError is a constant error type.
+type replayError string
+
+func (e replayError) Error() string { return string(e) }
+
+// Bundle signature errors.
+const (
+	ErrBundleUnsigned           replayError = "bundle is not signed"
+	ErrMalformedBundleSignature replayError = "bundle signature is malformed"
+	ErrBundleHashMismatch       replayError = "bundle content does not match its signed hash"
+	ErrInvalidBundleSignature   replayError = "bundle signature is invalid"
+	ErrUntrustedBundleKey       replayError = "bundle was signed by an untrusted key"
+)
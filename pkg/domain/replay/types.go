@@ -158,6 +158,10 @@ func (r *CanonicalRecordLine) Validate() error {
 type ReplayBundle struct {
 	Header  ReplayBundleHeader
 	Records []CanonicalRecordLine
+
+	// Signature is the exporting device's signature, if signed.
+	// Not part of the canonical content or the bundle hash.
+	Signature *BundleSignature
 }
 
 // CanonicalString returns the full canonical representation.
@@ -290,13 +294,22 @@ func ParseReplayBundle(content string) (*ReplayBundle, error) {
 		BundleHash:     bundleHash,
 	}
 
-	// Parse records
+	// Parse records, up to an optional signature trailer
 	var records []CanonicalRecordLine
+	var signature *BundleSignature
 	for i := separatorIdx + 1; i < len(lines); i++ {
 		line := strings.TrimSpace(lines[i])
 		if line == "" {
 			continue
 		}
+		if line == SignatureSeparator {
+			sig, err := parseBundleSignature(lines[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			signature = sig
+			break
+		}
 		record, err := ParseCanonicalRecordLine(line)
 		if err != nil {
 			return nil, fmt.Errorf("invalid record line %d: %w", i, err)
@@ -305,8 +318,9 @@ func ParseReplayBundle(content string) (*ReplayBundle, error) {
 	}
 
 	bundle := &ReplayBundle{
-		Header:  header,
-		Records: records,
+		Header:    header,
+		Records:   records,
+		Signature: signature,
 	}
 
 	// If no bundle hash was in header, compute it
@@ -323,6 +337,12 @@ type ExportResult struct {
 	Error      string
 	Bundle     *ReplayBundle
 	BundleText string
+
+	// Compressed is BundleText in the compressed container, set by Export.
+	Compressed []byte
+
+	// Compression buckets the space Compressed saves over BundleText.
+	Compression CompressionBucket
 }
 
 // ImportResult contains the result of bundle import.
@@ -0,0 +1,266 @@
+package runlog
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"quantumlife/pkg/domain/identity"
+	"quantumlife/pkg/domain/rulepack"
+	"quantumlife/pkg/hashutil"
+)
+
+// BundleVersion identifies the run bundle format.
+const BundleVersion = "run_bundle_v1"
+
+// Bundle errors.
+var (
+	// ErrBundleMalformed indicates the bundle could not be parsed.
+	ErrBundleMalformed = errors.New("run bundle is malformed")
+
+	// ErrBundleVersion indicates an unsupported bundle version.
+	ErrBundleVersion = errors.New("unsupported run bundle version")
+
+	// ErrBundleHashMismatch indicates the detached hash does not match the bundle.
+	ErrBundleHashMismatch = errors.New("run bundle hash mismatch")
+
+	// ErrBundleRunID indicates the bundle's run ID is not a loop run ID.
+	ErrBundleRunID = errors.New("run bundle run ID is invalid")
+)
+
+// minRunIDLen is the length of the hex run IDs the loop assigns.
+const minRunIDLen = 16
+
+// RunBundle is the archived form of a run snapshot.
+//
+// CRITICAL: Hashes and counts only. No raw content, no event payloads.
+type RunBundle struct {
+	Version    string `json:"version"`
+	RunID      string `json:"run_id"`
+	CircleID   string `json:"circle_id"`
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+	ConfigHash string `json:"config_hash"`
+
+	// InputsHash covers what the run was evaluated against.
+	InputsHash string `json:"inputs_hash"`
+
+	// OutputsHash covers what the run produced.
+	OutputsHash string `json:"outputs_hash"`
+
+	// ResultHash is the snapshot's own result hash.
+	ResultHash string `json:"result_hash"`
+
+	EventsIngested            int      `json:"events_ingested"`
+	InterruptionsCreated      int      `json:"interruptions_created"`
+	InterruptionsDeduplicated int      `json:"interruptions_deduplicated"`
+	DraftsCreated             int      `json:"drafts_created"`
+	NeedsYouItems             int      `json:"needs_you_items"`
+	NeedsYouHash              string   `json:"needs_you_hash"`
+	EventHashes               []string `json:"event_hashes"`
+	InterruptionHashes        []string `json:"interruption_hashes"`
+	DraftHashes               []string `json:"draft_hashes"`
+
+	// SeenDedupHashes and QuotaUsage are the recorded interruption state
+	// the run started from.
+	SeenDedupHashes []string       `json:"seen_dedup_hashes,omitempty"`
+	QuotaUsage      map[string]int `json:"quota_usage,omitempty"`
+
+	// EmittedEvents are hashes of the events emitted during the run.
+	EmittedEvents []string `json:"emitted_events"`
+}
+
+// bundleEnvelope pairs the canonical bundle JSON with its detached hash.
+type bundleEnvelope struct {
+	Bundle json.RawMessage `json:"bundle"`
+	Hash   string          `json:"hash"`
+}
+
+// HashInputEvent returns the hash recorded for an event the run read.
+// The event ID is itself derived from the event's canonical string.
+func HashInputEvent(eventID, canonical string) string {
+	return hashutil.Hash("runlog.InputEvent", []byte(eventID), []byte(canonical))
+}
+
+// HashEmittedEvent returns the hash recorded for an emitted event.
+// Metadata keys are sorted so the hash is independent of map order.
+func HashEmittedEvent(eventType string, metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(eventType)
+	for _, k := range keys {
+		b.WriteString("|")
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(metadata[k])
+	}
+	return hashutil.HashString("runlog.EmittedEvent", b.String())
+}
+
+// InputsHash returns the hash of the run's inputs: when it ran, for which
+// circle, under which config, over which events and from which recorded
+// interruption state.
+func (s *RunSnapshot) InputsHash() string {
+	var b strings.Builder
+	b.WriteString("start:")
+	b.WriteString(s.StartTime.UTC().Format(time.RFC3339Nano))
+	b.WriteString("|circle:")
+	b.WriteString(string(s.CircleID))
+	b.WriteString("|config_hash:")
+	b.WriteString(s.ConfigHash)
+	for _, h := range s.EventHashes {
+		b.WriteString("|event_hash:")
+		b.WriteString(h)
+	}
+	s.writeStartState(&b)
+	return hashutil.HashString("runlog.RunInputs", b.String())
+}
+
+// OutputsHash returns the hash of the run's outputs, including the
+// events emitted during the run.
+func (s *RunSnapshot) OutputsHash() string {
+	var b strings.Builder
+	b.WriteString("result_hash:")
+	b.WriteString(s.ResultHash)
+	for _, h := range s.EmittedEventHashes {
+		b.WriteString("|emitted:")
+		b.WriteString(h)
+	}
+	return hashutil.HashString("runlog.RunOutputs", b.String())
+}
+
+// ToBundle exports the snapshot as canonical JSON with a detached hash.
+// The bundle must pass the rule pack export privacy validation.
+func (s *RunSnapshot) ToBundle() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle := RunBundle{
+		Version:                   BundleVersion,
+		RunID:                     s.RunID,
+		CircleID:                  string(s.CircleID),
+		StartTime:                 s.StartTime.UTC().Format(time.RFC3339Nano),
+		EndTime:                   s.EndTime.UTC().Format(time.RFC3339Nano),
+		ConfigHash:                s.ConfigHash,
+		InputsHash:                s.InputsHash(),
+		OutputsHash:               s.OutputsHash(),
+		ResultHash:                s.ResultHash,
+		EventsIngested:            s.EventsIngested,
+		InterruptionsCreated:      s.InterruptionsCreated,
+		InterruptionsDeduplicated: s.InterruptionsDeduplicated,
+		DraftsCreated:             s.DraftsCreated,
+		NeedsYouItems:             s.NeedsYouItems,
+		NeedsYouHash:              s.NeedsYouHash,
+		EventHashes:               nonNil(s.EventHashes),
+		InterruptionHashes:        nonNil(s.InterruptionHashes),
+		DraftHashes:               nonNil(s.DraftHashes),
+		EmittedEvents:             nonNil(s.EmittedEventHashes),
+		SeenDedupHashes:           s.SeenDedupHashes,
+		QuotaUsage:                s.QuotaUsage,
+	}
+
+	canonical, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	if err := rulepack.ValidateExportPrivacy(string(canonical)); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(bundleEnvelope{
+		Bundle: canonical,
+		Hash:   hashutil.Hash("runlog.RunBundle", canonical),
+	})
+}
+
+// ParseBundle verifies a bundle produced by ToBundle and returns the
+// snapshot it describes. The detached hash, privacy validation, run ID
+// format, inputs and outputs hashes and the snapshot's result hash must
+// all hold.
+func ParseBundle(data []byte) (*RunSnapshot, error) {
+	var env bundleEnvelope
+	if err := json.Unmarshal(data, &env); err != nil || len(env.Bundle) == 0 {
+		return nil, ErrBundleMalformed
+	}
+	if hashutil.Hash("runlog.RunBundle", env.Bundle) != env.Hash {
+		return nil, ErrBundleHashMismatch
+	}
+	if err := rulepack.ValidateExportPrivacy(string(env.Bundle)); err != nil {
+		return nil, err
+	}
+
+	var bundle RunBundle
+	if err := json.Unmarshal(env.Bundle, &bundle); err != nil {
+		return nil, ErrBundleMalformed
+	}
+	if bundle.Version != BundleVersion {
+		return nil, ErrBundleVersion
+	}
+	// The detached hash is unkeyed, so the run ID is checked explicitly
+	if !validRunID(bundle.RunID) {
+		return nil, ErrBundleRunID
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, bundle.StartTime)
+	if err != nil {
+		return nil, ErrBundleMalformed
+	}
+	end, err := time.Parse(time.RFC3339Nano, bundle.EndTime)
+	if err != nil {
+		return nil, ErrBundleMalformed
+	}
+
+	s := NewRunSnapshot(bundle.RunID, start, end, identity.EntityID(bundle.CircleID), bundle.ConfigHash)
+	s.EventsIngested = bundle.EventsIngested
+	s.InterruptionsCreated = bundle.InterruptionsCreated
+	s.InterruptionsDeduplicated = bundle.InterruptionsDeduplicated
+	s.DraftsCreated = bundle.DraftsCreated
+	s.NeedsYouItems = bundle.NeedsYouItems
+	s.NeedsYouHash = bundle.NeedsYouHash
+	s.EventHashes = bundle.EventHashes
+	s.InterruptionHashes = bundle.InterruptionHashes
+	s.DraftHashes = bundle.DraftHashes
+	s.EmittedEventHashes = bundle.EmittedEvents
+	s.SeenDedupHashes = bundle.SeenDedupHashes
+	s.QuotaUsage = bundle.QuotaUsage
+	s.ResultHash = bundle.ResultHash
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	if s.InputsHash() != bundle.InputsHash || s.OutputsHash() != bundle.OutputsHash {
+		return nil, ErrBundleHashMismatch
+	}
+
+	return s, nil
+}
+
+// validRunID reports whether id is lowercase hex of at least minRunIDLen
+// characters, the form the loop assigns.
+func validRunID(id string) bool {
+	if len(id) < minRunIDLen {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// nonNil returns an empty slice for nil so bundles encode [] not null.
+func nonNil(hashes []string) []string {
+	if hashes == nil {
+		return []string{}
+	}
+	return hashes
+}
@@ -1,6 +1,7 @@
 package runlog
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -143,6 +144,28 @@ func TestVerifyReplay_Failure(t *testing.T) {
 	}
 }
 
+func TestVerifyReplay_HashListDifference(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	original := NewRunSnapshot("run-1", now, now, "work", "config-hash")
+	original.InterruptionHashes = []string{"int-a", "int-b"}
+	original.FinalizeSnapshot()
+
+	// Same counts, one interruption hash differs
+	replay := NewRunSnapshot("run-1", now, now, "work", "config-hash")
+	replay.InterruptionHashes = []string{"int-a", "int-c"}
+	replay.FinalizeSnapshot()
+
+	result := VerifyReplay(original, replay)
+	if result.Success {
+		t.Fatal("replay should fail due to different interruption hash")
+	}
+	want := "interruption_hash[1]: int-b vs int-c"
+	if len(result.Differences) != 1 || result.Differences[0] != want {
+		t.Errorf("expected [%s], got %v", want, result.Differences)
+	}
+}
+
 func TestInMemoryRunStore_StoreAndGet(t *testing.T) {
 	store := NewInMemoryRunStore()
 
@@ -276,3 +299,161 @@ func TestComputeRunID(t *testing.T) {
 func hasPrefix(s, prefix string) bool {
 	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
 }
+
+func TestInMemoryRunStore_ListRange(t *testing.T) {
+	store := NewInMemoryRunStore()
+
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	// run-a and run-b share a start time to exercise the ID tie-break
+	for i, id := range []string{"run-c", "run-b", "run-a", "run-d", "run-e"} {
+		start := now.Add(time.Duration(i) * time.Hour)
+		if id == "run-a" {
+			start = now.Add(time.Hour)
+		}
+		snap := NewRunSnapshot(id, start, start.Add(time.Minute), "work", "cfg")
+		snap.FinalizeSnapshot()
+		store.Store(snap)
+	}
+
+	page, total := store.ListRange(0, 2)
+	if total != 5 {
+		t.Fatalf("total = %d, want 5", total)
+	}
+	if len(page) != 2 || page[0].RunID != "run-e" || page[1].RunID != "run-d" {
+		t.Errorf("first page should be newest first, got %v", runIDs(page))
+	}
+
+	page, _ = store.ListRange(2, 2)
+	if len(page) != 2 || page[0].RunID != "run-a" || page[1].RunID != "run-b" {
+		t.Errorf("ties should be ordered by run ID, got %v", runIDs(page))
+	}
+
+	page, _ = store.ListRange(4, 2)
+	if len(page) != 1 || page[0].RunID != "run-c" {
+		t.Errorf("last page should hold the oldest run, got %v", runIDs(page))
+	}
+
+	page, total = store.ListRange(10, 2)
+	if len(page) != 0 || total != 5 {
+		t.Errorf("offset past end should return empty page, got %d (total %d)", len(page), total)
+	}
+}
+
+func runIDs(snapshots []*RunSnapshot) []string {
+	ids := make([]string, len(snapshots))
+	for i, s := range snapshots {
+		ids[i] = s.RunID
+	}
+	return ids
+}
+
+func TestRunSnapshot_BundleRoundTrip(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	original := NewRunSnapshot("0123456789abcdef", now, now, "work", "config-hash")
+	original.EventsIngested = 3
+	original.EventHashes = []string{"evt-b", "evt-a"}
+	original.SeenDedupHashes = []string{"dedup-a"}
+	original.QuotaUsage = map[string]int{"work": 2}
+	original.InterruptionHashes = []string{"int-a"}
+	original.EmittedEventHashes = []string{
+		HashEmittedEvent("phase6.daily.run.started", map[string]string{"run_id": "0123456789abcdef"}),
+	}
+	original.FinalizeSnapshot()
+
+	data, err := original.ToBundle()
+	if err != nil {
+		t.Fatalf("ToBundle failed: %v", err)
+	}
+
+	store := NewInMemoryRunStore()
+	imported, err := store.ImportBundle(data)
+	if err != nil {
+		t.Fatalf("ImportBundle failed: %v", err)
+	}
+	if imported.ResultHash != original.ResultHash {
+		t.Errorf("imported hash %s != original %s", imported.ResultHash, original.ResultHash)
+	}
+	if imported.InputsHash() != original.InputsHash() || imported.OutputsHash() != original.OutputsHash() {
+		t.Error("inputs/outputs hashes should survive the round trip")
+	}
+	if imported.QuotaUsage["work"] != 2 || len(imported.SeenDedupHashes) != 1 {
+		t.Error("recorded interruption state should survive the round trip")
+	}
+	if store.Count() != 1 {
+		t.Errorf("expected 1 stored snapshot, got %d", store.Count())
+	}
+	if _, err := store.ImportBundle(data); err != ErrRunExists {
+		t.Errorf("expected ErrRunExists on re-import, got %v", err)
+	}
+	if runs, _ := store.ListByCircle("work"); len(runs) != 1 {
+		t.Errorf("expected 1 run for circle after re-import, got %d", len(runs))
+	}
+
+	// Same snapshot, same bytes
+	again, _ := original.ToBundle()
+	if string(again) != string(data) {
+		t.Error("bundle should be deterministic")
+	}
+}
+
+func TestRunStore_ImportBundleRejectsTampering(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	original := NewRunSnapshot("0123456789abcdef", now, now, "work", "config-hash")
+	original.EventsIngested = 3
+	original.FinalizeSnapshot()
+
+	data, err := original.ToBundle()
+	if err != nil {
+		t.Fatalf("ToBundle failed: %v", err)
+	}
+
+	tampered := strings.Replace(string(data), `"events_ingested":3`, `"events_ingested":4`, 1)
+	if tampered == string(data) {
+		t.Fatal("test setup: events_ingested not found in bundle")
+	}
+
+	store := NewInMemoryRunStore()
+	if _, err := store.ImportBundle([]byte(tampered)); err != ErrBundleHashMismatch {
+		t.Errorf("expected ErrBundleHashMismatch, got %v", err)
+	}
+	if store.Count() != 0 {
+		t.Error("tampered bundle must not be stored")
+	}
+}
+
+func TestRunStore_ImportBundleRejectsInvalidRunID(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	// The detached hash is unkeyed, so these bundles are internally consistent
+	for _, runID := range []string{"run-1", "0123456789abcde", "0123456789ABCDEF", "0123456789abcdeg"} {
+		s := NewRunSnapshot(runID, now, now, "work", "config-hash")
+		s.FinalizeSnapshot()
+		data, err := s.ToBundle()
+		if err != nil {
+			t.Fatalf("ToBundle(%q) failed: %v", runID, err)
+		}
+
+		store := NewInMemoryRunStore()
+		if _, err := store.ImportBundle(data); err != ErrBundleRunID {
+			t.Errorf("run ID %q: expected ErrBundleRunID, got %v", runID, err)
+		}
+		if store.Count() != 0 {
+			t.Errorf("run ID %q: invalid bundle must not be stored", runID)
+		}
+	}
+}
+
+func TestRunSnapshot_ToBundlePrivacy(t *testing.T) {
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	// A circle ID that looks like an email address must not be exported
+	s := NewRunSnapshot("run-1", now, now, "alice@example.com", "config-hash")
+	s.FinalizeSnapshot()
+
+	if _, err := s.ToBundle(); err == nil {
+		t.Error("expected privacy validation to reject the bundle")
+	}
+}
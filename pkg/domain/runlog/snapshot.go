@@ -52,15 +52,28 @@ type RunSnapshot struct {
 	// NeedsYouItems is the count of items in NeedsYou view.
 	NeedsYouItems int
 
-	// EventHashes contains hashes of all events in deterministic order.
+	// EventHashes contains hashes of the input events the run read, in
+	// deterministic order. Replay evaluates against exactly these events.
 	EventHashes []string
 
+	// SeenDedupHashes are hashes of the interruption dedup keys the run
+	// found already seen when it started. Recorded input.
+	SeenDedupHashes []string
+
+	// QuotaUsage is the interruption quota already used per quota key
+	// when the run started. Recorded input.
+	QuotaUsage map[string]int
+
 	// InterruptionHashes contains hashes of all interruptions.
 	InterruptionHashes []string
 
 	// DraftHashes contains hashes of all drafts.
 	DraftHashes []string
 
+	// EmittedEventHashes contains hashes of the events emitted during the
+	// run, in emission order. Carried in bundles; not part of ResultHash.
+	EmittedEventHashes []string
+
 	// NeedsYouHash is the hash of the NeedsYou view snapshot.
 	NeedsYouHash string
 
@@ -99,6 +112,7 @@ func (s *RunSnapshot) ComputeResultHash() string {
 		b.WriteString("|event_hash:")
 		b.WriteString(h)
 	}
+	s.writeStartState(&b)
 	for _, h := range s.InterruptionHashes {
 		b.WriteString("|interruption_hash:")
 		b.WriteString(h)
@@ -117,6 +131,26 @@ func (s *RunSnapshot) ComputeResultHash() string {
 	return hex.EncodeToString(hash[:])
 }
 
+// writeStartState appends the recorded dedup and quota state. Nothing is
+// written when it is empty, so hashes of runs without it are unchanged.
+func (s *RunSnapshot) writeStartState(b *strings.Builder) {
+	for _, h := range s.SeenDedupHashes {
+		b.WriteString("|seen_dedup:")
+		b.WriteString(h)
+	}
+	keys := make([]string, 0, len(s.QuotaUsage))
+	for k := range s.QuotaUsage {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		b.WriteString("|quota:")
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(itoa(s.QuotaUsage[k]))
+	}
+}
+
 // Validate checks that the snapshot is valid.
 func (s *RunSnapshot) Validate() error {
 	if s.RunID == "" {
@@ -188,6 +222,7 @@ func (s *RunSnapshot) toPayload() string {
 var (
 	ErrHashMismatch    = errors.New("hash mismatch")
 	ErrRunNotFound     = errors.New("run not found")
+	ErrRunExists       = errors.New("run already exists")
 	ErrReplayFailed    = errors.New("replay verification failed")
 	ErrInvalidSnapshot = errors.New("invalid snapshot")
 )
@@ -226,6 +261,10 @@ func VerifyReplay(original, replay *RunSnapshot) *ReplayResult {
 	}
 
 	// Find differences
+	if !original.EndTime.Equal(replay.EndTime) {
+		result.Differences = append(result.Differences,
+			"end_time: "+original.EndTime.UTC().Format(time.RFC3339)+" vs "+replay.EndTime.UTC().Format(time.RFC3339))
+	}
 	if original.EventsIngested != replay.EventsIngested {
 		result.Differences = append(result.Differences,
 			"events_ingested: "+itoa(original.EventsIngested)+" vs "+itoa(replay.EventsIngested))
@@ -234,32 +273,50 @@ func VerifyReplay(original, replay *RunSnapshot) *ReplayResult {
 		result.Differences = append(result.Differences,
 			"interruptions_created: "+itoa(original.InterruptionsCreated)+" vs "+itoa(replay.InterruptionsCreated))
 	}
+	if original.InterruptionsDeduplicated != replay.InterruptionsDeduplicated {
+		result.Differences = append(result.Differences,
+			"interruptions_deduplicated: "+itoa(original.InterruptionsDeduplicated)+" vs "+itoa(replay.InterruptionsDeduplicated))
+	}
 	if original.DraftsCreated != replay.DraftsCreated {
 		result.Differences = append(result.Differences,
 			"drafts_created: "+itoa(original.DraftsCreated)+" vs "+itoa(replay.DraftsCreated))
 	}
+	if original.NeedsYouItems != replay.NeedsYouItems {
+		result.Differences = append(result.Differences,
+			"needs_you_items: "+itoa(original.NeedsYouItems)+" vs "+itoa(replay.NeedsYouItems))
+	}
 	if original.NeedsYouHash != replay.NeedsYouHash {
 		result.Differences = append(result.Differences,
 			"needs_you_hash: "+original.NeedsYouHash+" vs "+replay.NeedsYouHash)
 	}
-
-	// Check event hashes
-	if len(original.EventHashes) != len(replay.EventHashes) {
+	if original.ConfigHash != replay.ConfigHash {
 		result.Differences = append(result.Differences,
-			"event_hash_count: "+itoa(len(original.EventHashes))+" vs "+itoa(len(replay.EventHashes)))
-	} else {
-		for i := range original.EventHashes {
-			if original.EventHashes[i] != replay.EventHashes[i] {
-				result.Differences = append(result.Differences,
-					"event_hash["+itoa(i)+"]: "+original.EventHashes[i]+" vs "+replay.EventHashes[i])
-				break // Only report first difference
-			}
-		}
+			"config_hash: "+original.ConfigHash+" vs "+replay.ConfigHash)
 	}
 
+	// Check hash lists
+	result.Differences = append(result.Differences, diffHashes("event_hash", original.EventHashes, replay.EventHashes)...)
+	result.Differences = append(result.Differences, diffHashes("seen_dedup", original.SeenDedupHashes, replay.SeenDedupHashes)...)
+	result.Differences = append(result.Differences, diffHashes("interruption_hash", original.InterruptionHashes, replay.InterruptionHashes)...)
+	result.Differences = append(result.Differences, diffHashes("draft_hash", original.DraftHashes, replay.DraftHashes)...)
+	result.Differences = append(result.Differences, diffHashes("emitted_event", original.EmittedEventHashes, replay.EmittedEventHashes)...)
+
 	return result
 }
 
+// diffHashes reports the first difference between two ordered hash lists.
+func diffHashes(name string, original, replay []string) []string {
+	if len(original) != len(replay) {
+		return []string{name + "_count: " + itoa(len(original)) + " vs " + itoa(len(replay))}
+	}
+	for i := range original {
+		if original[i] != replay[i] {
+			return []string{name + "[" + itoa(i) + "]: " + original[i] + " vs " + replay[i]}
+		}
+	}
+	return nil
+}
+
 // RunStore provides storage for run snapshots.
 type RunStore interface {
 	// Store saves a run snapshot.
@@ -307,6 +364,7 @@ func NewRunSnapshot(
 func (s *RunSnapshot) FinalizeSnapshot() {
 	// Sort all hashes for determinism
 	sort.Strings(s.EventHashes)
+	sort.Strings(s.SeenDedupHashes)
 	sort.Strings(s.InterruptionHashes)
 	sort.Strings(s.DraftHashes)
 
@@ -50,6 +50,9 @@ func (s *FileRunStore) replay() error {
 			continue // Skip corrupted records
 		}
 		snapshot.StartTime = record.Timestamp
+		if _, exists := s.snapshots[snapshot.RunID]; exists {
+			continue // First record for a run ID wins
+		}
 		s.index(snapshot)
 	}
 
@@ -62,7 +65,7 @@ func (s *FileRunStore) index(snapshot *RunSnapshot) {
 	s.byCircle[snapshot.CircleID] = append(s.byCircle[snapshot.CircleID], snapshot)
 }
 
-// Store saves a run snapshot.
+// Store saves a run snapshot. A run ID already stored is rejected.
 func (s *FileRunStore) Store(snapshot *RunSnapshot) error {
 	if err := snapshot.Validate(); err != nil {
 		return err
@@ -71,6 +74,10 @@ func (s *FileRunStore) Store(snapshot *RunSnapshot) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if _, exists := s.snapshots[snapshot.RunID]; exists {
+		return ErrRunExists
+	}
+
 	// Create log record
 	payload := formatRunPayload(snapshot)
 	record := storelog.NewRecord(
@@ -89,6 +96,19 @@ func (s *FileRunStore) Store(snapshot *RunSnapshot) error {
 	return nil
 }
 
+// ImportBundle verifies a run bundle and stores the snapshot it describes.
+// A bundle for a run ID already stored is rejected with ErrRunExists.
+func (s *FileRunStore) ImportBundle(data []byte) (*RunSnapshot, error) {
+	snapshot, err := ParseBundle(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
 // Get retrieves a run snapshot by ID.
 func (s *FileRunStore) Get(runID string) (*RunSnapshot, error) {
 	s.mu.RLock()
@@ -158,7 +178,7 @@ func NewInMemoryRunStore() *InMemoryRunStore {
 	}
 }
 
-// Store saves a run snapshot.
+// Store saves a run snapshot. A run ID already stored is rejected.
 func (s *InMemoryRunStore) Store(snapshot *RunSnapshot) error {
 	if err := snapshot.Validate(); err != nil {
 		return err
@@ -167,11 +187,28 @@ func (s *InMemoryRunStore) Store(snapshot *RunSnapshot) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if _, exists := s.snapshots[snapshot.RunID]; exists {
+		return ErrRunExists
+	}
+
 	s.snapshots[snapshot.RunID] = snapshot
 	s.byCircle[snapshot.CircleID] = append(s.byCircle[snapshot.CircleID], snapshot)
 	return nil
 }
 
+// ImportBundle verifies a run bundle and stores the snapshot it describes.
+// A bundle for a run ID already stored is rejected with ErrRunExists.
+func (s *InMemoryRunStore) ImportBundle(data []byte) (*RunSnapshot, error) {
+	snapshot, err := ParseBundle(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
 // Get retrieves a run snapshot by ID.
 func (s *InMemoryRunStore) Get(runID string) (*RunSnapshot, error) {
 	s.mu.RLock()
@@ -201,6 +238,39 @@ func (s *InMemoryRunStore) List() ([]*RunSnapshot, error) {
 	return result, nil
 }
 
+// ListRange returns one page of run snapshots, newest first, and the total
+// number of snapshots. Ties on start time are ordered by run ID.
+// An offset past the end yields an empty page; a negative offset is treated as 0.
+func (s *InMemoryRunStore) ListRange(offset, limit int) ([]*RunSnapshot, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]*RunSnapshot, 0, len(s.snapshots))
+	for _, snapshot := range s.snapshots {
+		all = append(all, snapshot)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].StartTime.Equal(all[j].StartTime) {
+			return all[i].StartTime.After(all[j].StartTime)
+		}
+		return all[i].RunID < all[j].RunID
+	})
+
+	total := len(all)
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= total || limit <= 0 {
+		return []*RunSnapshot{}, total
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return all[offset:end], total
+}
+
 // ListByCircle returns run snapshots for a specific circle.
 func (s *InMemoryRunStore) ListByCircle(circleID identity.EntityID) ([]*RunSnapshot, error) {
 	s.mu.RLock()
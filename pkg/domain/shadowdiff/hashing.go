@@ -122,6 +122,13 @@ func (d *DiffResult) CanonicalString() string {
 	b.WriteString("|")
 	b.WriteString(d.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"))
 
+	// Similarity is appended only when set, so exact-comparison diffs keep
+	// their original hash.
+	if d.Similarity != "" {
+		b.WriteString("|similarity:")
+		b.WriteString(string(d.Similarity))
+	}
+
 	return b.String()
 }
 
@@ -212,6 +212,35 @@ func (n Novelty) Validate() bool {
 	}
 }
 
+// =============================================================================
+// Similarity
+// =============================================================================
+
+// SimilarityBucket is the abstract similarity between a canon and a shadow
+// signal that were paired by a novelty scorer. Raw scores are never stored.
+type SimilarityBucket string
+
+const (
+	// SimilarityHigh means the signals were judged the same observation.
+	SimilarityHigh SimilarityBucket = "high"
+
+	// SimilarityMedium means the signals were related but not paired.
+	SimilarityMedium SimilarityBucket = "medium"
+
+	// SimilarityLow means the signals were unrelated.
+	SimilarityLow SimilarityBucket = "low"
+)
+
+// Validate checks if the similarity bucket is valid.
+func (b SimilarityBucket) Validate() bool {
+	switch b {
+	case SimilarityHigh, SimilarityMedium, SimilarityLow:
+		return true
+	default:
+		return false
+	}
+}
+
 // =============================================================================
 // Calibration Vote
 // =============================================================================
@@ -270,6 +299,10 @@ type DiffResult struct {
 	// NoveltyType indicates if one system saw something the other missed.
 	NoveltyType Novelty
 
+	// Similarity is set when a novelty scorer paired a canon-only and a
+	// shadow-only signal into one diff. Empty for exact key comparison.
+	Similarity SimilarityBucket
+
 	// PeriodBucket is the time bucket for aggregation (e.g., "2024-01-15").
 	PeriodBucket string
 
@@ -325,6 +358,10 @@ func (d *DiffResult) Validate() error {
 		return ErrInvalidNovelty
 	}
 
+	if d.Similarity != "" && !d.Similarity.Validate() {
+		return ErrInvalidSimilarity
+	}
+
 	if d.PeriodBucket == "" {
 		return ErrMissingPeriodBucket
 	}
@@ -469,6 +506,7 @@ const (
 	ErrMissingCanonSignal    diffError = "missing canon signal"
 	ErrInvalidAgreement      diffError = "invalid agreement kind"
 	ErrInvalidNovelty        diffError = "invalid novelty type"
+	ErrInvalidSimilarity     diffError = "invalid similarity bucket"
 	ErrMissingPeriodBucket   diffError = "missing period bucket"
 	ErrMissingCreatedAt      diffError = "missing created at timestamp"
 	ErrMissingRecordID       diffError = "missing record ID"
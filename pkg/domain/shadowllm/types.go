@@ -20,6 +20,7 @@ package shadowllm
 import (
 	"time"
 
+	"quantumlife/pkg/domain/config"
 	"quantumlife/pkg/domain/identity"
 )
 
@@ -65,6 +66,10 @@ const (
 	// CRITICAL: Requires explicit opt-in and consent.
 	ProviderKindAzureOpenAI ProviderKind = "azure_openai"
 
+	// ProviderKindAnthropic is the Anthropic Claude provider.
+	// CRITICAL: Requires explicit opt-in and consent.
+	ProviderKindAnthropic ProviderKind = "anthropic"
+
 	// ProviderKindLocalSLM is a placeholder for future local SLM support.
 	// NOT IMPLEMENTED in Phase 19.3.
 	ProviderKindLocalSLM ProviderKind = "local_slm"
@@ -73,7 +78,7 @@ const (
 // Validate checks if the provider kind is valid.
 func (p ProviderKind) Validate() bool {
 	switch p {
-	case ProviderKindNone, ProviderKindStub, ProviderKindAzureOpenAI, ProviderKindLocalSLM:
+	case ProviderKindNone, ProviderKindStub, ProviderKindAzureOpenAI, ProviderKindAnthropic, ProviderKindLocalSLM:
 		return true
 	default:
 		return false
@@ -82,7 +87,7 @@ func (p ProviderKind) Validate() bool {
 
 // IsReal returns true if this is a real (non-stub) provider.
 func (p ProviderKind) IsReal() bool {
-	return p == ProviderKindAzureOpenAI || p == ProviderKindLocalSLM
+	return p == ProviderKindAzureOpenAI || p == ProviderKindAnthropic || p == ProviderKindLocalSLM
 }
 
 // RequiresConsent returns true if this provider requires explicit consent.
@@ -466,8 +471,8 @@ type MagnitudeBucket string
 
 const (
 	MagnitudeNothing MagnitudeBucket = "nothing" // Zero items
-	MagnitudeAFew    MagnitudeBucket = "a_few"   // 1-3 items
-	MagnitudeSeveral MagnitudeBucket = "several" // 4+ items
+	MagnitudeAFew    MagnitudeBucket = "a_few"   // 1-3 items (default scale)
+	MagnitudeSeveral MagnitudeBucket = "several" // 4+ items (default scale)
 )
 
 // Validate checks if the magnitude is valid.
@@ -480,12 +485,80 @@ func (m MagnitudeBucket) Validate() bool {
 	}
 }
 
-// MagnitudeFromCount converts a raw count to a magnitude bucket.
+// Rank orders buckets for threshold checks: nothing < a_few < several.
+// Unknown buckets rank below nothing.
+func (m MagnitudeBucket) Rank() int {
+	switch m {
+	case MagnitudeNothing:
+		return 0
+	case MagnitudeAFew:
+		return 1
+	case MagnitudeSeveral:
+		return 2
+	default:
+		return -1
+	}
+}
+
+// AtLeast returns true if m is at or above min.
+func (m MagnitudeBucket) AtLeast(min MagnitudeBucket) bool {
+	return m.Rank() >= min.Rank()
+}
+
+// MagnitudeFromCount converts a raw count to a magnitude bucket using the
+// default scale.
 func MagnitudeFromCount(count int) MagnitudeBucket {
+	return DefaultBucketScale().Bucket(count)
+}
+
+const (
+	// DefaultAFewMax is the largest count bucketed as "a few" by default.
+	DefaultAFewMax = 3
+
+	// MaxAFewMax is the hard ceiling on a configured "a few" threshold.
+	MaxAFewMax = 20
+)
+
+// BucketScale maps raw counts to magnitude buckets.
+// Zero is always nothing; counts up to AFewMax are a few; more are several.
+type BucketScale struct {
+	// AFewMax is the largest count bucketed as "a few".
+	AFewMax int
+}
+
+// DefaultBucketScale returns the 1-3 "a few" scale.
+func DefaultBucketScale() BucketScale {
+	return BucketScale{AFewMax: DefaultAFewMax}
+}
+
+// NewBucketScale returns a scale with the given "a few" threshold.
+// Out-of-range thresholds fall back to the default.
+func NewBucketScale(aFewMax int) BucketScale {
+	if aFewMax < 1 || aFewMax > MaxAFewMax {
+		return DefaultBucketScale()
+	}
+	return BucketScale{AFewMax: aFewMax}
+}
+
+// BucketScaleFromConfig returns the configured scale.
+// A nil config or unset threshold gives the default scale.
+func BucketScaleFromConfig(cfg *config.MultiCircleConfig) BucketScale {
+	if cfg == nil {
+		return DefaultBucketScale()
+	}
+	return NewBucketScale(cfg.MagnitudeAFewMax)
+}
+
+// Bucket converts a raw count to a magnitude bucket.
+func (s BucketScale) Bucket(count int) MagnitudeBucket {
+	aFewMax := s.AFewMax
+	if aFewMax < 1 {
+		aFewMax = DefaultAFewMax
+	}
 	switch {
-	case count == 0:
+	case count <= 0:
 		return MagnitudeNothing
-	case count <= 3:
+	case count <= aFewMax:
 		return MagnitudeAFew
 	default:
 		return MagnitudeSeveral
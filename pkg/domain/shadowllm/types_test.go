@@ -0,0 +1,39 @@
+package shadowllm
+
+import (
+	"testing"
+
+	"quantumlife/pkg/domain/config"
+)
+
+func TestBucketScale_DefaultPreservesMagnitudeFromCount(t *testing.T) {
+	want := map[int]MagnitudeBucket{
+		0: MagnitudeNothing,
+		1: MagnitudeAFew,
+		3: MagnitudeAFew,
+		4: MagnitudeSeveral,
+		9: MagnitudeSeveral,
+	}
+	scale := BucketScaleFromConfig(nil)
+	for count, bucket := range want {
+		if got := scale.Bucket(count); got != bucket {
+			t.Errorf("Bucket(%d) = %s, want %s", count, got, bucket)
+		}
+		if got := MagnitudeFromCount(count); got != bucket {
+			t.Errorf("MagnitudeFromCount(%d) = %s, want %s", count, got, bucket)
+		}
+	}
+	if (BucketScale{}).Bucket(3) != MagnitudeAFew {
+		t.Error("expected zero-value scale to use the default threshold")
+	}
+}
+
+func TestBucketScale_FromConfig(t *testing.T) {
+	scale := BucketScaleFromConfig(&config.MultiCircleConfig{MagnitudeAFewMax: 5})
+	if scale.Bucket(5) != MagnitudeAFew || scale.Bucket(6) != MagnitudeSeveral {
+		t.Errorf("expected a few up to 5, got %s/%s", scale.Bucket(5), scale.Bucket(6))
+	}
+	if NewBucketScale(MaxAFewMax+1) != DefaultBucketScale() {
+		t.Error("expected out-of-range threshold to fall back to the default")
+	}
+}
@@ -0,0 +1,192 @@
+// Package suppress defines suppression rules for interruptions.
+// This file contains the portable export/import format.
+//
+// The portable format is canonical JSON of abstract rule fields only
+// (circle ID, scope, key or pattern, times, reason, source). Runtime
+// tallies such as match counts are never exported.
+package suppress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PortableSchemaVersion is the schema version written by Export.
+// Import rejects any other version so future formats can coexist.
+const PortableSchemaVersion = 1
+
+// MaxImportRules bounds the number of rules accepted in one import.
+const MaxImportRules = 500
+
+var (
+	// ErrUnsupportedSchema is returned for an unknown schema version.
+	ErrUnsupportedSchema = errors.New("unsupported suppression schema version")
+
+	// ErrInvalidImport is returned when an import file or rule is malformed.
+	ErrInvalidImport = errors.New("invalid suppression import")
+)
+
+// portableFile is the top-level portable document.
+type portableFile struct {
+	SchemaVersion int            `json:"schema_version"`
+	Rules         []portableRule `json:"rules"`
+}
+
+// portableRule is one rule in the portable document.
+// Times are RFC3339 UTC, matching the precision of the rule ID.
+type portableRule struct {
+	RuleID    string `json:"rule_id"`
+	CircleID  string `json:"circle_id"`
+	Scope     Scope  `json:"scope"`
+	Key       string `json:"key,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+	Reason    string `json:"reason"`
+	Source    Source `json:"source"`
+}
+
+// Export returns the rules as canonical portable JSON.
+// Rules are written in the set's deterministic order, so the same set
+// always exports to the same bytes.
+func (s *SuppressionSet) Export() ([]byte, error) {
+	file := portableFile{
+		SchemaVersion: PortableSchemaVersion,
+		Rules:         make([]portableRule, 0, len(s.Rules)),
+	}
+	for _, r := range s.Rules {
+		p := portableRule{
+			RuleID:    r.RuleID,
+			CircleID:  r.CircleID,
+			Scope:     r.Scope,
+			Key:       r.Key,
+			Pattern:   r.Pattern,
+			CreatedAt: r.CreatedAt.UTC().Format(time.RFC3339),
+			Reason:    r.Reason,
+			Source:    r.Source,
+		}
+		if r.ExpiresAt != nil {
+			p.ExpiresAt = r.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		file.Rules = append(file.Rules, p)
+	}
+	b, err := json.Marshal(file)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// Import adds the rules from a portable document produced by Export.
+// Every rule is validated before any is applied; one invalid rule rejects
+// the whole import and leaves the set unchanged. Rules whose hash is
+// already in the set (or earlier in the same document) are skipped, so
+// importing the same file twice is a no-op.
+func (s *SuppressionSet) Import(data []byte) (added, skipped int, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var file portableFile
+	if err := dec.Decode(&file); err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if file.SchemaVersion != PortableSchemaVersion {
+		return 0, 0, fmt.Errorf("%w: %d", ErrUnsupportedSchema, file.SchemaVersion)
+	}
+	if len(file.Rules) > MaxImportRules {
+		return 0, 0, fmt.Errorf("%w: more than %d rules", ErrInvalidImport, MaxImportRules)
+	}
+
+	rules := make([]SuppressionRule, 0, len(file.Rules))
+	for i, p := range file.Rules {
+		rule, err := p.toRule()
+		if err != nil {
+			return 0, 0, fmt.Errorf("rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	seen := make(map[string]bool, len(s.Rules)+len(rules))
+	for _, r := range s.Rules {
+		seen[r.Hash()] = true
+	}
+	for _, rule := range rules {
+		hash := rule.Hash()
+		if seen[hash] {
+			skipped++
+			continue
+		}
+		seen[hash] = true
+		s.Rules = append(s.Rules, rule)
+		added++
+	}
+
+	if added > 0 {
+		s.sort()
+		s.Version++
+		s.ComputeHash()
+	}
+	return added, skipped, nil
+}
+
+// toRule validates a portable rule and converts it to a compiled rule.
+func (p portableRule) toRule() (SuppressionRule, error) {
+	switch p.Scope {
+	case ScopeCircle, ScopePerson, ScopeVendor, ScopeTrigger, ScopeItemKey:
+	default:
+		return SuppressionRule{}, fmt.Errorf("%w: unknown scope", ErrInvalidImport)
+	}
+	switch p.Source {
+	case SourceManual, SourceFeedback:
+	default:
+		return SuppressionRule{}, fmt.Errorf("%w: unknown source", ErrInvalidImport)
+	}
+	if p.CircleID == "" {
+		return SuppressionRule{}, fmt.Errorf("%w: missing circle", ErrInvalidImport)
+	}
+	if p.Key == "" && p.Pattern == "" {
+		return SuppressionRule{}, fmt.Errorf("%w: missing key", ErrInvalidImport)
+	}
+	if p.Key != "" && p.Pattern != "" {
+		return SuppressionRule{}, fmt.Errorf("%w: both key and pattern set", ErrInvalidImport)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, p.CreatedAt)
+	if err != nil {
+		return SuppressionRule{}, fmt.Errorf("%w: bad created_at", ErrInvalidImport)
+	}
+	var expiresAt *time.Time
+	if p.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, p.ExpiresAt)
+		if err != nil {
+			return SuppressionRule{}, fmt.Errorf("%w: bad expires_at", ErrInvalidImport)
+		}
+		if !t.After(createdAt) {
+			return SuppressionRule{}, fmt.Errorf("%w: expires before created", ErrInvalidImport)
+		}
+		t = t.UTC()
+		expiresAt = &t
+	}
+
+	rule := SuppressionRule{
+		CircleID:  p.CircleID,
+		Scope:     p.Scope,
+		Key:       p.Key,
+		CreatedAt: createdAt.UTC(),
+		ExpiresAt: expiresAt,
+		Reason:    p.Reason,
+		Source:    p.Source,
+		Pattern:   p.Pattern,
+	}
+	if err := rule.Compile(); err != nil {
+		return SuppressionRule{}, err
+	}
+	rule.RuleID = rule.computeRuleID()
+	if p.RuleID != rule.RuleID {
+		return SuppressionRule{}, fmt.Errorf("%w: rule_id mismatch", ErrInvalidImport)
+	}
+	return rule, nil
+}
@@ -1,6 +1,8 @@
 package suppress
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -387,3 +389,281 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestPatternRuleMatchesAbstractKeys(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rule, err := NewPatternRule("work", ScopeVendor, "^dom_(a1|b2)", now, nil, "newsletters", SourceManual)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	if !rule.IsPattern() || rule.Key != "" {
+		t.Errorf("Expected pattern rule with empty key, got %+v", rule)
+	}
+
+	ss := NewSuppressionSet()
+	if err := ss.AddRule(rule); err != nil {
+		t.Fatalf("AddRule failed: %v", err)
+	}
+
+	if ss.FindMatch(now, "work", ScopeVendor, "dom_a1f3") == nil {
+		t.Error("Expected pattern to match dom_a1f3")
+	}
+	if ss.FindMatch(now, "work", ScopeVendor, "dom_c9") != nil {
+		t.Error("Expected pattern not to match dom_c9")
+	}
+	if ss.FindMatch(now, "work", ScopeTrigger, "dom_a1") != nil {
+		t.Error("Pattern should only apply to its own scope")
+	}
+
+	// Pattern is part of the ID and canonical string
+	other, _ := NewPatternRule("work", ScopeVendor, "^dom_c", now, nil, "newsletters", SourceManual)
+	if rule.RuleID == other.RuleID || rule.Hash() == other.Hash() {
+		t.Error("Different patterns should have different IDs and hashes")
+	}
+}
+
+func TestPatternRuleRejectedAtAddTime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := NewPatternRule("work", ScopeVendor, "dom_(", now, nil, "", SourceManual); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern for bad regex, got %v", err)
+	}
+	if _, err := NewPatternRule("work", ScopeCircle, ".*", now, nil, "", SourceManual); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern for circle scope, got %v", err)
+	}
+
+	// A hand-built rule is validated when added; the set is unchanged
+	ss := NewSuppressionSet()
+	hash := ss.Hash
+	bad := SuppressionRule{RuleID: "sr_bad", CircleID: "work", Scope: ScopeTrigger, Pattern: "[", CreatedAt: now}
+	if err := ss.AddRule(bad); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern from AddRule, got %v", err)
+	}
+	if len(ss.Rules) != 0 || ss.Hash != hash {
+		t.Error("Rejected rule must not change the set")
+	}
+}
+
+func TestStatsCountMatchesPerRule(t *testing.T) {
+	now := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)
+	ss := NewSuppressionSet()
+
+	rule, _ := NewPatternRule("work", ScopeTrigger, "^obligation_", now, nil, "", SourceManual)
+	exact := NewSuppressionRule("work", ScopeItemKey, "dedup-1", now, nil, "", SourceManual)
+	ss.AddRule(rule)
+	ss.AddRule(exact)
+
+	ss.RecordSuppressedBy(now, "intr-1", rule.RuleID)
+	ss.RecordSuppressedBy(now, "intr-2", rule.RuleID)
+	ss.RecordSuppressedBy(now, "intr-2", rule.RuleID) // same item, counted once
+	ss.RecordSuppressedBy(now, "intr-3", exact.RuleID)
+
+	stats := ss.GetStats(now)
+	if stats.MatchesByRule[rule.RuleID] != 2 {
+		t.Errorf("Expected 2 matches for pattern rule, got %d", stats.MatchesByRule[rule.RuleID])
+	}
+	if stats.MatchesByRule[exact.RuleID] != 1 {
+		t.Errorf("Expected 1 match for exact rule, got %d", stats.MatchesByRule[exact.RuleID])
+	}
+	if stats.PatternRules != 1 {
+		t.Errorf("Expected 1 pattern rule, got %d", stats.PatternRules)
+	}
+	if ss.SuppressedCount(now) != 3 {
+		t.Errorf("Expected 3 suppressed items, got %d", ss.SuppressedCount(now))
+	}
+}
+
+func TestActiveSweepsExpiredRulesLazily(t *testing.T) {
+	now := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(QuickSuppressDuration)
+
+	ss := NewSuppressionSet()
+	expiring := NewSuppressionRule("work", ScopeTrigger, "calendar_upcoming", now, &expires, "", SourceManual)
+	permanent := NewSuppressionRule("work", ScopeTrigger, "finance_pending", now, nil, "", SourceManual)
+	ss.AddRule(expiring)
+	ss.AddRule(permanent)
+
+	left, ok := expiring.TimeLeft(now.Add(24 * time.Hour))
+	if !ok || left != 6*24*time.Hour {
+		t.Errorf("Expected 6 days left, got %v %v", left, ok)
+	}
+	if _, ok := permanent.TimeLeft(now); ok {
+		t.Error("Permanent rule should have no time left")
+	}
+
+	// Before expiry: both active, nothing lapsed
+	if got := ss.Active(expires.Add(-time.Second)); len(got) != 2 {
+		t.Fatalf("Expected 2 active rules, got %d", len(got))
+	}
+	if lapsed := ss.TakeLapsed(); len(lapsed) != 0 {
+		t.Errorf("Expected no lapsed rules, got %d", len(lapsed))
+	}
+
+	// At expiry the rule is swept from the set
+	hash := ss.Hash
+	if got := ss.Active(expires); len(got) != 1 || got[0].RuleID != permanent.RuleID {
+		t.Fatalf("Expected only the permanent rule, got %v", got)
+	}
+	if len(ss.Rules) != 1 || ss.Hash == hash {
+		t.Error("Expected expired rule swept and hash updated")
+	}
+
+	// Lapse is reported exactly once
+	lapsed := ss.TakeLapsed()
+	if len(lapsed) != 1 || lapsed[0].RuleID != expiring.RuleID {
+		t.Errorf("Expected the expiring rule to lapse, got %v", lapsed)
+	}
+	ss.Active(expires.Add(time.Hour))
+	if len(ss.TakeLapsed()) != 0 {
+		t.Error("Lapsed rule should only be reported once")
+	}
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	now := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(QuickSuppressDuration)
+
+	src := NewSuppressionSet()
+	src.AddRule(NewSuppressionRule("work", ScopeTrigger, "calendar_upcoming", now, &expires, "suppressed for 7 days", SourceManual))
+	src.AddRule(NewSuppressionRule("home", ScopeVendor, "v_3f2a", now, nil, "", SourceFeedback))
+	pattern, err := NewPatternRule("work", ScopeItemKey, `^newsletter\|`, now, nil, "", SourceManual)
+	if err != nil {
+		t.Fatalf("NewPatternRule failed: %v", err)
+	}
+	src.AddRule(pattern)
+
+	data, err := src.Export()
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	again, _ := src.Export()
+	if string(data) != string(again) {
+		t.Error("Export should be deterministic")
+	}
+
+	dst := NewSuppressionSet()
+	added, skipped, err := dst.Import(data)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if added != 3 || skipped != 0 {
+		t.Errorf("Expected 3 added 0 skipped, got %d %d", added, skipped)
+	}
+	if dst.CanonicalString()[len("version:X"):] != src.CanonicalString()[len("version:X"):] {
+		t.Error("Imported rules should match the exported rules")
+	}
+	if dst.FindMatch(now, "work", ScopeItemKey, "newsletter|weekly") == nil {
+		t.Error("Imported pattern rule should match")
+	}
+
+	// Importing again is a no-op
+	version := dst.Version
+	added, skipped, err = dst.Import(data)
+	if err != nil || added != 0 || skipped != 3 {
+		t.Errorf("Expected idempotent import, got %d %d %v", added, skipped, err)
+	}
+	if dst.Version != version {
+		t.Error("No-op import should not bump the version")
+	}
+}
+
+func TestImportRejectsInvalidInput(t *testing.T) {
+	now := time.Date(2025, 1, 13, 9, 0, 0, 0, time.UTC)
+	src := NewSuppressionSet()
+	src.AddRule(NewSuppressionRule("work", ScopeTrigger, "calendar_upcoming", now, nil, "", SourceManual))
+	data, _ := src.Export()
+
+	ss := NewSuppressionSet()
+	if _, _, err := ss.Import([]byte(`{"schema_version":2,"rules":[]}`)); !errors.Is(err, ErrUnsupportedSchema) {
+		t.Errorf("Expected ErrUnsupportedSchema, got %v", err)
+	}
+
+	tampered := []byte(strings.Replace(string(data), "calendar_upcoming", "finance_pending", 1))
+	if _, _, err := ss.Import(tampered); !errors.Is(err, ErrInvalidImport) {
+		t.Errorf("Expected ErrInvalidImport for rule_id mismatch, got %v", err)
+	}
+
+	// One bad rule rejects the whole file
+	mixed := []byte(strings.Replace(string(data), `]}`,
+		`,{"rule_id":"x","circle_id":"work","scope":"scope_trigger","pattern":"(","created_at":"2025-01-13T09:00:00Z","reason":"","source":"manual"}]}`, 1))
+	if _, _, err := ss.Import(mixed); !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("Expected ErrInvalidPattern, got %v", err)
+	}
+	if len(ss.Rules) != 0 {
+		t.Errorf("Rejected import should leave the set unchanged, got %d rules", len(ss.Rules))
+	}
+}
+
+func TestTimeWindowWrapsMidnightBoundaries(t *testing.T) {
+	window, err := ParseTimeWindow("work", "22:00-07:00", "")
+	if err != nil {
+		t.Fatalf("ParseTimeWindow failed: %v", err)
+	}
+
+	day := time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		at   string
+		want bool
+	}{
+		{"21:59", false},
+		{"22:00", true},
+		{"23:59", true},
+		{"00:00", true},
+		{"06:59", true},
+		{"07:00", false},
+		{"12:00", false},
+	}
+	for _, tt := range tests {
+		clk, _ := time.Parse("15:04", tt.at)
+		at := day.Add(time.Duration(clk.Hour())*time.Hour + time.Duration(clk.Minute())*time.Minute + 30*time.Second)
+		if got := window.Contains(at); got != tt.want {
+			t.Errorf("Contains(%s) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+
+	// Non-wrapping window is half-open too
+	lunch, _ := ParseTimeWindow("work", "12:00-13:00", "")
+	if !lunch.Contains(day.Add(12*time.Hour)) || lunch.Contains(day.Add(13*time.Hour)) {
+		t.Error("Expected [12:00, 13:00) window")
+	}
+}
+
+func TestTimeWindowUsesTimezone(t *testing.T) {
+	window, err := ParseTimeWindow("work", "22:00-07:00", "America/New_York")
+	if err != nil {
+		t.Fatalf("ParseTimeWindow failed: %v", err)
+	}
+	// 03:30 UTC is 22:30 in New York (EST)
+	if !window.Contains(time.Date(2025, 1, 13, 3, 30, 0, 0, time.UTC)) {
+		t.Error("Expected 22:30 local to be inside the window")
+	}
+	// 12:30 UTC is 07:30 in New York
+	if window.Contains(time.Date(2025, 1, 13, 12, 30, 0, 0, time.UTC)) {
+		t.Error("Expected 07:30 local to be outside the window")
+	}
+
+	ss := NewSuppressionSet()
+	hash := ss.Hash
+	ss.SetTimeWindow(window)
+	if !ss.InTimeWindow(time.Date(2025, 1, 13, 3, 30, 0, 0, time.UTC), "work") {
+		t.Error("Expected work circle in its window")
+	}
+	if ss.InTimeWindow(time.Date(2025, 1, 13, 3, 30, 0, 0, time.UTC), "home") {
+		t.Error("Circle without a window should never be in one")
+	}
+	if ss.ComputeHash() != hash {
+		t.Error("Time windows should not change the set hash")
+	}
+}
+
+func TestParseTimeWindowRejectsInvalid(t *testing.T) {
+	for _, spec := range []string{"", "22:00", "25:00-07:00", "22:00-22:00", "10pm-7am"} {
+		if _, err := ParseTimeWindow("work", spec, ""); !errors.Is(err, ErrInvalidTimeWindow) {
+			t.Errorf("ParseTimeWindow(%q): expected ErrInvalidTimeWindow, got %v", spec, err)
+		}
+	}
+	if _, err := ParseTimeWindow("work", "22:00-07:00", "Mars/Olympus"); !errors.Is(err, ErrInvalidTimeWindow) {
+		t.Errorf("Expected ErrInvalidTimeWindow for unknown zone, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+// Package suppress defines suppression rules for interruptions.
+// This file contains time-window (quiet hours) rules.
+//
+// A time-window rule suppresses everything in a circle during a daily
+// window such as 22:00-07:00, regardless of category. Windows may wrap
+// midnight. The window is evaluated against the injected clock only.
+package suppress
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// minutesPerDay is the number of minutes in a wall-clock day.
+const minutesPerDay = 24 * 60
+
+// ErrInvalidTimeWindow is returned for a malformed time window.
+var ErrInvalidTimeWindow = errors.New("invalid suppression time window")
+
+// TimeWindowRule suppresses everything in a circle during a daily window.
+// The window is [Start, End) in minutes after local midnight; when End is
+// not after Start the window wraps midnight.
+type TimeWindowRule struct {
+	// CircleID is the circle this window applies to.
+	CircleID string
+
+	// StartMinute is the first suppressed minute of the day.
+	StartMinute int
+
+	// EndMinute is the first minute after the window.
+	EndMinute int
+
+	// Location is the zone the window is read in (nil = UTC).
+	Location *time.Location
+}
+
+// ParseTimeWindow parses a "HH:MM-HH:MM" window in the given IANA zone.
+// An empty zone means UTC. Start and end must differ.
+func ParseTimeWindow(circleID, spec, timezone string) (TimeWindowRule, error) {
+	startStr, endStr, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return TimeWindowRule{}, fmt.Errorf("%w: expected HH:MM-HH:MM", ErrInvalidTimeWindow)
+	}
+	start, err := parseClockMinute(startStr)
+	if err != nil {
+		return TimeWindowRule{}, err
+	}
+	end, err := parseClockMinute(endStr)
+	if err != nil {
+		return TimeWindowRule{}, err
+	}
+	if start == end {
+		return TimeWindowRule{}, fmt.Errorf("%w: start equals end", ErrInvalidTimeWindow)
+	}
+
+	rule := TimeWindowRule{
+		CircleID:    circleID,
+		StartMinute: start,
+		EndMinute:   end,
+	}
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return TimeWindowRule{}, fmt.Errorf("%w: unknown timezone", ErrInvalidTimeWindow)
+		}
+		rule.Location = loc
+	}
+	return rule, nil
+}
+
+// parseClockMinute parses "HH:MM" into minutes after midnight.
+func parseClockMinute(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("%w: bad time %q", ErrInvalidTimeWindow, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Contains returns true if at falls inside the window.
+// CRITICAL: at comes from the injected clock, never time.Now().
+func (r TimeWindowRule) Contains(at time.Time) bool {
+	loc := r.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	if r.StartMinute < r.EndMinute {
+		return minute >= r.StartMinute && minute < r.EndMinute
+	}
+	// Wraps midnight
+	return minute >= r.StartMinute || minute < r.EndMinute
+}
+
+// String returns the window as "HH:MM-HH:MM".
+func (r TimeWindowRule) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d",
+		r.StartMinute/60, r.StartMinute%60, r.EndMinute/60, r.EndMinute%60)
+}
+
+// SetTimeWindow sets the time window for the rule's circle, replacing any
+// previous window. Windows are configuration, not rules: they are
+// excluded from the canonical string and the portable export.
+func (s *SuppressionSet) SetTimeWindow(rule TimeWindowRule) {
+	if s.windows == nil {
+		s.windows = make(map[string]TimeWindowRule)
+	}
+	s.windows[rule.CircleID] = rule
+}
+
+// TimeWindow returns the time window for a circle, if any.
+func (s *SuppressionSet) TimeWindow(circleID string) (TimeWindowRule, bool) {
+	rule, ok := s.windows[circleID]
+	return rule, ok
+}
+
+// InTimeWindow returns true if the circle's time window contains at.
+func (s *SuppressionSet) InTimeWindow(at time.Time, circleID string) bool {
+	rule, ok := s.windows[circleID]
+	return ok && rule.Contains(at)
+}
@@ -12,17 +12,33 @@
 // - ScopeTrigger: suppress a specific trigger type
 // - ScopeItemKey: suppress a specific dedup key
 //
+// Pattern rules match the scope key with a regular expression instead of
+// an exact value. Patterns run against abstract fields only (vendor or
+// sender-domain hashes, trigger categories, dedup keys) - never raw
+// message bodies, subjects or addresses.
+//
 // Reference: docs/ADR/ADR-0030-phase14-policy-learning.md
 package suppress
 
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 )
 
+// QuickSuppressDuration is how long a "suppress for 7 days" rule lasts.
+const QuickSuppressDuration = 7 * 24 * time.Hour
+
+// MaxPatternLength bounds the length of a pattern rule's expression.
+const MaxPatternLength = 256
+
+// ErrInvalidPattern is returned when a pattern rule cannot be used.
+var ErrInvalidPattern = errors.New("invalid suppression pattern")
+
 // Scope defines what a suppression rule applies to.
 type Scope string
 
@@ -79,6 +95,13 @@ type SuppressionRule struct {
 
 	// Source indicates how this rule was created.
 	Source Source
+
+	// Pattern is a regular expression matched against the scope key.
+	// Empty for exact-match rules; when set, Key is ignored.
+	Pattern string
+
+	// matcher is the compiled Pattern.
+	matcher *regexp.Regexp
 }
 
 // NewSuppressionRule creates a new suppression rule with computed RuleID.
@@ -104,6 +127,59 @@ func NewSuppressionRule(
 	return rule
 }
 
+// NewPatternRule creates a suppression rule that matches scope keys
+// against a regular expression. The pattern is validated and compiled
+// here so a bad pattern is rejected before it is ever added.
+func NewPatternRule(
+	circleID string,
+	scope Scope,
+	pattern string,
+	createdAt time.Time,
+	expiresAt *time.Time,
+	reason string,
+	source Source,
+) (SuppressionRule, error) {
+	rule := SuppressionRule{
+		CircleID:  circleID,
+		Scope:     scope,
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+		Reason:    reason,
+		Source:    source,
+		Pattern:   pattern,
+	}
+	if err := rule.Compile(); err != nil {
+		return SuppressionRule{}, err
+	}
+	rule.RuleID = rule.computeRuleID()
+	return rule, nil
+}
+
+// IsPattern returns true if this rule matches by regular expression.
+func (r SuppressionRule) IsPattern() bool {
+	return r.Pattern != ""
+}
+
+// Compile validates and compiles the rule's pattern.
+// Exact-match rules are always valid.
+func (r *SuppressionRule) Compile() error {
+	if !r.IsPattern() {
+		return nil
+	}
+	if r.Scope == ScopeCircle {
+		return fmt.Errorf("%w: circle scope has no key to match", ErrInvalidPattern)
+	}
+	if len(r.Pattern) > MaxPatternLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidPattern, MaxPatternLength)
+	}
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPattern, err)
+	}
+	r.matcher = re
+	return nil
+}
+
 // computeRuleID generates a deterministic ID from rule properties.
 func (r SuppressionRule) computeRuleID() string {
 	var expiresStr string
@@ -118,6 +194,9 @@ func (r SuppressionRule) computeRuleID() string {
 		expiresStr,
 		r.Source,
 	)
+	if r.IsPattern() {
+		input += "|pattern:" + r.Pattern
+	}
 	hash := sha256.Sum256([]byte(input))
 	return "sr_" + hex.EncodeToString(hash[:8]) // 16 char hex
 }
@@ -145,6 +224,10 @@ func (r SuppressionRule) CanonicalString() string {
 	sb.WriteString(r.Reason)
 	sb.WriteString("|source:")
 	sb.WriteString(string(r.Source))
+	if r.IsPattern() {
+		sb.WriteString("|pattern:")
+		sb.WriteString(r.Pattern)
+	}
 	return sb.String()
 }
 
@@ -168,6 +251,19 @@ func (r SuppressionRule) IsActive(at time.Time) bool {
 	return at.Before(*r.ExpiresAt)
 }
 
+// TimeLeft returns how long until the rule expires at the given time.
+// Returns false for permanent rules.
+func (r SuppressionRule) TimeLeft(at time.Time) (time.Duration, bool) {
+	if r.ExpiresAt == nil {
+		return 0, false
+	}
+	left := r.ExpiresAt.Sub(at)
+	if left < 0 {
+		left = 0
+	}
+	return left, true
+}
+
 // Matches checks if this rule matches the given criteria.
 func (r SuppressionRule) Matches(circleID string, scope Scope, key string) bool {
 	if r.CircleID != circleID && r.CircleID != "*" {
@@ -176,12 +272,28 @@ func (r SuppressionRule) Matches(circleID string, scope Scope, key string) bool
 	if r.Scope != scope {
 		return false
 	}
+	if r.IsPattern() {
+		return r.matchPattern(key)
+	}
 	if r.Key != key && r.Key != "*" {
 		return false
 	}
 	return true
 }
 
+// matchPattern matches key against the pattern.
+// An uncompiled rule (e.g. replayed from storage) is compiled on demand;
+// a pattern that does not compile never matches.
+func (r SuppressionRule) matchPattern(key string) bool {
+	if r.matcher != nil {
+		return r.matcher.MatchString(key)
+	}
+	if r.Compile() != nil {
+		return false
+	}
+	return r.matcher.MatchString(key)
+}
+
 // SuppressionSet contains all suppression rules with versioning.
 type SuppressionSet struct {
 	// Version is incremented on each update.
@@ -192,24 +304,45 @@ type SuppressionSet struct {
 
 	// Hash is the computed SHA256 hash.
 	Hash string
+
+	// suppressed tracks suppressed item hashes per period (PeriodKey).
+	// Runtime tally only - excluded from the canonical string.
+	suppressed map[string]map[string]bool
+
+	// matches counts suppressed items per rule ID.
+	// Runtime tally only - excluded from the canonical string.
+	matches map[string]int
+
+	// lapsed holds rules swept by Active until taken with TakeLapsed.
+	lapsed []SuppressionRule
+
+	// windows holds the configured time window per circle ID.
+	windows map[string]TimeWindowRule
 }
 
 // NewSuppressionSet creates an empty suppression set.
 func NewSuppressionSet() *SuppressionSet {
 	ss := &SuppressionSet{
-		Version: 1,
-		Rules:   []SuppressionRule{},
+		Version:    1,
+		Rules:      []SuppressionRule{},
+		suppressed: make(map[string]map[string]bool),
 	}
 	ss.ComputeHash()
 	return ss
 }
 
 // AddRule adds a rule and re-sorts the set.
-func (s *SuppressionSet) AddRule(rule SuppressionRule) {
+// Pattern rules are compiled first; an invalid pattern is rejected with
+// ErrInvalidPattern and the set is left unchanged.
+func (s *SuppressionSet) AddRule(rule SuppressionRule) error {
+	if err := rule.Compile(); err != nil {
+		return err
+	}
 	s.Rules = append(s.Rules, rule)
 	s.sort()
 	s.Version++
 	s.ComputeHash()
+	return nil
 }
 
 // RemoveRule removes a rule by ID.
@@ -226,6 +359,17 @@ func (s *SuppressionSet) RemoveRule(ruleID string) bool {
 	return false
 }
 
+// ClearRules removes every rule and runtime tally. Configured time
+// windows are kept.
+func (s *SuppressionSet) ClearRules() {
+	s.Rules = []SuppressionRule{}
+	s.suppressed = make(map[string]map[string]bool)
+	s.matches = nil
+	s.lapsed = nil
+	s.Version++
+	s.ComputeHash()
+}
+
 // GetRule returns a rule by ID.
 func (s *SuppressionSet) GetRule(ruleID string) *SuppressionRule {
 	for _, r := range s.Rules {
@@ -247,6 +391,40 @@ func (s *SuppressionSet) ListActive(at time.Time) []SuppressionRule {
 	return active
 }
 
+// Active returns the rules active at the given time, lazily sweeping
+// expired rules out of the set. Swept rules are kept for TakeLapsed so
+// callers can report each lapse exactly once.
+// CRITICAL: at comes from the injected clock, never time.Now().
+func (s *SuppressionSet) Active(at time.Time) []SuppressionRule {
+	kept := make([]SuppressionRule, 0, len(s.Rules))
+	active := []SuppressionRule{}
+	for _, r := range s.Rules {
+		switch {
+		case r.ExpiresAt != nil && !at.Before(*r.ExpiresAt):
+			s.lapsed = append(s.lapsed, r)
+		case r.IsActive(at):
+			kept = append(kept, r)
+			active = append(active, r)
+		default:
+			// Not yet started; keep without listing
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) != len(s.Rules) {
+		s.Rules = kept
+		s.Version++
+		s.ComputeHash()
+	}
+	return active
+}
+
+// TakeLapsed returns and clears the rules swept since the last call.
+func (s *SuppressionSet) TakeLapsed() []SuppressionRule {
+	lapsed := s.lapsed
+	s.lapsed = nil
+	return lapsed
+}
+
 // ListByCircle returns all rules for a circle.
 func (s *SuppressionSet) ListByCircle(circleID string) []SuppressionRule {
 	result := []SuppressionRule{}
@@ -268,6 +446,54 @@ func (s *SuppressionSet) FindMatch(at time.Time, circleID string, scope Scope, k
 	return nil
 }
 
+// PeriodKey returns the ISO week bucket (e.g. "2025-W03") containing at.
+func PeriodKey(at time.Time) string {
+	year, week := at.UTC().ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// RecordSuppressed records that an item was suppressed in the period
+// containing at. The same item is counted once per period.
+// Only a hash of itemKey is kept.
+// Returns true if the item was newly recorded.
+func (s *SuppressionSet) RecordSuppressed(at time.Time, itemKey string) bool {
+	if s.suppressed == nil {
+		s.suppressed = make(map[string]map[string]bool)
+	}
+	period := PeriodKey(at)
+	items, ok := s.suppressed[period]
+	if !ok {
+		items = make(map[string]bool)
+		s.suppressed[period] = items
+	}
+	hash := sha256.Sum256([]byte(itemKey))
+	itemHash := hex.EncodeToString(hash[:])
+	if items[itemHash] {
+		return false
+	}
+	items[itemHash] = true
+	return true
+}
+
+// RecordSuppressedBy records a suppressed item like RecordSuppressed and
+// credits the match to ruleID when the item is newly recorded.
+func (s *SuppressionSet) RecordSuppressedBy(at time.Time, itemKey, ruleID string) bool {
+	if !s.RecordSuppressed(at, itemKey) {
+		return false
+	}
+	if s.matches == nil {
+		s.matches = make(map[string]int)
+	}
+	s.matches[ruleID]++
+	return true
+}
+
+// SuppressedCount returns how many items were suppressed in the period
+// containing at.
+func (s *SuppressionSet) SuppressedCount(at time.Time) int {
+	return len(s.suppressed[PeriodKey(at)])
+}
+
 // PruneExpired removes all expired rules.
 func (s *SuppressionSet) PruneExpired(at time.Time) int {
 	pruned := 0
@@ -344,14 +570,19 @@ type Stats struct {
 	ExpiredRules int
 	ByScope      map[Scope]int
 	ByCircle     map[string]int
+	PatternRules int
+
+	// MatchesByRule counts suppressed items per rule ID.
+	MatchesByRule map[string]int
 }
 
 // GetStats returns statistics about the suppression set.
 func (s *SuppressionSet) GetStats(at time.Time) Stats {
 	stats := Stats{
-		TotalRules: len(s.Rules),
-		ByScope:    make(map[Scope]int),
-		ByCircle:   make(map[string]int),
+		TotalRules:    len(s.Rules),
+		ByScope:       make(map[Scope]int),
+		ByCircle:      make(map[string]int),
+		MatchesByRule: make(map[string]int),
 	}
 	for _, r := range s.Rules {
 		if r.IsActive(at) {
@@ -361,6 +592,12 @@ func (s *SuppressionSet) GetStats(at time.Time) Stats {
 		}
 		stats.ByScope[r.Scope]++
 		stats.ByCircle[r.CircleID]++
+		if r.IsPattern() {
+			stats.PatternRules++
+		}
+		if n := s.matches[r.RuleID]; n > 0 {
+			stats.MatchesByRule[r.RuleID] = n
+		}
 	}
 	return stats
 }
@@ -20,11 +20,15 @@
 package trust
 
 import (
+	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 	"time"
 
+	"quantumlife/pkg/domain/deviceidentity"
 	"quantumlife/pkg/domain/shadowllm"
+	"quantumlife/pkg/hashutil"
 )
 
 // =============================================================================
@@ -149,8 +153,7 @@ func (s *TrustSummary) CanonicalString() string {
 
 // ComputeHash computes the SHA256 hash of the canonical string.
 func (s *TrustSummary) ComputeHash() string {
-	h := sha256.Sum256([]byte(s.CanonicalString()))
-	return hex.EncodeToString(h[:])
+	return hashutil.HashString("trust.TrustSummary", s.CanonicalString())
 }
 
 // ComputeID computes a stable ID for this summary.
@@ -191,7 +194,13 @@ func (s *TrustSummary) IsDismissed() bool {
 // IsMeaningful returns true if this summary represents actual restraint.
 // "Nothing" magnitude means no meaningful activity occurred.
 func (s *TrustSummary) IsMeaningful() bool {
-	return s.MagnitudeBucket != shadowllm.MagnitudeNothing
+	return s.IsMeaningfulAt(shadowllm.MagnitudeAFew)
+}
+
+// IsMeaningfulAt returns true if the summary's magnitude reaches min.
+// "Nothing" is never meaningful, whatever the threshold.
+func (s *TrustSummary) IsMeaningfulAt(min shadowllm.MagnitudeBucket) bool {
+	return s.MagnitudeBucket != shadowllm.MagnitudeNothing && s.MagnitudeBucket.AtLeast(min)
 }
 
 // =============================================================================
@@ -257,6 +266,212 @@ func (d *TrustDismissal) Validate() error {
 	return nil
 }
 
+// =============================================================================
+// AggregateSummary
+// =============================================================================
+
+// AggregateStatement is the single abstract statement for one period,
+// rolled up across every circle's summaries.
+type AggregateStatement struct {
+	// Period is the time granularity (week | month).
+	Period TrustPeriod
+
+	// PeriodKey is the abstract period identifier.
+	PeriodKey string
+
+	// SignalKind is the dominant signal kind across circles.
+	SignalKind TrustSignalKind
+
+	// MagnitudeBucket is the saturating combination of circle buckets.
+	MagnitudeBucket shadowllm.MagnitudeBucket
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (a *AggregateStatement) CanonicalString() string {
+	return string(a.Period) + "|" +
+		a.PeriodKey + "|" +
+		string(a.SignalKind) + "|" +
+		string(a.MagnitudeBucket)
+}
+
+// AggregateSummary rolls trust summaries up across all circles.
+//
+// CRITICAL: No circle identifiers and no counts - one statement per period.
+type AggregateSummary struct {
+	// Statements holds one statement per period, most recent first.
+	Statements []AggregateStatement
+
+	// AggregateHash is the SHA256 hash of the canonical string.
+	AggregateHash string
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (a *AggregateSummary) CanonicalString() string {
+	s := "TRUST_AGGREGATE|v1"
+	for i := range a.Statements {
+		s += "|" + a.Statements[i].CanonicalString()
+	}
+	return s
+}
+
+// ComputeHash computes the SHA256 hash of the canonical string.
+func (a *AggregateSummary) ComputeHash() string {
+	return hashutil.HashString("trust.AggregateSummary", a.CanonicalString())
+}
+
+// IsEmpty returns true if no period has a meaningful statement.
+func (a *AggregateSummary) IsEmpty() bool {
+	return len(a.Statements) == 0
+}
+
+// CombineMagnitudes merges two magnitude buckets with saturating arithmetic.
+// nothing is the identity, a_few + a_few = several, and several is the cap.
+func CombineMagnitudes(a, b shadowllm.MagnitudeBucket) shadowllm.MagnitudeBucket {
+	switch {
+	case a == shadowllm.MagnitudeNothing:
+		return b
+	case b == shadowllm.MagnitudeNothing:
+		return a
+	default:
+		// Both are at least a_few, so the sum is at least several
+		return shadowllm.MagnitudeSeveral
+	}
+}
+
+// SignalPriority orders signal kinds for aggregation.
+// Matches the engine: prevented interruptions outrank quiet holds.
+func (s TrustSignalKind) SignalPriority() int {
+	switch s {
+	case SignalInterruptionPrevented:
+		return 2
+	case SignalQuietHeld:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// =============================================================================
+// SignedStatement
+// =============================================================================
+
+// SignedStatement is a shareable, device-signed statement of restraint
+// for one period.
+//
+// CRITICAL: Only the period, buckets and hashes - no raw activity.
+// Anyone holding the text can re-verify it with VerifyStatement.
+type SignedStatement struct {
+	// CircleIDHash matches the CircleIDHash of the circle's replay bundles.
+	CircleIDHash string
+
+	// Period is the time granularity (week | month).
+	Period TrustPeriod
+
+	// PeriodKey is the abstract period identifier.
+	PeriodKey string
+
+	// SignalKind is the restraint demonstrated in the period.
+	SignalKind TrustSignalKind
+
+	// MagnitudeBucket is the abstract amount of restraint.
+	MagnitudeBucket shadowllm.MagnitudeBucket
+
+	// StatementHash is the SHA256 hash of the canonical string.
+	StatementHash string
+
+	// PublicKey is the signing device's public key.
+	PublicKey deviceidentity.DevicePublicKey
+
+	// Signature is the device signature over StatementHash.
+	Signature deviceidentity.Signature
+}
+
+// CanonicalString returns the pipe-delimited canonical representation.
+func (st *SignedStatement) CanonicalString() string {
+	return "TRUST_STATEMENT|v1|" +
+		st.CircleIDHash + "|" +
+		string(st.Period) + "|" +
+		st.PeriodKey + "|" +
+		string(st.SignalKind) + "|" +
+		string(st.MagnitudeBucket)
+}
+
+// ComputeHash computes the SHA256 hash of the canonical string.
+func (st *SignedStatement) ComputeHash() string {
+	return hashutil.HashString("trust.SignedStatement", st.CanonicalString())
+}
+
+// Sentence returns the short human sentence for the statement.
+// CRITICAL: Period and bucket only - never a count.
+func (st *SignedStatement) Sentence() string {
+	prefix := "For " + string(st.Period) + " " + st.PeriodKey + ": it stayed quiet. "
+	if st.MagnitudeBucket == shadowllm.MagnitudeNothing {
+		return prefix + SignalNothingRequired.HumanReadable()
+	}
+	return prefix + st.SignalKind.HumanReadable() + " Amount: " + string(st.MagnitudeBucket) + "."
+}
+
+// Text returns the shareable plain-text form of the statement.
+func (st *SignedStatement) Text() string {
+	return st.Sentence() + "\n\n" +
+		"statement: " + st.CanonicalString() + "\n" +
+		"statement_hash: " + st.StatementHash + "\n" +
+		"public_key: " + string(st.PublicKey) + "\n" +
+		"fingerprint: " + string(st.PublicKey.Fingerprint()) + "\n" +
+		"signature: " + string(st.Signature) + "\n"
+}
+
+// ParseSignedStatement parses the text produced by Text.
+// The sentence is ignored; it is re-derived from the signed fields.
+func ParseSignedStatement(text string) (*SignedStatement, error) {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		if k, v, ok := strings.Cut(line, ": "); ok {
+			fields[k] = strings.TrimSpace(v)
+		}
+	}
+
+	parts := strings.Split(fields["statement"], "|")
+	if len(parts) != 7 || parts[0] != "TRUST_STATEMENT" || parts[1] != "v1" {
+		return nil, ErrMalformedStatement
+	}
+
+	st := &SignedStatement{
+		CircleIDHash:    parts[2],
+		Period:          TrustPeriod(parts[3]),
+		PeriodKey:       parts[4],
+		SignalKind:      TrustSignalKind(parts[5]),
+		MagnitudeBucket: shadowllm.MagnitudeBucket(parts[6]),
+		StatementHash:   fields["statement_hash"],
+		PublicKey:       deviceidentity.DevicePublicKey(fields["public_key"]),
+		Signature:       deviceidentity.Signature(fields["signature"]),
+	}
+	if !st.Period.Validate() || !st.SignalKind.Validate() || !st.MagnitudeBucket.Validate() {
+		return nil, ErrMalformedStatement
+	}
+	return st, nil
+}
+
+// VerifyStatement checks the statement hash and the device signature.
+// The public key should be compared against the device's known key.
+func VerifyStatement(st *SignedStatement) error {
+	if st.ComputeHash() != st.StatementHash {
+		return ErrStatementHashMismatch
+	}
+	if err := st.PublicKey.Validate(); err != nil {
+		return ErrInvalidStatementSignature
+	}
+	if err := st.Signature.Validate(); err != nil {
+		return ErrInvalidStatementSignature
+	}
+	pub, _ := st.PublicKey.ToBytes()
+	sig, _ := st.Signature.ToBytes()
+	if !ed25519.Verify(pub, []byte(st.StatementHash), sig) {
+		return ErrInvalidStatementSignature
+	}
+	return nil
+}
+
 // =============================================================================
 // Helpers
 // =============================================================================
@@ -328,4 +543,9 @@ const (
 	ErrMissingCreatedBucket trustError = "missing created bucket"
 	ErrMissingSummaryID     trustError = "missing summary ID"
 	ErrMissingSummaryHash   trustError = "missing summary hash"
+
+	ErrMalformedStatement        trustError = "malformed trust statement"
+	ErrStatementHashMismatch     trustError = "trust statement hash mismatch"
+	ErrInvalidStatementSignature trustError = "invalid trust statement signature"
+	ErrNoStatementSigner         trustError = "no statement signer configured"
 )
@@ -8,6 +8,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"time"
+
+	"quantumlife/pkg/domain/undowindow"
+	"quantumlife/pkg/hashutil"
 )
 
 // TrustActionKind represents the type of action.
@@ -64,16 +67,22 @@ func (p *TrustActionPreview) CanonicalString() string {
 	)
 }
 
-// UndoBucket represents a 15-minute bucket for undo window.
+// UndoBucket represents the undo window, starting on a 15-minute bucket.
 // Time is floored to :00, :15, :30, :45 boundaries.
 type UndoBucket struct {
 	BucketStartRFC3339    string // RFC3339 format
-	BucketDurationMinutes int    // always 15
+	BucketDurationMinutes int    // the undo window; 15 by default
 }
 
 // NewUndoBucket creates an undo bucket starting from the given time.
 // Time is floored to the nearest 15-minute boundary.
 func NewUndoBucket(t time.Time) UndoBucket {
+	return NewUndoBucketWithConfig(undowindow.DefaultConfig(), t)
+}
+
+// NewUndoBucketWithConfig creates an undo bucket starting from the given
+// time and lasting the configured undo window.
+func NewUndoBucketWithConfig(cfg undowindow.Config, t time.Time) UndoBucket {
 	// Floor to 15-minute boundary
 	minute := t.Minute()
 	flooredMinute := (minute / 15) * 15
@@ -81,7 +90,7 @@ func NewUndoBucket(t time.Time) UndoBucket {
 
 	return UndoBucket{
 		BucketStartRFC3339:    floored.Format(time.RFC3339),
-		BucketDurationMinutes: 15,
+		BucketDurationMinutes: cfg.Minutes(),
 	}
 }
 
@@ -95,6 +104,60 @@ func (u *UndoBucket) IsExpired(now time.Time) bool {
 	return now.After(end)
 }
 
+// Deadline returns when the undo window closes.
+// Returns the zero time for an invalid bucket.
+func (u *UndoBucket) Deadline() time.Time {
+	start, err := time.Parse(time.RFC3339, u.BucketStartRFC3339)
+	if err != nil {
+		return time.Time{}
+	}
+	return start.Add(time.Duration(u.BucketDurationMinutes) * time.Minute)
+}
+
+// Remaining returns the abstract remaining-window bucket at now.
+func (u *UndoBucket) Remaining(now time.Time) UndoRemainingBucket {
+	return UndoRemainingFor(u.Deadline(), now)
+}
+
+// UndoRemainingBucket is an abstract view of how much undo window is left.
+// There is deliberately no finer grain: no minutes, no countdown.
+type UndoRemainingBucket string
+
+const (
+	UndoRemainingLonger  UndoRemainingBucket = "a_little_longer"
+	UndoRemainingClosing UndoRemainingBucket = "closing_soon"
+	UndoRemainingClosed  UndoRemainingBucket = "closed"
+)
+
+// UndoClosingThreshold is the remaining window below which undo is
+// described as closing soon.
+const UndoClosingThreshold = 5 * time.Minute
+
+// UndoRemainingFor buckets the remaining window until deadline.
+func UndoRemainingFor(deadline, now time.Time) UndoRemainingBucket {
+	remaining := deadline.Sub(now)
+	switch {
+	case deadline.IsZero() || remaining < 0:
+		return UndoRemainingClosed
+	case remaining <= UndoClosingThreshold:
+		return UndoRemainingClosing
+	default:
+		return UndoRemainingLonger
+	}
+}
+
+// DisplayText returns the calm line for the bucket. Empty when closed.
+func (b UndoRemainingBucket) DisplayText() string {
+	switch b {
+	case UndoRemainingLonger:
+		return "Undo available for a little longer."
+	case UndoRemainingClosing:
+		return "Undo window closing soon."
+	default:
+		return ""
+	}
+}
+
 // CanonicalString returns a deterministic, pipe-delimited representation.
 func (u *UndoBucket) CanonicalString() string {
 	return fmt.Sprintf("v1|undo_bucket|%s|%d",
@@ -141,8 +204,7 @@ func (r *TrustActionReceipt) ComputeStatusHash() string {
 		r.DraftIDHash,
 		r.EnvelopeHash,
 	)
-	hash := sha256.Sum256([]byte(content))
-	return hex.EncodeToString(hash[:16]) // 32 hex chars
+	return hashutil.HashString("trustaction.TrustActionReceipt", content)[:32] // 32 hex chars
 }
 
 // ComputeReceiptID computes the receipt ID from content hash.
@@ -3,13 +3,16 @@
 // Phase 25: First Undoable Execution (Opt-In, Single-Shot)
 //
 // This package defines the model for the first real external write that is
-// meaningfully undoable. Phase 25 supports ONLY calendar_respond because:
-//   - Email send is not truly undoable
-//   - Finance is not undoable
+// meaningfully undoable. Two actions are supported:
 //   - Calendar RSVP can be reversed by applying previous response
+//   - Email send is undoable ONLY as a scheduled send: the provider holds
+//     the reply for the undo window and undo cancels it before dispatch
+//
+// A sent email is not undoable and finance is not undoable.
 //
 // CRITICAL INVARIANTS:
-//   - Only calendar_respond is undoable
+//   - Only calendar_respond and email_scheduled_send are undoable
+//   - Email is never offered unless the provider can delay a send
 //   - Single-shot per period (max one execution)
 //   - Undo window is bounded (bucketed time)
 //   - Undo is a first-class flow, not "best effort"
@@ -25,24 +28,29 @@ import (
 	"encoding/hex"
 	"strings"
 	"time"
+
+	"quantumlife/pkg/domain/undowindow"
 )
 
 // UndoableActionKind represents the type of undoable action.
-// Phase 25 ONLY supports calendar_respond.
 type UndoableActionKind string
 
 const (
-	// ActionKindCalendarRespond is the only undoable action in Phase 25.
+	// ActionKindCalendarRespond is the Phase 25 undoable action.
 	ActionKindCalendarRespond UndoableActionKind = "calendar_respond"
+
+	// ActionKindEmailScheduledSend is an email reply held by the provider
+	// until the undo window closes. Undo cancels it before dispatch.
+	ActionKindEmailScheduledSend UndoableActionKind = "email_scheduled_send"
 )
 
 // IsSupported returns true if this action kind is supported for undo.
 func (k UndoableActionKind) IsSupported() bool {
-	return k == ActionKindCalendarRespond
+	return k == ActionKindCalendarRespond || k == ActionKindEmailScheduledSend
 }
 
-// ResponseStatus represents the status of a calendar response.
-// Must match the calendar provider response values.
+// ResponseStatus represents the status of the action's target: a calendar
+// response (matching the calendar provider values) or an email reply.
 type ResponseStatus string
 
 const (
@@ -50,6 +58,11 @@ const (
 	StatusAccepted    ResponseStatus = "accepted"
 	StatusDeclined    ResponseStatus = "declined"
 	StatusTentative   ResponseStatus = "tentative"
+
+	// Email scheduled send: the reply is unsent before execution and
+	// held by the provider after it.
+	StatusUnsent    ResponseStatus = "unsent"
+	StatusScheduled ResponseStatus = "scheduled"
 )
 
 // UndoState represents the state of an undo record.
@@ -79,7 +92,8 @@ type UndoWindow struct {
 	// Format: "2006-01-02T15:04:00Z" (always :00 or :15 or :30 or :45)
 	BucketStartRFC3339 string
 
-	// BucketDurationMinutes is always 15.
+	// BucketDurationMinutes is 15 for execution buckets. For an undo
+	// deadline it is the configured undo window.
 	BucketDurationMinutes int
 }
 
@@ -99,9 +113,26 @@ func NewUndoWindow(t time.Time) UndoWindow {
 
 // DeadlineWindow returns the undo deadline window (one bucket after execution).
 func (w UndoWindow) DeadlineWindow() UndoWindow {
+	return w.DeadlineWindowWithConfig(undowindow.DefaultConfig())
+}
+
+// DeadlineWindowWithConfig returns the undo deadline window starting one
+// bucket after execution and lasting the configured undo window.
+func (w UndoWindow) DeadlineWindowWithConfig(cfg undowindow.Config) UndoWindow {
 	start, _ := time.Parse(time.RFC3339, w.BucketStartRFC3339)
-	deadline := start.Add(time.Duration(w.BucketDurationMinutes) * time.Minute)
-	return NewUndoWindow(deadline)
+	deadline := NewUndoWindow(start.Add(time.Duration(w.BucketDurationMinutes) * time.Minute))
+	deadline.BucketDurationMinutes = cfg.Minutes()
+	return deadline
+}
+
+// Deadline returns when this window closes.
+// Returns the zero time for an invalid window.
+func (w UndoWindow) Deadline() time.Time {
+	start, err := time.Parse(time.RFC3339, w.BucketStartRFC3339)
+	if err != nil {
+		return time.Time{}
+	}
+	return start.Add(time.Duration(w.BucketDurationMinutes) * time.Minute)
 }
 
 // IsExpired returns true if the current time is past the undo window.
@@ -152,7 +183,7 @@ type UndoRecord struct {
 	ExecutedAtBucket UndoWindow
 }
 
-// NewUndoRecord creates a new undo record.
+// NewUndoRecord creates a new undo record with the default undo window.
 func NewUndoRecord(
 	periodKey string,
 	circleID string,
@@ -162,9 +193,26 @@ func NewUndoRecord(
 	beforeStatus ResponseStatus,
 	afterStatus ResponseStatus,
 	executedAt time.Time,
+) *UndoRecord {
+	return NewUndoRecordWithConfig(undowindow.DefaultConfig(), periodKey, circleID, actionKind,
+		draftID, envelopeID, beforeStatus, afterStatus, executedAt)
+}
+
+// NewUndoRecordWithConfig creates a new undo record whose undo window
+// follows cfg.
+func NewUndoRecordWithConfig(
+	cfg undowindow.Config,
+	periodKey string,
+	circleID string,
+	actionKind UndoableActionKind,
+	draftID string,
+	envelopeID string,
+	beforeStatus ResponseStatus,
+	afterStatus ResponseStatus,
+	executedAt time.Time,
 ) *UndoRecord {
 	executedBucket := NewUndoWindow(executedAt)
-	undoDeadline := executedBucket.DeadlineWindow()
+	undoDeadline := executedBucket.DeadlineWindowWithConfig(cfg)
 
 	record := &UndoRecord{
 		PeriodKey:                periodKey,
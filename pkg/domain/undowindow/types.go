@@ -0,0 +1,63 @@
+// Package undowindow defines the undo-window policy shared by undoable
+// execution (Phase 25) and trust actions (Phase 28).
+//
+// Each of those packages decides where its window starts; this package
+// only decides how long it lasts.
+//
+// CRITICAL INVARIANTS:
+//   - The window is whole minutes, never longer than Max
+//   - Unset or invalid windows fall back to Default
+//   - No goroutines. No time.Now() - clock injection only.
+package undowindow
+
+import (
+	"time"
+
+	"quantumlife/pkg/domain/config"
+)
+
+const (
+	// Default is how long undo stays open when no window is configured.
+	Default = 15 * time.Minute
+
+	// DefaultMinutes is Default in whole minutes, for records written
+	// before the window was configurable.
+	DefaultMinutes = int(Default / time.Minute)
+
+	// Max is the hard ceiling on a configured undo window.
+	Max = 4 * time.Hour
+)
+
+// Config holds the undo policy.
+type Config struct {
+	// Window is how long undo stays open. Whole minutes only; unset
+	// means Default.
+	Window time.Duration
+}
+
+// DefaultConfig returns the default undo policy.
+func DefaultConfig() Config {
+	return Config{Window: Default}
+}
+
+// FromMultiCircle returns the configured undo policy.
+// A nil config or an unset window gives the default.
+func FromMultiCircle(cfg *config.MultiCircleConfig) Config {
+	if cfg == nil || cfg.UndoWindowMinutes <= 0 {
+		return DefaultConfig()
+	}
+	return Config{Window: time.Duration(cfg.UndoWindowMinutes) * time.Minute}
+}
+
+// Minutes returns the undo window in whole minutes, replacing unset or
+// out-of-range values with the default or the ceiling.
+func (c Config) Minutes() int {
+	window := c.Window
+	if window < time.Minute {
+		window = Default
+	}
+	if window > Max {
+		window = Max
+	}
+	return int(window / time.Minute)
+}
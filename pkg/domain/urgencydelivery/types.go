@@ -23,6 +23,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"quantumlife/pkg/hashutil"
 )
 
 // ═══════════════════════════════════════════════════════════════════════════
@@ -702,8 +704,7 @@ func (r UrgencyDeliveryReceipt) ComputeStatusHash() string {
 		r.OutcomeKind.CanonicalString(),
 		r.Intent.CanonicalString(),
 	)
-	h := sha256.Sum256([]byte(content))
-	return hex.EncodeToString(h[:16])
+	return hashutil.HashString("urgencydelivery.UrgencyDeliveryReceipt", content)[:32]
 }
 
 // DedupKey returns the deduplication key for this receipt.
@@ -0,0 +1,82 @@
+package events
+
+import "sync"
+
+// DefaultBufferCapacity is the default number of events retained in memory.
+const DefaultBufferCapacity = 10000
+
+// Buffer is a bounded ring buffer of emitted events.
+//
+// When full, the oldest event is dropped. Per-type counters count every
+// event ever appended, so metrics stay intact after events are dropped.
+type Buffer struct {
+	mu      sync.Mutex
+	events  []Event
+	start   int
+	size    int
+	dropped int
+	counts  map[EventType]int
+}
+
+// NewBuffer creates a buffer retaining at most capacity events.
+// A non-positive capacity uses DefaultBufferCapacity.
+func NewBuffer(capacity int) *Buffer {
+	if capacity <= 0 {
+		capacity = DefaultBufferCapacity
+	}
+	return &Buffer{
+		events: make([]Event, capacity),
+		counts: make(map[EventType]int),
+	}
+}
+
+// Emit appends an event, dropping the oldest if the buffer is full.
+func (b *Buffer) Emit(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.counts[event.Type]++
+	if b.size < len(b.events) {
+		b.events[(b.start+b.size)%len(b.events)] = event
+		b.size++
+		return
+	}
+	b.events[b.start] = event
+	b.start = (b.start + 1) % len(b.events)
+	b.dropped++
+}
+
+// Events returns the retained events, oldest first.
+func (b *Buffer) Events() []Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]Event, b.size)
+	for i := 0; i < b.size; i++ {
+		out[i] = b.events[(b.start+i)%len(b.events)]
+	}
+	return out
+}
+
+// Capacity returns the maximum number of retained events.
+func (b *Buffer) Capacity() int {
+	return len(b.events)
+}
+
+// Dropped returns how many events were dropped to stay within capacity.
+func (b *Buffer) Dropped() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
+
+// Count returns how many events of the given type were ever emitted,
+// including dropped ones.
+func (b *Buffer) Count(t EventType) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.counts[t]
+}
+
+// Verify interface compliance.
+var _ Emitter = (*Buffer)(nil)
@@ -0,0 +1,43 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuffer_CapsAtConfiguredSize(t *testing.T) {
+	ts := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	b := NewBuffer(3)
+
+	for i := 0; i < 5; i++ {
+		b.Emit(Event{Type: InvariantsChecked, Timestamp: ts.Add(time.Duration(i) * time.Second)})
+	}
+
+	got := b.Events()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 retained events, got %d", len(got))
+	}
+	// Oldest two were dropped; order is oldest first.
+	for i, e := range got {
+		want := ts.Add(time.Duration(i+2) * time.Second)
+		if !e.Timestamp.Equal(want) {
+			t.Errorf("event %d: expected %v, got %v", i, want, e.Timestamp)
+		}
+	}
+	if b.Dropped() != 2 {
+		t.Errorf("expected 2 dropped, got %d", b.Dropped())
+	}
+	if b.Count(InvariantsChecked) != 5 {
+		t.Errorf("expected counter to keep all 5 events, got %d", b.Count(InvariantsChecked))
+	}
+}
+
+func TestBuffer_DefaultCapacity(t *testing.T) {
+	b := NewBuffer(0)
+	if b.Capacity() != DefaultBufferCapacity {
+		t.Errorf("expected default capacity %d, got %d", DefaultBufferCapacity, b.Capacity())
+	}
+	if len(b.Events()) != 0 || b.Dropped() != 0 {
+		t.Error("expected empty buffer")
+	}
+}
@@ -600,6 +600,11 @@ const (
 	EventDraftRuleSkipped   EventType = "draft.rule.skipped"
 	EventDraftNoRuleMatched EventType = "draft.no_rule.matched"
 
+	// Draft version events (edit, regenerate, revert)
+	// CRITICAL: Metadata carries version numbers and content hashes only, never draft text.
+	EventDraftVersionCreated  EventType = "draft.version.created"
+	EventDraftVersionSelected EventType = "draft.version.selected"
+
 	// Phase 5: Calendar Execution Boundary events
 	// CRITICAL: This is the FIRST real external write in QuantumLife.
 	// CRITICAL: Execution ONLY from approved drafts.
@@ -681,6 +686,8 @@ const (
 	EmailExecutionBlocked    EventType = "email.execution.blocked"
 	EmailExecutionIdempotent EventType = "email.execution.idempotent"
 	EmailExecutionStoreError EventType = "email.execution.store_error"
+	EmailExecutionScheduled  EventType = "email.execution.scheduled"
+	EmailExecutionCancelled  EventType = "email.execution.cancelled"
 
 	// Policy snapshot events
 	EmailPolicySnapshotTaken    EventType = "email.policy.snapshot.taken"
@@ -721,6 +728,18 @@ const (
 	Phase10ExecutionBlocked   EventType = "phase10.intent.execution.blocked"
 	Phase10ExecutionFailed    EventType = "phase10.intent.execution.failed"
 
+	// Phase10ExecutionDeduplicated: a repeat request within the idempotency
+	// window got the prior outcome; nothing was executed.
+	Phase10ExecutionDeduplicated EventType = "phase10.intent.execution.deduplicated"
+
+	// Phase10ExecutionDryRun: dry-run mode routed the intent and skipped
+	// the write; nothing was executed.
+	Phase10ExecutionDryRun EventType = "phase10.intent.execution.dry_run"
+
+	// Phase10ExecutionRetried: a failed envelope's draft was executed again;
+	// metadata links the failed envelope (retry_of) to the new one.
+	Phase10ExecutionRetried EventType = "phase10.intent.execution.retried"
+
 	// Draft execution events (web layer)
 	Phase10DraftExecuteRequested EventType = "phase10.draft.execute.requested"
 	Phase10DraftExecuteCompleted EventType = "phase10.draft.execute.completed"
@@ -1024,6 +1043,7 @@ const (
 	Phase18_1InterestRegistered EventType = "phase18_1.interest.registered"
 	Phase18_1InterestDuplicate  EventType = "phase18_1.interest.duplicate"
 	Phase18_1InterestInvalid    EventType = "phase18_1.interest.invalid"
+	Phase18_1InterestThrottled  EventType = "phase18_1.interest.throttled"
 
 	// ═══════════════════════════════════════════════════════════════════════════
 	// Phase 18.2: Today, quietly - Recognition + Suppression + Preference
@@ -1039,6 +1059,12 @@ const (
 	// Suppression demonstrated event - emitted when suppressed insight is shown
 	Phase18_2SuppressionDemonstrated EventType = "phase18_2.suppression.demonstrated"
 
+	// Preference history viewed event - emitted when /today/preference/history is rendered
+	Phase18_2PreferenceHistoryViewed EventType = "phase18_2.preference.history_viewed"
+
+	// Preference reverted event - emitted when the user restores a prior preference
+	Phase18_2PreferenceReverted EventType = "phase18_2.preference.reverted"
+
 	// ═══════════════════════════════════════════════════════════════════════════
 	// Phase 18.3: The Proof of Care - Held, not shown
 	// Reference: docs/ADR/ADR-0035-phase18-3-proof-of-care.md
@@ -1063,6 +1089,9 @@ const (
 	// Surface page rendered event - emitted when /surface page is shown
 	Phase18_4SurfacePageRendered EventType = "phase18_4.surface.page.rendered"
 
+	// Surface list rendered event - emitted when /surface/all is shown (show_all only)
+	Phase18_4SurfaceListRendered EventType = "phase18_4.surface.list.rendered"
+
 	// Surface action events - emitted when user interacts with surfaced item
 	Phase18_4SurfaceActionViewed        EventType = "phase18_4.surface.action.viewed"
 	Phase18_4SurfaceActionHeld          EventType = "phase18_4.surface.action.held"
@@ -1084,6 +1113,13 @@ const (
 	// Proof dismissed event - emitted when user dismisses the proof
 	Phase18_5ProofDismissed EventType = "phase18_5.proof.dismissed"
 
+	// Proof exported event - emitted when the ledger CSV is downloaded
+	Phase18_5ProofExported EventType = "phase18_5.proof.exported"
+
+	// Refusals viewed event - emitted when /proof/refusals page is rendered
+	// CRITICAL: Contains refusal set hash only
+	Phase18_5RefusalsViewed EventType = "phase18_5.refusals.viewed"
+
 	// ═══════════════════════════════════════════════════════════════════════════
 	// Phase 18.6: First Connect - Consent-first Onboarding
 	// Reference: docs/ADR/ADR-0038-phase18-6-first-connect.md
@@ -1098,6 +1134,13 @@ const (
 	// Connection request events - emitted when user initiates connect/disconnect
 	Phase18_6ConnectionConnectRequested    EventType = "phase18_6.connection.connect.requested"
 	Phase18_6ConnectionDisconnectRequested EventType = "phase18_6.connection.disconnect.requested"
+	Phase18_6ConnectionDisconnectAll       EventType = "phase18_6.connection.disconnect.all"
+
+	// Consent history events - emitted when consent history is exported
+	Phase18_6ConsentHistoryExported EventType = "phase18_6.connection.consent.exported"
+
+	// Connection health events - one per checked source, status enum only
+	Phase18_6ConnectionHealthChecked EventType = "phase18_6.connection.health.checked"
 
 	// ═══════════════════════════════════════════════════════════════════════════
 	// Phase 18.7: Mirror Proof - Trust Through Evidence of Reading
@@ -1114,6 +1157,13 @@ const (
 	// Mirror acknowledged event - emitted when user acknowledges the mirror
 	Phase18_7MirrorAcknowledged EventType = "phase18_7.mirror.acknowledged"
 
+	// Unviewed reminder shown - a connected source's mirror was never viewed
+	// CRITICAL: Pull-only, emitted on /today render. Never a notification.
+	Phase18_7UnviewedReminderShown EventType = "phase18_7.unviewed_reminder.shown"
+
+	// Unviewed reminder dismissed for the current period
+	Phase18_7UnviewedReminderDismissed EventType = "phase18_7.unviewed_reminder.dismissed"
+
 	// ═══════════════════════════════════════════════════════════════════════════
 	// PHASE 18.8: Real OAuth (Gmail Read-Only)
 	// Reference: docs/ADR/ADR-0041-phase18-8-real-oauth-gmail-readonly.md
@@ -1137,6 +1187,10 @@ const (
 	// OAuth revoke completed event - emitted when revocation completes
 	Phase18_8OAuthRevokeCompleted EventType = "phase18_8.oauth.revoke_completed"
 
+	// OAuth token expiring soon event - emitted on page visit when a stored
+	// token lapses within connection.ExpiringSoonWithin. Bucket only.
+	Phase18_8OAuthTokenExpiringSoon EventType = "phase18_8.oauth.token_expiring_soon"
+
 	// Gmail sync started event - emitted when sync begins
 	Phase18_8GmailSyncStarted EventType = "phase18_8.gmail.sync_started"
 
@@ -1165,17 +1219,24 @@ const (
 	Phase18ApprovalTokenVerified EventType = "phase18.approval.token.verified"
 	Phase18ApprovalTokenExpired  EventType = "phase18.approval.token.expired"
 	Phase18ApprovalTokenInvalid  EventType = "phase18.approval.token.invalid"
+	Phase18ApprovalTokenRedeemed EventType = "phase18.approval.token.redeemed"
+	Phase18ApprovalTokenRevoked  EventType = "phase18.approval.token.revoked"
+	Phase18ApprovalQRRendered    EventType = "phase18.approval.qr.rendered"
+	Phase18ApprovalBulkApplied   EventType = "phase18.approval.bulk.applied"
 
 	// Run log events
 	Phase18RunSnapshotCreated EventType = "phase18.run.snapshot.created"
 	Phase18RunReplayRequested EventType = "phase18.run.replay.requested"
 	Phase18RunReplaySucceeded EventType = "phase18.run.replay.succeeded"
 	Phase18RunReplayFailed    EventType = "phase18.run.replay.failed"
+	Phase18RunExported        EventType = "phase18.run.exported"
 
 	// Suppression events
-	Phase18SuppressionCreated EventType = "phase18.suppression.created"
-	Phase18SuppressionRemoved EventType = "phase18.suppression.removed"
-	Phase18SuppressionExpired EventType = "phase18.suppression.expired"
+	Phase18SuppressionCreated   EventType = "phase18.suppression.created"
+	Phase18SuppressionRemoved   EventType = "phase18.suppression.removed"
+	Phase18SuppressionExpired   EventType = "phase18.suppression.expired"
+	Phase18SuppressionsExported EventType = "phase18.suppressions.exported"
+	Phase18SuppressionsImported EventType = "phase18.suppressions.imported"
 
 	// ═══════════════════════════════════════════════════════════════════════════
 	// PHASE 19: LLM Shadow-Mode Contract
@@ -1225,6 +1286,24 @@ const (
 	Phase19_1GmailSyncCompleted EventType = "phase19_1.gmail.sync.completed"
 	Phase19_1GmailSyncFailed    EventType = "phase19_1.gmail.sync.failed"
 
+	// Outlook (Microsoft Graph) sync lifecycle events - same caps as Gmail
+	Phase19_1OutlookSyncRequested EventType = "phase19_1.outlook.sync.requested"
+	Phase19_1OutlookSyncStarted   EventType = "phase19_1.outlook.sync.started"
+	Phase19_1OutlookSyncCompleted EventType = "phase19_1.outlook.sync.completed"
+	Phase19_1OutlookSyncFailed    EventType = "phase19_1.outlook.sync.failed"
+
+	// Google Calendar sync lifecycle events - 30 days forward, 25 events max
+	Phase19_1GCalSyncRequested EventType = "phase19_1.gcal.sync.requested"
+	Phase19_1GCalSyncStarted   EventType = "phase19_1.gcal.sync.started"
+	Phase19_1GCalSyncCompleted EventType = "phase19_1.gcal.sync.completed"
+	Phase19_1GCalSyncFailed    EventType = "phase19_1.gcal.sync.failed"
+
+	// CalDAV (Fastmail, Nextcloud) sync lifecycle events - same caps as Google Calendar
+	Phase19_1CalDAVSyncRequested EventType = "phase19_1.caldav.sync.requested"
+	Phase19_1CalDAVSyncStarted   EventType = "phase19_1.caldav.sync.started"
+	Phase19_1CalDAVSyncCompleted EventType = "phase19_1.caldav.sync.completed"
+	Phase19_1CalDAVSyncFailed    EventType = "phase19_1.caldav.sync.failed"
+
 	// Sync receipt events
 	Phase19_1SyncReceiptCreated  EventType = "phase19_1.sync.receipt.created"
 	Phase19_1SyncReceiptStored   EventType = "phase19_1.sync.receipt.stored"
@@ -1243,6 +1322,9 @@ const (
 	Phase19_1QuietCheckComputed  EventType = "phase19_1.quiet_check.computed"
 	Phase19_1QuietCheckVerified  EventType = "phase19_1.quiet_check.verified"
 
+	// Retention sweep over in-memory receipt stores (count bucket only)
+	Phase19RetentionPruned EventType = "phase19.retention.pruned"
+
 	// ═══════════════════════════════════════════════════════════════════════════
 	// PHASE 19.2: LLM Shadow Mode Contract
 	// Reference: docs/ADR/ADR-0043-phase19-2-shadow-mode-contract.md
@@ -1260,10 +1342,16 @@ const (
 	Phase19_2ShadowBlocked   EventType = "phase19_2.shadow.blocked"
 	Phase19_2ShadowFailed    EventType = "phase19_2.shadow.failed"
 
+	// Shadow batch events (one aggregate event per batch, no per-circle detail)
+	Phase19_2ShadowBatchCompleted EventType = "phase19_2.shadow.batch.completed"
+
 	// Shadow receipt events
 	Phase19_2ShadowReceiptCreated  EventType = "phase19_2.shadow.receipt.created"
 	Phase19_2ShadowReceiptVerified EventType = "phase19_2.shadow.receipt.verified"
 
+	// Shadow receipt export events (aggregate count bucket only)
+	Phase19_2ShadowReceiptsExported EventType = "phase19_2.shadow.receipts.exported"
+
 	// Shadow suggestion events (aggregated, no per-suggestion events for privacy)
 	Phase19_2ShadowSuggestionsComputed EventType = "phase19_2.shadow.suggestions.computed"
 
@@ -1294,6 +1382,7 @@ const (
 	// Provider selection events
 	Phase19_3ProviderSelected EventType = "phase19_3.provider.selected"
 	Phase19_3ProviderFallback EventType = "phase19_3.provider.fallback"
+	Phase19_3ProviderRetry    EventType = "phase19_3.provider.retry"
 
 	// ==========================================================================
 	// Phase 19.4: Shadow Diff + Calibration Events
@@ -1385,10 +1474,12 @@ const (
 	//   - Events include canonical hashes only
 
 	// Trust summary lifecycle events
-	Phase20TrustComputed  EventType = "phase20.trust.computed"
-	Phase20TrustPersisted EventType = "phase20.trust.persisted"
-	Phase20TrustViewed    EventType = "phase20.trust.viewed"
-	Phase20TrustDismissed EventType = "phase20.trust.dismissed"
+	Phase20TrustComputed          EventType = "phase20.trust.computed"
+	Phase20TrustPersisted         EventType = "phase20.trust.persisted"
+	Phase20TrustViewed            EventType = "phase20.trust.viewed"
+	Phase20TrustDismissed         EventType = "phase20.trust.dismissed"
+	Phase20TrustAggregateViewed   EventType = "phase20.trust.aggregate_viewed"
+	Phase20TrustStatementExported EventType = "phase20.trust.statement_exported"
 
 	// ======================================================================
 	// Phase 21: Unified Onboarding + Shadow Receipt Viewer
@@ -1404,6 +1495,10 @@ const (
 	Phase21OnboardingViewed EventType = "phase21.onboarding.viewed"
 	Phase21ModeComputed     EventType = "phase21.mode.computed"
 
+	// Mode change acknowledgment events
+	Phase21ModeChanged         EventType = "phase21.mode.changed"
+	Phase21ModeChangeDismissed EventType = "phase21.mode.change.dismissed"
+
 	// Shadow receipt viewer lifecycle events
 	Phase21ShadowReceiptViewed    EventType = "phase21.shadow.receipt.viewed"
 	Phase21ShadowReceiptDismissed EventType = "phase21.shadow.receipt.dismissed"
@@ -1546,6 +1641,9 @@ const (
 	// Phase26BFirstMinutesDismissed - first minutes receipt was dismissed.
 	Phase26BFirstMinutesDismissed EventType = "phase26b.first_minutes.dismissed"
 
+	// Phase26BFirstMinutesCardRendered - first minutes shareable card was rendered.
+	Phase26BFirstMinutesCardRendered EventType = "phase26b.first_minutes.card_rendered"
+
 	// ==========================================================================
 	// Phase 26C: Connected Reality Check
 	// ==========================================================================
@@ -1587,6 +1685,9 @@ const (
 	// Phase27ShadowReceiptDismissed - shadow receipt cue was dismissed.
 	Phase27ShadowReceiptDismissed EventType = "phase27.shadow_receipt.dismissed"
 
+	// Phase27ShadowFirstRealSuggestion - first real provider suggestion milestone recorded.
+	Phase27ShadowFirstRealSuggestion EventType = "phase27.shadow_receipt.first_real_suggestion"
+
 	// ==========================================================================
 	// Phase 28: Trust Kept — First Real Act, Then Silence
 	// ==========================================================================
@@ -1645,6 +1746,14 @@ const (
 	Phase29TrueLayerSyncFailed    EventType = "phase29.truelayer.sync.failed"
 	Phase29TrueLayerSyncPersisted EventType = "phase29.truelayer.sync.persisted"
 
+	// Plaid Link lifecycle and sync events (same bounds as TrueLayer)
+	Phase29PlaidLinkStart     EventType = "phase29.plaid.link.start"
+	Phase29PlaidLinkCallback  EventType = "phase29.plaid.link.callback"
+	Phase29PlaidRevoke        EventType = "phase29.plaid.revoke"
+	Phase29PlaidSyncStarted   EventType = "phase29.plaid.sync.started"
+	Phase29PlaidSyncCompleted EventType = "phase29.plaid.sync.completed"
+	Phase29PlaidSyncFailed    EventType = "phase29.plaid.sync.failed"
+
 	// Finance mirror page events
 	Phase29FinanceMirrorRendered EventType = "phase29.finance_mirror.rendered"
 	Phase29FinanceMirrorViewed   EventType = "phase29.finance_mirror.viewed"
@@ -1663,6 +1772,7 @@ const (
 	Phase30AReplayExported EventType = "phase30A.replay.exported"
 	Phase30AReplayImported EventType = "phase30A.replay.imported"
 	Phase30AReplayRejected EventType = "phase30A.replay.rejected"
+	Phase30AReplayDiffed   EventType = "phase30A.replay.diffed"
 
 	// Phase 31: Commerce Observers (Silent by Default)
 	// CRITICAL: NO amounts, NO merchant names, NO timestamps - buckets and hashes only.
@@ -2245,6 +2355,43 @@ const (
 	Phase55ObserverConsentProofRendered EventType = "phase55.observer_consent.proof.rendered"
 	// Phase55ObserverConsentAckDismissed - observer consent proof was dismissed.
 	Phase55ObserverConsentAckDismissed EventType = "phase55.observer_consent.ack.dismissed"
+
+	// =========================================================================
+	// Runtime invariants self-check (debug only)
+	// CRITICAL: Reports abstract pass/fail only.
+	// =========================================================================
+
+	// InvariantsChecked - engagement-free invariants were checked at runtime.
+	InvariantsChecked EventType = "invariants.checked"
+
+	// =========================================================================
+	// Demo reset (mock mode only)
+	// =========================================================================
+
+	// DemoReset - in-memory stores were cleared and mock fixtures re-seeded.
+	DemoReset EventType = "demo.reset"
+
+	// =========================================================================
+	// Config hot reload
+	// CRITICAL: Records config hashes only.
+	// =========================================================================
+
+	// ConfigReloaded - the config file was re-read and swapped in.
+	ConfigReloaded EventType = "config.reloaded"
+
+	// ConfigReloadRejected - the config file failed to load; the old config stays.
+	ConfigReloadRejected EventType = "config.reload.rejected"
+
+	// ConfigViewed - the effective config was viewed at /admin/config.
+	ConfigViewed EventType = "config.viewed"
+
+	// =========================================================================
+	// Weekly digest email preview (never sent)
+	// CRITICAL: Records the digest hash only.
+	// =========================================================================
+
+	// DigestWeekPreviewViewed - the weekly digest email preview was rendered.
+	DigestWeekPreviewViewed EventType = "digest.week.preview.viewed"
 )
 
 // Event represents a system event for audit and observability.
@@ -0,0 +1,206 @@
+package events
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnsafeMetadata is returned when a metadata value is not an abstract value.
+var ErrUnsafeMetadata = errors.New("unsafe event metadata")
+
+// safeMagnitudes is the closed vocabulary of abstract magnitude buckets.
+var safeMagnitudes = map[string]bool{
+	"nothing": true,
+	"none":    true,
+	"a_few":   true,
+	"handful": true,
+	"several": true,
+	"many":    true,
+}
+
+var (
+	hashPattern   = regexp.MustCompile(`^[0-9a-f]{8,128}$`)
+	periodPattern = regexp.MustCompile(`^\d{4}-(W\d{2}|\d{2}(-\d{2})?)$`)
+	labelPattern  = regexp.MustCompile(`^[a-z][a-z_]*$`)
+)
+
+// SafeMetadata builds event metadata from typed abstract values only:
+// magnitude buckets, hashes, period keys, booleans, labels and opaque IDs.
+//
+// Anything numeric-looking is rejected at construction, so raw counts can
+// never reach an event. A rejected value is dropped and the first rejection
+// is reported by Err.
+//
+// CRITICAL: There is deliberately no method that accepts an int.
+type SafeMetadata struct {
+	values map[string]string
+	err    error
+}
+
+// NewSafeMetadata creates an empty metadata builder.
+func NewSafeMetadata() *SafeMetadata {
+	return &SafeMetadata{values: make(map[string]string)}
+}
+
+// Magnitude adds an abstract magnitude bucket (nothing/a_few/several/...).
+func (m *SafeMetadata) Magnitude(key, bucket string) *SafeMetadata {
+	if !safeMagnitudes[bucket] {
+		return m.reject(key, "not a magnitude bucket")
+	}
+	return m.set(key, bucket)
+}
+
+// Hash adds a lowercase hex hash.
+func (m *SafeMetadata) Hash(key, hash string) *SafeMetadata {
+	if !hashPattern.MatchString(hash) {
+		return m.reject(key, "not a hex hash")
+	}
+	return m.set(key, hash)
+}
+
+// Period adds a period key (YYYY-MM-DD, YYYY-MM or YYYY-Www).
+func (m *SafeMetadata) Period(key, period string) *SafeMetadata {
+	if !periodPattern.MatchString(period) {
+		return m.reject(key, "not a period key")
+	}
+	return m.set(key, period)
+}
+
+// Bool adds a boolean flag.
+func (m *SafeMetadata) Bool(key string, v bool) *SafeMetadata {
+	return m.set(key, strconv.FormatBool(v))
+}
+
+// Label adds an enum-like token such as a reason or provider name.
+// Labels are lowercase letters and underscores only.
+func (m *SafeMetadata) Label(key, label string) *SafeMetadata {
+	if !labelPattern.MatchString(label) {
+		return m.reject(key, "not a label")
+	}
+	return m.set(key, label)
+}
+
+// ID adds an opaque identifier such as a circle or receipt ID.
+// Empty and numeric-looking values are rejected.
+func (m *SafeMetadata) ID(key, id string) *SafeMetadata {
+	if id == "" || isNumericLooking(id) {
+		return m.reject(key, "not an opaque id")
+	}
+	return m.set(key, id)
+}
+
+// Err returns the first rejection, or nil if every value was accepted.
+func (m *SafeMetadata) Err() error {
+	return m.err
+}
+
+// Map returns the accepted metadata.
+func (m *SafeMetadata) Map() map[string]string {
+	out := make(map[string]string, len(m.values))
+	for k, v := range m.values {
+		out[k] = v
+	}
+	return out
+}
+
+func (m *SafeMetadata) set(key, value string) *SafeMetadata {
+	m.values[key] = value
+	return m
+}
+
+func (m *SafeMetadata) reject(key, reason string) *SafeMetadata {
+	if m.err == nil {
+		m.err = errors.Join(ErrUnsafeMetadata, errors.New(key+": "+reason))
+	}
+	return m
+}
+
+// isNumericLooking reports whether s parses as a number.
+func isNumericLooking(s string) bool {
+	s = strings.TrimSpace(s)
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	_, err := strconv.ParseInt(s, 0, 64)
+	return err == nil
+}
+
+// Privacy lint limits for hand-built metadata maps.
+const (
+	// MaxMetadataValueLength is the longest value accepted (a sha512 hex).
+	MaxMetadataValueLength = 128
+
+	// MaxFreeformValueLength is the longest value containing whitespace.
+	MaxFreeformValueLength = 24
+)
+
+// urlPattern matches scheme URLs and bare www. hosts.
+var urlPattern = regexp.MustCompile(`(?i)([a-z][a-z0-9+.-]*://|\bwww\.)`)
+
+// ValidateMetadataPrivacy checks a hand-built metadata map for values that
+// look like raw content: anything containing '@' (emails), URLs, values
+// longer than MaxMetadataValueLength, and whitespace-separated text longer
+// than MaxFreeformValueLength. The error names offending keys, never values.
+func ValidateMetadataPrivacy(metadata map[string]string) error {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, k := range keys {
+		if reason := metadataPrivacyViolation(metadata[k]); reason != "" {
+			errs = append(errs, errors.New(k+": "+reason))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(append([]error{ErrUnsafeMetadata}, errs...)...)
+}
+
+// RedactUnsafeMetadata returns a copy of metadata with every value that
+// fails ValidateMetadataPrivacy replaced by "redacted".
+func RedactUnsafeMetadata(metadata map[string]string) map[string]string {
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if metadataPrivacyViolation(v) != "" {
+			v = "redacted"
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// SafeMetadataEntries returns only the metadata entries that pass the privacy
+// lint, so they can be shown to a person. Failing entries are omitted
+// entirely rather than redacted.
+func SafeMetadataEntries(metadata map[string]string) map[string]string {
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if metadataPrivacyViolation(v) == "" && metadataPrivacyViolation(k) == "" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// metadataPrivacyViolation returns why a value looks like raw content, or "".
+func metadataPrivacyViolation(v string) string {
+	switch {
+	case strings.Contains(v, "@"):
+		return "contains @"
+	case urlPattern.MatchString(v):
+		return "looks like a url"
+	case len(v) > MaxMetadataValueLength:
+		return "too long"
+	case len(v) > MaxFreeformValueLength && strings.ContainsAny(v, " \t\n"):
+		return "freeform text"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSafeMetadata_RejectsRawCount(t *testing.T) {
+	m := NewSafeMetadata().
+		ID("circle_id", "personal").
+		Magnitude("observation_magnitude", "12")
+
+	if !errors.Is(m.Err(), ErrUnsafeMetadata) {
+		t.Fatalf("expected ErrUnsafeMetadata for raw count, got %v", m.Err())
+	}
+	if _, ok := m.Map()["observation_magnitude"]; ok {
+		t.Error("rejected raw count must not reach metadata")
+	}
+	if m.Map()["circle_id"] != "personal" {
+		t.Error("expected accepted values to be kept")
+	}
+
+	for name, b := range map[string]*SafeMetadata{
+		"numeric id":    NewSafeMetadata().ID("circle_id", "42"),
+		"numeric label": NewSafeMetadata().Label("fail_reason", "3"),
+		"non-hex hash":  NewSafeMetadata().Hash("status_hash", "count=7"),
+		"bad period":    NewSafeMetadata().Period("period", "7"),
+	} {
+		if b.Err() == nil {
+			t.Errorf("%s: expected rejection", name)
+		}
+	}
+}
+
+func TestSafeMetadata_AcceptsAbstractValues(t *testing.T) {
+	m := NewSafeMetadata().
+		Magnitude("magnitude_bucket", "a_few").
+		Hash("receipt_hash", "0123456789abcdef").
+		Period("period", "2025-W03").
+		Period("day", "2025-01-15").
+		Bool("lookback_custom", false).
+		Label("fail_reason", "sync_error").
+		ID("event_id", "email_message_ab12cd34")
+
+	if err := m.Err(); err != nil {
+		t.Fatalf("unexpected rejection: %v", err)
+	}
+	if got := len(m.Map()); got != 7 {
+		t.Errorf("expected 7 values, got %d", got)
+	}
+}
+
+func TestValidateMetadataPrivacy(t *testing.T) {
+	ok := map[string]string{
+		"circle_id":    "personal",
+		"receipt_hash": "0123456789abcdef",
+		"status":       "valid",
+		"provider":     "caldav (read only)",
+	}
+	if err := ValidateMetadataPrivacy(ok); err != nil {
+		t.Errorf("expected abstract metadata to pass, got %v", err)
+	}
+
+	for name, value := range map[string]string{
+		"email":    "alice@example.com",
+		"url":      "https://dav.example.com/cal/",
+		"www":      "see www.example.com",
+		"freeform": "Re: dinner on Friday with the Andersons?",
+		"too long": strings.Repeat("a", MaxMetadataValueLength+1),
+	} {
+		err := ValidateMetadataPrivacy(map[string]string{"subject": value})
+		if !errors.Is(err, ErrUnsafeMetadata) {
+			t.Errorf("%s: expected ErrUnsafeMetadata, got %v", name, err)
+			continue
+		}
+		if strings.Contains(err.Error(), value) {
+			t.Errorf("%s: error must not echo the value", name)
+		}
+	}
+
+	redacted := RedactUnsafeMetadata(map[string]string{"circle_id": "personal", "sender": "bob@example.com"})
+	if redacted["sender"] != "redacted" || redacted["circle_id"] != "personal" {
+		t.Errorf("expected only the unsafe value redacted, got %v", redacted)
+	}
+}
@@ -0,0 +1,61 @@
+package events
+
+import (
+	"strings"
+	"time"
+)
+
+// Filter selects events for Query. Zero fields match everything.
+type Filter struct {
+	// TypePrefix matches event types starting with this prefix,
+	// case-insensitively, e.g. "phase19." or "phase18_5".
+	TypePrefix string
+
+	// CircleID matches events for exactly this circle.
+	CircleID string
+
+	// Since and Until bound the timestamp: Since is inclusive,
+	// Until exclusive.
+	Since time.Time
+	Until time.Time
+
+	// Limit keeps only the most recent Limit matches. Zero keeps all.
+	Limit int
+}
+
+// Matches reports whether an event passes the filter.
+func (f Filter) Matches(event Event) bool {
+	if f.TypePrefix != "" && !strings.HasPrefix(strings.ToLower(string(event.Type)), strings.ToLower(f.TypePrefix)) {
+		return false
+	}
+	if f.CircleID != "" && event.CircleID != f.CircleID {
+		return false
+	}
+	if !f.Since.IsZero() && event.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !event.Timestamp.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Query returns the events matching filter, keeping their order.
+// The input slice is not modified.
+func Query(events []Event, filter Filter) []Event {
+	var result []Event
+	for _, event := range events {
+		if filter.Matches(event) {
+			result = append(result, event)
+		}
+	}
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[len(result)-filter.Limit:]
+	}
+	return result
+}
+
+// Query returns the retained events matching filter, oldest first.
+func (b *Buffer) Query(filter Filter) []Event {
+	return Query(b.Events(), filter)
+}
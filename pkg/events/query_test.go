@@ -0,0 +1,51 @@
+package events
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuery_FiltersByPrefixCircleAndRange(t *testing.T) {
+	ts := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	b := NewBuffer(0)
+	b.Emit(Event{Type: "phase19.retention.pruned", Timestamp: ts})
+	b.Emit(Event{Type: "phase19_3.provider.fallback", Timestamp: ts.Add(time.Minute), CircleID: "work"})
+	b.Emit(Event{Type: "phase18_2.today.rendered", Timestamp: ts.Add(2 * time.Minute), CircleID: "work"})
+	b.Emit(Event{Type: "phase19.shadow.requested", Timestamp: ts.Add(3 * time.Minute), CircleID: "personal"})
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []EventType
+	}{
+		{"all", Filter{}, []EventType{"phase19.retention.pruned", "phase19_3.provider.fallback", "phase18_2.today.rendered", "phase19.shadow.requested"}},
+		{"prefix case-insensitive", Filter{TypePrefix: "Phase19"}, []EventType{"phase19.retention.pruned", "phase19_3.provider.fallback", "phase19.shadow.requested"}},
+		{"circle", Filter{CircleID: "work"}, []EventType{"phase19_3.provider.fallback", "phase18_2.today.rendered"}},
+		{"since inclusive until exclusive", Filter{Since: ts.Add(time.Minute), Until: ts.Add(3 * time.Minute)}, []EventType{"phase19_3.provider.fallback", "phase18_2.today.rendered"}},
+		{"limit keeps newest", Filter{TypePrefix: "phase19", Limit: 2}, []EventType{"phase19_3.provider.fallback", "phase19.shadow.requested"}},
+	}
+	for _, tt := range tests {
+		got := b.Query(tt.filter)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %d events, got %d", tt.name, len(tt.want), len(got))
+			continue
+		}
+		for i, e := range got {
+			if e.Type != tt.want[i] {
+				t.Errorf("%s: position %d: expected %s, got %s", tt.name, i, tt.want[i], e.Type)
+			}
+		}
+	}
+}
+
+func TestSafeMetadataEntries_OmitsUnsafeValues(t *testing.T) {
+	got := SafeMetadataEntries(map[string]string{
+		"hash":    "abc123",
+		"email":   "someone@example.com",
+		"link":    "https://example.com",
+		"subject": "Your invoice for January is ready to view",
+	})
+	if len(got) != 1 || got["hash"] != "abc123" {
+		t.Errorf("expected only the hash entry, got %v", got)
+	}
+}
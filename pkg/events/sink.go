@@ -0,0 +1,117 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Sink is a destination for emitted events.
+//
+// The in-memory Buffer is the default sink; others (such as a JSON-lines
+// file) receive the same events after the privacy lint has run.
+type Sink interface {
+	Emitter
+
+	// Close releases the sink. Events emitted after Close are dropped.
+	Close() error
+}
+
+// Close is a no-op; the buffer holds no external resources.
+func (b *Buffer) Close() error { return nil }
+
+// jsonLine is the canonical JSON form of one event.
+// Field order is fixed and metadata keys are sorted by encoding/json.
+type jsonLine struct {
+	Type      EventType         `json:"type"`
+	Timestamp string            `json:"timestamp"`
+	Circle    string            `json:"circle"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+// JSONLinesSink writes one canonical JSON object per event, one per line,
+// so the stream can be tailed and grepped.
+//
+// Each line is written with a single Write call. The first write error is
+// kept and returned by Err and Close; later events are still attempted.
+type JSONLinesSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	err    error
+	closed bool
+}
+
+// NewJSONLinesSink creates a sink writing to w.
+func NewJSONLinesSink(w io.Writer) *JSONLinesSink {
+	return &JSONLinesSink{w: w}
+}
+
+// OpenJSONLinesFile opens (or creates) path for appending and returns a
+// sink writing to it. The file is created owner-readable only.
+func OpenJSONLinesFile(path string) (*JSONLinesSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLinesSink{w: f, closer: f}, nil
+}
+
+// Emit writes the event as one JSON line.
+func (s *JSONLinesSink) Emit(event Event) {
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	line, err := json.Marshal(jsonLine{
+		Type:      event.Type,
+		Timestamp: event.Timestamp.UTC().Format(time.RFC3339Nano),
+		Circle:    event.CircleID,
+		Metadata:  metadata,
+	})
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	if err == nil {
+		_, err = s.w.Write(line)
+	}
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the first error encountered while writing, if any.
+func (s *JSONLinesSink) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close closes the underlying file, if the sink opened one, and returns
+// the first write or close error.
+func (s *JSONLinesSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return s.err
+	}
+	s.closed = true
+	if s.closer != nil {
+		if err := s.closer.Close(); err != nil && s.err == nil {
+			s.err = err
+		}
+	}
+	return s.err
+}
+
+// Verify interface compliance.
+var (
+	_ Sink = (*Buffer)(nil)
+	_ Sink = (*JSONLinesSink)(nil)
+)
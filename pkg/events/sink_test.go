@@ -0,0 +1,73 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONLinesSink_WritesCanonicalLines(t *testing.T) {
+	ts := time.Date(2025, 1, 15, 9, 0, 0, 0, time.FixedZone("X", 3600))
+	var sb strings.Builder
+	s := NewJSONLinesSink(&sb)
+
+	s.Emit(Event{Type: InvariantsChecked, Timestamp: ts, CircleID: "circle-1", Metadata: map[string]string{"z": "1", "a": "2"}})
+	s.Emit(Event{Type: InvariantsChecked, Timestamp: ts})
+
+	want := `{"type":"invariants.checked","timestamp":"2025-01-15T08:00:00Z","circle":"circle-1","metadata":{"a":"2","z":"1"}}` + "\n" +
+		`{"type":"invariants.checked","timestamp":"2025-01-15T08:00:00Z","circle":"","metadata":{}}` + "\n"
+	if sb.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", sb.String(), want)
+	}
+	if err := s.Close(); err != nil {
+		t.Errorf("close: %v", err)
+	}
+
+	// Dropped after close
+	s.Emit(Event{Type: InvariantsChecked, Timestamp: ts})
+	if sb.String() != want {
+		t.Error("expected no writes after close")
+	}
+}
+
+func TestOpenJSONLinesFile_Appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	ts := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		s, err := OpenJSONLinesFile(path)
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		s.Emit(Event{Type: InvariantsChecked, Timestamp: ts.Add(time.Duration(i) * time.Second)})
+		if err := s.Close(); err != nil {
+			t.Fatalf("close: %v", err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	defer f.Close()
+
+	var lines int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %d not JSON: %v", lines, err)
+		}
+		if line["type"] != string(InvariantsChecked) {
+			t.Errorf("line %d: unexpected type %v", lines, line["type"])
+		}
+		lines++
+	}
+	if lines != 2 {
+		t.Errorf("expected 2 lines across reopen, got %d", lines)
+	}
+}
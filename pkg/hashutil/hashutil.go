@@ -0,0 +1,68 @@
+// Package hashutil provides domain-separated SHA-256 hashing.
+//
+// Every hash is computed over a domain tag followed by length-prefixed
+// parts, so two record types with identical field values can never
+// produce the same hash, and part boundaries are unambiguous.
+//
+// Migration: records persisted before v1 carry a plain SHA-256 of their
+// canonical string, reproduced by Legacy. Stored hashes are never
+// rewritten; code that compares a persisted hash against a recomputed one
+// uses Matches, which accepts either encoding.
+//
+// CRITICAL: Uses only Go stdlib. No goroutines. No time.Now().
+package hashutil
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+)
+
+// prefix versions the encoding so it can change without silent collisions.
+const prefix = "QL_HASH|v1|"
+
+// Hash returns the hex SHA-256 of parts under the given domain tag.
+//
+// Encoding: prefix, len(domain), domain, then len(part), part for each
+// part. Lengths are 8-byte big-endian.
+func Hash(domain string, parts ...[]byte) string {
+	h := sha256.New()
+	var n [8]byte
+
+	h.Write([]byte(prefix))
+	binary.BigEndian.PutUint64(n[:], uint64(len(domain)))
+	h.Write(n[:])
+	h.Write([]byte(domain))
+
+	for _, p := range parts {
+		binary.BigEndian.PutUint64(n[:], uint64(len(p)))
+		h.Write(n[:])
+		h.Write(p)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// HashString is Hash over a single string part, typically a canonical string.
+func HashString(domain, canonical string) string {
+	return Hash(domain, []byte(canonical))
+}
+
+// Legacy returns the pre-v1 hash of canonical: the hex SHA-256 with no
+// prefix or domain tag.
+func Legacy(canonical string) string {
+	h := sha256.Sum256([]byte(canonical))
+	return hex.EncodeToString(h[:])
+}
+
+// Matches reports whether hash is the v1 or the legacy hash of canonical
+// under domain. A hash shorter than 64 hex chars is compared as a
+// truncated prefix, as stored by hashers that keep 128 bits.
+func Matches(domain, canonical, hash string) bool {
+	if hash == "" {
+		return false
+	}
+	return strings.HasPrefix(HashString(domain, canonical), hash) ||
+		strings.HasPrefix(Legacy(canonical), hash)
+}
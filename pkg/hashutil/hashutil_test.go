@@ -0,0 +1,53 @@
+package hashutil
+
+import "testing"
+
+func TestHash_DomainSeparation(t *testing.T) {
+	payload := []byte("v1|circle-1|a_few|2025-01-15")
+
+	receipt := Hash("test.Receipt", payload)
+	summary := Hash("test.Summary", payload)
+	if receipt == summary {
+		t.Fatal("identical payloads under different domains must not collide")
+	}
+	if receipt != Hash("test.Receipt", payload) {
+		t.Error("hash must be deterministic")
+	}
+	if len(receipt) != 64 {
+		t.Errorf("expected 64 hex chars, got %d", len(receipt))
+	}
+}
+
+func TestHash_PartBoundaries(t *testing.T) {
+	if Hash("d", []byte("ab"), []byte("c")) == Hash("d", []byte("a"), []byte("bc")) {
+		t.Error("different part boundaries must not collide")
+	}
+	if Hash("ab", []byte("c")) == Hash("a", []byte("bc")) {
+		t.Error("domain and part boundary must not collide")
+	}
+	if HashString("d", "x") != Hash("d", []byte("x")) {
+		t.Error("HashString must match Hash over one part")
+	}
+}
+
+func TestMatches_Legacy(t *testing.T) {
+	canonical := "SYNC_RECEIPT|v1|r-1|circle-1|gmail|a_few|1736931600|true|"
+
+	// Plain SHA-256 of canonical, as receipts were hashed before v1.
+	const legacy = "0041b4598dfb7deaeda5f0f073389515d3b0848718bce247879d263ca5fbbe4e"
+	if got := Legacy(canonical); got != legacy {
+		t.Fatalf("Legacy(%q) = %s, want %s", canonical, got, legacy)
+	}
+
+	current := HashString("persist.SyncReceipt", canonical)
+	for _, hash := range []string{current, legacy, current[:32], legacy[:32]} {
+		if !Matches("persist.SyncReceipt", canonical, hash) {
+			t.Errorf("expected %s to match", hash)
+		}
+	}
+	for _, hash := range []string{"", HashString("persist.Other", canonical), Legacy(canonical + "x")} {
+		if Matches("persist.SyncReceipt", canonical, hash) {
+			t.Errorf("expected %q not to match", hash)
+		}
+	}
+}
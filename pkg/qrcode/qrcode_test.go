@@ -0,0 +1,135 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomonKnownVector(t *testing.T) {
+	// Version 1-M "HELLO WORLD" data codewords and their 10 EC codewords.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	got := rsRemainder(data, rsDivisor(len(want)))
+	if !bytes.Equal(got, want) {
+		t.Errorf("expected EC codewords %v, got %v", want, got)
+	}
+}
+
+func TestEncodePicksSmallestVersion(t *testing.T) {
+	tests := []struct {
+		length  int
+		version int
+	}{
+		{1, 1},
+		{17, 1},
+		{18, 2},
+		{271, 10},
+		{858, 20},
+	}
+	for _, tt := range tests {
+		c, err := Encode(bytes.Repeat([]byte("a"), tt.length))
+		if err != nil {
+			t.Fatalf("Encode(%d bytes) failed: %v", tt.length, err)
+		}
+		if c.Version != tt.version || c.Size != 17+4*tt.version {
+			t.Errorf("%d bytes: expected version %d, got version %d size %d", tt.length, tt.version, c.Version, c.Size)
+		}
+	}
+
+	if _, err := Encode(bytes.Repeat([]byte("a"), 859)); !errors.Is(err, ErrTooLong) {
+		t.Errorf("expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestEncodeDrawsFunctionPatterns(t *testing.T) {
+	c, err := Encode([]byte("https://example.com/approve?t=abc"))
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	// Finder patterns: dark ring, light ring, dark 3x3 centre
+	for _, corner := range [][2]int{{0, 0}, {c.Size - 7, 0}, {0, c.Size - 7}} {
+		for dy := 0; dy < 7; dy++ {
+			for dx := 0; dx < 7; dx++ {
+				ring := maxInt(absInt(dx-3), absInt(dy-3))
+				want := ring != 2
+				if got := c.Dark(corner[0]+dx, corner[1]+dy); got != want {
+					t.Fatalf("finder at %v: module (%d,%d) expected dark=%v", corner, dx, dy, want)
+				}
+			}
+		}
+	}
+
+	// Timing patterns alternate, starting dark
+	for i := 8; i < c.Size-8; i++ {
+		if c.Dark(i, 6) != (i%2 == 0) || c.Dark(6, i) != (i%2 == 0) {
+			t.Fatalf("timing pattern broken at %d", i)
+		}
+	}
+
+	// Both format copies agree and carry level L
+	var first, second int
+	for i := 0; i <= 5; i++ {
+		first |= boolBit(c.Dark(8, i)) << i
+	}
+	first |= boolBit(c.Dark(8, 7))<<6 | boolBit(c.Dark(8, 8))<<7 | boolBit(c.Dark(7, 8))<<8
+	for i := 9; i < 15; i++ {
+		first |= boolBit(c.Dark(14-i, 8)) << i
+	}
+	for i := 0; i < 8; i++ {
+		second |= boolBit(c.Dark(c.Size-1-i, 8)) << i
+	}
+	for i := 8; i < 15; i++ {
+		second |= boolBit(c.Dark(8, c.Size-15+i)) << i
+	}
+	if first != second {
+		t.Errorf("format copies differ: %015b vs %015b", first, second)
+	}
+	if level := (first ^ 0x5412) >> 13; level != 1 {
+		t.Errorf("expected level L (01), got %02b", level)
+	}
+}
+
+func TestPNGDeterministic(t *testing.T) {
+	data := []byte("https://example.com/approve?t=" + strings.Repeat("x", 200))
+
+	c1, err := Encode(data)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	c2, _ := Encode(data)
+	p1, err := c1.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG failed: %v", err)
+	}
+	p2, _ := c2.PNG(4)
+	if !bytes.Equal(p1, p2) {
+		t.Fatal("same input produced different PNG bytes")
+	}
+
+	img, err := png.Decode(bytes.NewReader(p1))
+	if err != nil {
+		t.Fatalf("PNG does not decode: %v", err)
+	}
+	if want := (c1.Size + 2*QuietZone) * 4; img.Bounds().Dx() != want || img.Bounds().Dy() != want {
+		t.Errorf("expected %dx%d image, got %v", want, want, img.Bounds())
+	}
+	// Quiet zone is light, finder corner is dark
+	if r, _, _, _ := img.At(0, 0).RGBA(); r == 0 {
+		t.Error("expected light quiet zone")
+	}
+	if r, _, _, _ := img.At(QuietZone*4, QuietZone*4).RGBA(); r != 0 {
+		t.Error("expected dark finder corner")
+	}
+}
+
+func boolBit(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
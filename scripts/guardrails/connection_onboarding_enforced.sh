@@ -153,7 +153,7 @@ fi
 
 # Check 14: Start template exists
 echo "Checking start template exists..."
-if grep -q '{{define "start"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "start"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/start.html"; then
     echo -e "${GREEN}✓${NC} Start template exists"
 else
     echo -e "${RED}✗${NC} Start template missing"
@@ -162,7 +162,7 @@ fi
 
 # Check 15: Connections template exists
 echo "Checking connections template exists..."
-if grep -q '{{define "connections"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "connections"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/start.html"; then
     echo -e "${GREEN}✓${NC} Connections template exists"
 else
     echo -e "${RED}✗${NC} Connections template missing"
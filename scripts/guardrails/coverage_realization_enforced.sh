@@ -430,7 +430,7 @@ echo "Section 14: Proof page does not display pack IDs"
 echo "-----------------------------------------------------------"
 
 # Check that proof page template doesn't reference pack IDs
-if grep -A100 "coverage-proof-content" cmd/quantumlife-web/main.go 2>/dev/null | grep -E "pack_id|PackSlug|PackSlugHash" > /dev/null 2>&1; then
+if grep -A100 "coverage-proof-content" cmd/quantumlife-web/templates/coverage-proof.html 2>/dev/null | grep -E "pack_id|PackSlug|PackSlugHash" > /dev/null 2>&1; then
     fail "Pack ID reference found in coverage proof template"
 else
     pass "No pack ID references in coverage proof template"
@@ -658,13 +658,13 @@ fi
 section "Section 38: Template Content"
 # ============================================================================
 
-if grep -q 'define "enforcement-audit"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "enforcement-audit"' cmd/quantumlife-web/templates/enforcement-audit.html; then
     pass "enforcement-audit template defined"
 else
     fail "enforcement-audit template missing"
 fi
 
-if grep -q 'define "enforcement-audit-content"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "enforcement-audit-content"' cmd/quantumlife-web/templates/enforcement-audit.html; then
     pass "enforcement-audit-content template defined"
 else
     fail "enforcement-audit-content template missing"
@@ -114,7 +114,7 @@ fi
 
 # Check 10: Held template exists
 echo "Checking held template exists..."
-if grep -q '{{define "held"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "held"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/held.html"; then
     echo -e "${GREEN}✓${NC} Held template exists"
 else
     echo -e "${RED}✗${NC} Held template missing"
@@ -123,7 +123,7 @@ fi
 
 # Check 11: Held-content template exists
 echo "Checking held-content template exists..."
-if grep -q '{{define "held-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "held-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/held.html"; then
     echo -e "${GREEN}✓${NC} Held-content template exists"
 else
     echo -e "${RED}✗${NC} Held-content template missing"
@@ -133,7 +133,7 @@ fi
 # Check 12: No identifiers in held template
 echo "Checking no identifiers in held template..."
 # Extract the held-content template and check for forbidden patterns
-HELD_TEMPLATE=$(sed -n '/{{define "held-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/main.go")
+HELD_TEMPLATE=$(sed -n '/{{define "held-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/templates/held.html")
 FORBIDDEN_IDENTIFIERS="@|\\$|http://|https://|meeting|bill|appointment|invoice"
 if echo "$HELD_TEMPLATE" | grep -E "$FORBIDDEN_IDENTIFIERS" 2>/dev/null | grep -v 'HeldSummary'; then
     echo -e "${RED}✗${NC} Potential identifiers found in held template"
@@ -400,13 +400,13 @@ fi
 section "Section 24: Template Exists"
 # ============================================================================
 
-if grep -q 'define "held-proof"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "held-proof"' cmd/quantumlife-web/templates/held-proof.html; then
     pass "held-proof template defined"
 else
     fail "held-proof template missing"
 fi
 
-if grep -q 'define "held-proof-content"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "held-proof-content"' cmd/quantumlife-web/templates/held-proof.html; then
     pass "held-proof-content template defined"
 else
     fail "held-proof-content template missing"
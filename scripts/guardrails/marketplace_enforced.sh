@@ -185,7 +185,7 @@ check_file_not_contains "$PROJECT_ROOT/internal/marketplace/registry.go" "observ
 check_file_contains "$PROJECT_ROOT/pkg/domain/marketplace/types.go" "ObserverBindingDisplay" "ObserverBindingDisplay exists"
 
 # Template mentions intent-only
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "Intent Only" "Template mentions Intent Only"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" "Intent Only" "Template mentions Intent Only"
 
 # Engine BuildDetailPage uses binding displays
 check_file_contains "$PROJECT_ROOT/internal/marketplace/engine.go" "ObserverBindingDisplay" "Engine uses ObserverBindingDisplay"
@@ -236,7 +236,7 @@ check_file_contains "$PROJECT_ROOT/pkg/domain/marketplace/types.go" "pipe-delimi
 
 # SlugHash in UI
 check_file_contains "$PROJECT_ROOT/pkg/domain/marketplace/types.go" "SlugHash.*string" "PackCard has SlugHash"
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "SlugHash" "Template uses SlugHash"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" "SlugHash" "Template uses SlugHash"
 
 echo ""
 
@@ -306,9 +306,9 @@ check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" '/marketplace/in
 check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" '/marketplace/remove.*POST' "Remove route comment shows POST"
 
 # Templates use POST forms
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'method="POST" action="/marketplace/install"' "Install form uses POST"
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'method="POST" action="/marketplace/remove"' "Remove form uses POST"
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'method="POST" action="/proof/marketplace/dismiss"' "Dismiss form uses POST"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'method="POST" action="/marketplace/install"' "Install form uses POST"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'method="POST" action="/marketplace/remove"' "Remove form uses POST"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'method="POST" action="/proof/marketplace/dismiss"' "Dismiss form uses POST"
 
 # GET routes for read-only (check route registration)
 check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" '/marketplace.*handleMarketplaceHome' "Home handler registered"
@@ -383,9 +383,9 @@ check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "marketplaceEngi
 check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "marketplaceInstallStore" "Server has install store field"
 
 # Templates defined
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'define "marketplace-home"' "Home template defined"
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'define "marketplace-pack-detail"' "Detail template defined"
-check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'define "marketplace-proof"' "Proof template defined"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'define "marketplace-home"' "Home template defined"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'define "marketplace-pack-detail"' "Detail template defined"
+check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/templates/marketplace-home.html" 'define "marketplace-proof"' "Proof template defined"
 
 # Imports
 check_file_contains "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 'internalmarketplace "quantumlife/internal/marketplace"' "Internal marketplace import"
@@ -154,7 +154,7 @@ fi
 
 # Check 14: Mirror template exists
 echo "Checking mirror template exists..."
-if grep -q '{{define "mirror"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "mirror"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/gmail-disconnected.html"; then
     echo -e "${GREEN}✓${NC} Mirror template exists"
 else
     echo -e "${RED}✗${NC} Mirror template missing"
@@ -210,13 +210,13 @@ fi
 
 # Check 20: Link from connections to mirror exists
 echo "Checking link from connections to mirror..."
-if grep -q '/mirror' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" | grep -q 'connections'; then
+if grep -q '/mirror' "$PROJECT_ROOT/cmd/quantumlife-web/templates/start.html" | grep -q 'connections'; then
     echo -e "${GREEN}✓${NC} Link from connections to mirror exists"
-elif grep -q 'connections-mirror-link' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+elif grep -q 'connections-mirror-link' "$PROJECT_ROOT/cmd/quantumlife-web/templates/start.html"; then
     echo -e "${GREEN}✓${NC} Link from connections to mirror exists"
 else
     # Try another pattern
-    if grep -q 'What we noticed' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+    if grep -q 'What we noticed' "$PROJECT_ROOT/cmd/quantumlife-web/templates/start.html"; then
         echo -e "${GREEN}✓${NC} Link from connections to mirror exists"
     else
         echo -e "${RED}✗${NC} Link from connections to mirror missing"
@@ -441,8 +441,8 @@ echo ""
 # ============================================================================
 echo "Section 28: Template Checks"
 
-check "observer_settings template exists" grep -q 'define "observer_settings"' cmd/quantumlife-web/main.go
-check "observer_proof template exists" grep -q 'define "observer_proof"' cmd/quantumlife-web/main.go
+check "observer_settings template exists" grep -q 'define "observer_settings"' cmd/quantumlife-web/templates/observer.html
+check "observer_proof template exists" grep -q 'define "observer_proof"' cmd/quantumlife-web/templates/observer.html
 check "Settings template uses ObserverSettingsPage" grep -q "ObserverSettingsPage" cmd/quantumlife-web/main.go
 check "Proof template uses ObserverProofPage" grep -q "ObserverProofPage" cmd/quantumlife-web/main.go
 
@@ -117,7 +117,7 @@ fi
 
 # Check 10: Proof template exists
 echo "Checking proof template exists..."
-if grep -q '{{define "proof"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "proof"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/proof.html"; then
     echo -e "${GREEN}✓${NC} Proof template exists"
 else
     echo -e "${RED}✗${NC} Proof template missing"
@@ -126,7 +126,7 @@ fi
 
 # Check 11: Proof-content template exists
 echo "Checking proof-content template exists..."
-if grep -q '{{define "proof-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "proof-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/proof.html"; then
     echo -e "${GREEN}✓${NC} Proof-content template exists"
 else
     echo -e "${RED}✗${NC} Proof-content template missing"
@@ -135,7 +135,7 @@ fi
 
 # Check 12: No identifiers in proof template
 echo "Checking no identifiers in proof template..."
-PROOF_TEMPLATE=$(sed -n '/{{define "proof-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" | head -100)
+PROOF_TEMPLATE=$(sed -n '/{{define "proof-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/templates/proof.html" | head -100)
 FORBIDDEN_IDENTIFIERS="@|\\$|£|€|http://|https://|amazon|uber|netflix|spotify"
 if echo "$PROOF_TEMPLATE" | grep -iE "$FORBIDDEN_IDENTIFIERS" 2>/dev/null | grep -v 'ProofSummary'; then
     echo -e "${RED}✗${NC} Potential identifiers found in proof template"
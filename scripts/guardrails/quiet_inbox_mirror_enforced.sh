@@ -225,7 +225,7 @@ else
 fi
 
 # Check no action buttons in mirror template (look for form with action methods)
-if grep -A50 "quietMirrorTemplate" "${REPO_ROOT}/cmd/quantumlife-web/main.go" | grep -q '<button\|<input type="submit"\|action="/'; then
+if grep -q '<button\|<input type="submit"\|action="/' "${REPO_ROOT}/cmd/quantumlife-web/templates/quiet-mirror.html"; then
     check "No action buttons in mirror template" "fail"
 else
     check "No action buttons in mirror template" "pass"
@@ -109,7 +109,7 @@ fi
 
 # Check 9: Surface template exists
 echo "Checking surface template exists..."
-if grep -q '{{define "surface"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "surface"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/surface.html"; then
     echo -e "${GREEN}✓${NC} Surface template exists"
 else
     echo -e "${RED}✗${NC} Surface template missing"
@@ -118,7 +118,7 @@ fi
 
 # Check 10: Surface-content template exists
 echo "Checking surface-content template exists..."
-if grep -q '{{define "surface-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "surface-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/surface.html"; then
     echo -e "${GREEN}✓${NC} Surface-content template exists"
 else
     echo -e "${RED}✗${NC} Surface-content template missing"
@@ -127,7 +127,7 @@ fi
 
 # Check 11: No identifiers in surface template
 echo "Checking no identifiers in surface template..."
-SURFACE_TEMPLATE=$(sed -n '/{{define "surface-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" | head -100)
+SURFACE_TEMPLATE=$(sed -n '/{{define "surface-content"}}/,/{{end}}/p' "$PROJECT_ROOT/cmd/quantumlife-web/templates/surface.html" | head -100)
 FORBIDDEN_IDENTIFIERS="@|\\\$|http://|https://|amazon|uber|netflix|spotify"
 if echo "$SURFACE_TEMPLATE" | grep -iE "$FORBIDDEN_IDENTIFIERS" 2>/dev/null | grep -v 'SurfacePage'; then
     echo -e "${RED}✗${NC} Potential identifiers found in surface template"
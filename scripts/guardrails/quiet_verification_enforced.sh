@@ -41,7 +41,7 @@ fi
 
 # Check 2: Gmail consent template has restraint language
 echo "Checking Gmail consent template has restraint language..."
-if grep -q 'Read-only\. Revocable\. Nothing stored\.' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q 'Read-only\. Revocable\. Nothing stored\.' "$PROJECT_ROOT/cmd/quantumlife-web/templates/gmail-connect.html"; then
     echo -e "${GREEN}✓${NC} Gmail consent has restraint tagline"
 else
     echo -e "${RED}✗${NC} Gmail consent missing restraint tagline"
@@ -50,7 +50,7 @@ fi
 
 # Check 3: Gmail consent template explains what is NOT stored
 echo "Checking Gmail consent explains what is NOT stored..."
-if grep -q 'Not:.*email bodies\|Not:.*attachments\|Not:.*subject lines' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q 'Not:.*email bodies\|Not:.*attachments\|Not:.*subject lines' "$PROJECT_ROOT/cmd/quantumlife-web/templates/gmail-connect.html"; then
     echo -e "${GREEN}✓${NC} Gmail consent explains what is NOT stored"
 else
     echo -e "${RED}✗${NC} Gmail consent missing 'Not stored' explanation"
@@ -183,7 +183,7 @@ fi
 
 # Check 17: Disconnect confirmation template exists
 echo "Checking disconnect confirmation template..."
-if grep -q 'gmail-disconnected\|Nothing further is read' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q 'gmail-disconnected\|Nothing further is read' "$PROJECT_ROOT/cmd/quantumlife-web/templates/gmail-disconnected.html"; then
     echo -e "${GREEN}✓${NC} Disconnect confirmation template exists"
 else
     echo -e "${RED}✗${NC} Disconnect confirmation template missing"
@@ -39,12 +39,13 @@ else
     FAILED=1
 fi
 
-# Check 2: Sync handler enforces 7 day limit
-echo "Checking sync handler enforces 7 day limit..."
-if grep -q 'syncDays = 7' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
-    echo -e "${GREEN}✓${NC} Sync handler enforces 7 day limit"
+# Check 2: Sync handler enforces 7 day default limit (clamped lookback)
+echo "Checking sync handler enforces 7 day default limit..."
+if grep -q 'DefaultSyncLookbackDays = 7' "$PROJECT_ROOT/pkg/domain/config/types.go" && \
+   grep -q 'EffectiveLookbackDays(connection.KindEmail)' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+    echo -e "${GREEN}✓${NC} Sync handler enforces 7 day default limit"
 else
-    echo -e "${RED}✗${NC} Sync handler does not enforce 7 day limit"
+    echo -e "${RED}✗${NC} Sync handler does not enforce 7 day default limit"
     FAILED=1
 fi
 
@@ -105,7 +106,7 @@ fi
 
 # Check 9: Templates don't render Subject field
 echo "Checking templates don't render Subject field..."
-if grep -rn '\.Subject' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 2>/dev/null | grep 'template\|{{' | grep -v '^[^:]*:[0-9]*:\s*//'; then
+if grep -rn '\.Subject' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "$PROJECT_ROOT/cmd/quantumlife-web/templates" 2>/dev/null | grep 'template\|{{' | grep -v '^[^:]*:[0-9]*:\s*//'; then
     echo -e "${RED}✗${NC} Templates render Subject field"
     FAILED=1
 else
@@ -114,7 +115,7 @@ fi
 
 # Check 10: Templates don't render Body field
 echo "Checking templates don't render Body field..."
-if grep -rn '\.Body\>' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" 2>/dev/null | grep 'template\|{{' | grep -v '^[^:]*:[0-9]*:\s*//'; then
+if grep -rn '\.Body\>' "$PROJECT_ROOT/cmd/quantumlife-web/main.go" "$PROJECT_ROOT/cmd/quantumlife-web/templates" 2>/dev/null | grep 'template\|{{' | grep -v '^[^:]*:[0-9]*:\s*//'; then
     echo -e "${RED}✗${NC} Templates render Body field"
     FAILED=1
 else
@@ -186,7 +187,7 @@ fi
 
 # Check 18: Quiet check template exists
 echo "Checking quiet check template exists..."
-if grep -q 'quiet-check-content' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q 'quiet-check-content' "$PROJECT_ROOT/cmd/quantumlife-web/templates/quiet-check.html"; then
     echo -e "${GREEN}✓${NC} Quiet check template exists"
 else
     echo -e "${RED}✗${NC} Quiet check template missing"
@@ -360,9 +360,9 @@ fi
 # Check 19: Navigation link exists
 # =============================================================================
 check "Link from shadow report to candidates"
-if ! grep -q '/shadow/candidates' cmd/quantumlife-web/main.go | grep -q 'View candidates'; then
+if ! grep -q '/shadow/candidates' cmd/quantumlife-web/templates/shadow-report.html | grep -q 'View candidates'; then
     # Check in a different way
-    if ! grep 'View candidates' cmd/quantumlife-web/main.go > /dev/null 2>&1; then
+    if ! grep 'View candidates' cmd/quantumlife-web/templates/shadow-report.html > /dev/null 2>&1; then
         error "Navigation link to /shadow/candidates not found"
     fi
 fi
@@ -269,7 +269,7 @@ check "WindowsProofPage struct exists" "grep -q 'type WindowsProofPage struct' p
 check "BuildWindowsProofPage function" "grep -q 'func BuildWindowsProofPage' pkg/domain/timewindow/types.go"
 check "GetOverallMagnitude function" "grep -q 'func (r \*TimeWindowBuildResult) GetOverallMagnitude()' pkg/domain/timewindow/types.go"
 check "GetSourceChips function" "grep -q 'func (r \*TimeWindowBuildResult) GetSourceChips()' pkg/domain/timewindow/types.go"
-check "No details stored text" "grep -q 'No details.*stored' cmd/quantumlife-web/main.go"
+check "No details stored text" "grep -q 'No details.*stored' cmd/quantumlife-web/templates/time-windows.html"
 echo ""
 
 echo ""
@@ -95,7 +95,7 @@ fi
 
 # Check 8: Today template exists
 echo "Checking today template exists..."
-if grep -q '{{define "today"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "today"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/today.html"; then
     echo -e "${GREEN}✓${NC} Today template exists"
 else
     echo -e "${RED}✗${NC} Today template missing"
@@ -104,7 +104,7 @@ fi
 
 # Check 9: Today-content template exists
 echo "Checking today-content template exists..."
-if grep -q '{{define "today-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/main.go"; then
+if grep -q '{{define "today-content"}}' "$PROJECT_ROOT/cmd/quantumlife-web/templates/today.html"; then
     echo -e "${GREEN}✓${NC} Today-content template exists"
 else
     echo -e "${RED}✗${NC} Today-content template missing"
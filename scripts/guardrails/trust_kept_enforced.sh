@@ -76,7 +76,7 @@ echo
 echo "--- 5. Undo window (15 minutes) ---"
 check "UndoBucket type exists" grep -q 'type UndoBucket struct' "$ROOT_DIR/pkg/domain/trustaction/types.go"
 check "BucketDurationMinutes field" grep -q 'BucketDurationMinutes.*int' "$ROOT_DIR/pkg/domain/trustaction/types.go"
-check "15 minute default" grep -q 'BucketDurationMinutes:.*15\|BucketDurationMinutes = 15' "$ROOT_DIR/pkg/domain/trustaction/types.go"
+check "15 minute default" grep -q 'Default = 15 \* time.Minute' "$ROOT_DIR/pkg/domain/undowindow/types.go"
 check "IsExpired method" grep -q 'func.*IsExpired' "$ROOT_DIR/pkg/domain/trustaction/types.go"
 
 echo
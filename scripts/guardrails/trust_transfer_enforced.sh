@@ -508,25 +508,25 @@ fi
 section "Section 30: Template Content"
 # ============================================================================
 
-if grep -q 'define "trust-transfer"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "trust-transfer"' cmd/quantumlife-web/templates/trust-transfer.html; then
     pass "trust-transfer template defined"
 else
     fail "trust-transfer template missing"
 fi
 
-if grep -q 'define "trust-transfer-content"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "trust-transfer-content"' cmd/quantumlife-web/templates/trust-transfer.html; then
     pass "trust-transfer-content template defined"
 else
     fail "trust-transfer-content template missing"
 fi
 
-if grep -q 'define "trust-transfer-proof"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "trust-transfer-proof"' cmd/quantumlife-web/templates/trust-transfer.html; then
     pass "trust-transfer-proof template defined"
 else
     fail "trust-transfer-proof template missing"
 fi
 
-if grep -q 'define "trust-transfer-proof-content"' cmd/quantumlife-web/main.go; then
+if grep -q 'define "trust-transfer-proof-content"' cmd/quantumlife-web/templates/trust-transfer.html; then
     pass "trust-transfer-proof-content template defined"
 else
     fail "trust-transfer-proof-content template missing"
@@ -2,7 +2,8 @@
 # Guardrails for Phase 25: First Undoable Execution (Opt-In, Single-Shot)
 #
 # CRITICAL INVARIANTS:
-#   - ONLY calendar_respond is allowed (no email, no finance)
+#   - ONLY calendar_respond and email_scheduled_send are allowed (no finance)
+#   - Email is undoable only as a scheduled send the provider can hold
 #   - Single-shot per period (max one execution per day)
 #   - Undo window is bucketed time (15-minute buckets)
 #   - Undo is first-class flow, not "best effort"
@@ -61,11 +62,14 @@ echo "--- Domain Model Checks ---"
 check "UndoableActionKind type exists" \
     "grep -q 'type UndoableActionKind string' pkg/domain/undoableexec/types.go"
 
-check "Only calendar_respond action kind defined" \
+check "calendar_respond action kind defined" \
     "grep -q 'ActionKindCalendarRespond.*calendar_respond' pkg/domain/undoableexec/types.go"
 
-check_not "No email action kind defined" \
-    "grep -q 'ActionKindEmail' pkg/domain/undoableexec/types.go"
+check "Email action kind is scheduled send only" \
+    "grep -q 'ActionKindEmailScheduledSend.*email_scheduled_send' pkg/domain/undoableexec/types.go"
+
+check_not "No immediate email send action kind defined" \
+    "grep -qE 'ActionKindEmail(Send|Reply)\b' pkg/domain/undoableexec/types.go"
 
 check_not "No finance action kind defined" \
     "grep -q 'ActionKindFinance' pkg/domain/undoableexec/types.go"
@@ -131,6 +135,15 @@ check "Uses calendar execution boundary" \
 check "Uses calendar/execution package" \
     "grep -q 'calendar/execution' internal/undoableexec/engine.go"
 
+check "Email offered only when the provider can schedule" \
+    "grep -q 'CanSchedule' internal/undoableexec/engine.go"
+
+check "Email uses the scheduled send boundary" \
+    "grep -q 'ExecuteScheduled' internal/undoableexec/engine.go"
+
+check_not "No immediate email send in engine" \
+    "grep -qE 'emailExecutor\.Execute\(' internal/undoableexec/engine.go"
+
 # =============================================================================
 # Persistence Checks
 # =============================================================================